@@ -7,11 +7,15 @@ import (
 	"github.com/spf13/afero"
 	"github.com/surahman/mcq-platform/pkg/auth"
 	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/catalogue"
 	"github.com/surahman/mcq-platform/pkg/grading"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
 	"github.com/surahman/mcq-platform/pkg/http/graph"
 	"github.com/surahman/mcq-platform/pkg/http/rest"
+	"github.com/surahman/mcq-platform/pkg/limits"
 	"github.com/surahman/mcq-platform/pkg/logger"
 	"github.com/surahman/mcq-platform/pkg/redis"
+	"github.com/surahman/mcq-platform/pkg/scheduler"
 	_ "go.uber.org/automaxprocs"
 	"go.uber.org/zap"
 )
@@ -25,6 +29,8 @@ func main() {
 		authorization auth.Auth
 		database      cassandra.Cassandra
 		cache         redis.Redis
+		quizCatalogue catalogue.Catalogue
+		quizScheduler *scheduler.Scheduler
 		grader        = grading.NewGrading()
 		waitGroup     sync.WaitGroup
 	)
@@ -43,6 +49,11 @@ func main() {
 		logging.Panic("failed to configure authorization module", zap.Error(err))
 	}
 
+	// Quiz and response size limits setup. The limits are enforced by the validator and grader.
+	if _, err = limits.NewLimits(&fs, logging); err != nil {
+		logging.Panic("failed to configure quiz and response limits", zap.Error(err))
+	}
+
 	// Cassandra setup.
 	if database, err = cassandra.NewCassandra(&fs, logging); err != nil {
 		logging.Panic("failed to configure Cassandra module", zap.Error(err))
@@ -56,6 +67,11 @@ func main() {
 		}
 	}(database)
 
+	// Bootstrap the administrator account, if configured.
+	if err = http_common.BootstrapAdmin(authorization, database); err != nil {
+		logging.Panic("failed to bootstrap the administrator account", zap.Error(err))
+	}
+
 	// Cache setup
 	if cache, err = redis.NewRedis(&fs, logging); err != nil {
 		logging.Panic("failed to configure Redis module", zap.Error(err))
@@ -69,16 +85,31 @@ func main() {
 		}
 	}(cache)
 
+	// Quiz catalogue setup. The index is rebuilt from the published quizzes in the database.
+	if quizCatalogue, err = catalogue.NewCatalogue(database, logging); err != nil {
+		logging.Panic("failed to configure quiz catalogue", zap.Error(err))
+	}
+	if err = quizCatalogue.Load(); err != nil {
+		logging.Panic("failed to load the quiz catalogue", zap.Error(err))
+	}
+
+	// Setup quiz scheduler and start it.
+	waitGroup.Add(1)
+	if quizScheduler, err = scheduler.NewScheduler(&fs, database, cache, quizCatalogue, logging, &waitGroup); err != nil {
+		logging.Panic("failed to create the quiz scheduler", zap.Error(err))
+	}
+	go quizScheduler.Run()
+
 	// Setup REST server and start it.
 	waitGroup.Add(1)
-	if serverREST, err = rest.NewServer(&fs, authorization, database, cache, grader, logging, &waitGroup); err != nil {
+	if serverREST, err = rest.NewServer(&fs, authorization, database, cache, quizCatalogue, grader, logging, &waitGroup); err != nil {
 		logging.Panic("failed to create the REST server", zap.Error(err))
 	}
 	go serverREST.Run()
 
 	// Setup GraphQL server and start it.
 	waitGroup.Add(1)
-	if serverGraphQL, err = graphql.NewServer(&fs, authorization, database, cache, grader, logging, &waitGroup); err != nil {
+	if serverGraphQL, err = graphql.NewServer(&fs, authorization, database, cache, quizCatalogue, grader, logging, &waitGroup); err != nil {
 		logging.Panic("failed to create the GraphQL server", zap.Error(err))
 	}
 	go serverGraphQL.Run()
@@ -30,41 +30,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/health": {
-            "get": {
-                "description": "This endpoint is exposed to allow load balancers etc. to check the health of the service.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "health healthcheck liveness"
-                ],
-                "summary": "Healthcheck for service liveness.",
-                "operationId": "healthcheck",
-                "responses": {
-                    "200": {
-                        "description": "message: healthy",
-                        "schema": {
-                            "$ref": "#/definitions/model_http.Success"
-                        }
-                    },
-                    "503": {
-                        "description": "error message with any available details",
-                        "schema": {
-                            "$ref": "#/definitions/model_http.Error"
-                        }
-                    }
-                }
-            }
-        },
-        "/quiz/create/": {
-            "post": {
+        "/admin/role": {
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint will create a quiz with randomly generated Test ID and associate it with the requester.\nThe username will be extracted from the JWT and associated with the Test ID.",
+                "description": "Changes the role of a user to admin, instructor, or student. The new role is carried by the user's JWT once they next login or refresh their JWT.",
                 "consumes": [
                     "application/json"
                 ],
@@ -72,42 +45,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "create test quiz"
+                    "user users admin role security"
                 ],
-                "summary": "Create a quiz.",
-                "operationId": "createQuiz",
+                "summary": "Changes the role of a user. The requester must be an administrator.",
+                "operationId": "setUserRole",
                 "parameters": [
                     {
-                        "description": "The Quiz to be created as unpublished",
-                        "name": "quiz",
+                        "description": "The username and the role to assign to the user",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_cassandra.QuizCore"
+                            "$ref": "#/definitions/model_cassandra.UserRoleRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "The message will contain the Quiz ID of the newly generated quiz",
+                        "description": "message with a confirmation of the changed role",
                         "schema": {
                             "$ref": "#/definitions/model_http.Success"
                         }
                     },
                     "400": {
-                        "description": "Error message with any available details in payload",
+                        "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/model_http.Error"
                         }
                     },
-                    "409": {
-                        "description": "Error message with any available details in payload",
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/model_http.Error"
                         }
                     },
                     "500": {
-                        "description": "Error message with any available details in payload",
+                        "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/model_http.Error"
                         }
@@ -115,46 +94,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/quiz/delete/{quiz_id}": {
+        "/admin/user/{username}": {
             "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint will mark a quiz as delete if it was created by the requester. The provided Test ID is provided is a path parameter.",
+                "description": "Deletes any user stored in the database by marking it as deleted.\nAdministrators cannot delete their own account or the configured administrator's account.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "delete remove test quiz"
+                    "user users admin delete security"
                 ],
-                "summary": "Delete a quiz.",
-                "operationId": "deleteQuiz",
+                "summary": "Deletes any user. The requester must be an administrator.",
+                "operationId": "deleteAnyUser",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The Test ID for the quiz being deleted.",
-                        "name": "quiz_id",
+                        "description": "The username of the account to delete.",
+                        "name": "username",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "The message will contain a confirmation of deletion",
+                        "description": "message with a confirmation of a deleted user account",
                         "schema": {
                             "$ref": "#/definitions/model_http.Success"
                         }
                     },
                     "403": {
-                        "description": "Error message with any available details in payload",
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/model_http.Error"
                         }
                     },
                     "500": {
-                        "description": "Error message with any available details in payload",
+                        "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/model_http.Error"
                         }
@@ -162,39 +147,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/quiz/publish/{quiz_id}": {
-            "patch": {
+        "/bank/create/": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "When a quiz is submitted it is not published by default and is thus unavailable to be taken.\nThis endpoint will publish a quiz with the provided Test ID if it was created by the requester.",
+                "description": "This endpoint will create a question bank with a randomly generated Bank ID and associate it with the requester. The requester must be an instructor or an administrator.\nQuizzes by the same author can draw their questions at random from the question bank.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "publish test quiz create"
+                    "create question bank"
                 ],
-                "summary": "Publish a quiz.",
-                "operationId": "publishQuiz",
+                "summary": "Create a question bank.",
+                "operationId": "createQuestionBank",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "The Test ID for the quiz being published.",
-                        "name": "quiz_id",
-                        "in": "path",
-                        "required": true
+                        "description": "The question bank to be created",
+                        "name": "bank",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_cassandra.QuestionBankCore"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "The message will contain a confirmation of publishing",
+                        "description": "The message will contain the Bank ID of the newly generated question bank",
                         "schema": {
                             "$ref": "#/definitions/model_http.Success"
                         }
                     },
-                    "403": {
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "409": {
                         "description": "Error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/model_http.Error"
@@ -209,46 +205,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/quiz/take/{quiz_id}": {
-            "post": {
+        "/bank/delete/{bank_id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Take a quiz by submitting an answer sheet. The username will be extracted from the JWT and associated with the scorecard.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This endpoint will mark a question bank as deleted if it was created by the requester. The Bank ID is provided as a path parameter.\nQuestions are no longer drawn from deleted question banks. Attempts that have already been viewed keep the questions that were drawn for them.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "take test quiz submit answer"
+                    "delete remove question bank"
                 ],
-                "summary": "Take a quiz.",
-                "operationId": "takeQuiz",
+                "summary": "Delete a question bank.",
+                "operationId": "deleteQuestionBank",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The Test ID for the answers being submitted.",
-                        "name": "quiz_id",
+                        "description": "The Bank ID for the question bank being deleted.",
+                        "name": "bank_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "The answer card to be submitted.",
-                        "name": "answers",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model_cassandra.QuizResponse"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Score will be in the payload",
+                        "description": "The message will contain a confirmation of deletion",
                         "schema": {
                             "$ref": "#/definitions/model_http.Success"
                         }
@@ -274,14 +258,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/quiz/update/{quiz_id}": {
+        "/bank/update/{bank_id}": {
             "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint will update a quiz with the provided Test ID if it was created by the requester and is not published.",
+                "description": "This endpoint will update a question bank with the provided Bank ID if it was created by the requester and is not deleted.\nAttempts that have already been viewed keep the questions that were drawn for them.",
                 "consumes": [
                     "application/json"
                 ],
@@ -289,25 +273,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "update modify test quiz"
+                    "update modify question bank"
                 ],
-                "summary": "Update a quiz.",
-                "operationId": "updateQuiz",
+                "summary": "Update a question bank.",
+                "operationId": "updateQuestionBank",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The Test ID for the quiz being updated.",
-                        "name": "quiz_id",
+                        "description": "The Bank ID for the question bank being updated.",
+                        "name": "bank_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "The Quiz to replace the one already submitted",
-                        "name": "quiz",
+                        "description": "The question bank to replace the one already submitted",
+                        "name": "bank",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_cassandra.QuizCore"
+                            "$ref": "#/definitions/model_cassandra.QuestionBankCore"
                         }
                     }
                 ],
@@ -339,38 +323,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/quiz/view/{quiz_id}": {
+        "/bank/view/{bank_id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint will retrieve a quiz with a provided quiz ID if it is published.",
+                "description": "This endpoint will retrieve a question bank with a provided Bank ID if it was created by the requester and is not deleted.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "view test quiz"
+                    "view question bank"
                 ],
-                "summary": "View a quiz.",
-                "operationId": "viewQuiz",
+                "summary": "View a question bank.",
+                "operationId": "viewQuestionBank",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The quiz ID for the quiz being requested.",
-                        "name": "quiz_id",
+                        "description": "The Bank ID for the question bank being requested.",
+                        "name": "bank_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "The message will contain the quiz ID and the payload will contain the quiz",
+                        "description": "The message will contain the Bank ID and the payload will contain the question bank",
                         "schema": {
                             "$ref": "#/definitions/model_http.Success"
                         }
                     },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
                     "403": {
                         "description": "Error message with any available details in payload",
                         "schema": {
@@ -392,48 +382,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/score/stats-paged/{quiz_id}": {
-            "get": {
+        "/group/accept/{group_id}": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Gets the paginated statistics associated with a specific test if the user created the test.\nExtracts username from the JWT and the Test ID is provided as a query parameter.\nA query string to be appended to the next request to retrieve the next page of data will be returned in the response.",
+                "description": "This endpoint will add the requester to a class or cohort with the provided Group ID if they have been invited to join it.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "score scores stats statistics"
+                    "accept invitations join group class cohort"
                 ],
-                "summary": "Get paginated statistics associated with a specific test.",
-                "operationId": "getStatsPaged",
+                "summary": "Accept a group invitation.",
+                "operationId": "acceptGroupInvitation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The Test ID for the requested statistics.",
-                        "name": "quiz_id",
+                        "description": "The Group ID for the group the invitation is to.",
+                        "name": "group_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "The page cursor into the query results records.",
-                        "name": "pageCursor",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "The number of records to retrieve on this page.",
-                        "name": "pageSize",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "A page of statistics data",
+                        "description": "The message will contain a confirmation of joining",
                         "schema": {
-                            "$ref": "#/definitions/model_http.StatsResponse"
+                            "$ref": "#/definitions/model_http.Success"
                         }
                     },
                     "400": {
@@ -442,13 +420,13 @@ const docTemplate = `{
                             "$ref": "#/definitions/model_http.Error"
                         }
                     },
-                    "403": {
+                    "404": {
                         "description": "Error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/model_http.Error"
                         }
                     },
-                    "404": {
+                    "409": {
                         "description": "Error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/model_http.Error"
@@ -463,26 +441,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/score/stats/{quiz_id}": {
-            "get": {
+        "/group/assign/{group_id}/{quiz_id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Gets the statistics associated with a specific test if the user created the test.\nExtracts username from the JWT and the Test ID is provided as a path parameter.",
+                "description": "This endpoint will unassign a quiz with the provided Test ID from a class or cohort with the provided Group ID. The requester must be an owner of the group and manage the quiz.\nPublished quizzes that are no longer assigned to any group are listed in the catalogue and may be viewed and taken by all users.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "score scores stats statistics"
+                    "unassign test quiz group class cohort"
                 ],
-                "summary": "Get all statistics associated with a specific test.",
-                "operationId": "getStats",
+                "summary": "Unassign a quiz from a group.",
+                "operationId": "unassignQuiz",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The Test ID for the requested statistics.",
+                        "description": "The Group ID for the group the quiz is being unassigned from.",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the quiz being unassigned.",
                         "name": "quiz_id",
                         "in": "path",
                         "required": true
@@ -490,7 +475,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Statistics will be in the payload",
+                        "description": "The message will contain a confirmation of the unassignment",
                         "schema": {
                             "$ref": "#/definitions/model_http.Success"
                         }
@@ -520,28 +505,33 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/score/test/{quiz_id}": {
-            "get": {
+            },
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Gets a scorecard for a user. Extracts username from the JWT and Test ID is provided as a path parameter.",
+                "description": "This endpoint will assign a quiz with the provided Test ID to a class or cohort with the provided Group ID. The requester must be an owner of the group and manage the quiz.\nQuizzes assigned to groups are removed from the catalogue and may only be viewed and taken by members of at least one of the groups they are assigned to.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "score scores"
+                    "assign test quiz group class cohort"
                 ],
-                "summary": "Get a user's score.",
-                "operationId": "getScore",
+                "summary": "Assign a quiz to a group.",
+                "operationId": "assignQuiz",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The Test ID for the requested scorecard.",
+                        "description": "The Group ID for the group the quiz is being assigned to.",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the quiz being assigned.",
                         "name": "quiz_id",
                         "in": "path",
                         "required": true
@@ -549,7 +539,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Score will be in the payload",
+                        "description": "The message will contain a confirmation of the assignment",
                         "schema": {
                             "$ref": "#/definitions/model_http.Success"
                         }
@@ -560,6 +550,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/model_http.Error"
                         }
                     },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
                     "404": {
                         "description": "Error message with any available details in payload",
                         "schema": {
@@ -575,14 +571,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/delete": {
-            "delete": {
+        "/group/create": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Deletes a user stored in the database by marking it as deleted. The user must supply their login credentials as well as complete the following confirmation message: \"I understand the consequences, delete my user account USERNAME HERE\"",
+                "description": "This endpoint will create a class or cohort with a randomly generated Group ID and join code. The requester must be an instructor or an administrator.\nThe requester is the creator and the first owner of the group.",
                 "consumes": [
                     "application/json"
                 ],
@@ -590,42 +586,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user users delete security"
+                    "create group class cohort"
                 ],
-                "summary": "Deletes a user. The user must supply their credentials as well as a confirmation message.",
-                "operationId": "deleteUser",
+                "summary": "Create a group.",
+                "operationId": "createGroup",
                 "parameters": [
                     {
-                        "description": "The request payload for deleting an account",
-                        "name": "request",
+                        "description": "The name and description of the group to be created",
+                        "name": "group",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_http.DeleteUserRequest"
+                            "$ref": "#/definitions/model_cassandra.GroupCore"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "message with a confirmation of a deleted user account",
+                        "description": "The message will contain the Group ID and the payload will contain the group",
                         "schema": {
                             "$ref": "#/definitions/model_http.Success"
                         }
                     },
                     "400": {
-                        "description": "error message with any available details in payload",
+                        "description": "Error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/model_http.Error"
                         }
                     },
                     "409": {
-                        "description": "error message with any available details in payload",
+                        "description": "Error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/model_http.Error"
                         }
                     },
                     "500": {
-                        "description": "error message with any available details in payload",
+                        "description": "Error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/model_http.Error"
                         }
@@ -633,52 +629,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/login": {
-            "post": {
-                "description": "Logs in a user by validating credentials and returning a JWT.",
-                "consumes": [
-                    "application/json"
+        "/group/decline/{group_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "This endpoint will remove the requester's invitation to join a class or cohort with the provided Group ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user users login security"
+                    "decline remove invitations group class cohort"
                 ],
-                "summary": "Login a user.",
-                "operationId": "loginUser",
+                "summary": "Decline a group invitation.",
+                "operationId": "declineGroupInvitation",
                 "parameters": [
                     {
-                        "description": "Username and password to login with",
-                        "name": "credentials",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model_cassandra.UserLoginCredentials"
-                        }
+                        "type": "string",
+                        "description": "The Group ID for the group the invitation is to.",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "JWT in the api-key",
+                        "description": "The message will contain a confirmation of declining",
                         "schema": {
-                            "$ref": "#/definitions/model_http.JWTAuthResponse"
+                            "$ref": "#/definitions/model_http.Success"
                         }
                     },
                     "400": {
-                        "description": "error message with any available details in payload",
+                        "description": "Error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/model_http.Error"
                         }
                     },
-                    "403": {
-                        "description": "error message with any available details in payload",
+                    "404": {
+                        "description": "Error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/model_http.Error"
                         }
                     },
                     "500": {
-                        "description": "error message with any available details in payload",
+                        "description": "Error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/model_http.Error"
                         }
@@ -686,8 +682,2592 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/refresh": {
-            "post": {
+        "/group/delete/{group_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will mark a class or cohort with the provided Group ID as deleted and remove its members if the requester is an owner.\nQuizzes must be unassigned from the group before it can be deleted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "delete remove group class cohort"
+                ],
+                "summary": "Delete a group.",
+                "operationId": "deleteGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Group ID for the group being deleted.",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain a confirmation of deletion",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/group/invitations": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will retrieve the pending invitations for the requester to join classes and cohorts.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "list invitations group class cohort"
+                ],
+                "summary": "List my group invitations.",
+                "operationId": "listGroupInvitations",
+                "responses": {
+                    "200": {
+                        "description": "The payload will contain the pending invitations",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/group/invite/{group_id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will invite a user to join a class or cohort with the provided Group ID if the requester is an owner.\nUsers may be invited as owners. Inviting a user again will replace their pending invitation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invite members group class cohort"
+                ],
+                "summary": "Invite a user to a group.",
+                "operationId": "inviteToGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Group ID for the group the user is being invited to.",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The user being invited",
+                        "name": "invitation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_cassandra.GroupInviteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain a confirmation of the invitation",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/group/join-code/{group_id}": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will replace the join code of a class or cohort with the provided Group ID if the requester is an owner. The previous join code can no longer be used.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reset join code group class cohort"
+                ],
+                "summary": "Reset the join code of a group.",
+                "operationId": "resetGroupJoinCode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Group ID for the group whose join code is being replaced.",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain the Group ID and the payload will contain the new join code",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/group/join/{join_code}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will add the requester as a member of the class or cohort with the provided join code. Join codes are not case-sensitive.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "join members group class cohort"
+                ],
+                "summary": "Join a group.",
+                "operationId": "joinGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The join code for the group being joined.",
+                        "name": "join_code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain a confirmation of joining and the payload will contain the Group ID",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/group/members/{group_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will retrieve the members and owners of a class or cohort with the provided Group ID if the requester is an owner.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "list members group class cohort"
+                ],
+                "summary": "List the members of a group.",
+                "operationId": "listGroupMembers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Group ID for the group whose members are being requested.",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain the Group ID and the payload will contain the members",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/group/members/{group_id}/{username}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will remove a member from a class or cohort with the provided Group ID if the requester is an owner.\nMembers may remove themselves to leave the group. The creator of the group cannot be removed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "remove delete leave members group class cohort"
+                ],
+                "summary": "Remove a member from a group.",
+                "operationId": "removeGroupMember",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Group ID for the group the member is being removed from.",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The username of the member being removed.",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain a confirmation of removal",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/group/mine": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will retrieve the classes and cohorts the requester is a member or an owner of.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "list my groups class cohort"
+                ],
+                "summary": "List my groups.",
+                "operationId": "listMyGroups",
+                "responses": {
+                    "200": {
+                        "description": "The payload will contain the groups",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/group/quizzes/{group_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will retrieve the quizzes assigned to a class or cohort with the provided Group ID if the requester is a member.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "list test quiz group class cohort"
+                ],
+                "summary": "List the quizzes assigned to a group.",
+                "operationId": "listGroupQuizzes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Group ID for the group whose quizzes are being requested.",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain the Group ID and the payload will contain the quizzes",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/group/scores/{group_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will compute the scores of the members of a class or cohort with the provided Group ID on the quizzes assigned to it if the requester is an owner.\nEvery assigned quiz is summarized with the number of responses and outstanding members and the mean, minimum, and maximum scores. Every member is totalled across the assigned quizzes.\nOwners of the group are not included in the rollup.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "score stats statistics group class cohort"
+                ],
+                "summary": "Get the scores of a group.",
+                "operationId": "getGroupScores",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Group ID for the group whose scores are being requested.",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain the Group ID and the payload will contain the rollup",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/group/update/{group_id}": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will update the name and description of a class or cohort with the provided Group ID if the requester is an owner.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "update modify group class cohort"
+                ],
+                "summary": "Update a group.",
+                "operationId": "updateGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Group ID for the group being updated.",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The name and description to replace the existing ones",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_cassandra.GroupCore"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain a confirmation of the update",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/group/view/{group_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will retrieve a class or cohort with a provided Group ID if the requester is a member.\nThe join code is only included if the requester is an owner of the group.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "view group class cohort"
+                ],
+                "summary": "View a group.",
+                "operationId": "viewGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Group ID for the group being requested.",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain the Group ID and the payload will contain the group",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "This endpoint is exposed to allow load balancers etc. to check the health of the service.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health healthcheck liveness"
+                ],
+                "summary": "Healthcheck for service liveness.",
+                "operationId": "healthcheck",
+                "responses": {
+                    "200": {
+                        "description": "message: healthy",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "503": {
+                        "description": "error message with any available details",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/catalogue": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Gets a page of the published quizzes that are not deleted, most recently created first.\nThe quizzes can be filtered by a tag and searched for by terms that must all appear in their titles or descriptions.\nA query string to be appended to the next request to retrieve the next page of data will be returned in the response.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "view test quiz list catalogue search tag"
+                ],
+                "summary": "List the published quizzes in the catalogue.",
+                "operationId": "listCatalogue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The terms to search for in the titles and descriptions of the quizzes.",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The tag the quizzes must have.",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "A page of the quizzes in the catalogue",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.CatalogueResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/create/": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will create a quiz with randomly generated Test ID and associate it with the requester. The requester must be an instructor or an administrator.\nThe username will be extracted from the JWT and associated with the Test ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "create test quiz"
+                ],
+                "summary": "Create a quiz.",
+                "operationId": "createQuiz",
+                "parameters": [
+                    {
+                        "description": "The Quiz to be created as unpublished",
+                        "name": "quiz",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_cassandra.QuizCore"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain the Quiz ID of the newly generated quiz",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/delete/{quiz_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will mark a quiz as delete if it was created by the requester or the requester is an administrator. The provided Test ID is provided is a path parameter.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "delete remove test quiz"
+                ],
+                "summary": "Delete a quiz.",
+                "operationId": "deleteQuiz",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the quiz being deleted.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain a confirmation of deletion",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/draft/{quiz_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the partially completed answer sheet that was last saved for the requester's next attempt at a quiz.\nDrafts saved for attempts that have since been submitted are not resumed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "draft resume test quiz answer"
+                ],
+                "summary": "Resume a draft of the answers to a quiz.",
+                "operationId": "resumeDraft",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the draft being resumed.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The saved draft will be in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Save the partially completed answer sheet for the requester's next attempt at a quiz without submitting it. Saving a draft again replaces the answers.\nDrafts are answered in the order the quiz was presented in and are only saved whilst the quiz is available and the requester has attempts remaining.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "draft save autosave test quiz answer"
+                ],
+                "summary": "Save a draft of the answers to a quiz.",
+                "operationId": "saveDraft",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the answers being saved.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The partially completed answer card to be saved.",
+                        "name": "answers",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_cassandra.QuizResponse"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The saved draft will be in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "429": {
+                        "description": "Error message with the time until the next attempt in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/export/{quiz_id}/{format}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will download a quiz created by the requester, or any quiz if the requester is an administrator, as a file in the GIFT, Aiken, Moodle XML, or QTI 2.1 content package format.\nQuizzes drawn from question banks cannot be exported.\nQuestions that cannot be represented in the format are reported with their index in the quiz.",
+                "produces": [
+                    "text/plain",
+                    "text/xml",
+                    "application/zip",
+                    "application/json"
+                ],
+                "tags": [
+                    "export test quiz"
+                ],
+                "summary": "Export a quiz.",
+                "operationId": "exportQuiz",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the quiz being exported.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The format of the file: gift, aiken, moodle-xml, or qti.",
+                        "name": "format",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The quiz file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/import/{format}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will create an unpublished quiz from a file in the GIFT, Aiken, Moodle XML, or QTI 2.1 content package format and associate it with the requester. The requester must be an instructor or an administrator.\nThe file is supplied as the request body. The title query parameter is required for formats without titles, such as Aiken.\nThe marking type is not part of any of the formats and must be supplied as a query parameter.\nQuestions that cannot be read or fail validation are reported with their index in the file.",
+                "consumes": [
+                    "text/plain",
+                    "text/xml",
+                    "application/zip"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "create import test quiz"
+                ],
+                "summary": "Import a quiz.",
+                "operationId": "importQuiz",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The format of the file: gift, aiken, moodle-xml, or qti.",
+                        "name": "format",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The title of the quiz, which replaces any title in the file.",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The marking type of the quiz.",
+                        "name": "markingType",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "The quiz file",
+                        "name": "file",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain the Quiz ID of the newly generated quiz",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "413": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/marking-schemes": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will retrieve the names of all the registered marking types that can be assigned to a quiz.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "marking schemes grading test quiz"
+                ],
+                "summary": "List the marking schemes.",
+                "operationId": "listMarkingSchemes",
+                "responses": {
+                    "200": {
+                        "description": "The payload will contain the list of marking scheme names",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/mine": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Gets a page of the quizzes created by the requester, most recently created first. Extracts username from the JWT.\nThe quizzes can be filtered by a status of draft, published, or deleted.\nA query string to be appended to the next request to retrieve the next page of data will be returned in the response.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "view test quiz list mine"
+                ],
+                "summary": "List the quizzes created by the requester.",
+                "operationId": "listMyQuizzes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The status of the quizzes to list: draft, published, or deleted.",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "A page of the requester's quizzes",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.AuthorQuizzesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/practice/{quiz_id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Check the answer to a single question of a published quiz. Practice checks are graded immediately and are never recorded as responses or counted in the quiz statistics.\nQuestions and options are indexed in the order the quiz is presented to the requester for their next attempt.\nAuthors and administrators practice the quiz in its original order and cannot practice quizzes drawn from question banks.\nThe correctness, points, and feedback for the selected options are returned. The answer key and explanation are only returned if the quiz reveals its answers.\nPractice checks are limited per user and checks over the limit will be told to retry later.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "practice test quiz check answer"
+                ],
+                "summary": "Practice a quiz.",
+                "operationId": "practiceQuiz",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the quiz being practiced.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The answer to the question being checked.",
+                        "name": "check",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_http.PracticeCheck"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The result of the check will be in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "429": {
+                        "description": "Error message with the practice limit in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/publish/{quiz_id}": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "When a quiz is submitted it is not published by default and is thus unavailable to be taken.\nThis endpoint will publish a quiz with the provided Test ID if it was created by the requester or the requester is an administrator.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publish test quiz create"
+                ],
+                "summary": "Publish a quiz.",
+                "operationId": "publishQuiz",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the quiz being published.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain a confirmation of publishing",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/revise/{quiz_id}": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will publish a new version of a quiz with the provided Test ID if it was created by the requester, or the requester is an administrator, and is published.\nEarlier versions are retained and responses will continue to be graded against the version that was taken.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "revise update modify test quiz version"
+                ],
+                "summary": "Revise a published quiz.",
+                "operationId": "reviseQuiz",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the quiz being revised.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The Quiz to be published as the next version",
+                        "name": "quiz",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_cassandra.QuizCore"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain the new version number",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/schedule/{quiz_id}": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will set the opening and closing times of a quiz with the provided Test ID if it was created by the requester or the requester is an administrator.\nAn unpublished quiz is published at its opening time and a quiz can no longer be taken after its closing time. Closed quizzes remain viewable.\nOnly unpublished quizzes can be given an opening time. Scheduling a closed quiz with a later closing time will reopen it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedule publish close test quiz"
+                ],
+                "summary": "Schedule a quiz.",
+                "operationId": "scheduleQuiz",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the quiz being scheduled.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The opening and closing times of the quiz",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_cassandra.QuizSchedule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain a confirmation of scheduling",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/start/{quiz_id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Start the next attempt at a quiz with a time limit. The server records the deadline for submitting the attempt and the time remaining is returned.\nStarting an attempt that is already in progress will return the existing session without extending its deadline.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "start take test quiz timed"
+                ],
+                "summary": "Start a timed quiz.",
+                "operationId": "startQuiz",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the quiz being started.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The attempt session and the seconds remaining will be in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "429": {
+                        "description": "Error message with the time until the next attempt in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/submit/{quiz_id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Submit the answer sheet that was last saved for the requester's next attempt at a quiz. The draft is graded and recorded in the same way as an answer sheet that is submitted to take the quiz.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "draft submit take test quiz answer"
+                ],
+                "summary": "Submit a draft of the answers to a quiz.",
+                "operationId": "submitDraft",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the draft being submitted.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Effective score, attempt history, and any breakdown will be in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "429": {
+                        "description": "Error message with the time until the next attempt in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/take/{quiz_id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Take a quiz by submitting an answer sheet. The username will be extracted from the JWT and associated with the scorecard.\nEach submission is an attempt and is limited by the attempt limit and cooldown of the quiz. The effective score is set by the attempt policy of the quiz.\nAttempts at timed quizzes must be started first. Submissions after the deadline and grace period are recorded as late and scored zero.\nAttempts at shuffled quizzes must be viewed first and are answered in the order they were presented in.\nAttempts at quizzes drawn from question banks must be viewed first and are graded against the questions drawn for them.\nQuizzes that reveal their answers immediately include a breakdown of the answer key, points, explanation, and feedback for every question.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "take test quiz submit answer"
+                ],
+                "summary": "Take a quiz.",
+                "operationId": "takeQuiz",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the answers being submitted.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The answer card to be submitted.",
+                        "name": "answers",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_cassandra.QuizResponse"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Effective score, attempt history, and any breakdown will be in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "429": {
+                        "description": "Error message with the time until the next attempt in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/update/{quiz_id}": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will update a quiz with the provided Test ID if it was created by the requester, or the requester is an administrator, and is not published.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "update modify test quiz"
+                ],
+                "summary": "Update a quiz.",
+                "operationId": "updateQuiz",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the quiz being updated.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The Quiz to replace the one already submitted",
+                        "name": "quiz",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_cassandra.QuizCore"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain a confirmation of the update",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/versions/{quiz_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will retrieve all the published versions of a quiz with a provided quiz ID, oldest first.\nAnswer keys are only included if the requester is the author or an administrator.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "view test quiz version"
+                ],
+                "summary": "List the versions of a quiz.",
+                "operationId": "listQuizVersions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The quiz ID for the quiz being requested.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain the quiz ID and the payload will contain the versions",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/versions/{quiz_id}/{version}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will retrieve a published version of a quiz with a provided quiz ID and version number.\nAnswer keys are only included if the requester is the author or an administrator.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "view test quiz version"
+                ],
+                "summary": "View a version of a quiz.",
+                "operationId": "viewQuizVersion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The quiz ID for the quiz being requested.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The version of the quiz being requested.",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain the quiz ID and the payload will contain the version",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/quiz/view/{quiz_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint will retrieve a quiz with a provided quiz ID if it is published.\nShuffled quizzes are presented in the order fixed for the requester's next attempt.\nQuizzes drawn from question banks present the questions drawn for the requester's next attempt.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "view test quiz"
+                ],
+                "summary": "View a quiz.",
+                "operationId": "viewQuiz",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The quiz ID for the quiz being requested.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The message will contain the quiz ID and the payload will contain the quiz",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/score/export/{quiz_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Streams the username, effective score, and selected options for every question of every response to a specific test if the user created the test.\nThe format is negotiated with the Accept header and is either CSV (text/csv) or NDJSON (application/x-ndjson). CSV is the default.\nThe correctness of every answer is included when requested and is graded against the version of the test the response was graded against.\nExtracts username from the JWT and the Test ID is provided as a path parameter.",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/json"
+                ],
+                "tags": [
+                    "score scores results export csv ndjson"
+                ],
+                "summary": "Export the results of a specific test.",
+                "operationId": "exportResults",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the requested results.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include the correctness of every answer.",
+                        "name": "correctness",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The results of every response to the test",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "406": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/score/items/{quiz_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Gets the difficulty and discrimination of every question in a specific test if the user created the test.\nThe selection frequencies of the options are counted overall and in the top and bottom 27% of the responses by score.\nThe reliability of the test is reported as KR-20 and Cronbach's alpha. Responses graded against other versions are excluded.\nExtracts username from the JWT and the Test ID is provided as a path parameter.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "score scores stats statistics item analysis"
+                ],
+                "summary": "Get the item analysis for a specific test.",
+                "operationId": "getItemAnalysis",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the requested item analysis.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The item analysis will be in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/score/mine": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Gets a page of the requester's scores on every quiz they have taken, most recently created quiz first.\nExtracts username from the JWT. Every score contains the quiz title, the number of attempts, and the time of the latest attempt.\nA query string to be appended to the next request to retrieve the next page of data will be returned in the response.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "score scores list mine"
+                ],
+                "summary": "List the requester's scores across all quizzes.",
+                "operationId": "listMyScores",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "A page of the requester's scores",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.UserScoresResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/score/regrade/{quiz_id}": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Regrades every response to a quiz against its current version and writes back the updated scores if the user created the test.\nResponses that cannot be graded against the current version are left unchanged and reported as failed.\nAttempts are only regraded if the version taken has the same number of questions, and each question the same type and number of options and matches, as the current version.\nExtracts username from the JWT and the Test ID is provided as a path parameter.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "score scores regrade"
+                ],
+                "summary": "Regrade all the responses to a quiz.",
+                "operationId": "regradeScores",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the responses to be regraded.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "A summary of the regrade will be in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "A summary of the progress made before the failure will be in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/score/stats-paged/{quiz_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Gets the paginated statistics associated with a specific test if the user created the test.\nExtracts username from the JWT and the Test ID is provided as a query parameter.\nA query string to be appended to the next request to retrieve the next page of data will be returned in the response.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "score scores stats statistics"
+                ],
+                "summary": "Get paginated statistics associated with a specific test.",
+                "operationId": "getStatsPaged",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the requested statistics.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "A page of statistics data",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.StatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/score/stats/{quiz_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Gets the statistics associated with a specific test if the user created the test.\nExtracts username from the JWT and the Test ID is provided as a path parameter.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "score scores stats statistics"
+                ],
+                "summary": "Get all statistics associated with a specific test.",
+                "operationId": "getStats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the requested statistics.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Statistics will be in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/score/summary/{quiz_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Gets the mean, median, standard deviation, minimum, and maximum of the scores for a specific test if the user created the test.\nThe histogram counts the scores as a percentage of the maximum score in ten equal width bins.\nExtracts username from the JWT and the Test ID is provided as a path parameter.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "score scores stats statistics summary"
+                ],
+                "summary": "Get the aggregate statistics of the scores for a specific test.",
+                "operationId": "getStatsSummary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the requested statistics summary.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The statistics summary will be in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/score/test/{quiz_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Gets a scorecard for a user. Extracts username from the JWT and Test ID is provided as a path parameter.\nThe scorecard contains the effective score set by the attempt policy of the quiz along with the history of every attempt.\nOnce the answers to the quiz are revealed by its reveal policy, the scorecard includes a breakdown of the answer key, points, explanation, and feedback for every question in the effective attempt.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "score scores"
+                ],
+                "summary": "Get a user's score.",
+                "operationId": "getScore",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The Test ID for the requested scorecard.",
+                        "name": "quiz_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Effective score, attempt history, and any breakdown will be in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a user stored in the database by marking it as deleted. The user must supply their login credentials as well as complete the following confirmation message: \"I understand the consequences, delete my user account USERNAME HERE\"",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users delete security"
+                ],
+                "summary": "Deletes a user. The user must supply their credentials as well as a confirmation message.",
+                "operationId": "deleteUser",
+                "parameters": [
+                    {
+                        "description": "The request payload for deleting an account",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_http.DeleteUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "message with a confirmation of a deleted user account",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/login": {
+            "post": {
+                "description": "Logs in a user by validating credentials and returning a JWT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users login security"
+                ],
+                "summary": "Login a user.",
+                "operationId": "loginUser",
+                "parameters": [
+                    {
+                        "description": "Username and password to login with",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_cassandra.UserLoginCredentials"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JWT in the api-key",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.JWTAuthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/model_http.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/refresh": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -788,75 +3368,342 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "model_cassandra.Question": {
+        }
+    },
+    "definitions": {
+        "model_cassandra.Attempt": {
+            "type": "object",
+            "properties": {
+                "late": {
+                    "description": "The attempt was submitted after the deadline and grace period and scored zero.",
+                    "type": "boolean"
+                },
+                "max_score": {
+                    "description": "The maximum score that could be awarded for the attempt.",
+                    "type": "number"
+                },
+                "number": {
+                    "description": "The attempt number, starting at one.",
+                    "type": "integer"
+                },
+                "responses": {
+                    "description": "The answer card for the attempt.",
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "score": {
+                    "description": "The score awarded for the attempt.",
+                    "type": "number"
+                },
+                "submitted_at": {
+                    "description": "The time at which the attempt was submitted.",
+                    "type": "string"
+                },
+                "text_responses": {
+                    "description": "The answers to numeric and text questions for the attempt.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "version": {
+                    "description": "The version of the quiz the attempt was graded against.",
+                    "type": "integer"
+                }
+            }
+        },
+        "model_cassandra.AuthorQuiz": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "description": "The username of the quiz creator.",
+                    "type": "string"
+                },
+                "quiz_id": {
+                    "description": "The unique identifier for the quiz.",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "The status of the quiz: draft, published, or deleted.",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "The title description of the quiz.",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "The current published version of the quiz. Unpublished quizzes are not versioned.",
+                    "type": "integer"
+                }
+            }
+        },
+        "model_cassandra.GroupCore": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "description": "A description of the class or cohort.",
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "name": {
+                    "description": "The name of the class or cohort.",
+                    "type": "string",
+                    "maxLength": 128
+                }
+            }
+        },
+        "model_cassandra.GroupInviteRequest": {
+            "type": "object",
+            "required": [
+                "username"
+            ],
+            "properties": {
+                "is_owner": {
+                    "description": "Invite the user as an owner of the group.",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "The username of the user to invite.",
+                    "type": "string"
+                }
+            }
+        },
+        "model_cassandra.Question": {
+            "type": "object",
+            "required": [
+                "description",
+                "tags"
+            ],
+            "properties": {
+                "answers": {
+                    "description": "The indices of the options that are correct answers in the question.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "asset": {
+                    "description": "URI of an asset to be displayed with question.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "The description that contains the text of the question.",
+                    "type": "string"
+                },
+                "difficulty": {
+                    "description": "The difficulty of the question: easy, medium, or hard.",
+                    "type": "string",
+                    "enum": [
+                        "easy",
+                        "medium",
+                        "hard"
+                    ]
+                },
+                "explanation": {
+                    "description": "The explanation of the answer that is revealed after submission.",
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "feedback": {
+                    "description": "The feedback for each option that is revealed after submission when the option is selected.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "matches": {
+                    "description": "The entries that the options are paired with in a matching question.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "numeric_answer": {
+                    "description": "The answer to a numeric question.",
+                    "type": "number"
+                },
+                "options": {
+                    "description": "The available options for the question.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "points": {
+                    "description": "The weight of the question when grading. Defaults to a single point.",
+                    "type": "number"
+                },
+                "tags": {
+                    "description": "The topics that the question covers.",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "text_answers": {
+                    "description": "The accepted answers or regular expressions for a text question.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tolerance": {
+                    "description": "The maximum absolute difference from the numeric answer for a response to be correct.",
+                    "type": "number",
+                    "minimum": 0
+                },
+                "type": {
+                    "description": "The type of question which determines how it is answered and graded.",
+                    "type": "string"
+                }
+            }
+        },
+        "model_cassandra.QuestionBankCore": {
             "type": "object",
             "required": [
-                "answers",
-                "description",
-                "options"
+                "questions",
+                "title"
             ],
             "properties": {
-                "answers": {
-                    "description": "The indices of the options that are correct answers in the question.",
+                "questions": {
+                    "description": "A list of questions in the question bank.",
                     "type": "array",
-                    "maxItems": 5,
+                    "maxItems": 500,
                     "minItems": 1,
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/model_cassandra.Question"
                     }
                 },
-                "asset": {
-                    "description": "URI of an asset to be displayed with question.",
-                    "type": "string"
-                },
-                "description": {
-                    "description": "The description that contains the text of the question.",
+                "title": {
+                    "description": "The title description of the question bank.",
                     "type": "string"
-                },
-                "options": {
-                    "description": "The available options for the question.",
+                }
+            }
+        },
+        "model_cassandra.QuestionDraw": {
+            "type": "object",
+            "required": [
+                "banks",
+                "count"
+            ],
+            "properties": {
+                "banks": {
+                    "description": "The ids of the question banks to draw from.",
                     "type": "array",
-                    "maxItems": 5,
-                    "minItems": 2,
+                    "maxItems": 10,
+                    "minItems": 1,
+                    "uniqueItems": true,
                     "items": {
                         "type": "string"
                     }
+                },
+                "count": {
+                    "description": "The number of questions drawn for each attempt.",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "stratify_by": {
+                    "description": "The question attribute the draw is balanced across.",
+                    "type": "string",
+                    "enum": [
+                        "tag",
+                        "difficulty"
+                    ]
                 }
             }
         },
         "model_cassandra.QuizCore": {
             "type": "object",
             "required": [
-                "questions",
+                "tags",
                 "title"
             ],
             "properties": {
-                "marking_type": {
-                    "description": "Marking scheme type can be not marked, negative marking, or all or nothing.",
+                "attempt_cooldown": {
+                    "description": "The number of seconds a user must wait between attempts.",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "attempt_policy": {
+                    "description": "The attempt that counts towards a user's score.",
                     "type": "string",
                     "enum": [
-                        "None",
-                        "none",
-                        "Negative",
-                        "negative",
-                        "Non-negative",
-                        "non-negative",
-                        "Binary",
-                        "binary"
+                        "best",
+                        "latest",
+                        "average"
+                    ]
+                },
+                "description": {
+                    "description": "A summary of the quiz shown in the catalogue.",
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "draw": {
+                    "description": "Draw the questions at random from question banks for each user and attempt.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model_cassandra.QuestionDraw"
+                        }
                     ]
                 },
+                "grace_period": {
+                    "description": "The number of seconds after the time limit that a submission is still accepted.",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "marking_type": {
+                    "description": "Marking scheme type can be not marked or any of the registered marking schemes.",
+                    "type": "string"
+                },
+                "max_attempts": {
+                    "description": "The number of times a user may take the quiz.",
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
                 "questions": {
                     "description": "A list of questions in the quiz.",
                     "type": "array",
-                    "maxItems": 10,
                     "minItems": 1,
                     "items": {
                         "$ref": "#/definitions/model_cassandra.Question"
                     }
                 },
+                "reveal_policy": {
+                    "description": "When the answers are revealed to the users that took the quiz.",
+                    "type": "string",
+                    "enum": [
+                        "immediately",
+                        "after-close",
+                        "never"
+                    ]
+                },
+                "shuffle": {
+                    "description": "Present the questions and options in a random order that is fixed for each user and attempt.",
+                    "type": "boolean"
+                },
+                "tags": {
+                    "description": "The topics the quiz can be filtered by in the catalogue.",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "time_limit": {
+                    "description": "The number of seconds a user has to submit an attempt. Quizzes without a limit are untimed.",
+                    "type": "integer",
+                    "minimum": 0
+                },
                 "title": {
                     "description": "The title description of the quiz.",
                     "type": "string"
@@ -872,14 +3719,32 @@ const docTemplate = `{
                 "responses": {
                     "description": "The answer card to a quiz. The rows indices are the question numbers and the columns indices are the selected option numbers.",
                     "type": "array",
-                    "maxItems": 10,
-                    "minItems": 0,
                     "items": {
                         "type": "array",
                         "items": {
                             "type": "integer"
                         }
                     }
+                },
+                "text_responses": {
+                    "description": "The answers to numeric and text questions. The indices are the question numbers and the entries for all other question types are ignored.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model_cassandra.QuizSchedule": {
+            "type": "object",
+            "properties": {
+                "closes_at": {
+                    "description": "The time after which the quiz can no longer be taken.",
+                    "type": "string"
+                },
+                "opens_at": {
+                    "description": "The time at which the quiz is published.",
+                    "type": "string"
                 }
             }
         },
@@ -889,17 +3754,25 @@ const docTemplate = `{
                 "responses"
             ],
             "properties": {
+                "attempts": {
+                    "description": "Every attempt at the quiz, oldest first. The score is the effective score across the attempts.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_cassandra.Attempt"
+                    }
+                },
                 "author": {
                     "type": "string"
                 },
+                "max_score": {
+                    "type": "number"
+                },
                 "quiz_id": {
                     "type": "string"
                 },
                 "responses": {
                     "description": "The answer card to a quiz. The rows indices are the question numbers and the columns indices are the selected option numbers.",
                     "type": "array",
-                    "maxItems": 10,
-                    "minItems": 0,
                     "items": {
                         "type": "array",
                         "items": {
@@ -910,8 +3783,19 @@ const docTemplate = `{
                 "score": {
                     "type": "number"
                 },
+                "text_responses": {
+                    "description": "The answers to numeric and text questions. The indices are the question numbers and the entries for all other question types are ignored.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "username": {
                     "type": "string"
+                },
+                "version": {
+                    "description": "The version of the quiz the response was graded against.",
+                    "type": "integer"
                 }
             }
         },
@@ -963,6 +3847,158 @@ const docTemplate = `{
                 }
             }
         },
+        "model_cassandra.UserRoleRequest": {
+            "type": "object",
+            "required": [
+                "role",
+                "username"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "admin",
+                        "instructor",
+                        "student"
+                    ]
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_cassandra.UserScore": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "description": "The number of attempts at the quiz.",
+                    "type": "integer"
+                },
+                "max_score": {
+                    "description": "The maximum score that could be awarded for the effective attempt.",
+                    "type": "number"
+                },
+                "quiz_id": {
+                    "description": "The unique identifier for the quiz.",
+                    "type": "string"
+                },
+                "score": {
+                    "description": "The effective score across the attempts at the quiz.",
+                    "type": "number"
+                },
+                "submitted_at": {
+                    "description": "The time at which the latest attempt was submitted.",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "The title description of the quiz.",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "The username of the test taker.",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "The version of the quiz the effective score was graded against.",
+                    "type": "integer"
+                }
+            }
+        },
+        "model_http.AuthorQuizzesMetadata": {
+            "type": "object",
+            "properties": {
+                "num_records": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_http.AuthorQuizzesResponse": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "object",
+                    "properties": {
+                        "next_page": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "metadata": {
+                    "$ref": "#/definitions/model_http.AuthorQuizzesMetadata"
+                },
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_cassandra.AuthorQuiz"
+                    }
+                }
+            }
+        },
+        "model_http.CatalogueMetadata": {
+            "type": "object",
+            "properties": {
+                "num_records": {
+                    "type": "integer"
+                },
+                "search": {
+                    "type": "string"
+                },
+                "tag": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_http.CatalogueQuiz": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "quiz_id": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model_http.CatalogueResponse": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "object",
+                    "properties": {
+                        "next_page": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "metadata": {
+                    "$ref": "#/definitions/model_http.CatalogueMetadata"
+                },
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_http.CatalogueQuiz"
+                    }
+                }
+            }
+        },
         "model_http.DeleteUserRequest": {
             "type": "object",
             "required": [
@@ -1027,6 +4063,27 @@ const docTemplate = `{
                 }
             }
         },
+        "model_http.PracticeCheck": {
+            "type": "object",
+            "properties": {
+                "question": {
+                    "description": "Index of the question being checked.",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "response": {
+                    "description": "Options selected, or the order or matches chosen.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "text_response": {
+                    "description": "Answer to a numeric or text question.",
+                    "type": "string"
+                }
+            }
+        },
         "model_http.StatsResponse": {
             "type": "object",
             "properties": {
@@ -1057,6 +4114,36 @@ const docTemplate = `{
                 },
                 "payload": {}
             }
+        },
+        "model_http.UserScoresMetadata": {
+            "type": "object",
+            "properties": {
+                "num_records": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model_http.UserScoresResponse": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "object",
+                    "properties": {
+                        "next_page": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "metadata": {
+                    "$ref": "#/definitions/model_http.UserScoresMetadata"
+                },
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_cassandra.UserScore"
+                    }
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -1078,6 +4165,8 @@ var SwaggerInfo = &swag.Spec{
 	Description:      "Multiple Choice Question Platform API.\nThis application supports the creation, managing, marking, viewing, retrieving stats, and scores of quizzes.",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
+	LeftDelim:        "{{",
+	RightDelim:       "}}",
 }
 
 func init() {
@@ -12,6 +12,7 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/spf13/afero"
 	"github.com/surahman/mcq-platform/pkg/logger"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
 	"github.com/surahman/mcq-platform/pkg/model/http"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
@@ -28,12 +29,13 @@ type Auth interface {
 	// CheckPassword will take the plaintext and hashed passwords as input, in that order, and verify if they match.
 	CheckPassword(string, string) error
 
-	// GenerateJWT will create a valid JSON Web Token and return it in a JWT Authorization Response structure.
-	GenerateJWT(string) (*model_http.JWTAuthResponse, error)
+	// GenerateJWT will take the username and role, in that order, and create a valid JSON Web Token and return it in a
+	// JWT Authorization Response structure.
+	GenerateJWT(string, string) (*model_http.JWTAuthResponse, error)
 
-	// ValidateJWT will take the JSON Web Token and validate it. It will extract and return the username and expiration
-	// time (Unix timestamp) or an error if validation fails.
-	ValidateJWT(string) (string, int64, error)
+	// ValidateJWT will take the JSON Web Token and validate it. It will extract and return the username, expiration
+	// time (Unix timestamp), and role or an error if validation fails.
+	ValidateJWT(string) (string, int64, string, error)
 
 	// RefreshJWT will take a valid JSON Web Token, and if valid and expiring soon, issue a fresh valid JWT with the time
 	// extended in JWT Authorization Response structure.
@@ -48,6 +50,9 @@ type Auth interface {
 
 	// DecryptFromString will decrypt an encrypted base64 encoded character from the ciphertext.
 	DecryptFromString(string) ([]byte, error)
+
+	// AdminAccount returns the account of the administrator to bootstrap, or nil if one is not configured.
+	AdminAccount() *model_cassandra.UserAccount
 }
 
 // Check to ensure the Auth interface has been implemented.
@@ -101,13 +106,15 @@ func (a *authImpl) CheckPassword(hashed, plaintext string) (err error) {
 // jwtClaim is used internally by the JWT generation and validation routines.
 type jwtClaim struct {
 	Username string `json:"username" yaml:"username"`
+	Role     string `json:"role,omitempty" yaml:"role,omitempty"`
 	jwt.RegisteredClaims
 }
 
-// GenerateJWT creates a payload consisting of the JWT with the username as well as expiration time.
-func (a *authImpl) GenerateJWT(username string) (*model_http.JWTAuthResponse, error) {
+// GenerateJWT creates a payload consisting of the JWT with the username and role as well as expiration time.
+func (a *authImpl) GenerateJWT(username, role string) (*model_http.JWTAuthResponse, error) {
 	claims := &jwtClaim{
 		Username: username,
+		Role:     role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    a.conf.JWTConfig.Issuer,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(a.conf.JWTConfig.ExpirationDuration) * time.Second).UTC()),
@@ -128,42 +135,48 @@ func (a *authImpl) GenerateJWT(username string) (*model_http.JWTAuthResponse, er
 	return authResponse, err
 }
 
-// ValidateJWT will validate a signed JWT and extracts the username from it.
-func (a *authImpl) ValidateJWT(signedToken string) (string, int64, error) {
+// ValidateJWT will validate a signed JWT and extracts the username and role from it. Tokens issued without a role
+// carry the student role.
+func (a *authImpl) ValidateJWT(signedToken string) (string, int64, string, error) {
 	claims := jwt.MapClaims{}
 	if _, err := jwt.ParseWithClaims(signedToken, &claims, func(token *jwt.Token) (interface{}, error) {
 		return []byte(a.conf.JWTConfig.Key), nil
 	}); err != nil {
-		return "", -1, err
+		return "", -1, "", err
 	}
 
 	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
-		return "", -1, errors.New("token has expired")
+		return "", -1, "", errors.New("token has expired")
 	}
 	if !claims.VerifyIssuer(a.conf.JWTConfig.Issuer, true) {
-		return "", -1, errors.New("unauthorized issuer")
+		return "", -1, "", errors.New("unauthorized issuer")
 	}
 
 	username, ok := claims["username"]
 	if !ok {
-		return "", -1, errors.New("username not found")
+		return "", -1, "", errors.New("username not found")
 	}
 
 	expiresAt, ok := claims["exp"]
 	if !ok {
-		return "", -1, errors.New("expiration time not found")
+		return "", -1, "", errors.New("expiration time not found")
+	}
+
+	role, ok := claims["role"].(string)
+	if !ok || role == "" {
+		role = model_cassandra.RoleStudent
 	}
 
-	return username.(string), int64(expiresAt.(float64)), nil
+	return username.(string), int64(expiresAt.(float64)), role, nil
 }
 
-// RefreshJWT will extend a valid JWT's lease by generating a fresh valid JWT.
+// RefreshJWT will extend a valid JWT's lease by generating a fresh valid JWT with the same role.
 func (a *authImpl) RefreshJWT(token string) (authResponse *model_http.JWTAuthResponse, err error) {
-	var username string
-	if username, _, err = a.ValidateJWT(token); err != nil {
+	var username, role string
+	if username, _, role, err = a.ValidateJWT(token); err != nil {
 		return
 	}
-	if authResponse, err = a.GenerateJWT(username); err != nil {
+	if authResponse, err = a.GenerateJWT(username, role); err != nil {
 		return
 	}
 
@@ -245,3 +258,20 @@ func (a *authImpl) DecryptFromString(ciphertext string) (plaintext []byte, err e
 	}
 	return a.decryptAES256(bytes)
 }
+
+// AdminAccount is the account of the administrator that is created or promoted at startup, if one is configured.
+func (a *authImpl) AdminAccount() *model_cassandra.UserAccount {
+	if a.conf.Admin.Username == "" {
+		return nil
+	}
+
+	return &model_cassandra.UserAccount{
+		UserLoginCredentials: model_cassandra.UserLoginCredentials{
+			Username: a.conf.Admin.Username,
+			Password: a.conf.Admin.Password,
+		},
+		FirstName: "Platform",
+		LastName:  "Administrator",
+		Email:     a.conf.Admin.Email,
+	}
+}
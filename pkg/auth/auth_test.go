@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/surahman/mcq-platform/pkg/constants"
 	"github.com/surahman/mcq-platform/pkg/logger"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
 )
 
 func TestNewAuth(t *testing.T) {
@@ -161,16 +162,24 @@ func TestAuthImpl_CheckPassword(t *testing.T) {
 
 func TestAuthImpl_GenerateJWT(t *testing.T) {
 	userName := "test username"
-	authResponse, err := testAuth.GenerateJWT(userName)
+	authResponse, err := testAuth.GenerateJWT(userName, model_cassandra.RoleInstructor)
 	require.NoError(t, err, "JWT creation failed")
 	require.True(t, authResponse.Expires > time.Now().Unix(), "JWT expires before current time")
 	require.True(t, authResponse.Expires < time.Now().Add(time.Duration(expirationDuration+1)*time.Second).Unix(), "JWT expires after deadline")
 
-	// Check validate token and check for username in claim.
-	actualUname, expiresAt, err := testAuth.ValidateJWT(authResponse.Token)
+	// Check validate token and check for username and role in claim.
+	actualUname, expiresAt, role, err := testAuth.ValidateJWT(authResponse.Token)
 	require.NoError(t, err, "failed to extract username from JWT")
 	require.Equalf(t, userName, actualUname, "incorrect username retrieved from JWT")
 	require.True(t, expiresAt > 0, "invalid expiration time")
+	require.Equal(t, model_cassandra.RoleInstructor, role, "incorrect role retrieved from JWT")
+
+	// Tokens issued without a role carry the student role.
+	authResponse, err = testAuth.GenerateJWT(userName, "")
+	require.NoError(t, err, "JWT creation without a role failed")
+	_, _, role, err = testAuth.ValidateJWT(authResponse.Token)
+	require.NoError(t, err, "failed to validate JWT without a role")
+	require.Equal(t, model_cassandra.RoleStudent, role, "tokens without a role should carry the student role")
 }
 
 func TestAuthImpl_ValidateJWT(t *testing.T) {
@@ -178,10 +187,10 @@ func TestAuthImpl_ValidateJWT(t *testing.T) {
 		testAuthImpl, err := getTestConfiguration()
 		require.NoError(t, err, "failed to generate test authorization for claim parsing")
 
-		_, _, err = testAuthImpl.ValidateJWT("")
+		_, _, _, err = testAuthImpl.ValidateJWT("")
 		require.Error(t, err, "parsing an empty token should fail")
 
-		_, _, err = testAuthImpl.ValidateJWT("bad#token#string")
+		_, _, _, err = testAuthImpl.ValidateJWT("bad#token#string")
 		require.Error(t, err, "parsing and invalid token should fail")
 	})
 
@@ -228,7 +237,7 @@ func TestAuthImpl_ValidateJWT(t *testing.T) {
 			}
 
 			// Generate test token.
-			testJWT, err := testAuthImpl.GenerateJWT(testUsername)
+			testJWT, err := testAuthImpl.GenerateJWT(testUsername, model_cassandra.RoleStudent)
 			require.NoError(t, err, "failed to create test JWT")
 
 			// Conditional sleep to expire token.
@@ -236,7 +245,7 @@ func TestAuthImpl_ValidateJWT(t *testing.T) {
 				time.Sleep(time.Duration(testCase.expirationDuration+1) * time.Second)
 			}
 
-			username, expiresAt, err := testAuth.ValidateJWT(testJWT.Token)
+			username, expiresAt, _, err := testAuth.ValidateJWT(testJWT.Token)
 			testCase.expectErr(t, err, "validation of issued token error condition failed")
 
 			if err != nil {
@@ -280,9 +289,9 @@ func TestAuthImpl_RefreshJWT(t *testing.T) {
 			testAuthImpl, err := getTestConfiguration()
 			testAuthImpl.conf.JWTConfig.ExpirationDuration = testCase.expirationDuration
 			require.NoError(t, err, "failed to generate test authorization")
-			testJWT, err := testAuthImpl.GenerateJWT(testCase.testUsername)
+			testJWT, err := testAuthImpl.GenerateJWT(testCase.testUsername, model_cassandra.RoleAdmin)
 			require.NoError(t, err, "failed to create initial JWT")
-			actualUsername, expiresAt, err := testAuthImpl.ValidateJWT(testJWT.Token)
+			actualUsername, expiresAt, _, err := testAuthImpl.ValidateJWT(testJWT.Token)
 			require.NoError(t, err, "failed to validate original test token")
 			require.Equal(t, testCase.testUsername, actualUsername, "failed to extract correct username from original JWT")
 			require.True(t, expiresAt > 0, "invalid expiration time of original token")
@@ -299,14 +308,31 @@ func TestAuthImpl_RefreshJWT(t *testing.T) {
 				refreshedToken.Expires > time.Now().Add(time.Duration(testAuthImpl.conf.JWTConfig.ExpirationDuration-1)*time.Second).Unix(),
 				"token expires before the required deadline")
 
-			actualUsername, expiresAt, err = testAuthImpl.ValidateJWT(testJWT.Token)
+			var role string
+			actualUsername, expiresAt, role, err = testAuthImpl.ValidateJWT(refreshedToken.Token)
 			require.NoErrorf(t, err, "failed to validate refreshed JWT")
+			require.Equal(t, model_cassandra.RoleAdmin, role, "failed to extract correct role from refreshed JWT")
 			require.Equal(t, testCase.testUsername, actualUsername, "failed to extract correct username from refreshed JWT")
 			require.True(t, expiresAt > 0, "invalid expiration time of refreshed token")
 		})
 	}
 }
 
+func TestAuthImpl_AdminAccount(t *testing.T) {
+	testAuthImpl, err := getTestConfiguration()
+	require.NoError(t, err, "failed to generate test authorization")
+	require.Nil(t, testAuthImpl.AdminAccount(), "administrator should not be configured")
+
+	testAuthImpl.conf.Admin.Username = "administrator"
+	testAuthImpl.conf.Admin.Password = "admin-password"
+	testAuthImpl.conf.Admin.Email = "admin@email-address.com"
+	account := testAuthImpl.AdminAccount()
+	require.NotNil(t, account, "administrator should be configured")
+	require.Equal(t, "administrator", account.Username, "username mismatch")
+	require.Equal(t, "admin-password", account.Password, "password mismatch")
+	require.Equal(t, "admin@email-address.com", account.Email, "email mismatch")
+}
+
 func TestAuthImpl_RefreshThreshold(t *testing.T) {
 	require.Equal(t, refreshThreshold, testAuth.RefreshThreshold(), "token refresh threshold did not match expected threshold")
 }
@@ -18,6 +18,11 @@ type config struct {
 		BcryptCost   int    `json:"bcrypt_cost,omitempty" yaml:"bcrypt_cost,omitempty" mapstructure:"bcrypt_cost" validate:"required,min=4,max=31"`
 		CryptoSecret string `json:"crypto_secret,omitempty" yaml:"crypto_secret,omitempty" mapstructure:"crypto_secret" validate:"required,len=32"`
 	} `json:"general,omitempty" yaml:"general,omitempty" mapstructure:"general" validate:"required"`
+	Admin struct {
+		Username string `json:"username,omitempty" yaml:"username,omitempty" mapstructure:"username" validate:"omitempty,min=8,alphanum"`
+		Password string `json:"password,omitempty" yaml:"password,omitempty" mapstructure:"password" validate:"required_with=Username,omitempty,min=8,max=32"`
+		Email    string `json:"email,omitempty" yaml:"email,omitempty" mapstructure:"email" validate:"required_with=Username,omitempty,email"`
+	} `json:"admin,omitempty" yaml:"admin,omitempty" mapstructure:"admin"`
 }
 
 // newConfig creates a blank configuration struct for authorization.
@@ -36,6 +36,24 @@ func TestAuthConfigs_Load(t *testing.T) {
 			require.NoError,
 			0,
 		},
+		{
+			"valid admin - etc dir",
+			authConfigTestData["valid_admin"],
+			require.NoError,
+			0,
+		},
+		{
+			"admin without password or email - etc dir",
+			authConfigTestData["admin_no_password_or_email"],
+			require.Error,
+			2,
+		},
+		{
+			"admin username too short - etc dir",
+			authConfigTestData["admin_invalid_username"],
+			require.Error,
+			1,
+		},
 		{
 			"no issuer - etc dir",
 			authConfigTestData["no_issuer"],
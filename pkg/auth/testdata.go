@@ -16,6 +16,46 @@ general:
   bcrypt_cost: 8
   crypto_secret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$`,
 
+		"valid_admin": `
+jwt:
+  key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
+  issuer: MCQ Platform
+  expiration_duration: 600
+  refresh_threshold: 60
+general:
+  bcrypt_cost: 8
+  crypto_secret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+admin:
+  username: administrator
+  password: admin-password
+  email: admin@email-address.com`,
+
+		"admin_no_password_or_email": `
+jwt:
+  key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
+  issuer: MCQ Platform
+  expiration_duration: 600
+  refresh_threshold: 60
+general:
+  bcrypt_cost: 8
+  crypto_secret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+admin:
+  username: administrator`,
+
+		"admin_invalid_username": `
+jwt:
+  key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
+  issuer: MCQ Platform
+  expiration_duration: 600
+  refresh_threshold: 60
+general:
+  bcrypt_cost: 8
+  crypto_secret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+admin:
+  username: admin
+  password: admin-password
+  email: admin@email-address.com`,
+
 		"no_issuer": `
 jwt:
   key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
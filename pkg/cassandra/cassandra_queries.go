@@ -3,6 +3,7 @@ package cassandra
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/surahman/mcq-platform/pkg/model/cassandra"
@@ -49,8 +50,9 @@ func CreateUserQuery(c Cassandra, params any) (response any, err error) {
 	resp := model_cassandra.User{UserAccount: &model_cassandra.UserAccount{}} // Discarded, only used as container for Cassandra response.
 	applied := false
 	if applied, err = conn.session.Query(model_cassandra.CreateUser,
-		input.Username, input.AccountID, input.Password, input.FirstName, input.LastName, input.Email).ScanCAS(
-		&resp.Username, &resp.AccountID, &resp.Email, &resp.FirstName, &resp.IsDeleted, &resp.LastName, &resp.Password); err != nil {
+		input.Username, input.AccountID, input.Password, input.FirstName, input.LastName, input.Email, input.Role).ScanCAS(
+		&resp.Username, &resp.AccountID, &resp.Email, &resp.FirstName, &resp.IsDeleted, &resp.LastName, &resp.Password,
+		&resp.Role); err != nil {
 		conn.logger.Error("failed to create input record",
 			zap.Strings("Account info:", []string{input.Username, input.AccountID}), zap.Error(err))
 		return nil, NewError(err.Error()).internalError()
@@ -77,7 +79,8 @@ func ReadUserQuery(c Cassandra, params any) (response any, err error) {
 	accountID := blake2b256(username)
 
 	if err = conn.session.Query(model_cassandra.ReadUser, username, accountID).Scan(
-		&resp.Username, &resp.AccountID, &resp.Email, &resp.FirstName, &resp.IsDeleted, &resp.LastName, &resp.Password); err != nil {
+		&resp.Username, &resp.AccountID, &resp.Email, &resp.FirstName, &resp.IsDeleted, &resp.LastName, &resp.Password,
+		&resp.Role); err != nil {
 		conn.logger.Error("failed to read user record",
 			zap.String("username", username), zap.String("account_id", accountID), zap.Error(err))
 		return nil, NewError("user not found").notFoundError()
@@ -98,7 +101,8 @@ func DeleteUserQuery(c Cassandra, params any) (response any, err error) {
 	resp := model_cassandra.User{UserAccount: &model_cassandra.UserAccount{}} // Discarded, only used as container for Cassandra response.
 	applied := false
 	if applied, err = conn.session.Query(model_cassandra.DeleteUser, username, accountID).ScanCAS(
-		&resp.Username, &resp.AccountID, &resp.Email, &resp.FirstName, &resp.IsDeleted, &resp.LastName, &resp.Password); err != nil {
+		&resp.Username, &resp.AccountID, &resp.Email, &resp.FirstName, &resp.IsDeleted, &resp.LastName, &resp.Password,
+		&resp.Role); err != nil {
 		conn.logger.Error("failed to create user record",
 			zap.Strings("Account info:", []string{username, accountID}), zap.Error(err))
 		return nil, NewError(err.Error()).internalError()
@@ -113,6 +117,33 @@ func DeleteUserQuery(c Cassandra, params any) (response any, err error) {
 	return nil, err
 }
 
+// UpdateUserRoleQuery will change the role of a user record in the users table that has not been deleted.
+// Param: pointer to the user role request containing the username and role
+func UpdateUserRoleQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.UserRoleRequest)
+
+	// Create hash of username using Blake2b 256 hashing algorithm.
+	accountID := blake2b256(input.Username)
+
+	var isDeleted bool // Discarded, only used as container for Cassandra response.
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.UpdateUserRole, input.Role, input.Username, accountID).ScanCAS(
+		&isDeleted); err != nil {
+		conn.logger.Error("failed to update user role",
+			zap.Strings("Account info:", []string{input.Username, accountID, input.Role}), zap.Error(err))
+		return nil, NewError(err.Error()).internalError()
+	}
+
+	if !applied {
+		msg := "failed to update role of a user record that does not exist or is deleted"
+		conn.logger.Error(msg, zap.Strings("Account info:", []string{input.Username, accountID, input.Role}))
+		return nil, NewError("user not found").notFoundError()
+	}
+
+	return nil, nil
+}
+
 // -----   Quizzes Table Queries   -----
 
 // CreateQuizQuery will create a quiz record in the quizzes table.
@@ -124,8 +155,12 @@ func CreateQuizQuery(c Cassandra, params any) (response any, err error) {
 
 	applied := false
 	if applied, err = conn.session.Query(model_cassandra.CreateQuiz,
-		input.QuizID, input.Author, input.Title, input.Questions, input.MarkingType, input.IsPublished, input.IsDeleted).ScanCAS(
-		&resp.QuizID, &resp.Author, &resp.IsDeleted, &resp.IsPublished, &resp.MarkingType, &resp.Questions, &resp.Title); err != nil {
+		input.QuizID, input.Author, input.Title, input.Questions, input.MarkingType, input.MaxAttempts, input.AttemptCooldown,
+		input.AttemptPolicy, input.TimeLimit, input.GracePeriod, input.Shuffle, input.Draw, input.Description, input.Tags, input.RevealPolicy, input.IsPublished,
+		input.IsDeleted).ScanCAS(
+		&resp.QuizID, &resp.AttemptCooldown, &resp.AttemptPolicy, &resp.Author, &resp.ClosesAt, &resp.Description, &resp.Draw,
+		&resp.GracePeriod, &resp.Groups, &resp.IsClosed, &resp.IsDeleted, &resp.IsPublished, &resp.MarkingType, &resp.MaxAttempts,
+		&resp.OpensAt, &resp.Questions, &resp.RevealPolicy, &resp.Shuffle, &resp.Tags, &resp.TimeLimit, &resp.Title, &resp.Version); err != nil {
 		conn.logger.Error("failed to create quiz record",
 			zap.Strings("Quiz info:", []string{input.QuizID.String(), input.Author}), zap.Error(err))
 		return nil, err
@@ -137,6 +172,10 @@ func CreateQuizQuery(c Cassandra, params any) (response any, err error) {
 		return nil, errors.New(msg)
 	}
 
+	if err = createAuthorQuiz(conn, input); err != nil {
+		return nil, NewError("unable to list quiz for author").internalError()
+	}
+
 	return nil, nil
 }
 
@@ -149,7 +188,9 @@ func ReadQuizQuery(c Cassandra, params any) (response any, err error) {
 	resp := model_cassandra.Quiz{QuizCore: &model_cassandra.QuizCore{}}
 
 	if err = conn.session.Query(model_cassandra.ReadQuiz, input).Scan(
-		&resp.QuizID, &resp.Author, &resp.IsDeleted, &resp.IsPublished, &resp.MarkingType, &resp.Questions, &resp.Title); err != nil {
+		&resp.QuizID, &resp.AttemptCooldown, &resp.AttemptPolicy, &resp.Author, &resp.ClosesAt, &resp.Description, &resp.Draw,
+		&resp.GracePeriod, &resp.Groups, &resp.IsClosed, &resp.IsDeleted, &resp.IsPublished, &resp.MarkingType, &resp.MaxAttempts,
+		&resp.OpensAt, &resp.Questions, &resp.RevealPolicy, &resp.Shuffle, &resp.Tags, &resp.TimeLimit, &resp.Title, &resp.Version); err != nil {
 		conn.logger.Error("failed to read quiz record", zap.String("Quiz info:", input.String()), zap.Error(err))
 		return nil, NewError("quiz not found").notFoundError()
 	}
@@ -157,6 +198,36 @@ func ReadQuizQuery(c Cassandra, params any) (response any, err error) {
 	return &resp, nil
 }
 
+// ReadPublishedQuizzesQuery will read all published quiz records that are not deleted from the quizzes table. This is a full
+// table scan that is used to build the quiz catalogue on startup.
+// Param: none
+// Return: slice of quiz records
+func ReadPublishedQuizzesQuery(c Cassandra, _ any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	var results []*model_cassandra.Quiz
+
+	iter := conn.session.Query(model_cassandra.ReadPublishedQuizzes).Iter()
+	defer func(iter *gocql.Iter) {
+		if err := iter.Close(); err != nil {
+			conn.logger.Error("failed to close iterator whilst reading published quizzes", zap.Error(err))
+		}
+	}(iter)
+
+	scanRows := iter.Scanner()
+	for scanRows.Next() {
+		row := model_cassandra.Quiz{QuizCore: &model_cassandra.QuizCore{}}
+		if err = scanRows.Scan(&row.QuizID, &row.AttemptCooldown, &row.AttemptPolicy, &row.Author, &row.ClosesAt, &row.Description,
+			&row.Draw, &row.GracePeriod, &row.Groups, &row.IsClosed, &row.IsDeleted, &row.IsPublished, &row.MarkingType, &row.MaxAttempts,
+			&row.OpensAt, &row.Questions, &row.RevealPolicy, &row.Shuffle, &row.Tags, &row.TimeLimit, &row.Title, &row.Version); err != nil {
+			conn.logger.Error("failed to read row in published quizzes", zap.Error(err))
+			return nil, NewError(err.Error()).internalError()
+		}
+		results = append(results, &row)
+	}
+
+	return results, err
+}
+
 // UpdateQuizQuery will update a quiz record in the quizzes table.
 // Param: pointer to the quiz struct containing the query parameters
 func UpdateQuizQuery(c Cassandra, params any) (response any, err error) {
@@ -169,7 +240,9 @@ func UpdateQuizQuery(c Cassandra, params any) (response any, err error) {
 	}{}
 
 	applied := false
-	if applied, err = conn.session.Query(model_cassandra.UpdateQuiz, input.Quiz.Title, input.Quiz.Questions, input.Quiz.MarkingType, input.QuizID, input.Username).ScanCAS(
+	if applied, err = conn.session.Query(model_cassandra.UpdateQuiz, input.Quiz.Title, input.Quiz.Questions, input.Quiz.MarkingType,
+		input.Quiz.MaxAttempts, input.Quiz.AttemptCooldown, input.Quiz.AttemptPolicy, input.Quiz.TimeLimit, input.Quiz.GracePeriod,
+		input.Quiz.Shuffle, input.Quiz.Draw, input.Quiz.Description, input.Quiz.Tags, input.Quiz.RevealPolicy, input.QuizID, input.Username).ScanCAS(
 		&resp.author, &resp.isDeleted, &resp.isPublished); err != nil {
 		conn.logger.Error("failed to update quiz record", zap.Strings("Quiz info:", []string{input.QuizID.String(), input.Username, input.Quiz.Author}), zap.Error(err))
 		return nil, NewError("failed to update quiz").internalError()
@@ -181,6 +254,10 @@ func UpdateQuizQuery(c Cassandra, params any) (response any, err error) {
 		return nil, NewError(msg).forbiddenError()
 	}
 
+	if err = createAuthorQuiz(conn, &model_cassandra.Quiz{QuizCore: input.Quiz.QuizCore, QuizID: input.QuizID, Author: input.Username}); err != nil {
+		return nil, NewError("unable to list quiz for author").internalError()
+	}
+
 	return nil, err
 }
 
@@ -189,6 +266,7 @@ func UpdateQuizQuery(c Cassandra, params any) (response any, err error) {
 func DeleteQuizQuery(c Cassandra, params any) (response any, err error) {
 	conn := c.(*cassandraImpl)
 	input := params.(*model_cassandra.QuizMutateRequest)
+	var record any
 	resp := struct {
 		author string
 	}{}
@@ -205,104 +283,277 @@ func DeleteQuizQuery(c Cassandra, params any) (response any, err error) {
 		return nil, NewError(msg).forbiddenError()
 	}
 
+	// List the quiz as deleted for its author.
+	if record, err = ReadQuizQuery(c, input.QuizID); err != nil {
+		return nil, err
+	}
+	if err = createAuthorQuiz(conn, record.(*model_cassandra.Quiz)); err != nil {
+		return nil, NewError("unable to list quiz for author").internalError()
+	}
+
 	return nil, err
 }
 
-// PublishQuizQuery will mark a quiz record as published in the quizzes table.
+// PublishQuizQuery will mark a quiz record as published in the quizzes table and record a snapshot of its first version.
+// Publishing a quiz that the requester has already published will only ensure a snapshot of its current version exists.
 // Param: quiz id
 func PublishQuizQuery(c Cassandra, params any) (response any, err error) {
 	conn := c.(*cassandraImpl)
 	input := params.(*model_cassandra.QuizMutateRequest)
+	var record any
 	resp := struct {
-		author    string
-		isDeleted bool
+		author      string
+		isDeleted   bool
+		isPublished bool
 	}{}
 
 	applied := false
-	if applied, err = conn.session.Query(model_cassandra.PublishQuiz, input.QuizID, input.Username).ScanCAS(&resp.author, &resp.isDeleted); err != nil {
+	if applied, err = conn.session.Query(model_cassandra.PublishQuiz, input.QuizID, input.Username).ScanCAS(
+		&resp.author, &resp.isDeleted, &resp.isPublished); err != nil {
 		conn.logger.Error("failed to publish quiz record", zap.Strings("Quiz info:", []string{input.QuizID.String(), input.Username}), zap.Error(err))
 		return nil, NewError("unable to publish quiz").internalError()
 	}
 
-	if !applied {
+	if !applied && (resp.author != input.Username || resp.isDeleted || !resp.isPublished) {
 		msg := "failed to publish quiz record"
 		conn.logger.Error(msg, zap.Strings("Quiz info:", []string{input.QuizID.String(), input.Username}), zap.Error(err))
 		return nil, NewError(msg).forbiddenError()
 	}
 
-	return nil, err
+	// Snapshot the version of the quiz that is now published.
+	if record, err = ReadQuizQuery(c, input.QuizID); err != nil {
+		return nil, err
+	}
+	if _, err = createQuizVersion(conn, record.(*model_cassandra.Quiz)); err != nil {
+		return nil, NewError("unable to record published quiz version").internalError()
+	}
+
+	if err = createAuthorQuiz(conn, record.(*model_cassandra.Quiz)); err != nil {
+		return nil, NewError("unable to list quiz for author").internalError()
+	}
+
+	return nil, nil
 }
 
-// -----   Responses Table Queries   -----
+// ReviseQuizQuery will replace a published quiz record in the quizzes table with a new version. The new version is recorded
+// in the quiz versions table before the quiz record is updated, so concurrent revisions of the same version will conflict.
+// Param: pointer to the quiz mutate request containing the revised quiz
+// Return: the new version number
+func ReviseQuizQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.QuizMutateRequest)
+	var record any
+	resp := struct {
+		author      string
+		isDeleted   bool
+		isPublished bool
+	}{}
 
-// CreateResponseQuery will insert a response record into the responses table.
-// Param: pointer to the user struct containing the response parameters
-func CreateResponseQuery(c Cassandra, params any) (response any, err error) {
+	if record, err = ReadQuizQuery(c, input.QuizID); err != nil {
+		return nil, err
+	}
+	quiz := record.(*model_cassandra.Quiz)
+
+	if quiz.Author != input.Username || !quiz.IsPublished || quiz.IsDeleted {
+		msg := "failed to revise quiz. Either it is not published, is deleted, or the requester is not the author"
+		conn.logger.Error(msg, zap.Strings("Quiz info:", []string{input.QuizID.String(), input.Username}))
+		return nil, NewError(msg).forbiddenError()
+	}
+
+	// Quizzes published before versioning was introduced will not have a snapshot of their current version.
+	if _, err = createQuizVersion(conn, quiz); err != nil {
+		return nil, NewError("unable to record current quiz version").internalError()
+	}
+
+	revision := model_cassandra.Quiz{
+		QuizCore: input.Quiz.QuizCore,
+		QuizID:   quiz.QuizID,
+		Author:   quiz.Author,
+		Version:  quiz.Version + 1,
+	}
+
+	applied := false
+	if applied, err = createQuizVersion(conn, &revision); err != nil {
+		return nil, NewError("unable to record revised quiz version").internalError()
+	}
+
+	if !applied {
+		msg := "failed to revise quiz, a newer version was published concurrently"
+		conn.logger.Error(msg, zap.Strings("Quiz info:", []string{input.QuizID.String(), input.Username}))
+		return nil, NewError(msg).conflictError()
+	}
+
+	if applied, err = conn.session.Query(model_cassandra.ReviseQuiz, revision.Title, revision.Questions, revision.MarkingType,
+		revision.MaxAttempts, revision.AttemptCooldown, revision.AttemptPolicy, revision.TimeLimit, revision.GracePeriod, revision.Shuffle,
+		revision.Draw, revision.Description, revision.Tags, revision.RevealPolicy, revision.Version, input.QuizID, input.Username).ScanCAS(&resp.author, &resp.isDeleted, &resp.isPublished); err != nil {
+		conn.logger.Error("failed to revise quiz record", zap.Strings("Quiz info:", []string{input.QuizID.String(), input.Username}), zap.Error(err))
+		return nil, NewError("failed to revise quiz").internalError()
+	}
+
+	if !applied {
+		msg := "failed to revise quiz. Either it is not published, is deleted, or the requester is not the author"
+		conn.logger.Error(msg, zap.Strings("Quiz info:", []string{input.QuizID.String(), input.Username}))
+		return nil, NewError(msg).forbiddenError()
+	}
+
+	revision.IsPublished = true
+	if err = createAuthorQuiz(conn, &revision); err != nil {
+		return nil, NewError("unable to list quiz for author").internalError()
+	}
+
+	return revision.Version, nil
+}
+
+// ScheduleQuizQuery will set the availability window of a quiz record in the quizzes table and reopen it if it was closed.
+// The opening and closing times are then queued in the quiz schedule table for the scheduler. Only unpublished quizzes can
+// be given an opening time.
+// Param: pointer to the quiz schedule request containing the query parameters
+func ScheduleQuizQuery(c Cassandra, params any) (response any, err error) {
 	conn := c.(*cassandraImpl)
-	input := params.(*model_cassandra.Response)
-	resp := model_cassandra.Response{QuizResponse: &model_cassandra.QuizResponse{}}
+	input := params.(*model_cassandra.QuizScheduleRequest)
+	var record any
+	resp := struct {
+		author      string
+		isDeleted   bool
+		isPublished bool
+	}{}
+
+	if record, err = ReadQuizQuery(c, input.QuizID); err != nil {
+		return nil, err
+	}
+	quiz := record.(*model_cassandra.Quiz)
+
+	if quiz.Author != input.Username || quiz.IsDeleted {
+		msg := "failed to schedule quiz. Either it is deleted or the requester is not the author"
+		conn.logger.Error(msg, zap.Strings("Quiz info:", []string{input.QuizID.String(), input.Username}))
+		return nil, NewError(msg).forbiddenError()
+	}
+
+	if quiz.IsPublished && input.OpensAt != nil {
+		msg := "failed to schedule quiz, an opening time cannot be set on a published quiz"
+		conn.logger.Error(msg, zap.Strings("Quiz info:", []string{input.QuizID.String(), input.Username}))
+		return nil, NewError(msg).forbiddenError()
+	}
 
 	applied := false
-	if applied, err = conn.session.Query(model_cassandra.CreateResponse,
-		input.Username, input.QuizID, input.Author, input.Responses, input.Score).ScanCAS(
-		&resp.Username, &resp.QuizID, &resp.Author, &resp.Responses, &resp.Score); err != nil {
-		conn.logger.Error("failed to create response record",
-			zap.Strings("Response info:", []string{input.Username, input.QuizID.String()}), zap.Error(err))
-		return nil, NewError(err.Error()).internalError()
+	if applied, err = conn.session.Query(model_cassandra.ScheduleQuiz, input.OpensAt, input.ClosesAt, input.QuizID,
+		input.Username, quiz.IsPublished).ScanCAS(&resp.author, &resp.isDeleted, &resp.isPublished); err != nil {
+		conn.logger.Error("failed to schedule quiz record", zap.Strings("Quiz info:", []string{input.QuizID.String(), input.Username}), zap.Error(err))
+		return nil, NewError("failed to schedule quiz").internalError()
 	}
 
 	if !applied {
-		msg := "failed to record response, user has already taken this quiz"
-		conn.logger.Error(msg, zap.Strings("Response info:", []string{input.Username, input.QuizID.String()}), zap.Error(err))
+		msg := "failed to schedule quiz, it was modified concurrently"
+		conn.logger.Error(msg, zap.Strings("Quiz info:", []string{input.QuizID.String(), input.Username}))
 		return nil, NewError(msg).conflictError()
 	}
 
+	events := make([]*model_cassandra.QuizScheduleEvent, 0, 2)
+	if input.OpensAt != nil {
+		events = append(events, &model_cassandra.QuizScheduleEvent{
+			Action: model_cassandra.ScheduleActionPublish, DueAt: *input.OpensAt, QuizID: input.QuizID})
+	}
+	if input.ClosesAt != nil {
+		events = append(events, &model_cassandra.QuizScheduleEvent{
+			Action: model_cassandra.ScheduleActionClose, DueAt: *input.ClosesAt, QuizID: input.QuizID})
+	}
+	for _, event := range events {
+		if _, err = CreateQuizScheduleEventQuery(c, event); err != nil {
+			return nil, err
+		}
+	}
+
 	return nil, nil
 }
 
-// ReadResponseQuery will read a response record from the responses table.
-// Param: pointer to the response struct containing the query parameters
-// Return: address to a response record
-func ReadResponseQuery(c Cassandra, params any) (response any, err error) {
+// CloseQuizQuery will mark a quiz record as closed in the quizzes table. Quizzes that are deleted or whose closing time has
+// been changed since it was read will not be closed.
+// Param: pointer to the close quiz request containing the query parameters
+// Return: whether the quiz was closed
+func CloseQuizQuery(c Cassandra, params any) (response any, err error) {
 	conn := c.(*cassandraImpl)
-	input := params.(*model_cassandra.QuizMutateRequest)
-	resp := model_cassandra.Response{QuizResponse: &model_cassandra.QuizResponse{}}
+	input := params.(*model_cassandra.CloseQuizRequest)
+	resp := struct {
+		isDeleted bool
+		closesAt  time.Time
+	}{}
 
-	if err = conn.session.Query(model_cassandra.ReadResponse, input.Username, input.QuizID).Scan(
-		&resp.Username, &resp.QuizID, &resp.Author, &resp.Responses, &resp.Score); err != nil {
-		conn.logger.Error("failed to read response record",
-			zap.Strings("Response info:", []string{input.Username, input.QuizID.String()}), zap.Error(err))
-		return nil, NewError("score card not found").notFoundError()
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.CloseQuiz, input.QuizID, input.ClosesAt).ScanCAS(
+		&resp.isDeleted, &resp.closesAt); err != nil {
+		conn.logger.Error("failed to close quiz record", zap.String("Quiz info:", input.QuizID.String()), zap.Error(err))
+		return nil, NewError("failed to close quiz").internalError()
+	}
+
+	return applied, nil
+}
+
+// -----   Quiz Versions Table Queries   -----
+
+// createQuizVersion will record a snapshot of the contents of a quiz as its current version in the quiz versions table.
+// Versions are immutable and an existing version will not be overwritten.
+func createQuizVersion(conn *cassandraImpl, quiz *model_cassandra.Quiz) (applied bool, err error) {
+	resp := model_cassandra.QuizVersion{QuizCore: &model_cassandra.QuizCore{}}
+
+	if applied, err = conn.session.Query(model_cassandra.CreateQuizVersion,
+		quiz.QuizID, quiz.Version, quiz.Author, quiz.Title, quiz.Questions, quiz.MarkingType, quiz.MaxAttempts, quiz.AttemptCooldown,
+		quiz.AttemptPolicy, quiz.TimeLimit, quiz.GracePeriod, quiz.Shuffle, quiz.Draw, quiz.Description, quiz.Tags, quiz.RevealPolicy).ScanCAS(
+		&resp.QuizID, &resp.Version, &resp.AttemptCooldown, &resp.AttemptPolicy, &resp.Author, &resp.Description, &resp.Draw,
+		&resp.GracePeriod, &resp.MarkingType, &resp.MaxAttempts, &resp.Questions, &resp.RevealPolicy, &resp.Shuffle, &resp.Tags, &resp.TimeLimit, &resp.Title); err != nil {
+		conn.logger.Error("failed to create quiz version record",
+			zap.Strings("Quiz info:", []string{quiz.QuizID.String(), quiz.Author}), zap.Int("version", quiz.Version), zap.Error(err))
+		return false, err
+	}
+
+	return applied, nil
+}
+
+// ReadQuizVersionQuery will read a quiz version record from the quiz versions table.
+// Param: pointer to the quiz version request containing the quiz id and version
+// Return: address to a quiz version record
+func ReadQuizVersionQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.QuizVersionRequest)
+	resp := model_cassandra.QuizVersion{QuizCore: &model_cassandra.QuizCore{}}
+
+	if err = conn.session.Query(model_cassandra.ReadQuizVersion, input.QuizID, input.Version).Scan(
+		&resp.QuizID, &resp.Version, &resp.AttemptCooldown, &resp.AttemptPolicy, &resp.Author, &resp.Description, &resp.Draw,
+		&resp.GracePeriod, &resp.MarkingType, &resp.MaxAttempts, &resp.Questions, &resp.RevealPolicy, &resp.Shuffle, &resp.Tags, &resp.TimeLimit, &resp.Title); err != nil {
+		conn.logger.Error("failed to read quiz version record",
+			zap.String("Quiz info:", input.QuizID.String()), zap.Int("version", input.Version), zap.Error(err))
+		return nil, NewError("quiz version not found").notFoundError()
 	}
 
 	return &resp, nil
 }
 
-// ReadResponseStatisticsQuery will read all response records from the responses table corresponding to a Quiz ID.
+// ReadQuizVersionsQuery will read all quiz version records from the quiz versions table corresponding to a Quiz ID.
 // Param: QuizID gocql UUID
-// Return: address to slice of responses
-func ReadResponseStatisticsQuery(c Cassandra, params any) (response any, err error) {
+// Return: slice of quiz versions in ascending order of version
+func ReadQuizVersionsQuery(c Cassandra, params any) (response any, err error) {
 	conn := c.(*cassandraImpl)
 	input := params.(gocql.UUID)
-	var results []*model_cassandra.Response
+	var results []*model_cassandra.QuizVersion
 
-	iter := conn.session.Query(model_cassandra.ReadResponseStatistics, input).Iter()
+	iter := conn.session.Query(model_cassandra.ReadQuizVersions, input).Iter()
 	defer func(iter *gocql.Iter) {
 		if err := iter.Close(); err != nil {
-			conn.logger.Error("failed to close iterator whilst reading response statistics",
+			conn.logger.Error("failed to close iterator whilst reading quiz versions",
 				zap.String("quiz_id", input.String()), zap.Error(err))
 		}
 	}(iter)
 
 	if numRows := iter.NumRows(); numRows > 0 {
-		results = make([]*model_cassandra.Response, 0, numRows)
+		results = make([]*model_cassandra.QuizVersion, 0, numRows)
 	}
 
 	scanRows := iter.Scanner()
 	for scanRows.Next() {
-		row := model_cassandra.Response{QuizResponse: &model_cassandra.QuizResponse{}}
-		if err = scanRows.Scan(&row.Username, &row.QuizID, &row.Author, &row.Responses, &row.Score); err != nil {
-			conn.logger.Error("failed to read row in response statistics",
+		row := model_cassandra.QuizVersion{QuizCore: &model_cassandra.QuizCore{}}
+		if err = scanRows.Scan(&row.QuizID, &row.Version, &row.AttemptCooldown, &row.AttemptPolicy, &row.Author, &row.Description,
+			&row.Draw, &row.GracePeriod, &row.MarkingType, &row.MaxAttempts, &row.Questions, &row.RevealPolicy, &row.Shuffle, &row.Tags, &row.TimeLimit,
+			&row.Title); err != nil {
+			conn.logger.Error("failed to read row in quiz versions",
 				zap.String("quiz_id", input.String()), zap.Error(err))
 			return nil, NewError(err.Error()).internalError()
 		}
@@ -312,20 +563,52 @@ func ReadResponseStatisticsQuery(c Cassandra, params any) (response any, err err
 	return results, err
 }
 
-// ReadResponseStatisticsPageQuery will read response records pages from the responses table corresponding to a Quiz ID.
-// Param: StatsRequest containing the page size and state to the page to be read
-// Return: address to slice of responses of a page size specified in the request
-func ReadResponseStatisticsPageQuery(c Cassandra, params any) (response any, err error) {
+// -----   Author Quizzes Table Queries   -----
+
+// authorQuizStatus will determine the status of a quiz that is listed for its author.
+func authorQuizStatus(quiz *model_cassandra.Quiz) string {
+	switch {
+	case quiz.IsDeleted:
+		return model_cassandra.QuizStatusDeleted
+	case quiz.IsPublished:
+		return model_cassandra.QuizStatusPublished
+	default:
+		return model_cassandra.QuizStatusDraft
+	}
+}
+
+// createAuthorQuiz will list the title, status, and version of a quiz for its author in the author quizzes table. The
+// existing listing of the quiz is replaced, so quizzes created before the author quizzes table was introduced are listed
+// once they are next modified.
+func createAuthorQuiz(conn *cassandraImpl, quiz *model_cassandra.Quiz) (err error) {
+	if err = conn.session.Query(model_cassandra.CreateAuthorQuiz,
+		quiz.Author, quiz.QuizID, quiz.Title, authorQuizStatus(quiz), quiz.Version).Exec(); err != nil {
+		conn.logger.Error("failed to create author quiz record",
+			zap.Strings("Quiz info:", []string{quiz.QuizID.String(), quiz.Author}), zap.Error(err))
+	}
+
+	return
+}
+
+// ReadAuthorQuizzesPageQuery will read a page of author quiz records from the author quizzes table corresponding to an
+// author, optionally filtered by status. Quizzes are listed from the most to the least recently created.
+// Param: AuthorQuizzesRequest containing the author, status, page size and state to the page to be read
+// Return: address to slice of author quizzes of a page size specified in the request
+func ReadAuthorQuizzesPageQuery(c Cassandra, params any) (response any, err error) {
 	conn := c.(*cassandraImpl)
-	input := params.(*model_cassandra.StatsRequest)
-	var results model_cassandra.StatsResponse
+	input := params.(*model_cassandra.AuthorQuizzesRequest)
+	var results model_cassandra.AuthorQuizzesResponse
 
-	// Get page of response records.
-	iter := conn.session.Query(model_cassandra.ReadResponseStatistics, input.QuizID).PageSize(input.PageSize).PageState(input.PageCursor).Iter()
+	// Get page of author quiz records.
+	query := conn.session.Query(model_cassandra.ReadAuthorQuizzes, input.Author)
+	if len(input.Status) != 0 {
+		query = conn.session.Query(model_cassandra.ReadAuthorQuizzesByStatus, input.Author, input.Status)
+	}
+	iter := query.PageSize(input.PageSize).PageState(input.PageCursor).Iter()
 	defer func(iter *gocql.Iter) {
 		if err := iter.Close(); err != nil {
-			conn.logger.Error("failed to close iterator whilst reading response statistics page",
-				zap.String("quiz_id", input.QuizID.String()), zap.Error(err))
+			conn.logger.Error("failed to close iterator whilst reading author quizzes page",
+				zap.String("author", input.Author), zap.Error(err))
 		}
 	}(iter)
 
@@ -333,16 +616,16 @@ func ReadResponseStatisticsPageQuery(c Cassandra, params any) (response any, err
 	results.PageSize = input.PageSize
 	results.PageCursor = iter.PageState()
 	if numRows := iter.NumRows(); numRows > 0 {
-		results.Records = make([]*model_cassandra.Response, 0, numRows)
+		results.Records = make([]*model_cassandra.AuthorQuiz, 0, numRows)
 	}
 
 	// Read-in rows from the database.
 	scanRows := iter.Scanner()
 	for scanRows.Next() {
-		row := model_cassandra.Response{QuizResponse: &model_cassandra.QuizResponse{}}
-		if err = scanRows.Scan(&row.Username, &row.QuizID, &row.Author, &row.Responses, &row.Score); err != nil {
-			conn.logger.Error("failed to read row in response statistics page",
-				zap.String("quiz_id", input.QuizID.String()), zap.Error(err))
+		row := model_cassandra.AuthorQuiz{}
+		if err = scanRows.Scan(&row.Author, &row.QuizID, &row.Status, &row.Title, &row.Version); err != nil {
+			conn.logger.Error("failed to read row in author quizzes page",
+				zap.String("author", input.Author), zap.Error(err))
 			return nil, NewError(err.Error()).internalError()
 		}
 		results.Records = append(results.Records, &row)
@@ -350,3 +633,1156 @@ func ReadResponseStatisticsPageQuery(c Cassandra, params any) (response any, err
 
 	return &results, err
 }
+
+// -----   Question Banks Table Queries   -----
+
+// CreateQuestionBankQuery will create a question bank record in the question banks table.
+// Param: pointer to the question bank struct containing the query parameters
+func CreateQuestionBankQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.QuestionBank)
+	resp := model_cassandra.QuestionBank{QuestionBankCore: &model_cassandra.QuestionBankCore{}}
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.CreateQuestionBank,
+		input.BankID, input.Author, input.Title, input.Questions).ScanCAS(
+		&resp.BankID, &resp.Author, &resp.IsDeleted, &resp.Questions, &resp.Title); err != nil {
+		conn.logger.Error("failed to create question bank record",
+			zap.Strings("Bank info:", []string{input.BankID.String(), input.Author}), zap.Error(err))
+		return nil, NewError("failed to create question bank").internalError()
+	}
+
+	if !applied {
+		msg := "failed to create question bank with id, it already exists"
+		conn.logger.Error(msg, zap.Strings("Bank info:", []string{input.BankID.String(), input.Author}))
+		return nil, NewError(msg).conflictError()
+	}
+
+	return nil, nil
+}
+
+// ReadQuestionBankQuery will read a question bank record from the question banks table.
+// Param: bank id
+// Return: address to a question bank record
+func ReadQuestionBankQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(gocql.UUID)
+	resp := model_cassandra.QuestionBank{QuestionBankCore: &model_cassandra.QuestionBankCore{}}
+
+	if err = conn.session.Query(model_cassandra.ReadQuestionBank, input).Scan(
+		&resp.BankID, &resp.Author, &resp.IsDeleted, &resp.Questions, &resp.Title); err != nil {
+		conn.logger.Error("failed to read question bank record", zap.String("Bank info:", input.String()), zap.Error(err))
+		return nil, NewError("question bank not found").notFoundError()
+	}
+
+	return &resp, nil
+}
+
+// ReadQuestionBanksQuery will read multiple question bank records from the question banks table. Banks that do not exist
+// are not returned.
+// Param: slice of bank ids
+// Return: slice of question bank records
+func ReadQuestionBanksQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.([]gocql.UUID)
+	var results []*model_cassandra.QuestionBank
+
+	iter := conn.session.Query(model_cassandra.ReadQuestionBanks, input).Iter()
+	defer func(iter *gocql.Iter) {
+		if err := iter.Close(); err != nil {
+			conn.logger.Error("failed to close iterator whilst reading question banks", zap.Error(err))
+		}
+	}(iter)
+
+	if numRows := iter.NumRows(); numRows > 0 {
+		results = make([]*model_cassandra.QuestionBank, 0, numRows)
+	}
+
+	scanRows := iter.Scanner()
+	for scanRows.Next() {
+		row := model_cassandra.QuestionBank{QuestionBankCore: &model_cassandra.QuestionBankCore{}}
+		if err = scanRows.Scan(&row.BankID, &row.Author, &row.IsDeleted, &row.Questions, &row.Title); err != nil {
+			conn.logger.Error("failed to read row in question banks", zap.Error(err))
+			return nil, NewError("failed to read question banks").internalError()
+		}
+		results = append(results, &row)
+	}
+
+	return results, nil
+}
+
+// UpdateQuestionBankQuery will update a question bank record in the question banks table.
+// Param: pointer to the question bank mutate request containing the query parameters
+func UpdateQuestionBankQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.QuestionBankMutateRequest)
+	resp := struct {
+		author    string
+		isDeleted bool
+	}{}
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.UpdateQuestionBank, input.Bank.Title, input.Bank.Questions,
+		input.BankID, input.Username).ScanCAS(&resp.author, &resp.isDeleted); err != nil {
+		conn.logger.Error("failed to update question bank record",
+			zap.Strings("Bank info:", []string{input.BankID.String(), input.Username}), zap.Error(err))
+		return nil, NewError("failed to update question bank").internalError()
+	}
+
+	if !applied {
+		msg := "failed to update question bank. Either it does not exist, is deleted, or the requester is not the author"
+		conn.logger.Error(msg, zap.Strings("Bank info:", []string{input.BankID.String(), input.Username}))
+		return nil, NewError(msg).forbiddenError()
+	}
+
+	return nil, nil
+}
+
+// DeleteQuestionBankQuery will mark a question bank record as deleted in the question banks table.
+// Param: pointer to the question bank mutate request containing the query parameters
+func DeleteQuestionBankQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.QuestionBankMutateRequest)
+	resp := struct {
+		author string
+	}{}
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.DeleteQuestionBank, input.BankID, input.Username).ScanCAS(&resp.author); err != nil {
+		conn.logger.Error("failed to delete question bank record",
+			zap.Strings("Bank info:", []string{input.BankID.String(), input.Username}), zap.Error(err))
+		return nil, NewError("failed to delete question bank").internalError()
+	}
+
+	if !applied {
+		msg := "failed to delete question bank record"
+		conn.logger.Error(msg, zap.Strings("Bank info:", []string{input.BankID.String(), input.Username}))
+		return nil, NewError(msg).forbiddenError()
+	}
+
+	return nil, nil
+}
+
+// -----   Responses Table Queries   -----
+
+// CreateResponseQuery will insert a response record into the responses table.
+// Param: pointer to the user struct containing the response parameters
+func CreateResponseQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.Response)
+	resp := model_cassandra.Response{QuizResponse: &model_cassandra.QuizResponse{}}
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.CreateResponse,
+		input.Username, input.QuizID, input.Author, input.Responses, input.Score, input.MaxScore, input.TextResponses, input.Version,
+		input.Attempts).ScanCAS(
+		&resp.Username, &resp.QuizID, &resp.Attempts, &resp.Author, &resp.MaxScore, &resp.Responses, &resp.Score, &resp.TextResponses,
+		&resp.Version); err != nil {
+		conn.logger.Error("failed to create response record",
+			zap.Strings("Response info:", []string{input.Username, input.QuizID.String()}), zap.Error(err))
+		return nil, NewError(err.Error()).internalError()
+	}
+
+	if !applied {
+		msg := "failed to record response, another attempt was recorded concurrently"
+		conn.logger.Error(msg, zap.Strings("Response info:", []string{input.Username, input.QuizID.String()}), zap.Error(err))
+		return nil, NewError(msg).conflictError()
+	}
+
+	createUserScore(conn, input)
+
+	return nil, nil
+}
+
+// UpdateResponseQuery will replace the attempts and effective score of a response record in the responses table. The update
+// will only be applied if the attempts in the record have not changed since they were read.
+// Param: pointer to the response update request containing the updated response and the attempts as they were read
+func UpdateResponseQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.ResponseUpdateRequest)
+	update := input.Response
+	var attempts []*model_cassandra.Attempt // Discarded, only used as container for Cassandra response.
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.UpdateResponse,
+		update.Responses, update.TextResponses, update.Score, update.MaxScore, update.Version, update.Attempts,
+		update.Username, update.QuizID, input.PreviousAttempts).ScanCAS(&attempts); err != nil {
+		conn.logger.Error("failed to update response record",
+			zap.Strings("Response info:", []string{update.Username, update.QuizID.String()}), zap.Error(err))
+		return nil, NewError(err.Error()).internalError()
+	}
+
+	if !applied {
+		msg := "failed to update response, another attempt was recorded concurrently"
+		conn.logger.Error(msg, zap.Strings("Response info:", []string{update.Username, update.QuizID.String()}))
+		return nil, NewError(msg).conflictError()
+	}
+
+	createUserScore(conn, update)
+
+	return nil, nil
+}
+
+// ReadResponseQuery will read a response record from the responses table.
+// Param: pointer to the response struct containing the query parameters
+// Return: address to a response record
+func ReadResponseQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.QuizMutateRequest)
+	resp := model_cassandra.Response{QuizResponse: &model_cassandra.QuizResponse{}}
+
+	if err = conn.session.Query(model_cassandra.ReadResponse, input.Username, input.QuizID).Scan(
+		&resp.Username, &resp.QuizID, &resp.Attempts, &resp.Author, &resp.MaxScore, &resp.Responses, &resp.Score, &resp.TextResponses,
+		&resp.Version); err != nil {
+		conn.logger.Error("failed to read response record",
+			zap.Strings("Response info:", []string{input.Username, input.QuizID.String()}), zap.Error(err))
+		return nil, NewError("score card not found").notFoundError()
+	}
+
+	return &resp, nil
+}
+
+// ReadResponseStatisticsQuery will read all response records from the responses table corresponding to a Quiz ID.
+// Param: QuizID gocql UUID
+// Return: address to slice of responses
+func ReadResponseStatisticsQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(gocql.UUID)
+	var results []*model_cassandra.Response
+
+	iter := conn.session.Query(model_cassandra.ReadResponseStatistics, input).Iter()
+	defer func(iter *gocql.Iter) {
+		if err := iter.Close(); err != nil {
+			conn.logger.Error("failed to close iterator whilst reading response statistics",
+				zap.String("quiz_id", input.String()), zap.Error(err))
+		}
+	}(iter)
+
+	if numRows := iter.NumRows(); numRows > 0 {
+		results = make([]*model_cassandra.Response, 0, numRows)
+	}
+
+	scanRows := iter.Scanner()
+	for scanRows.Next() {
+		row := model_cassandra.Response{QuizResponse: &model_cassandra.QuizResponse{}}
+		if err = scanRows.Scan(&row.Username, &row.QuizID, &row.Attempts, &row.Author, &row.MaxScore, &row.Responses, &row.Score,
+			&row.TextResponses, &row.Version); err != nil {
+			conn.logger.Error("failed to read row in response statistics",
+				zap.String("quiz_id", input.String()), zap.Error(err))
+			return nil, NewError(err.Error()).internalError()
+		}
+		results = append(results, &row)
+	}
+
+	return results, err
+}
+
+// ReadResponseStatisticsPageQuery will read response records pages from the responses table corresponding to a Quiz ID.
+// Param: StatsRequest containing the page size and state to the page to be read
+// Return: address to slice of responses of a page size specified in the request
+func ReadResponseStatisticsPageQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.StatsRequest)
+	var results model_cassandra.StatsResponse
+
+	// Get page of response records.
+	iter := conn.session.Query(model_cassandra.ReadResponseStatistics, input.QuizID).PageSize(input.PageSize).PageState(input.PageCursor).Iter()
+	defer func(iter *gocql.Iter) {
+		if err := iter.Close(); err != nil {
+			conn.logger.Error("failed to close iterator whilst reading response statistics page",
+				zap.String("quiz_id", input.QuizID.String()), zap.Error(err))
+		}
+	}(iter)
+
+	// Configure results with next pages cursor, current results per page, and current pages data container.
+	results.PageSize = input.PageSize
+	results.PageCursor = iter.PageState()
+	if numRows := iter.NumRows(); numRows > 0 {
+		results.Records = make([]*model_cassandra.Response, 0, numRows)
+	}
+
+	// Read-in rows from the database.
+	scanRows := iter.Scanner()
+	for scanRows.Next() {
+		row := model_cassandra.Response{QuizResponse: &model_cassandra.QuizResponse{}}
+		if err = scanRows.Scan(&row.Username, &row.QuizID, &row.Attempts, &row.Author, &row.MaxScore, &row.Responses, &row.Score,
+			&row.TextResponses, &row.Version); err != nil {
+			conn.logger.Error("failed to read row in response statistics page",
+				zap.String("quiz_id", input.QuizID.String()), zap.Error(err))
+			return nil, NewError(err.Error()).internalError()
+		}
+		results.Records = append(results.Records, &row)
+	}
+
+	return &results, err
+}
+
+// -----   User Scores Table Queries   -----
+
+// createUserScore will list the effective score of a response in the score history of the user who submitted it. The
+// existing listing of the quiz is replaced, so the history reflects the latest attempt and any regrading. Failures are
+// logged but not returned because the response has already been recorded and will be listed on the next write.
+func createUserScore(conn *cassandraImpl, response *model_cassandra.Response) {
+	var title string
+	if err := conn.session.Query(model_cassandra.ReadQuizTitle, response.QuizID).Scan(&title); err != nil {
+		conn.logger.Error("failed to read quiz title for user score record",
+			zap.Strings("Response info:", []string{response.Username, response.QuizID.String()}), zap.Error(err))
+		return
+	}
+
+	var submittedAt time.Time
+	if len(response.Attempts) > 0 {
+		submittedAt = response.Attempts[len(response.Attempts)-1].SubmittedAt
+	}
+
+	if err := conn.session.Query(model_cassandra.CreateUserScore, response.Username, response.QuizID, title, response.Score,
+		response.MaxScore, len(response.Attempts), response.Version, submittedAt).Exec(); err != nil {
+		conn.logger.Error("failed to create user score record",
+			zap.Strings("Response info:", []string{response.Username, response.QuizID.String()}), zap.Error(err))
+	}
+}
+
+// ReadUserScoresPageQuery will read a page of user score records from the user scores table corresponding to a user.
+// Scores are listed from the most to the least recently created quiz.
+// Param: UserScoresRequest containing the username, page size and state to the page to be read
+// Return: address to slice of user scores of a page size specified in the request
+func ReadUserScoresPageQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.UserScoresRequest)
+	var results model_cassandra.UserScoresResponse
+
+	// Get page of user score records.
+	iter := conn.session.Query(model_cassandra.ReadUserScores, input.Username).PageSize(input.PageSize).PageState(input.PageCursor).Iter()
+	defer func(iter *gocql.Iter) {
+		if err := iter.Close(); err != nil {
+			conn.logger.Error("failed to close iterator whilst reading user scores page",
+				zap.String("username", input.Username), zap.Error(err))
+		}
+	}(iter)
+
+	// Configure results with next pages cursor, current results per page, and current pages data container.
+	results.PageSize = input.PageSize
+	results.PageCursor = iter.PageState()
+	if numRows := iter.NumRows(); numRows > 0 {
+		results.Records = make([]*model_cassandra.UserScore, 0, numRows)
+	}
+
+	// Read-in rows from the database.
+	scanRows := iter.Scanner()
+	for scanRows.Next() {
+		row := model_cassandra.UserScore{}
+		if err = scanRows.Scan(&row.Username, &row.QuizID, &row.Attempts, &row.MaxScore, &row.Score, &row.SubmittedAt,
+			&row.Title, &row.Version); err != nil {
+			conn.logger.Error("failed to read row in user scores page",
+				zap.String("username", input.Username), zap.Error(err))
+			return nil, NewError(err.Error()).internalError()
+		}
+		results.Records = append(results.Records, &row)
+	}
+
+	return &results, err
+}
+
+// -----   Attempt Sessions Table Queries   -----
+
+// CreateAttemptSessionQuery will insert an attempt session record into the attempt sessions table. Sessions are immutable
+// and starting an attempt that has already been started will not change its start time or deadline.
+// Param: pointer to the attempt session struct containing the query parameters
+// Return: address to the attempt session record as it is stored
+func CreateAttemptSessionQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.AttemptSession)
+	resp := model_cassandra.AttemptSession{}
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.CreateAttemptSession,
+		input.Username, input.QuizID, input.Attempt, input.StartedAt, input.Deadline).ScanCAS(
+		&resp.Username, &resp.QuizID, &resp.Attempt, &resp.Deadline, &resp.StartedAt); err != nil {
+		conn.logger.Error("failed to create attempt session record",
+			zap.Strings("Session info:", []string{input.Username, input.QuizID.String()}), zap.Int("attempt", input.Attempt), zap.Error(err))
+		return nil, NewError("failed to start attempt").internalError()
+	}
+
+	if !applied {
+		return &resp, nil
+	}
+
+	return input, nil
+}
+
+// ReadAttemptSessionQuery will read an attempt session record from the attempt sessions table.
+// Param: pointer to the attempt session request containing the query parameters
+// Return: address to an attempt session record
+func ReadAttemptSessionQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.AttemptSessionRequest)
+	resp := model_cassandra.AttemptSession{}
+
+	if err = conn.session.Query(model_cassandra.ReadAttemptSession, input.Username, input.QuizID, input.Attempt).Scan(
+		&resp.Username, &resp.QuizID, &resp.Attempt, &resp.Deadline, &resp.StartedAt); err != nil {
+		conn.logger.Error("failed to read attempt session record",
+			zap.Strings("Session info:", []string{input.Username, input.QuizID.String()}), zap.Int("attempt", input.Attempt), zap.Error(err))
+		return nil, NewError("attempt has not been started").notFoundError()
+	}
+
+	return &resp, nil
+}
+
+// -----   Attempt Shuffles Table Queries   -----
+
+// CreateAttemptShuffleQuery will insert an attempt shuffle record into the attempt shuffles table. Shuffles are immutable
+// and viewing an attempt that has already been viewed will not change its order.
+// Param: pointer to the attempt shuffle struct containing the query parameters
+// Return: address to the attempt shuffle record as it is stored
+func CreateAttemptShuffleQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.AttemptShuffle)
+	resp := model_cassandra.AttemptShuffle{}
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.CreateAttemptShuffle,
+		input.Username, input.QuizID, input.Attempt, input.Seed).ScanCAS(
+		&resp.Username, &resp.QuizID, &resp.Attempt, &resp.Seed); err != nil {
+		conn.logger.Error("failed to create attempt shuffle record",
+			zap.Strings("Shuffle info:", []string{input.Username, input.QuizID.String()}), zap.Int("attempt", input.Attempt), zap.Error(err))
+		return nil, NewError("failed to shuffle quiz").internalError()
+	}
+
+	if !applied {
+		return &resp, nil
+	}
+
+	return input, nil
+}
+
+// ReadAttemptShuffleQuery will read an attempt shuffle record from the attempt shuffles table.
+// Param: pointer to the attempt shuffle request containing the query parameters
+// Return: address to an attempt shuffle record
+func ReadAttemptShuffleQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.AttemptShuffleRequest)
+	resp := model_cassandra.AttemptShuffle{}
+
+	if err = conn.session.Query(model_cassandra.ReadAttemptShuffle, input.Username, input.QuizID, input.Attempt).Scan(
+		&resp.Username, &resp.QuizID, &resp.Attempt, &resp.Seed); err != nil {
+		conn.logger.Error("failed to read attempt shuffle record",
+			zap.Strings("Shuffle info:", []string{input.Username, input.QuizID.String()}), zap.Int("attempt", input.Attempt), zap.Error(err))
+		return nil, NewError("attempt has not been viewed").notFoundError()
+	}
+
+	return &resp, nil
+}
+
+// -----   Attempt Draws Table Queries   -----
+
+// CreateAttemptDrawQuery will insert an attempt draw record into the attempt draws table. Draws are immutable and viewing
+// an attempt that has already been viewed will not change its questions.
+// Param: pointer to the attempt draw struct containing the query parameters
+// Return: address to the attempt draw record as it is stored
+func CreateAttemptDrawQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.AttemptDraw)
+	resp := model_cassandra.AttemptDraw{}
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.CreateAttemptDraw,
+		input.Username, input.QuizID, input.Attempt, input.Questions).ScanCAS(
+		&resp.Username, &resp.QuizID, &resp.Attempt, &resp.Questions); err != nil {
+		conn.logger.Error("failed to create attempt draw record",
+			zap.Strings("Draw info:", []string{input.Username, input.QuizID.String()}), zap.Int("attempt", input.Attempt), zap.Error(err))
+		return nil, NewError("failed to draw quiz questions").internalError()
+	}
+
+	if !applied {
+		return &resp, nil
+	}
+
+	return input, nil
+}
+
+// ReadAttemptDrawQuery will read an attempt draw record from the attempt draws table.
+// Param: pointer to the attempt draw request containing the query parameters
+// Return: address to an attempt draw record
+func ReadAttemptDrawQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.AttemptDrawRequest)
+	resp := model_cassandra.AttemptDraw{}
+
+	if err = conn.session.Query(model_cassandra.ReadAttemptDraw, input.Username, input.QuizID, input.Attempt).Scan(
+		&resp.Username, &resp.QuizID, &resp.Attempt, &resp.Questions); err != nil {
+		conn.logger.Error("failed to read attempt draw record",
+			zap.Strings("Draw info:", []string{input.Username, input.QuizID.String()}), zap.Int("attempt", input.Attempt), zap.Error(err))
+		return nil, NewError("attempt has not been drawn").notFoundError()
+	}
+
+	return &resp, nil
+}
+
+// -----   Answer Drafts Table Queries   -----
+
+// CreateAnswerDraftQuery will insert an answer draft record into the answer drafts table, replacing the previously saved
+// draft for the attempt.
+// Param: pointer to the answer draft struct containing the query parameters
+func CreateAnswerDraftQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.AnswerDraft)
+
+	if err = conn.session.Query(model_cassandra.CreateAnswerDraft, input.Username, input.QuizID, input.Attempt,
+		input.Responses, input.TextResponses, input.SavedAt).Exec(); err != nil {
+		conn.logger.Error("failed to create answer draft record",
+			zap.Strings("Draft info:", []string{input.Username, input.QuizID.String()}), zap.Int("attempt", input.Attempt), zap.Error(err))
+		return nil, NewError("failed to save draft").internalError()
+	}
+
+	return nil, nil
+}
+
+// ReadAnswerDraftQuery will read an answer draft record from the answer drafts table.
+// Param: pointer to the answer draft request containing the query parameters
+// Return: address to an answer draft record
+func ReadAnswerDraftQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.AnswerDraftRequest)
+	resp := model_cassandra.AnswerDraft{QuizResponse: &model_cassandra.QuizResponse{}}
+
+	if err = conn.session.Query(model_cassandra.ReadAnswerDraft, input.Username, input.QuizID, input.Attempt).Scan(
+		&resp.Username, &resp.QuizID, &resp.Attempt, &resp.Responses, &resp.SavedAt, &resp.TextResponses); err != nil {
+		conn.logger.Error("failed to read answer draft record",
+			zap.Strings("Draft info:", []string{input.Username, input.QuizID.String()}), zap.Int("attempt", input.Attempt), zap.Error(err))
+		return nil, NewError("draft has not been saved").notFoundError()
+	}
+
+	return &resp, nil
+}
+
+// -----   Quiz Schedule Table Queries   -----
+
+// CreateQuizScheduleEventQuery will insert a pending action on a quiz into the quiz schedule table.
+// Param: pointer to the quiz schedule event struct containing the query parameters
+func CreateQuizScheduleEventQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.QuizScheduleEvent)
+
+	if err = conn.session.Query(model_cassandra.CreateQuizScheduleEvent, input.Action, input.DueAt, input.QuizID).Exec(); err != nil {
+		conn.logger.Error("failed to create quiz schedule record",
+			zap.Strings("Schedule info:", []string{input.Action, input.QuizID.String()}), zap.Time("due_at", input.DueAt), zap.Error(err))
+		return nil, NewError("failed to schedule quiz").internalError()
+	}
+
+	return nil, nil
+}
+
+// ReadQuizScheduleEventsQuery will read the earliest pending actions of a type that are due from the quiz schedule table.
+// Param: pointer to the quiz schedule events request containing the query parameters
+// Return: slice of quiz schedule event records
+func ReadQuizScheduleEventsQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.QuizScheduleEventsRequest)
+	results := make([]*model_cassandra.QuizScheduleEvent, 0)
+
+	iter := conn.session.Query(model_cassandra.ReadQuizScheduleEvents, input.Action, input.DueBy, input.Limit).Iter()
+	defer func(iter *gocql.Iter) {
+		if err := iter.Close(); err != nil {
+			conn.logger.Error("failed to close iterator whilst reading quiz schedule",
+				zap.String("action", input.Action), zap.Error(err))
+		}
+	}(iter)
+
+	scanRows := iter.Scanner()
+	for scanRows.Next() {
+		row := model_cassandra.QuizScheduleEvent{}
+		if err = scanRows.Scan(&row.Action, &row.DueAt, &row.QuizID); err != nil {
+			conn.logger.Error("failed to read row in quiz schedule", zap.String("action", input.Action), zap.Error(err))
+			return nil, NewError(err.Error()).internalError()
+		}
+		results = append(results, &row)
+	}
+
+	return results, err
+}
+
+// DeleteQuizScheduleEventQuery will remove a pending action on a quiz from the quiz schedule table.
+// Param: pointer to the quiz schedule event struct containing the query parameters
+func DeleteQuizScheduleEventQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.QuizScheduleEvent)
+
+	if err = conn.session.Query(model_cassandra.DeleteQuizScheduleEvent, input.Action, input.DueAt, input.QuizID).Exec(); err != nil {
+		conn.logger.Error("failed to delete quiz schedule record",
+			zap.Strings("Schedule info:", []string{input.Action, input.QuizID.String()}), zap.Time("due_at", input.DueAt), zap.Error(err))
+		return nil, NewError("failed to remove scheduled quiz action").internalError()
+	}
+
+	return nil, nil
+}
+
+// -----   Groups Table Queries   -----
+
+// CreateGroupQuery will create a group record in the groups table, reserve its join code, and add its creator as an owner.
+// Param: pointer to the group struct containing the query parameters
+func CreateGroupQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.Group)
+	resp := model_cassandra.Group{GroupCore: &model_cassandra.GroupCore{}} // Discarded, only used as container for Cassandra response.
+
+	// Reserve the join code so that it is unique across groups.
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.CreateGroupJoinCode, input.JoinCode, input.GroupID).ScanCAS(
+		&resp.JoinCode, &resp.GroupID); err != nil {
+		conn.logger.Error("failed to create group join code record",
+			zap.Strings("Group info:", []string{input.GroupID.String(), input.Creator}), zap.Error(err))
+		return nil, NewError("failed to create group").internalError()
+	}
+
+	if !applied {
+		msg := "failed to create group, the join code is already in use"
+		conn.logger.Error(msg, zap.Strings("Group info:", []string{input.GroupID.String(), input.Creator}))
+		return nil, NewError(msg).conflictError()
+	}
+
+	if applied, err = conn.session.Query(model_cassandra.CreateGroup,
+		input.GroupID, input.Name, input.Description, input.Creator, input.JoinCode).ScanCAS(
+		&resp.GroupID, &resp.Creator, &resp.Description, &resp.IsDeleted, &resp.JoinCode, &resp.Name); err != nil {
+		conn.logger.Error("failed to create group record",
+			zap.Strings("Group info:", []string{input.GroupID.String(), input.Creator}), zap.Error(err))
+		return nil, NewError("failed to create group").internalError()
+	}
+
+	if !applied {
+		msg := "failed to create group with id, it already exists"
+		conn.logger.Error(msg, zap.Strings("Group info:", []string{input.GroupID.String(), input.Creator}))
+		return nil, NewError(msg).conflictError()
+	}
+
+	creator := model_cassandra.GroupMember{GroupID: input.GroupID, Username: input.Creator, IsOwner: true, JoinedAt: time.Now()}
+	if err = createGroupMember(conn, &creator, input.Name); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ReadGroupQuery will read a group record from the groups table. Deleted groups are not found.
+// Param: group id
+// Return: address to a group record
+func ReadGroupQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(gocql.UUID)
+	resp := model_cassandra.Group{GroupCore: &model_cassandra.GroupCore{}}
+
+	if err = conn.session.Query(model_cassandra.ReadGroup, input).Scan(
+		&resp.GroupID, &resp.Creator, &resp.Description, &resp.IsDeleted, &resp.JoinCode, &resp.Name); err != nil {
+		conn.logger.Error("failed to read group record", zap.String("Group info:", input.String()), zap.Error(err))
+		return nil, NewError("group not found").notFoundError()
+	}
+
+	if resp.IsDeleted {
+		return nil, NewError("group not found").notFoundError()
+	}
+
+	return &resp, nil
+}
+
+// UpdateGroupQuery will update the name and description of a group record in the groups table and the name of the group
+// listed for its members.
+// Param: pointer to the group mutate request containing the query parameters
+func UpdateGroupQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.GroupMutateRequest)
+	var isDeleted bool // Discarded, only used as container for Cassandra response.
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.UpdateGroup, input.Group.Name, input.Group.Description,
+		input.GroupID).ScanCAS(&isDeleted); err != nil {
+		conn.logger.Error("failed to update group record", zap.String("Group info:", input.GroupID.String()), zap.Error(err))
+		return nil, NewError("failed to update group").internalError()
+	}
+
+	if !applied {
+		msg := "failed to update group record that does not exist or is deleted"
+		conn.logger.Error(msg, zap.String("Group info:", input.GroupID.String()))
+		return nil, NewError("group not found").notFoundError()
+	}
+
+	// List the new name of the group for its members. Failures are logged but not returned because the group has already
+	// been updated.
+	members, err := ReadGroupMembersQuery(c, input.GroupID)
+	if err != nil {
+		return nil, nil
+	}
+	for _, member := range members.([]*model_cassandra.GroupMember) {
+		if err = conn.session.Query(model_cassandra.UpdateUserGroupName, input.Group.Name, member.Username, input.GroupID).Exec(); err != nil {
+			conn.logger.Error("failed to update user group record",
+				zap.Strings("Group info:", []string{input.GroupID.String(), member.Username}), zap.Error(err))
+		}
+	}
+
+	return nil, nil
+}
+
+// UpdateGroupJoinCodeQuery will replace the join code of a group record in the groups table. The replacement join code is
+// reserved before the group record is updated and the previous join code is released afterwards.
+// Param: pointer to the group join code request containing the query parameters
+func UpdateGroupJoinCodeQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.GroupJoinCodeRequest)
+	resp := struct {
+		joinCode  string
+		groupId   gocql.UUID
+		isDeleted bool
+	}{}
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.CreateGroupJoinCode, input.JoinCode, input.GroupID).ScanCAS(
+		&resp.joinCode, &resp.groupId); err != nil {
+		conn.logger.Error("failed to create group join code record", zap.String("Group info:", input.GroupID.String()), zap.Error(err))
+		return nil, NewError("failed to replace join code").internalError()
+	}
+
+	if !applied {
+		msg := "failed to replace join code, the join code is already in use"
+		conn.logger.Error(msg, zap.String("Group info:", input.GroupID.String()))
+		return nil, NewError(msg).conflictError()
+	}
+
+	if applied, err = conn.session.Query(model_cassandra.UpdateGroupJoinCode, input.JoinCode, input.GroupID,
+		input.PreviousCode).ScanCAS(&resp.isDeleted, &resp.joinCode); err != nil {
+		conn.logger.Error("failed to update group join code", zap.String("Group info:", input.GroupID.String()), zap.Error(err))
+		return nil, NewError("failed to replace join code").internalError()
+	}
+
+	// Release the join code that was not used, or the one that was replaced.
+	released := input.PreviousCode
+	if !applied {
+		released = input.JoinCode
+	}
+	if err := conn.session.Query(model_cassandra.DeleteGroupJoinCode, released).Exec(); err != nil {
+		conn.logger.Error("failed to delete group join code record", zap.String("Group info:", input.GroupID.String()), zap.Error(err))
+	}
+
+	if !applied {
+		msg := "failed to replace join code. Either the group is deleted or its join code was replaced concurrently"
+		conn.logger.Error(msg, zap.String("Group info:", input.GroupID.String()))
+		return nil, NewError(msg).conflictError()
+	}
+
+	return nil, nil
+}
+
+// DeleteGroupQuery will mark a group record as deleted in the groups table, release its join code, and remove its members.
+// Param: group id
+func DeleteGroupQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(gocql.UUID)
+	var record, members any
+	var isDeleted bool // Discarded, only used as container for Cassandra response.
+
+	if record, err = ReadGroupQuery(c, input); err != nil {
+		return nil, err
+	}
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.DeleteGroup, input).ScanCAS(&isDeleted); err != nil {
+		conn.logger.Error("failed to delete group record", zap.String("Group info:", input.String()), zap.Error(err))
+		return nil, NewError("failed to delete group").internalError()
+	}
+
+	if !applied {
+		msg := "failed to delete group record that does not exist or is deleted"
+		conn.logger.Error(msg, zap.String("Group info:", input.String()))
+		return nil, NewError("group not found").notFoundError()
+	}
+
+	if err = conn.session.Query(model_cassandra.DeleteGroupJoinCode, record.(*model_cassandra.Group).JoinCode).Exec(); err != nil {
+		conn.logger.Error("failed to delete group join code record", zap.String("Group info:", input.String()), zap.Error(err))
+		return nil, NewError("failed to release group join code").internalError()
+	}
+
+	// Remove the group from the groups listed for its members before removing the members.
+	if members, err = ReadGroupMembersQuery(c, input); err != nil {
+		return nil, err
+	}
+	for _, member := range members.([]*model_cassandra.GroupMember) {
+		if err = conn.session.Query(model_cassandra.DeleteUserGroup, member.Username, input).Exec(); err != nil {
+			conn.logger.Error("failed to delete user group record",
+				zap.Strings("Group info:", []string{input.String(), member.Username}), zap.Error(err))
+			return nil, NewError("failed to remove group members").internalError()
+		}
+	}
+
+	if err = conn.session.Query(model_cassandra.DeleteGroupMembers, input).Exec(); err != nil {
+		conn.logger.Error("failed to delete group member records", zap.String("Group info:", input.String()), zap.Error(err))
+		return nil, NewError("failed to remove group members").internalError()
+	}
+
+	return nil, nil
+}
+
+// -----   Group Members Table Queries   -----
+
+// createGroupMember will add a member to a group in the group members table and list the group for the member in the user
+// groups table. Members that have already joined are not replaced.
+func createGroupMember(conn *cassandraImpl, member *model_cassandra.GroupMember, name string) (err error) {
+	resp := model_cassandra.GroupMember{} // Discarded, only used as container for Cassandra response.
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.CreateGroupMember,
+		member.GroupID, member.Username, member.IsOwner, member.JoinedAt).ScanCAS(
+		&resp.GroupID, &resp.Username, &resp.IsOwner, &resp.JoinedAt); err != nil {
+		conn.logger.Error("failed to create group member record",
+			zap.Strings("Group info:", []string{member.GroupID.String(), member.Username}), zap.Error(err))
+		return NewError("failed to add group member").internalError()
+	}
+
+	if !applied {
+		msg := "failed to add group member, the user is already a member"
+		conn.logger.Error(msg, zap.Strings("Group info:", []string{member.GroupID.String(), member.Username}))
+		return NewError(msg).conflictError()
+	}
+
+	if err = conn.session.Query(model_cassandra.CreateUserGroup,
+		member.Username, member.GroupID, name, member.IsOwner).Exec(); err != nil {
+		conn.logger.Error("failed to create user group record",
+			zap.Strings("Group info:", []string{member.GroupID.String(), member.Username}), zap.Error(err))
+		return NewError("unable to list group for member").internalError()
+	}
+
+	return nil
+}
+
+// CreateGroupMemberQuery will add a member to a group that has not been deleted in the group members table.
+// Param: pointer to the group member struct containing the query parameters
+func CreateGroupMemberQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.GroupMember)
+	resp := struct {
+		name      string
+		isDeleted bool
+	}{}
+
+	if err = conn.session.Query(model_cassandra.ReadGroupName, input.GroupID).Scan(&resp.name, &resp.isDeleted); err != nil || resp.isDeleted {
+		conn.logger.Error("failed to read group record to add member",
+			zap.Strings("Group info:", []string{input.GroupID.String(), input.Username}), zap.Error(err))
+		return nil, NewError("group not found").notFoundError()
+	}
+
+	if err = createGroupMember(conn, input, resp.name); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ReadGroupMemberQuery will read a group member record from the group members table.
+// Param: pointer to the group member request containing the query parameters
+// Return: address to a group member record
+func ReadGroupMemberQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.GroupMemberRequest)
+	resp := model_cassandra.GroupMember{}
+
+	if err = conn.session.Query(model_cassandra.ReadGroupMember, input.GroupID, input.Username).Scan(
+		&resp.GroupID, &resp.Username, &resp.IsOwner, &resp.JoinedAt); err != nil {
+		conn.logger.Error("failed to read group member record",
+			zap.Strings("Group info:", []string{input.GroupID.String(), input.Username}), zap.Error(err))
+		return nil, NewError("group member not found").notFoundError()
+	}
+
+	return &resp, nil
+}
+
+// ReadGroupMembersQuery will read all group member records from the group members table corresponding to a group.
+// Param: group id
+// Return: slice of group member records
+func ReadGroupMembersQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(gocql.UUID)
+	results := make([]*model_cassandra.GroupMember, 0)
+
+	iter := conn.session.Query(model_cassandra.ReadGroupMembers, input).Iter()
+	defer func(iter *gocql.Iter) {
+		if err := iter.Close(); err != nil {
+			conn.logger.Error("failed to close iterator whilst reading group members", zap.String("group_id", input.String()), zap.Error(err))
+		}
+	}(iter)
+
+	scanRows := iter.Scanner()
+	for scanRows.Next() {
+		row := model_cassandra.GroupMember{}
+		if err = scanRows.Scan(&row.GroupID, &row.Username, &row.IsOwner, &row.JoinedAt); err != nil {
+			conn.logger.Error("failed to read row in group members", zap.String("group_id", input.String()), zap.Error(err))
+			return nil, NewError(err.Error()).internalError()
+		}
+		results = append(results, &row)
+	}
+
+	return results, err
+}
+
+// DeleteGroupMemberQuery will remove a member from a group in the group members table and remove the group from the groups
+// listed for the member.
+// Param: pointer to the group member request containing the query parameters
+func DeleteGroupMemberQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.GroupMemberRequest)
+	resp := model_cassandra.GroupMember{} // Discarded, only used as container for Cassandra response.
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.DeleteGroupMember, input.GroupID, input.Username).ScanCAS(
+		&resp.GroupID, &resp.Username, &resp.IsOwner, &resp.JoinedAt); err != nil {
+		conn.logger.Error("failed to delete group member record",
+			zap.Strings("Group info:", []string{input.GroupID.String(), input.Username}), zap.Error(err))
+		return nil, NewError("failed to remove group member").internalError()
+	}
+
+	if !applied {
+		msg := "failed to remove group member that does not exist"
+		conn.logger.Error(msg, zap.Strings("Group info:", []string{input.GroupID.String(), input.Username}))
+		return nil, NewError("group member not found").notFoundError()
+	}
+
+	if err = conn.session.Query(model_cassandra.DeleteUserGroup, input.Username, input.GroupID).Exec(); err != nil {
+		conn.logger.Error("failed to delete user group record",
+			zap.Strings("Group info:", []string{input.GroupID.String(), input.Username}), zap.Error(err))
+		return nil, NewError("unable to remove group listing for member").internalError()
+	}
+
+	return nil, nil
+}
+
+// -----   User Groups Table Queries   -----
+
+// ReadUserGroupsQuery will read all user group records from the user groups table corresponding to a user. Groups are listed
+// from the most to the least recently created.
+// Param: username
+// Return: slice of user group records
+func ReadUserGroupsQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(string)
+	results := make([]*model_cassandra.UserGroup, 0)
+
+	iter := conn.session.Query(model_cassandra.ReadUserGroups, input).Iter()
+	defer func(iter *gocql.Iter) {
+		if err := iter.Close(); err != nil {
+			conn.logger.Error("failed to close iterator whilst reading user groups", zap.String("username", input), zap.Error(err))
+		}
+	}(iter)
+
+	scanRows := iter.Scanner()
+	for scanRows.Next() {
+		row := model_cassandra.UserGroup{}
+		if err = scanRows.Scan(&row.Username, &row.GroupID, &row.IsOwner, &row.Name); err != nil {
+			conn.logger.Error("failed to read row in user groups", zap.String("username", input), zap.Error(err))
+			return nil, NewError(err.Error()).internalError()
+		}
+		results = append(results, &row)
+	}
+
+	return results, err
+}
+
+// -----   Group Invitations Table Queries   -----
+
+// CreateGroupInvitationQuery will insert a group invitation record into the group invitations table, replacing any existing
+// invitation of the user to the group.
+// Param: pointer to the group invitation struct containing the query parameters
+func CreateGroupInvitationQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.GroupInvitation)
+
+	if err = conn.session.Query(model_cassandra.CreateGroupInvitation,
+		input.Username, input.GroupID, input.Name, input.InvitedBy, input.IsOwner, input.InvitedAt).Exec(); err != nil {
+		conn.logger.Error("failed to create group invitation record",
+			zap.Strings("Group info:", []string{input.GroupID.String(), input.Username}), zap.Error(err))
+		return nil, NewError("failed to invite user").internalError()
+	}
+
+	return nil, nil
+}
+
+// ReadGroupInvitationQuery will read a group invitation record from the group invitations table.
+// Param: pointer to the group member request containing the query parameters
+// Return: address to a group invitation record
+func ReadGroupInvitationQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.GroupMemberRequest)
+	resp := model_cassandra.GroupInvitation{}
+
+	if err = conn.session.Query(model_cassandra.ReadGroupInvitation, input.Username, input.GroupID).Scan(
+		&resp.Username, &resp.GroupID, &resp.InvitedAt, &resp.InvitedBy, &resp.IsOwner, &resp.Name); err != nil {
+		conn.logger.Error("failed to read group invitation record",
+			zap.Strings("Group info:", []string{input.GroupID.String(), input.Username}), zap.Error(err))
+		return nil, NewError("group invitation not found").notFoundError()
+	}
+
+	return &resp, nil
+}
+
+// ReadGroupInvitationsQuery will read all group invitation records from the group invitations table corresponding to a user.
+// Param: username
+// Return: slice of group invitation records
+func ReadGroupInvitationsQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(string)
+	results := make([]*model_cassandra.GroupInvitation, 0)
+
+	iter := conn.session.Query(model_cassandra.ReadGroupInvitations, input).Iter()
+	defer func(iter *gocql.Iter) {
+		if err := iter.Close(); err != nil {
+			conn.logger.Error("failed to close iterator whilst reading group invitations", zap.String("username", input), zap.Error(err))
+		}
+	}(iter)
+
+	scanRows := iter.Scanner()
+	for scanRows.Next() {
+		row := model_cassandra.GroupInvitation{}
+		if err = scanRows.Scan(&row.Username, &row.GroupID, &row.InvitedAt, &row.InvitedBy, &row.IsOwner, &row.Name); err != nil {
+			conn.logger.Error("failed to read row in group invitations", zap.String("username", input), zap.Error(err))
+			return nil, NewError(err.Error()).internalError()
+		}
+		results = append(results, &row)
+	}
+
+	return results, err
+}
+
+// DeleteGroupInvitationQuery will remove a group invitation record from the group invitations table.
+// Param: pointer to the group member request containing the query parameters
+func DeleteGroupInvitationQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.GroupMemberRequest)
+	resp := model_cassandra.GroupInvitation{} // Discarded, only used as container for Cassandra response.
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.DeleteGroupInvitation, input.Username, input.GroupID).ScanCAS(
+		&resp.Username, &resp.GroupID, &resp.InvitedAt, &resp.InvitedBy, &resp.IsOwner, &resp.Name); err != nil {
+		conn.logger.Error("failed to delete group invitation record",
+			zap.Strings("Group info:", []string{input.GroupID.String(), input.Username}), zap.Error(err))
+		return nil, NewError("failed to remove group invitation").internalError()
+	}
+
+	if !applied {
+		msg := "failed to remove group invitation that does not exist"
+		conn.logger.Error(msg, zap.Strings("Group info:", []string{input.GroupID.String(), input.Username}))
+		return nil, NewError("group invitation not found").notFoundError()
+	}
+
+	return nil, nil
+}
+
+// -----   Group Join Codes Table Queries   -----
+
+// ReadGroupJoinCodeQuery will read the group that a join code belongs to from the group join codes table.
+// Param: join code
+// Return: group id
+func ReadGroupJoinCodeQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(string)
+	var groupId gocql.UUID
+
+	if err = conn.session.Query(model_cassandra.ReadGroupJoinCode, input).Scan(&groupId); err != nil {
+		conn.logger.Error("failed to read group join code record", zap.Error(err))
+		return nil, NewError("invalid join code").notFoundError()
+	}
+
+	return groupId, nil
+}
+
+// -----   Group Quizzes Table Queries   -----
+
+// AssignQuizQuery will restrict a quiz record in the quizzes table to the members of a group and list the quiz for the group
+// in the group quizzes table.
+// Param: pointer to the quiz assign request containing the query parameters
+func AssignQuizQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.QuizAssignRequest)
+	resp := struct {
+		author    string
+		isDeleted bool
+	}{}
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.AssignQuizGroup, []gocql.UUID{input.GroupID}, input.QuizID,
+		input.Author).ScanCAS(&resp.author, &resp.isDeleted); err != nil {
+		conn.logger.Error("failed to assign quiz to group",
+			zap.Strings("Quiz info:", []string{input.QuizID.String(), input.GroupID.String(), input.Author}), zap.Error(err))
+		return nil, NewError("failed to assign quiz").internalError()
+	}
+
+	if !applied {
+		msg := "failed to assign quiz. Either it does not exist, is deleted, or the requester is not the author"
+		conn.logger.Error(msg, zap.Strings("Quiz info:", []string{input.QuizID.String(), input.GroupID.String(), input.Author}))
+		return nil, NewError(msg).forbiddenError()
+	}
+
+	if err = conn.session.Query(model_cassandra.CreateGroupQuiz,
+		input.GroupID, input.QuizID, input.Title, input.AssignedBy, input.AssignedAt).Exec(); err != nil {
+		conn.logger.Error("failed to create group quiz record",
+			zap.Strings("Quiz info:", []string{input.QuizID.String(), input.GroupID.String()}), zap.Error(err))
+		return nil, NewError("unable to list quiz for group").internalError()
+	}
+
+	return nil, nil
+}
+
+// UnassignQuizQuery will remove a group from the groups a quiz record in the quizzes table is restricted to and remove the
+// quiz from the quizzes listed for the group.
+// Param: pointer to the quiz assign request containing the query parameters
+func UnassignQuizQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(*model_cassandra.QuizAssignRequest)
+	resp := struct {
+		author string
+	}{}
+
+	applied := false
+	if applied, err = conn.session.Query(model_cassandra.UnassignQuizGroup, []gocql.UUID{input.GroupID}, input.QuizID,
+		input.Author).ScanCAS(&resp.author); err != nil {
+		conn.logger.Error("failed to unassign quiz from group",
+			zap.Strings("Quiz info:", []string{input.QuizID.String(), input.GroupID.String(), input.Author}), zap.Error(err))
+		return nil, NewError("failed to unassign quiz").internalError()
+	}
+
+	if !applied {
+		msg := "failed to unassign quiz. Either it does not exist or the requester is not the author"
+		conn.logger.Error(msg, zap.Strings("Quiz info:", []string{input.QuizID.String(), input.GroupID.String(), input.Author}))
+		return nil, NewError(msg).forbiddenError()
+	}
+
+	if err = conn.session.Query(model_cassandra.DeleteGroupQuiz, input.GroupID, input.QuizID).Exec(); err != nil {
+		conn.logger.Error("failed to delete group quiz record",
+			zap.Strings("Quiz info:", []string{input.QuizID.String(), input.GroupID.String()}), zap.Error(err))
+		return nil, NewError("unable to remove quiz listing for group").internalError()
+	}
+
+	return nil, nil
+}
+
+// ReadGroupQuizzesQuery will read all group quiz records from the group quizzes table corresponding to a group. Quizzes are
+// listed from the most to the least recently created.
+// Param: group id
+// Return: slice of group quiz records
+func ReadGroupQuizzesQuery(c Cassandra, params any) (response any, err error) {
+	conn := c.(*cassandraImpl)
+	input := params.(gocql.UUID)
+	results := make([]*model_cassandra.GroupQuiz, 0)
+
+	iter := conn.session.Query(model_cassandra.ReadGroupQuizzes, input).Iter()
+	defer func(iter *gocql.Iter) {
+		if err := iter.Close(); err != nil {
+			conn.logger.Error("failed to close iterator whilst reading group quizzes", zap.String("group_id", input.String()), zap.Error(err))
+		}
+	}(iter)
+
+	scanRows := iter.Scanner()
+	for scanRows.Next() {
+		row := model_cassandra.GroupQuiz{}
+		if err = scanRows.Scan(&row.GroupID, &row.QuizID, &row.AssignedAt, &row.AssignedBy, &row.Title); err != nil {
+			conn.logger.Error("failed to read row in group quizzes", zap.String("group_id", input.String()), zap.Error(err))
+			return nil, NewError(err.Error()).internalError()
+		}
+		results = append(results, &row)
+	}
+
+	return results, err
+}
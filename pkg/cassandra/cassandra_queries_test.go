@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/stretchr/testify/require"
@@ -28,6 +29,10 @@ func insertTestUsers(t *testing.T) {
 func insertTestQuizzes(t *testing.T) {
 	_, err := truncateTableQuery(connection.db, "quizzes")
 	require.NoErrorf(t, err, "failed to truncate quizzes table before populating")
+	_, err = truncateTableQuery(connection.db, "quiz_versions")
+	require.NoErrorf(t, err, "failed to truncate quiz versions table before populating")
+	_, err = truncateTableQuery(connection.db, "author_quizzes")
+	require.NoErrorf(t, err, "failed to truncate author quizzes table before populating")
 
 	for _, quiz := range testQuizRecords {
 		_, err := CreateQuizQuery(connection.db, quiz)
@@ -38,6 +43,8 @@ func insertTestQuizzes(t *testing.T) {
 func insertTestResponses(t *testing.T) {
 	_, err := truncateTableQuery(connection.db, "responses")
 	require.NoErrorf(t, err, "failed to truncate responses table before populating")
+	_, err = truncateTableQuery(connection.db, "user_scores")
+	require.NoErrorf(t, err, "failed to truncate user scores table before populating")
 
 	for _, response := range testResponseRecords {
 		_, err := CreateResponseQuery(connection.db, response)
@@ -140,6 +147,38 @@ func TestReadUserQuery(t *testing.T) {
 	}
 }
 
+func TestUpdateUserRoleQuery(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Lock connection to Cassandra cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	// Insert new users.
+	insertTestUsers(t)
+
+	// Non-existent user.
+	_, err := connection.db.Execute(UpdateUserRoleQuery,
+		&model_cassandra.UserRoleRequest{Username: "user-5", Role: model_cassandra.RoleInstructor})
+	require.Error(t, err, "user account that does not exist")
+	require.Equal(t, http.StatusNotFound, err.(*Error).Status)
+
+	// Roles of created accounts updated.
+	for key, testCase := range testUserRecords {
+		t.Run(fmt.Sprintf("Test case %s", key), func(t *testing.T) {
+			_, err := connection.db.Execute(UpdateUserRoleQuery,
+				&model_cassandra.UserRoleRequest{Username: testCase.Username, Role: model_cassandra.RoleInstructor})
+			require.NoError(t, err, "failed to update user role")
+
+			resp, err := connection.db.Execute(ReadUserQuery, testCase.Username)
+			require.NoError(t, err, "failed to read user")
+			require.Equal(t, model_cassandra.RoleInstructor, resp.(*model_cassandra.User).Role, "role mismatch")
+		})
+	}
+}
+
 func TestCreateQuizQuery(t *testing.T) {
 	// Skip integration tests for short test runs.
 	if testing.Short() {
@@ -188,6 +227,36 @@ func TestReadQuizQuery(t *testing.T) {
 	}
 }
 
+func TestReadPublishedQuizzesQuery(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Lock connection to Cassandra cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	// Insert new quizzes.
+	insertTestQuizzes(t)
+
+	expected := make(map[gocql.UUID]*model_cassandra.Quiz)
+	for _, quiz := range testQuizRecords {
+		if quiz.IsPublished && !quiz.IsDeleted {
+			expected[quiz.QuizID] = quiz
+		}
+	}
+
+	resp, err := connection.db.Execute(ReadPublishedQuizzesQuery, nil)
+	require.NoError(t, err, "failed to read published quizzes")
+	actual := resp.([]*model_cassandra.Quiz)
+	require.Equal(t, len(expected), len(actual), "number of published quizzes mismatch")
+
+	for _, quiz := range actual {
+		require.Truef(t, reflect.DeepEqual(expected[quiz.QuizID], quiz), "expected quiz, %v, does not match actual, %v",
+			expected[quiz.QuizID], quiz)
+	}
+}
+
 func TestUpdateQuizQuery(t *testing.T) {
 	// Skip integration tests for short test runs.
 	if testing.Short() {
@@ -346,10 +415,326 @@ func TestPublishQuizQuery(t *testing.T) {
 			require.NoError(t, err, "read quiz record failed")
 			actual := resp.(*model_cassandra.Quiz)
 			require.Truef(t, actual.IsPublished, "expected quiz to be published but actual, %v", actual)
+
+			// Quizzes published before insertion are unversioned and retain their version when published again.
+			expectedVersion := 1
+			if testCase.IsPublished {
+				expectedVersion = 0
+			}
+			require.Equal(t, expectedVersion, actual.Version, "published version mismatch")
+
+			resp, err = connection.db.Execute(ReadQuizVersionQuery,
+				&model_cassandra.QuizVersionRequest{QuizID: testCase.QuizID, Version: actual.Version})
+			require.NoError(t, err, "read quiz version record failed")
+			snapshot := resp.(*model_cassandra.QuizVersion)
+			require.Truef(t, reflect.DeepEqual(actual.QuizCore, snapshot.QuizCore),
+				"expected quiz version, %v, does not match actual, %v", actual.QuizCore, snapshot.QuizCore)
+
+			// Publishing again must not reset the version.
+			_, err = connection.db.Execute(PublishQuizQuery, &req)
+			require.NoError(t, err, "publishing a published quiz failed")
+			resp, err = connection.db.Execute(ReadQuizQuery, testCase.QuizID)
+			require.NoError(t, err, "read quiz record failed")
+			require.Equal(t, expectedVersion, resp.(*model_cassandra.Quiz).Version, "version changed when publishing again")
+		})
+	}
+}
+
+func TestReviseQuizQuery(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Lock connection to Cassandra cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	// Insert new quizzes.
+	insertTestQuizzes(t)
+
+	// Non-existent quiz.
+	_, err := connection.db.Execute(ReviseQuizQuery, &model_cassandra.QuizMutateRequest{
+		Username: "",
+		QuizID:   gocql.TimeUUID(),
+		Quiz:     &model_cassandra.Quiz{QuizCore: &model_cassandra.QuizCore{}},
+	})
+	require.Error(t, err, "quiz that does not exist")
+
+	for key, testCase := range GetTestQuizzes() {
+		t.Run(fmt.Sprintf("Test case %s", key), func(t *testing.T) {
+			original := *testCase.QuizCore
+			revised := *testCase.QuizCore
+			revised.Title = "revised title"
+
+			// Not owner revision failures.
+			_, err := connection.db.Execute(ReviseQuizQuery, &model_cassandra.QuizMutateRequest{
+				Username: testCase.Author + "no-owner",
+				QuizID:   testCase.QuizID,
+				Quiz:     &model_cassandra.Quiz{QuizCore: &revised},
+			})
+			require.Error(t, err, "revise record succeeded with not author")
+
+			// Owner revisions.
+			var resp any
+			resp, err = connection.db.Execute(ReviseQuizQuery, &model_cassandra.QuizMutateRequest{
+				Username: testCase.Author,
+				QuizID:   testCase.QuizID,
+				Quiz:     &model_cassandra.Quiz{QuizCore: &revised},
+			})
+			if !testCase.IsPublished || testCase.IsDeleted {
+				require.Error(t, err, "revision of an unpublished or deleted quiz should fail")
+				return
+			}
+			require.NoError(t, err, "revise record failed")
+			require.Equal(t, testCase.Version+1, resp.(int), "revised version mismatch")
+
+			resp, err = connection.db.Execute(ReadQuizQuery, testCase.QuizID)
+			require.NoError(t, err, "read quiz record failed")
+			actual := resp.(*model_cassandra.Quiz)
+			require.Equal(t, testCase.Version+1, actual.Version, "quiz record version mismatch")
+			require.Truef(t, reflect.DeepEqual(&revised, actual.QuizCore), "expected quiz, %v, does not match actual, %v", revised, actual.QuizCore)
+
+			// Both the original and revised versions must be retrievable.
+			resp, err = connection.db.Execute(ReadQuizVersionsQuery, testCase.QuizID)
+			require.NoError(t, err, "read quiz versions failed")
+			versions := resp.([]*model_cassandra.QuizVersion)
+			require.Equal(t, 2, len(versions), "expected original and revised versions")
+			require.Truef(t, reflect.DeepEqual(&original, versions[0].QuizCore), "original version does not match")
+			require.Truef(t, reflect.DeepEqual(&revised, versions[1].QuizCore), "revised version does not match")
 		})
 	}
 }
 
+func TestScheduleQuizQuery(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Lock connection to Cassandra cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	// Insert new quizzes.
+	insertTestQuizzes(t)
+	_, err := truncateTableQuery(connection.db, "quiz_schedule")
+	require.NoErrorf(t, err, "failed to truncate quiz schedule table")
+
+	opensAt := time.UnixMilli(time.Now().UnixMilli()).UTC()
+	closesAt := opensAt.Add(time.Hour)
+
+	// Non-existent quiz.
+	_, err = connection.db.Execute(ScheduleQuizQuery, &model_cassandra.QuizScheduleRequest{
+		QuizID:       gocql.TimeUUID(),
+		QuizSchedule: &model_cassandra.QuizSchedule{ClosesAt: &closesAt},
+	})
+	require.Error(t, err, "quiz that does not exist")
+
+	for key, testCase := range testQuizRecords {
+		t.Run(fmt.Sprintf("Test case %s", key), func(t *testing.T) {
+			schedule := &model_cassandra.QuizSchedule{ClosesAt: &closesAt}
+			if !testCase.IsPublished {
+				schedule.OpensAt = &opensAt
+			}
+
+			// Not owner schedule failures.
+			_, err := connection.db.Execute(ScheduleQuizQuery, &model_cassandra.QuizScheduleRequest{
+				Username: testCase.Author + "no-owner", QuizID: testCase.QuizID, QuizSchedule: schedule})
+			require.Error(t, err, "schedule record succeeded with not author")
+
+			// Owner schedule.
+			_, err = connection.db.Execute(ScheduleQuizQuery, &model_cassandra.QuizScheduleRequest{
+				Username: testCase.Author, QuizID: testCase.QuizID, QuizSchedule: schedule})
+			if testCase.IsDeleted {
+				require.Error(t, err, "a deleted record should not be scheduled")
+				return
+			}
+			require.NoError(t, err, "schedule record failed")
+
+			resp, err := connection.db.Execute(ReadQuizQuery, testCase.QuizID)
+			require.NoError(t, err, "read quiz record failed")
+			actual := resp.(*model_cassandra.Quiz)
+			require.Equal(t, schedule.OpensAt, actual.OpensAt, "opening time mismatch")
+			require.Equal(t, closesAt, actual.ClosesAt.UTC(), "closing time mismatch")
+
+			// Published quizzes cannot be given an opening time.
+			if testCase.IsPublished {
+				_, err = connection.db.Execute(ScheduleQuizQuery, &model_cassandra.QuizScheduleRequest{Username: testCase.Author,
+					QuizID: testCase.QuizID, QuizSchedule: &model_cassandra.QuizSchedule{OpensAt: &opensAt}})
+				require.Error(t, err, "opening time set on a published quiz")
+			}
+
+			// Closing requires the closing time to be unchanged.
+			resp, err = connection.db.Execute(CloseQuizQuery,
+				&model_cassandra.CloseQuizRequest{QuizID: testCase.QuizID, ClosesAt: closesAt.Add(time.Minute)})
+			require.NoError(t, err, "close with stale closing time failed")
+			require.False(t, resp.(bool), "quiz closed with stale closing time")
+
+			resp, err = connection.db.Execute(CloseQuizQuery,
+				&model_cassandra.CloseQuizRequest{QuizID: testCase.QuizID, ClosesAt: closesAt})
+			require.NoError(t, err, "close failed")
+			require.True(t, resp.(bool), "quiz not closed")
+
+			resp, err = connection.db.Execute(ReadQuizQuery, testCase.QuizID)
+			require.NoError(t, err, "read quiz record failed")
+			require.True(t, resp.(*model_cassandra.Quiz).IsClosed, "quiz record not closed")
+		})
+	}
+
+	// Scheduled actions are queued until they are due.
+	resp, err := connection.db.Execute(ReadQuizScheduleEventsQuery, &model_cassandra.QuizScheduleEventsRequest{
+		Action: model_cassandra.ScheduleActionClose, DueBy: opensAt, Limit: 100})
+	require.NoError(t, err, "read of close actions that are not due failed")
+	require.Empty(t, resp.([]*model_cassandra.QuizScheduleEvent), "close actions are not yet due")
+
+	resp, err = connection.db.Execute(ReadQuizScheduleEventsQuery, &model_cassandra.QuizScheduleEventsRequest{
+		Action: model_cassandra.ScheduleActionClose, DueBy: closesAt, Limit: 100})
+	require.NoError(t, err, "read of due close actions failed")
+	events := resp.([]*model_cassandra.QuizScheduleEvent)
+	require.NotEmpty(t, events, "close actions are due")
+
+	for _, event := range events {
+		_, err = connection.db.Execute(DeleteQuizScheduleEventQuery, event)
+		require.NoError(t, err, "delete of close action failed")
+	}
+	resp, err = connection.db.Execute(ReadQuizScheduleEventsQuery, &model_cassandra.QuizScheduleEventsRequest{
+		Action: model_cassandra.ScheduleActionClose, DueBy: closesAt, Limit: 100})
+	require.NoError(t, err, "read of close actions after deletion failed")
+	require.Empty(t, resp.([]*model_cassandra.QuizScheduleEvent), "close actions were not deleted")
+}
+
+func TestReadQuizVersionQuery(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Lock connection to Cassandra cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	// Insert new quizzes.
+	insertTestQuizzes(t)
+
+	// Non-existent quiz version.
+	_, err := connection.db.Execute(ReadQuizVersionQuery, &model_cassandra.QuizVersionRequest{QuizID: gocql.TimeUUID(), Version: 1})
+	require.Error(t, err, "quiz version that does not exist")
+
+	// No versions for a quiz that does not exist.
+	resp, err := connection.db.Execute(ReadQuizVersionsQuery, gocql.TimeUUID())
+	require.NoError(t, err, "reading versions of a quiz that does not exist failed")
+	require.Equal(t, 0, len(resp.([]*model_cassandra.QuizVersion)), "versions found for a quiz that does not exist")
+
+	testCase := testQuizRecords["myNoPubQuiz"]
+	_, err = connection.db.Execute(PublishQuizQuery, &model_cassandra.QuizMutateRequest{Username: testCase.Author, QuizID: testCase.QuizID})
+	require.NoError(t, err, "publish record failed")
+
+	resp, err = connection.db.Execute(ReadQuizVersionQuery, &model_cassandra.QuizVersionRequest{QuizID: testCase.QuizID, Version: 1})
+	require.NoError(t, err, "read quiz version failed")
+	actual := resp.(*model_cassandra.QuizVersion)
+	require.Equal(t, testCase.Author, actual.Author, "author mismatch")
+	require.Equal(t, 1, actual.Version, "version mismatch")
+	require.Truef(t, reflect.DeepEqual(testCase.QuizCore, actual.QuizCore), "expected quiz, %v, does not match actual, %v", testCase.QuizCore, actual.QuizCore)
+}
+
+func TestReadAuthorQuizzesPageQuery(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Lock connection to Cassandra cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	// Insert new quizzes.
+	insertTestQuizzes(t)
+
+	// readAll will page through the author's quizzes and return all the records retrieved.
+	readAll := func(t *testing.T, author, status string, pageSize int) []*model_cassandra.AuthorQuiz {
+		var records []*model_cassandra.AuthorQuiz
+		request := &model_cassandra.AuthorQuizzesRequest{Author: author, Status: status, PageSize: pageSize}
+		for {
+			response, err := connection.db.Execute(ReadAuthorQuizzesPageQuery, request)
+			require.NoError(t, err, "failed to execute paged author quizzes query")
+			actual := response.(*model_cassandra.AuthorQuizzesResponse)
+			require.Equal(t, pageSize, actual.PageSize, "page size not set in response")
+			records = append(records, actual.Records...)
+
+			if len(actual.PageCursor) == 0 {
+				break
+			}
+			request.PageCursor = actual.PageCursor
+		}
+		return records
+	}
+
+	testCases := []struct {
+		name                string
+		author              string
+		status              string
+		expectedRecordCount int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "not found",
+			author:              "user-not-found",
+			expectedRecordCount: 0,
+		}, {
+			name:                "all statuses",
+			author:              "user-2",
+			expectedRecordCount: 2,
+		}, {
+			name:                "published",
+			author:              "user-2",
+			status:              model_cassandra.QuizStatusPublished,
+			expectedRecordCount: 1,
+		}, {
+			name:                "deleted",
+			author:              "user-2",
+			status:              model_cassandra.QuizStatusDeleted,
+			expectedRecordCount: 1,
+		}, {
+			name:                "no drafts",
+			author:              "user-2",
+			status:              model_cassandra.QuizStatusDraft,
+			expectedRecordCount: 0,
+		}, {
+			name:                "drafts",
+			author:              "user-3",
+			status:              model_cassandra.QuizStatusDraft,
+			expectedRecordCount: 1,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			for _, pageSize := range []int{1, 10} {
+				records := readAll(t, testCase.author, testCase.status, pageSize)
+				require.Equal(t, testCase.expectedRecordCount, len(records), "number of author quiz records doesn't match expected")
+				for _, record := range records {
+					require.Equal(t, testCase.author, record.Author, "author mismatch")
+					if len(testCase.status) != 0 {
+						require.Equal(t, testCase.status, record.Status, "status mismatch")
+					}
+				}
+			}
+		})
+	}
+
+	// Publishing and deleting a quiz updates its listing.
+	testCase := testQuizRecords["myNoPubQuiz"]
+	_, err := connection.db.Execute(PublishQuizQuery, &model_cassandra.QuizMutateRequest{Username: testCase.Author, QuizID: testCase.QuizID})
+	require.NoError(t, err, "publish record failed")
+	records := readAll(t, testCase.Author, model_cassandra.QuizStatusPublished, 10)
+	require.Equal(t, 1, len(records), "published quiz not listed")
+	require.Equal(t, testCase.QuizID, records[0].QuizID, "quiz id mismatch")
+	require.Equal(t, testCase.Title, records[0].Title, "title mismatch")
+	require.Equal(t, 1, records[0].Version, "version mismatch")
+
+	_, err = connection.db.Execute(DeleteQuizQuery, &model_cassandra.QuizMutateRequest{Username: testCase.Author, QuizID: testCase.QuizID})
+	require.NoError(t, err, "delete record failed")
+	require.Equal(t, 0, len(readAll(t, testCase.Author, model_cassandra.QuizStatusPublished, 10)), "deleted quiz listed as published")
+	require.Equal(t, 2, len(readAll(t, testCase.Author, model_cassandra.QuizStatusDeleted, 10)), "deleted quiz not listed")
+}
+
 func TestCreateResponseQuery(t *testing.T) {
 	// Skip integration tests for short test runs.
 	if testing.Short() {
@@ -406,6 +791,58 @@ func TestReadResponseQuery(t *testing.T) {
 	}
 }
 
+func TestUpdateResponseQuery(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Lock connection to Cassandra cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	// Insert new responses.
+	insertTestResponses(t)
+
+	// Non-existent Response.
+	_, err := connection.db.Execute(UpdateResponseQuery, &model_cassandra.ResponseUpdateRequest{
+		Response: &model_cassandra.Response{Username: "user-1", QuizID: gocql.TimeUUID(), QuizResponse: &model_cassandra.QuizResponse{}},
+	})
+	require.Error(t, err, "user response that does not exist")
+
+	for key, testCase := range GetTestResponses() {
+		t.Run(fmt.Sprintf("Test case %s", key), func(t *testing.T) {
+			// The test responses were recorded without attempts.
+			previousAttempts := testCase.Attempts
+			testCase.Score += 1
+			testCase.MaxScore += 2
+			testCase.Version += 1
+			testCase.Attempts = []*model_cassandra.Attempt{{
+				Number:      1,
+				Score:       testCase.Score,
+				MaxScore:    testCase.MaxScore,
+				Version:     testCase.Version,
+				Responses:   testCase.Responses,
+				SubmittedAt: time.UnixMilli(time.Now().UnixMilli()).UTC(),
+			}}
+			request := &model_cassandra.ResponseUpdateRequest{Response: testCase, PreviousAttempts: previousAttempts}
+			_, err := connection.db.Execute(UpdateResponseQuery, request)
+			require.NoError(t, err, "update response failed")
+
+			resp, err := connection.db.Execute(ReadResponseQuery, &model_cassandra.QuizMutateRequest{
+				Username: testCase.Username,
+				QuizID:   testCase.QuizID,
+			})
+			require.NoError(t, err, "read response failed")
+			actual := resp.(*model_cassandra.Response)
+			require.Truef(t, reflect.DeepEqual(testCase, actual), "expected response, %v, does not match actual, %v", testCase, actual)
+
+			// Stale attempts.
+			_, err = connection.db.Execute(UpdateResponseQuery, request)
+			require.Error(t, err, "update with stale attempts succeeded")
+		})
+	}
+}
+
 func TestReadResponseStatisticsQuery(t *testing.T) {
 	// Skip integration tests for short test runs.
 	if testing.Short() {
@@ -464,6 +901,319 @@ func TestReadResponseStatisticsQuery(t *testing.T) {
 	}
 }
 
+func TestReadUserScoresPageQuery(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Lock connection to Cassandra cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	// Insert new quizzes and responses.
+	insertTestQuizzes(t)
+	insertTestResponses(t)
+
+	titles := make(map[gocql.UUID]string)
+	for _, quiz := range testQuizRecords {
+		titles[quiz.QuizID] = quiz.Title
+	}
+
+	// readAll will page through the user's scores and return all the records retrieved.
+	readAll := func(t *testing.T, username string, pageSize int) []*model_cassandra.UserScore {
+		var records []*model_cassandra.UserScore
+		request := &model_cassandra.UserScoresRequest{Username: username, PageSize: pageSize}
+		for {
+			response, err := connection.db.Execute(ReadUserScoresPageQuery, request)
+			require.NoError(t, err, "failed to execute paged user scores query")
+			actual := response.(*model_cassandra.UserScoresResponse)
+			require.Equal(t, pageSize, actual.PageSize, "page size not set in response")
+			records = append(records, actual.Records...)
+
+			if len(actual.PageCursor) == 0 {
+				break
+			}
+			request.PageCursor = actual.PageCursor
+		}
+		return records
+	}
+
+	testCases := []struct {
+		name                string
+		username            string
+		expectedRecordCount int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "not found",
+			username:            "user-not-found",
+			expectedRecordCount: 0,
+		}, {
+			name:                "single quiz",
+			username:            "user-1",
+			expectedRecordCount: 1,
+		}, {
+			name:                "multiple quizzes",
+			username:            "user-2",
+			expectedRecordCount: 2,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			for _, pageSize := range []int{1, 10} {
+				records := readAll(t, testCase.username, pageSize)
+				require.Equal(t, testCase.expectedRecordCount, len(records), "number of user score records doesn't match expected")
+				for _, record := range records {
+					require.Equal(t, testCase.username, record.Username, "username mismatch")
+					require.Equal(t, titles[record.QuizID], record.Title, "title mismatch")
+				}
+			}
+		})
+	}
+
+	// Updating a response replaces its listing.
+	testCase := GetTestResponses()["user-1_myPubQuiz"]
+	previousAttempts := testCase.Attempts
+	testCase.Score += 1
+	testCase.Attempts = []*model_cassandra.Attempt{
+		{Number: 1, Score: testCase.Score, SubmittedAt: time.UnixMilli(time.Now().UnixMilli()).UTC()},
+		{Number: 2, Score: testCase.Score, SubmittedAt: time.UnixMilli(time.Now().UnixMilli()).UTC()},
+	}
+	_, err := connection.db.Execute(UpdateResponseQuery,
+		&model_cassandra.ResponseUpdateRequest{Response: testCase, PreviousAttempts: previousAttempts})
+	require.NoError(t, err, "update response failed")
+
+	records := readAll(t, testCase.Username, 10)
+	require.Equal(t, 1, len(records), "updated response listed more than once")
+	require.Equal(t, testCase.Score, records[0].Score, "score mismatch")
+	require.Equal(t, 2, records[0].Attempts, "number of attempts mismatch")
+	require.Equal(t, testCase.Attempts[1].SubmittedAt, records[0].SubmittedAt.UTC(), "submission time mismatch")
+}
+
+func TestAttemptSessionQueries(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Lock connection to Cassandra cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	_, err := truncateTableQuery(connection.db, "attempt_sessions")
+	require.NoErrorf(t, err, "failed to truncate attempt sessions table")
+
+	startedAt := time.UnixMilli(time.Now().UnixMilli()).UTC()
+	session := &model_cassandra.AttemptSession{
+		Username:  "user-1",
+		QuizID:    gocql.TimeUUID(),
+		Attempt:   1,
+		StartedAt: startedAt,
+		Deadline:  startedAt.Add(time.Hour),
+	}
+	request := &model_cassandra.AttemptSessionRequest{Username: session.Username, QuizID: session.QuizID, Attempt: session.Attempt}
+
+	// Session not started.
+	_, err = connection.db.Execute(ReadAttemptSessionQuery, request)
+	require.Error(t, err, "read of a session that was not started succeeded")
+
+	// Start the session.
+	resp, err := connection.db.Execute(CreateAttemptSessionQuery, session)
+	require.NoError(t, err, "failed to start session")
+	require.Equal(t, session, resp.(*model_cassandra.AttemptSession), "started session mismatch")
+
+	resp, err = connection.db.Execute(ReadAttemptSessionQuery, request)
+	require.NoError(t, err, "failed to read started session")
+	require.Equal(t, session, resp.(*model_cassandra.AttemptSession), "stored session mismatch")
+
+	// Restarting the session must not move the deadline.
+	restart := *session
+	restart.StartedAt = startedAt.Add(time.Minute)
+	restart.Deadline = restart.StartedAt.Add(time.Hour)
+	resp, err = connection.db.Execute(CreateAttemptSessionQuery, &restart)
+	require.NoError(t, err, "failed to restart session")
+	require.Equal(t, session, resp.(*model_cassandra.AttemptSession), "restarted session was modified")
+}
+
+func TestAttemptShuffleQueries(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Lock connection to Cassandra cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	_, err := truncateTableQuery(connection.db, "attempt_shuffles")
+	require.NoErrorf(t, err, "failed to truncate attempt shuffles table")
+
+	shuffle := &model_cassandra.AttemptShuffle{Username: "user-1", QuizID: gocql.TimeUUID(), Attempt: 1, Seed: 1234567890}
+	request := &model_cassandra.AttemptShuffleRequest{Username: shuffle.Username, QuizID: shuffle.QuizID, Attempt: shuffle.Attempt}
+
+	// Attempt not viewed.
+	_, err = connection.db.Execute(ReadAttemptShuffleQuery, request)
+	require.Error(t, err, "read of a shuffle that was not created succeeded")
+
+	// View the attempt.
+	resp, err := connection.db.Execute(CreateAttemptShuffleQuery, shuffle)
+	require.NoError(t, err, "failed to create shuffle")
+	require.Equal(t, shuffle, resp.(*model_cassandra.AttemptShuffle), "created shuffle mismatch")
+
+	resp, err = connection.db.Execute(ReadAttemptShuffleQuery, request)
+	require.NoError(t, err, "failed to read created shuffle")
+	require.Equal(t, shuffle, resp.(*model_cassandra.AttemptShuffle), "stored shuffle mismatch")
+
+	// Viewing the attempt again must not change the order.
+	reshuffle := *shuffle
+	reshuffle.Seed = 987654321
+	resp, err = connection.db.Execute(CreateAttemptShuffleQuery, &reshuffle)
+	require.NoError(t, err, "failed to recreate shuffle")
+	require.Equal(t, shuffle, resp.(*model_cassandra.AttemptShuffle), "recreated shuffle was modified")
+}
+
+func TestAnswerDraftQueries(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Lock connection to Cassandra cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	_, err := truncateTableQuery(connection.db, "answer_drafts")
+	require.NoErrorf(t, err, "failed to truncate answer drafts table")
+
+	draft := &model_cassandra.AnswerDraft{Username: "user-1", QuizID: gocql.TimeUUID(), Attempt: 1,
+		QuizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{1}, {}}, TextResponses: []string{"", "answer"}},
+		SavedAt:      time.Now().UTC().Truncate(time.Millisecond)}
+	request := &model_cassandra.AnswerDraftRequest{Username: draft.Username, QuizID: draft.QuizID, Attempt: draft.Attempt}
+
+	// Draft not saved.
+	_, err = connection.db.Execute(ReadAnswerDraftQuery, request)
+	require.Error(t, err, "read of a draft that was not saved succeeded")
+
+	// Save the draft.
+	_, err = connection.db.Execute(CreateAnswerDraftQuery, draft)
+	require.NoError(t, err, "failed to save draft")
+
+	resp, err := connection.db.Execute(ReadAnswerDraftQuery, request)
+	require.NoError(t, err, "failed to read saved draft")
+	require.Equal(t, draft, resp.(*model_cassandra.AnswerDraft), "stored draft mismatch")
+
+	// Saving the draft again must replace the answers.
+	resave := *draft
+	resave.QuizResponse = &model_cassandra.QuizResponse{Responses: [][]int32{{1}, {2}}, TextResponses: []string{"", "answer"}}
+	resave.SavedAt = draft.SavedAt.Add(time.Minute)
+	_, err = connection.db.Execute(CreateAnswerDraftQuery, &resave)
+	require.NoError(t, err, "failed to resave draft")
+
+	resp, err = connection.db.Execute(ReadAnswerDraftQuery, request)
+	require.NoError(t, err, "failed to read resaved draft")
+	require.Equal(t, &resave, resp.(*model_cassandra.AnswerDraft), "resaved draft mismatch")
+}
+
+func TestQuestionBankQueries(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Lock connection to Cassandra cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	_, err := truncateTableQuery(connection.db, "question_banks")
+	require.NoErrorf(t, err, "failed to truncate question banks table")
+
+	questions := GetTestQuizzes()["myPubQuiz"].Questions
+	questions[0].Tags = []string{"tag-1", "tag-2"}
+	questions[0].Difficulty = "easy"
+	bank := &model_cassandra.QuestionBank{
+		QuestionBankCore: &model_cassandra.QuestionBankCore{Title: "bank-1", Questions: questions},
+		BankID:           gocql.TimeUUID(),
+		Author:           "user-1",
+	}
+
+	// Bank not created.
+	_, err = connection.db.Execute(ReadQuestionBankQuery, bank.BankID)
+	require.Error(t, err, "read of a bank that was not created succeeded")
+
+	// Create the bank.
+	_, err = connection.db.Execute(CreateQuestionBankQuery, bank)
+	require.NoError(t, err, "failed to create bank")
+	_, err = connection.db.Execute(CreateQuestionBankQuery, bank)
+	require.Error(t, err, "created a bank that already exists")
+
+	resp, err := connection.db.Execute(ReadQuestionBankQuery, bank.BankID)
+	require.NoError(t, err, "failed to read created bank")
+	require.Equal(t, bank, resp.(*model_cassandra.QuestionBank), "stored bank mismatch")
+
+	resp, err = connection.db.Execute(ReadQuestionBanksQuery, []gocql.UUID{bank.BankID, gocql.TimeUUID()})
+	require.NoError(t, err, "failed to read created banks")
+	require.Equal(t, []*model_cassandra.QuestionBank{bank}, resp.([]*model_cassandra.QuestionBank), "stored banks mismatch")
+
+	// Update the bank.
+	update := &model_cassandra.QuestionBankMutateRequest{Username: "user-2", BankID: bank.BankID, Bank: &model_cassandra.QuestionBank{
+		QuestionBankCore: &model_cassandra.QuestionBankCore{Title: "bank-1 updated", Questions: questions[:1]}}}
+	_, err = connection.db.Execute(UpdateQuestionBankQuery, update)
+	require.Error(t, err, "updated a bank as a user that is not the author")
+
+	update.Username = bank.Author
+	_, err = connection.db.Execute(UpdateQuestionBankQuery, update)
+	require.NoError(t, err, "failed to update bank")
+	resp, err = connection.db.Execute(ReadQuestionBankQuery, bank.BankID)
+	require.NoError(t, err, "failed to read updated bank")
+	require.Equal(t, update.Bank.QuestionBankCore, resp.(*model_cassandra.QuestionBank).QuestionBankCore, "updated bank mismatch")
+
+	// Delete the bank.
+	_, err = connection.db.Execute(DeleteQuestionBankQuery, &model_cassandra.QuestionBankMutateRequest{Username: "user-2", BankID: bank.BankID})
+	require.Error(t, err, "deleted a bank as a user that is not the author")
+	_, err = connection.db.Execute(DeleteQuestionBankQuery, &model_cassandra.QuestionBankMutateRequest{Username: bank.Author, BankID: bank.BankID})
+	require.NoError(t, err, "failed to delete bank")
+	resp, err = connection.db.Execute(ReadQuestionBankQuery, bank.BankID)
+	require.NoError(t, err, "failed to read deleted bank")
+	require.True(t, resp.(*model_cassandra.QuestionBank).IsDeleted, "bank not marked as deleted")
+
+	_, err = connection.db.Execute(UpdateQuestionBankQuery, update)
+	require.Error(t, err, "updated a deleted bank")
+}
+
+func TestAttemptDrawQueries(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Lock connection to Cassandra cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	_, err := truncateTableQuery(connection.db, "attempt_draws")
+	require.NoErrorf(t, err, "failed to truncate attempt draws table")
+
+	questions := GetTestQuizzes()["myPubQuiz"].Questions
+	draw := &model_cassandra.AttemptDraw{Username: "user-1", QuizID: gocql.TimeUUID(), Attempt: 1, Questions: questions[1:]}
+	request := &model_cassandra.AttemptDrawRequest{Username: draw.Username, QuizID: draw.QuizID, Attempt: draw.Attempt}
+
+	// Attempt not viewed.
+	_, err = connection.db.Execute(ReadAttemptDrawQuery, request)
+	require.Error(t, err, "read of a draw that was not created succeeded")
+
+	// View the attempt.
+	resp, err := connection.db.Execute(CreateAttemptDrawQuery, draw)
+	require.NoError(t, err, "failed to create draw")
+	require.Equal(t, draw, resp.(*model_cassandra.AttemptDraw), "created draw mismatch")
+
+	resp, err = connection.db.Execute(ReadAttemptDrawQuery, request)
+	require.NoError(t, err, "failed to read created draw")
+	require.Equal(t, draw, resp.(*model_cassandra.AttemptDraw), "stored draw mismatch")
+
+	// Viewing the attempt again must not change the questions.
+	redraw := *draw
+	redraw.Questions = questions[:1]
+	resp, err = connection.db.Execute(CreateAttemptDrawQuery, &redraw)
+	require.NoError(t, err, "failed to recreate draw")
+	require.Equal(t, draw, resp.(*model_cassandra.AttemptDraw), "recreated draw was modified")
+}
+
 func TestHealthcheckQuery(t *testing.T) {
 	// Skip integration tests for short test runs.
 	if testing.Short() {
@@ -579,3 +1329,176 @@ func TestReadResponseStatisticsPageQuery(t *testing.T) {
 		})
 	}
 }
+
+func insertTestGroups(t *testing.T) {
+	for _, table := range []string{"groups", "group_members", "user_groups", "group_invitations", "group_join_codes", "group_quizzes"} {
+		_, err := truncateTableQuery(connection.db, table)
+		require.NoErrorf(t, err, "failed to truncate %s table before populating", table)
+	}
+}
+
+func TestGroupQueries(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Lock connection to Cassandra cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	insertTestGroups(t)
+
+	group := &model_cassandra.Group{
+		GroupCore: &model_cassandra.GroupCore{Name: "group-1", Description: "first group"},
+		GroupID:   gocql.TimeUUID(),
+		Creator:   "user-1",
+		JoinCode:  "CODE1234",
+	}
+
+	// Group not created.
+	_, err := connection.db.Execute(ReadGroupQuery, group.GroupID)
+	require.Error(t, err, "read of a group that was not created succeeded")
+
+	// Create the group.
+	_, err = connection.db.Execute(CreateGroupQuery, group)
+	require.NoError(t, err, "failed to create group")
+	duplicate := *group
+	duplicate.GroupID = gocql.TimeUUID()
+	_, err = connection.db.Execute(CreateGroupQuery, &duplicate)
+	require.Error(t, err, "created a group with a join code that is in use")
+
+	resp, err := connection.db.Execute(ReadGroupQuery, group.GroupID)
+	require.NoError(t, err, "failed to read created group")
+	require.Equal(t, group, resp.(*model_cassandra.Group), "stored group mismatch")
+
+	resp, err = connection.db.Execute(ReadGroupJoinCodeQuery, group.JoinCode)
+	require.NoError(t, err, "failed to read join code")
+	require.Equal(t, group.GroupID, resp.(gocql.UUID), "join code group mismatch")
+
+	// The creator is an owner.
+	resp, err = connection.db.Execute(ReadGroupMemberQuery, &model_cassandra.GroupMemberRequest{GroupID: group.GroupID, Username: group.Creator})
+	require.NoError(t, err, "failed to read creator membership")
+	require.True(t, resp.(*model_cassandra.GroupMember).IsOwner, "creator is not an owner")
+
+	// Invite and add a member.
+	invitation := &model_cassandra.GroupInvitation{Username: "user-2", GroupID: group.GroupID, Name: group.Name, InvitedBy: group.Creator,
+		InvitedAt: time.Now().UTC().Truncate(time.Millisecond)}
+	_, err = connection.db.Execute(CreateGroupInvitationQuery, invitation)
+	require.NoError(t, err, "failed to invite member")
+	request := &model_cassandra.GroupMemberRequest{GroupID: group.GroupID, Username: invitation.Username}
+	resp, err = connection.db.Execute(ReadGroupInvitationQuery, request)
+	require.NoError(t, err, "failed to read invitation")
+	require.Equal(t, invitation, resp.(*model_cassandra.GroupInvitation), "stored invitation mismatch")
+	resp, err = connection.db.Execute(ReadGroupInvitationsQuery, invitation.Username)
+	require.NoError(t, err, "failed to read invitations")
+	require.Equal(t, []*model_cassandra.GroupInvitation{invitation}, resp.([]*model_cassandra.GroupInvitation), "invitations mismatch")
+	_, err = connection.db.Execute(DeleteGroupInvitationQuery, request)
+	require.NoError(t, err, "failed to remove invitation")
+	_, err = connection.db.Execute(DeleteGroupInvitationQuery, request)
+	require.Error(t, err, "removed an invitation that does not exist")
+
+	member := &model_cassandra.GroupMember{GroupID: group.GroupID, Username: "user-2", JoinedAt: time.Now().UTC().Truncate(time.Millisecond)}
+	_, err = connection.db.Execute(CreateGroupMemberQuery, member)
+	require.NoError(t, err, "failed to add member")
+	_, err = connection.db.Execute(CreateGroupMemberQuery, member)
+	require.Error(t, err, "added a member that already joined")
+	_, err = connection.db.Execute(CreateGroupMemberQuery, &model_cassandra.GroupMember{GroupID: gocql.TimeUUID(), Username: "user-2"})
+	require.Error(t, err, "added a member to a group that does not exist")
+
+	resp, err = connection.db.Execute(ReadGroupMembersQuery, group.GroupID)
+	require.NoError(t, err, "failed to read members")
+	require.Len(t, resp.([]*model_cassandra.GroupMember), 2, "number of members mismatch")
+
+	// Renaming the group is listed for its members.
+	update := &model_cassandra.GroupMutateRequest{GroupID: group.GroupID, Group: &model_cassandra.GroupCore{Name: "group-1 renamed"}}
+	_, err = connection.db.Execute(UpdateGroupQuery, update)
+	require.NoError(t, err, "failed to update group")
+	resp, err = connection.db.Execute(ReadUserGroupsQuery, member.Username)
+	require.NoError(t, err, "failed to read user groups")
+	require.Equal(t, []*model_cassandra.UserGroup{{Username: member.Username, GroupID: group.GroupID, Name: update.Group.Name}},
+		resp.([]*model_cassandra.UserGroup), "user groups mismatch")
+
+	// Replace the join code.
+	_, err = connection.db.Execute(UpdateGroupJoinCodeQuery,
+		&model_cassandra.GroupJoinCodeRequest{GroupID: group.GroupID, JoinCode: "CODE5678", PreviousCode: "WRONG123"})
+	require.Error(t, err, "replaced a join code that was not current")
+	_, err = connection.db.Execute(UpdateGroupJoinCodeQuery,
+		&model_cassandra.GroupJoinCodeRequest{GroupID: group.GroupID, JoinCode: "CODE5678", PreviousCode: group.JoinCode})
+	require.NoError(t, err, "failed to replace join code")
+	_, err = connection.db.Execute(ReadGroupJoinCodeQuery, group.JoinCode)
+	require.Error(t, err, "previous join code was not released")
+
+	// Remove the member.
+	_, err = connection.db.Execute(DeleteGroupMemberQuery, request)
+	require.NoError(t, err, "failed to remove member")
+	_, err = connection.db.Execute(DeleteGroupMemberQuery, request)
+	require.Error(t, err, "removed a member that does not exist")
+	resp, err = connection.db.Execute(ReadUserGroupsQuery, member.Username)
+	require.NoError(t, err, "failed to read user groups of removed member")
+	require.Empty(t, resp.([]*model_cassandra.UserGroup), "group is listed for removed member")
+
+	// Delete the group.
+	_, err = connection.db.Execute(DeleteGroupQuery, group.GroupID)
+	require.NoError(t, err, "failed to delete group")
+	_, err = connection.db.Execute(ReadGroupQuery, group.GroupID)
+	require.Error(t, err, "read a deleted group")
+	_, err = connection.db.Execute(ReadGroupJoinCodeQuery, "CODE5678")
+	require.Error(t, err, "join code of deleted group was not released")
+	resp, err = connection.db.Execute(ReadUserGroupsQuery, group.Creator)
+	require.NoError(t, err, "failed to read user groups of creator")
+	require.Empty(t, resp.([]*model_cassandra.UserGroup), "deleted group is listed for creator")
+	_, err = connection.db.Execute(UpdateGroupQuery, update)
+	require.Error(t, err, "updated a deleted group")
+}
+
+func TestAssignQuizQuery(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Lock connection to Cassandra cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+	insertTestQuizzes(t)
+	insertTestGroups(t)
+
+	quiz := testQuizRecords["myPubQuiz"]
+	request := &model_cassandra.QuizAssignRequest{Author: "user-1", AssignedBy: "user-1", GroupID: gocql.TimeUUID(),
+		QuizID: quiz.QuizID, Title: quiz.Title, AssignedAt: time.Now().UTC().Truncate(time.Millisecond)}
+
+	// Only the author can assign the quiz.
+	_, err := connection.db.Execute(AssignQuizQuery, request)
+	require.Error(t, err, "assigned a quiz as a user that is not the author")
+
+	request.Author = quiz.Author
+	_, err = connection.db.Execute(AssignQuizQuery, request)
+	require.NoError(t, err, "failed to assign quiz")
+
+	resp, err := connection.db.Execute(ReadQuizQuery, quiz.QuizID)
+	require.NoError(t, err, "failed to read assigned quiz")
+	require.Equal(t, []gocql.UUID{request.GroupID}, resp.(*model_cassandra.Quiz).Groups, "quiz groups mismatch")
+
+	resp, err = connection.db.Execute(ReadGroupQuizzesQuery, request.GroupID)
+	require.NoError(t, err, "failed to read group quizzes")
+	require.Equal(t, []*model_cassandra.GroupQuiz{{GroupID: request.GroupID, QuizID: quiz.QuizID, Title: quiz.Title,
+		AssignedBy: request.AssignedBy, AssignedAt: request.AssignedAt}}, resp.([]*model_cassandra.GroupQuiz), "group quizzes mismatch")
+
+	// Unassign the quiz.
+	_, err = connection.db.Execute(UnassignQuizQuery, request)
+	require.NoError(t, err, "failed to unassign quiz")
+
+	resp, err = connection.db.Execute(ReadQuizQuery, quiz.QuizID)
+	require.NoError(t, err, "failed to read unassigned quiz")
+	require.Empty(t, resp.(*model_cassandra.Quiz).Groups, "quiz is still restricted to the group")
+
+	resp, err = connection.db.Execute(ReadGroupQuizzesQuery, request.GroupID)
+	require.NoError(t, err, "failed to read group quizzes")
+	require.Empty(t, resp.([]*model_cassandra.GroupQuiz), "quiz is still listed for the group")
+
+	// Deleted quizzes cannot be assigned.
+	deleted := *request
+	deleted.QuizID = testQuizRecords["myPubQuizDeleted"].QuizID
+	_, err = connection.db.Execute(AssignQuizQuery, &deleted)
+	require.Error(t, err, "assigned a deleted quiz")
+}
@@ -160,14 +160,20 @@ func createTestingKeyspace(c *cassandraImpl) (err error) {
 	}
 	c.logger.Info("connected to cluster and scoped to integration test keyspace", zap.String("name", integrationKeyspace))
 
-	// Create users, quizzes, and responses tables.
+	// Create users, quizzes, question banks, responses, user scores, attempt sessions, attempt shuffles, attempt draws, answer
+	// drafts, quiz schedule, and groups tables.
 	createTablesWg := sync.WaitGroup{}
-	createTablesWg.Add(3)
-	errorsChan := make(chan error, 3)
+	createTablesWg.Add(8)
+	errorsChan := make(chan error, 8)
 
 	go createUsersTable(c, errorsChan, &createTablesWg)
 	go createQuizzesTable(c, errorsChan, &createTablesWg)
 	go createResponsesTable(c, errorsChan, &createTablesWg)
+	go createAttemptSessionsTable(c, errorsChan, &createTablesWg)
+	go createAttemptShufflesTable(c, errorsChan, &createTablesWg)
+	go createAnswerDraftsTable(c, errorsChan, &createTablesWg)
+	go createQuizScheduleTable(c, errorsChan, &createTablesWg)
+	go createGroupsTables(c, errorsChan, &createTablesWg)
 
 	createTablesWg.Wait()
 	close(errorsChan)
@@ -191,7 +197,8 @@ func createUsersTable(c *cassandraImpl, errors chan<- error, wg *sync.WaitGroup)
 	c.logger.Info("created users table in integration test keyspace")
 }
 
-// createQuizzesTable will create the quizzes table in the integration test keyspace.
+// createQuizzesTable will create the question and question draw UDTs, and the quizzes, quiz versions, author quizzes,
+// question banks, and attempt draws tables in the integration test keyspace.
 func createQuizzesTable(c *cassandraImpl, errors chan<- error, wg *sync.WaitGroup) {
 	defer wg.Done()
 	if err := c.session.Query(model_cassandra.CreateQuestionUDT).Exec(); err != nil {
@@ -200,17 +207,53 @@ func createQuizzesTable(c *cassandraImpl, errors chan<- error, wg *sync.WaitGrou
 		return
 	}
 	c.logger.Info("created questions UDT in integration test keyspace")
+	if err := c.session.Query(model_cassandra.CreateQuestionDrawUDT).Exec(); err != nil {
+		c.logger.Error("failed to create question draw UDT in integration test keyspace", zap.Error(err))
+		errors <- err
+		return
+	}
+	c.logger.Info("created question draw UDT in integration test keyspace")
 	if err := c.session.Query(model_cassandra.CreateQuizzesTable).Exec(); err != nil {
 		c.logger.Error("failed to create quizzes table in integration test keyspace", zap.Error(err))
 		errors <- err
 		return
 	}
 	c.logger.Info("created quizzed table in integration test keyspace")
+	if err := c.session.Query(model_cassandra.CreateQuizVersionsTable).Exec(); err != nil {
+		c.logger.Error("failed to create quiz versions table in integration test keyspace", zap.Error(err))
+		errors <- err
+		return
+	}
+	c.logger.Info("created quiz versions table in integration test keyspace")
+	if err := c.session.Query(model_cassandra.CreateAuthorQuizzesTable).Exec(); err != nil {
+		c.logger.Error("failed to create author quizzes table in integration test keyspace", zap.Error(err))
+		errors <- err
+		return
+	}
+	c.logger.Info("created author quizzes table in integration test keyspace")
+	if err := c.session.Query(model_cassandra.CreateQuestionBanksTable).Exec(); err != nil {
+		c.logger.Error("failed to create question banks table in integration test keyspace", zap.Error(err))
+		errors <- err
+		return
+	}
+	c.logger.Info("created question banks table in integration test keyspace")
+	if err := c.session.Query(model_cassandra.CreateAttemptDrawsTable).Exec(); err != nil {
+		c.logger.Error("failed to create attempt draws table in integration test keyspace", zap.Error(err))
+		errors <- err
+		return
+	}
+	c.logger.Info("created attempt draws table in integration test keyspace")
 }
 
-// createResponsesTable will create the responses table in the integration test keyspace.
+// createResponsesTable will create the attempts UDT, and the responses and user scores tables in the integration test keyspace.
 func createResponsesTable(c *cassandraImpl, errors chan<- error, wg *sync.WaitGroup) {
 	defer wg.Done()
+	if err := c.session.Query(model_cassandra.CreateAttemptUDT).Exec(); err != nil {
+		c.logger.Error("failed to create attempts UDT in integration test keyspace", zap.Error(err))
+		errors <- err
+		return
+	}
+	c.logger.Info("created attempts UDT in integration test keyspace")
 	if err := c.session.Query(model_cassandra.CreateResponsesTable).Exec(); err != nil {
 		c.logger.Error("failed to create responses table in integration test keyspace", zap.Error(err))
 		errors <- err
@@ -223,4 +266,76 @@ func createResponsesTable(c *cassandraImpl, errors chan<- error, wg *sync.WaitGr
 		return
 	}
 	c.logger.Info("created responses index in integration test keyspace")
+	if err := c.session.Query(model_cassandra.CreateUserScoresTable).Exec(); err != nil {
+		c.logger.Error("failed to create user scores table in integration test keyspace", zap.Error(err))
+		errors <- err
+		return
+	}
+	c.logger.Info("created user scores table in integration test keyspace")
+}
+
+// createAttemptSessionsTable will create the attempt sessions table in the integration test keyspace.
+func createAttemptSessionsTable(c *cassandraImpl, errors chan<- error, wg *sync.WaitGroup) {
+	defer wg.Done()
+	if err := c.session.Query(model_cassandra.CreateAttemptSessionsTable).Exec(); err != nil {
+		c.logger.Error("failed to create attempt sessions table in integration test keyspace", zap.Error(err))
+		errors <- err
+		return
+	}
+	c.logger.Info("created attempt sessions table in integration test keyspace")
+}
+
+// createAttemptShufflesTable will create the attempt shuffles table in the integration test keyspace.
+func createAttemptShufflesTable(c *cassandraImpl, errors chan<- error, wg *sync.WaitGroup) {
+	defer wg.Done()
+	if err := c.session.Query(model_cassandra.CreateAttemptShufflesTable).Exec(); err != nil {
+		c.logger.Error("failed to create attempt shuffles table in integration test keyspace", zap.Error(err))
+		errors <- err
+		return
+	}
+	c.logger.Info("created attempt shuffles table in integration test keyspace")
+}
+
+// createAnswerDraftsTable will create the answer drafts table in the integration test keyspace.
+func createAnswerDraftsTable(c *cassandraImpl, errors chan<- error, wg *sync.WaitGroup) {
+	defer wg.Done()
+	if err := c.session.Query(model_cassandra.CreateAnswerDraftsTable).Exec(); err != nil {
+		c.logger.Error("failed to create answer drafts table in integration test keyspace", zap.Error(err))
+		errors <- err
+		return
+	}
+	c.logger.Info("created answer drafts table in integration test keyspace")
+}
+
+// createQuizScheduleTable will create the quiz schedule table in the integration test keyspace.
+func createQuizScheduleTable(c *cassandraImpl, errors chan<- error, wg *sync.WaitGroup) {
+	defer wg.Done()
+	if err := c.session.Query(model_cassandra.CreateQuizScheduleTable).Exec(); err != nil {
+		c.logger.Error("failed to create quiz schedule table in integration test keyspace", zap.Error(err))
+		errors <- err
+		return
+	}
+	c.logger.Info("created quiz schedule table in integration test keyspace")
+}
+
+// createGroupsTables will create the groups, group members, user groups, group invitations, group join codes, and group
+// quizzes tables in the integration test keyspace.
+func createGroupsTables(c *cassandraImpl, errors chan<- error, wg *sync.WaitGroup) {
+	defer wg.Done()
+	tables := map[string]string{
+		"groups":            model_cassandra.CreateGroupsTable,
+		"group members":     model_cassandra.CreateGroupMembersTable,
+		"user groups":       model_cassandra.CreateUserGroupsTable,
+		"group invitations": model_cassandra.CreateGroupInvitationsTable,
+		"group join codes":  model_cassandra.CreateGroupJoinCodesTable,
+		"group quizzes":     model_cassandra.CreateGroupQuizzesTable,
+	}
+	for name, query := range tables {
+		if err := c.session.Query(query).Exec(); err != nil {
+			c.logger.Error(fmt.Sprintf("failed to create %s table in integration test keyspace", name), zap.Error(err))
+			errors <- err
+			return
+		}
+		c.logger.Info(fmt.Sprintf("created %s table in integration test keyspace", name))
+	}
 }
@@ -219,6 +219,7 @@ func GetTestUsers() map[string]*model_cassandra.User {
 			},
 			AccountID: blake2b256(uname),
 			IsDeleted: false,
+			Role:      model_cassandra.RoleStudent,
 		}
 	}
 
@@ -246,9 +247,16 @@ func GetTestQuizzes() map[string]*model_cassandra.Quiz {
 
 	data["providedPubQuiz"] = &model_cassandra.Quiz{QuizID: quizzesUUIDMapping["providedPubQuiz"], Author: "user-1", IsPublished: true,
 		QuizCore: &model_cassandra.QuizCore{
-			Title:       "Sample quiz published",
-			MarkingType: "Negative",
-			Questions:   []*model_cassandra.Question{&temperatureQuestion, &moonQuestion},
+			Title:           "Sample quiz published",
+			MarkingType:     "Negative",
+			Questions:       []*model_cassandra.Question{&temperatureQuestion, &moonQuestion},
+			MaxAttempts:     3,
+			AttemptCooldown: 60,
+			AttemptPolicy:   model_cassandra.AttemptPolicyAverage,
+			TimeLimit:       600,
+			GracePeriod:     30,
+			Description:     "Units of measurement and astronomy",
+			Tags:            []string{"science", "units"},
 		}}
 	data["providedNoPubQuiz"] = &model_cassandra.Quiz{QuizID: quizzesUUIDMapping["providedNoPubQuiz"], Author: "user-1",
 		QuizCore: &model_cassandra.QuizCore{
@@ -0,0 +1,251 @@
+package catalogue
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/gocql/gocql"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/logger"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+	"go.uber.org/zap"
+)
+
+// Mock Catalogue interface stub generation.
+//go:generate mockgen -destination=../mocks/mock_catalogue.go -package=mocks github.com/surahman/mcq-platform/pkg/catalogue Catalogue
+
+// Catalogue is the interface through which the published quizzes are discovered. Created to support mock testing.
+type Catalogue interface {
+	// Load will rebuild the catalogue from the published quizzes in the database.
+	Load() error
+
+	// Add will list a quiz in the catalogue and replace any existing listing for it. Quizzes that are not published, are
+	// deleted, or are restricted to groups will be removed from the catalogue.
+	Add(*model_cassandra.Quiz)
+
+	// Remove will remove a quiz from the catalogue.
+	Remove(gocql.UUID)
+
+	// Search will retrieve a page of the quizzes in the catalogue that match the search terms and tag in the request.
+	Search(*model_http.CatalogueRequest) *model_http.CataloguePage
+}
+
+// Check to ensure the Catalogue interface has been implemented.
+var _ Catalogue = &catalogueImpl{}
+
+// catalogueImpl is an in-process inverted index of the published quizzes. Search terms and tags are mapped to the set of
+// quizzes that contain them, and the quizzes are listed from newest to oldest.
+type catalogueImpl struct {
+	db     cassandra.Cassandra
+	logger *logger.Logger
+
+	mu      sync.RWMutex
+	quizzes map[gocql.UUID]*model_http.CatalogueQuiz
+	terms   map[string]map[gocql.UUID]struct{}
+	tags    map[string]map[gocql.UUID]struct{}
+}
+
+// NewCatalogue will create a new empty quiz catalogue. Load should be called to populate it from the database.
+func NewCatalogue(cassandra cassandra.Cassandra, logger *logger.Logger) (Catalogue, error) {
+	if cassandra == nil || logger == nil {
+		return nil, errors.New("nil database or logger supplied")
+	}
+
+	return &catalogueImpl{
+		db:      cassandra,
+		logger:  logger,
+		quizzes: make(map[gocql.UUID]*model_http.CatalogueQuiz),
+		terms:   make(map[string]map[gocql.UUID]struct{}),
+		tags:    make(map[string]map[gocql.UUID]struct{}),
+	}, nil
+}
+
+// Load will replace the contents of the catalogue with the published quizzes in the database.
+func (c *catalogueImpl) Load() error {
+	response, err := c.db.Execute(cassandra.ReadPublishedQuizzesQuery, nil)
+	if err != nil {
+		c.logger.Error("failed to read published quizzes to load the catalogue", zap.Error(err))
+		return err
+	}
+	quizzes := response.([]*model_cassandra.Quiz)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.quizzes = make(map[gocql.UUID]*model_http.CatalogueQuiz, len(quizzes))
+	c.terms = make(map[string]map[gocql.UUID]struct{})
+	c.tags = make(map[string]map[gocql.UUID]struct{})
+	for _, quiz := range quizzes {
+		c.add(quiz)
+	}
+
+	c.logger.Info("Quiz catalogue loaded", zap.Int("quizzes", len(c.quizzes)))
+
+	return nil
+}
+
+// Add will list a published quiz in the catalogue.
+func (c *catalogueImpl) Add(quiz *model_cassandra.Quiz) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.remove(quiz.QuizID)
+	c.add(quiz)
+}
+
+// Remove will remove a quiz from the catalogue.
+func (c *catalogueImpl) Remove(quizId gocql.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.remove(quizId)
+}
+
+// Search will retrieve a page of matching quizzes from the catalogue. The page begins after the quiz in the cursor, so
+// quizzes added or removed between requests do not cause the pages to skip or repeat quizzes.
+func (c *catalogueImpl) Search(request *model_http.CatalogueRequest) *model_http.CataloguePage {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var filters []map[gocql.UUID]struct{}
+	for _, term := range tokenize(request.Search) {
+		filters = append(filters, c.terms[term])
+	}
+	if tag := normalizeTag(request.Tag); len(tag) > 0 {
+		filters = append(filters, c.tags[tag])
+	}
+
+	// Collect the quizzes that match all the filters.
+	matches := make([]gocql.UUID, 0)
+	for quizId := range c.quizzes {
+		matched := true
+		for _, filter := range filters {
+			if _, ok := filter[quizId]; !ok {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			matches = append(matches, quizId)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return listedBefore(matches[i], matches[j]) })
+
+	// Skip to the quiz after the cursor.
+	start := 0
+	if cursor, err := gocql.UUIDFromBytes(request.PageCursor); err == nil {
+		start = sort.Search(len(matches), func(i int) bool { return listedBefore(cursor, matches[i]) })
+	}
+
+	response := model_http.CataloguePage{PageSize: request.PageSize, Records: make([]*model_http.CatalogueQuiz, 0)}
+	if request.PageSize < 1 {
+		return &response
+	}
+
+	end := start + request.PageSize
+	if end >= len(matches) {
+		end = len(matches)
+	} else {
+		response.PageCursor = matches[end-1].Bytes()
+	}
+	for _, quizId := range matches[start:end] {
+		response.Records = append(response.Records, c.quizzes[quizId])
+	}
+
+	return &response
+}
+
+// add will index a quiz if it is published, not deleted, and not restricted to groups. The lock must be held by the caller.
+func (c *catalogueImpl) add(quiz *model_cassandra.Quiz) {
+	if !quiz.IsPublished || quiz.IsDeleted || quiz.QuizCore == nil || len(quiz.Groups) > 0 {
+		return
+	}
+
+	entry := model_http.CatalogueQuiz{
+		QuizID:      quiz.QuizID.String(),
+		Author:      quiz.Author,
+		Title:       quiz.Title,
+		Description: quiz.Description,
+		Tags:        quiz.Tags,
+		Version:     quiz.Version,
+	}
+	c.quizzes[quiz.QuizID] = &entry
+
+	for _, term := range tokenize(quiz.Title + " " + quiz.Description) {
+		insert(c.terms, term, quiz.QuizID)
+	}
+	for _, tag := range quiz.Tags {
+		insert(c.tags, normalizeTag(tag), quiz.QuizID)
+	}
+}
+
+// remove will delete a quiz and its index entries. The lock must be held by the caller.
+func (c *catalogueImpl) remove(quizId gocql.UUID) {
+	entry, ok := c.quizzes[quizId]
+	if !ok {
+		return
+	}
+	delete(c.quizzes, quizId)
+
+	for _, term := range tokenize(entry.Title + " " + entry.Description) {
+		erase(c.terms, term, quizId)
+	}
+	for _, tag := range entry.Tags {
+		erase(c.tags, normalizeTag(tag), quizId)
+	}
+}
+
+// insert will add a quiz to the set of quizzes under a key in an index.
+func insert(index map[string]map[gocql.UUID]struct{}, key string, quizId gocql.UUID) {
+	if _, ok := index[key]; !ok {
+		index[key] = make(map[gocql.UUID]struct{})
+	}
+	index[key][quizId] = struct{}{}
+}
+
+// erase will remove a quiz from the set of quizzes under a key in an index and remove keys that no longer have any quizzes.
+func erase(index map[string]map[gocql.UUID]struct{}, key string, quizId gocql.UUID) {
+	delete(index[key], quizId)
+	if len(index[key]) == 0 {
+		delete(index, key)
+	}
+}
+
+// tokenize will split text into lowercase terms on any character that is not a letter or digit. Repeated terms are removed.
+func tokenize(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	seen := make(map[string]struct{}, len(fields))
+	terms := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if _, ok := seen[field]; ok {
+			continue
+		}
+		seen[field] = struct{}{}
+		terms = append(terms, field)
+	}
+
+	return terms
+}
+
+// normalizeTag will convert a tag to the case-insensitive form it is indexed under.
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// listedBefore reports whether a quiz is listed before another in the catalogue. Quizzes are listed from newest to oldest
+// using the time in their ids, with ties broken by the ids themselves.
+func listedBefore(lhs, rhs gocql.UUID) bool {
+	if lhsTime, rhsTime := lhs.Time(), rhs.Time(); !lhsTime.Equal(rhsTime) {
+		return lhsTime.After(rhsTime)
+	}
+
+	return bytes.Compare(lhs[:], rhs[:]) > 0
+}
@@ -0,0 +1,271 @@
+package catalogue
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+)
+
+// testCatalogueQuizzes will generate published quizzes with ids that are a minute apart, in order from oldest to newest.
+func testCatalogueQuizzes() []*model_cassandra.Quiz {
+	start := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	cores := []*model_cassandra.QuizCore{
+		{Title: "Algebra basics", Description: "Linear equations and inequalities", Tags: []string{"Math", "algebra"}},
+		{Title: "Organic chemistry", Description: "Alkanes, alkenes and alkynes", Tags: []string{"science"}},
+		{Title: "Advanced algebra", Description: "Polynomials and rational functions", Tags: []string{"math"}},
+		{Title: "Physics of motion", Description: "Newton's laws and linear momentum", Tags: []string{"science", "physics"}},
+	}
+
+	quizzes := make([]*model_cassandra.Quiz, 0, len(cores))
+	for idx, core := range cores {
+		quizzes = append(quizzes, &model_cassandra.Quiz{
+			QuizCore:    core,
+			QuizID:      gocql.UUIDFromTime(start.Add(time.Duration(idx) * time.Minute)),
+			Author:      "author",
+			IsPublished: true,
+			Version:     1,
+		})
+	}
+
+	return quizzes
+}
+
+// searchTitles will retrieve the titles of all the quizzes matching a request by following the page cursors.
+func searchTitles(t *testing.T, catalogue Catalogue, request *model_http.CatalogueRequest) (titles []string, pages int) {
+	for {
+		response := catalogue.Search(request)
+		require.LessOrEqual(t, len(response.Records), request.PageSize, "page exceeds page size")
+		for _, record := range response.Records {
+			titles = append(titles, record.Title)
+		}
+		pages++
+
+		if response.PageCursor == nil {
+			return
+		}
+		request.PageCursor = response.PageCursor
+	}
+}
+
+func TestNewCatalogue(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	catalogue, err := NewCatalogue(nil, zapLogger)
+	require.Error(t, err, "nil database should fail")
+	require.Nil(t, catalogue, "nil database should not return a catalogue")
+
+	catalogue, err = NewCatalogue(mocks.NewMockCassandra(mockCtrl), nil)
+	require.Error(t, err, "nil logger should fail")
+	require.Nil(t, catalogue, "nil logger should not return a catalogue")
+
+	catalogue, err = NewCatalogue(mocks.NewMockCassandra(mockCtrl), zapLogger)
+	require.NoError(t, err, "valid catalogue should not fail")
+	require.NotNil(t, catalogue, "valid catalogue should be returned")
+}
+
+func TestCatalogue_Load(t *testing.T) {
+	quizzes := testCatalogueQuizzes()
+
+	testCases := []struct {
+		name        string
+		readData    any
+		readErr     error
+		expectErr   require.ErrorAssertionFunc
+		expectedLen int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:        "db failure",
+			readErr:     errors.New("db failure"),
+			expectErr:   require.Error,
+			expectedLen: 1,
+		}, {
+			name:        "no quizzes",
+			readData:    []*model_cassandra.Quiz{},
+			expectErr:   require.NoError,
+			expectedLen: 0,
+		}, {
+			name:        "quizzes",
+			readData:    quizzes,
+			expectErr:   require.NoError,
+			expectedLen: len(quizzes),
+		},
+		// ----- test cases end ----- //
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(testCase.readData, testCase.readErr).Times(1)
+
+			catalogue, err := NewCatalogue(mockCassandra, zapLogger)
+			require.NoError(t, err, "failed to create catalogue")
+
+			// A quiz listed before loading is replaced unless the load fails.
+			catalogue.Add(&model_cassandra.Quiz{QuizID: gocql.TimeUUID(), IsPublished: true,
+				QuizCore: &model_cassandra.QuizCore{Title: "listed before loading"}})
+
+			testCase.expectErr(t, catalogue.Load())
+
+			response := catalogue.Search(&model_http.CatalogueRequest{PageSize: 10})
+			require.Equal(t, testCase.expectedLen, len(response.Records), "number of quizzes in catalogue mismatch")
+		})
+	}
+}
+
+func TestCatalogue_Search(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	catalogue, err := NewCatalogue(mocks.NewMockCassandra(mockCtrl), zapLogger)
+	require.NoError(t, err, "failed to create catalogue")
+	for _, quiz := range testCatalogueQuizzes() {
+		catalogue.Add(quiz)
+	}
+
+	testCases := []struct {
+		name          string
+		request       *model_http.CatalogueRequest
+		expected      []string
+		expectedPages int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:          "all quizzes newest first",
+			request:       &model_http.CatalogueRequest{PageSize: 10},
+			expected:      []string{"Physics of motion", "Advanced algebra", "Organic chemistry", "Algebra basics"},
+			expectedPages: 1,
+		}, {
+			name:          "all quizzes paged",
+			request:       &model_http.CatalogueRequest{PageSize: 3},
+			expected:      []string{"Physics of motion", "Advanced algebra", "Organic chemistry", "Algebra basics"},
+			expectedPages: 2,
+		}, {
+			name:          "all quizzes exact pages",
+			request:       &model_http.CatalogueRequest{PageSize: 2},
+			expected:      []string{"Physics of motion", "Advanced algebra", "Organic chemistry", "Algebra basics"},
+			expectedPages: 2,
+		}, {
+			name:          "search title",
+			request:       &model_http.CatalogueRequest{Search: "ALGEBRA", PageSize: 10},
+			expected:      []string{"Advanced algebra", "Algebra basics"},
+			expectedPages: 1,
+		}, {
+			name:          "search description",
+			request:       &model_http.CatalogueRequest{Search: "linear", PageSize: 1},
+			expected:      []string{"Physics of motion", "Algebra basics"},
+			expectedPages: 2,
+		}, {
+			name:          "search all terms",
+			request:       &model_http.CatalogueRequest{Search: "linear algebra", PageSize: 10},
+			expected:      []string{"Algebra basics"},
+			expectedPages: 1,
+		}, {
+			name:          "search punctuation",
+			request:       &model_http.CatalogueRequest{Search: "newton's", PageSize: 10},
+			expected:      []string{"Physics of motion"},
+			expectedPages: 1,
+		}, {
+			name:          "search no match",
+			request:       &model_http.CatalogueRequest{Search: "geography", PageSize: 10},
+			expected:      nil,
+			expectedPages: 1,
+		}, {
+			name:          "tag",
+			request:       &model_http.CatalogueRequest{Tag: " MATH ", PageSize: 10},
+			expected:      []string{"Advanced algebra", "Algebra basics"},
+			expectedPages: 1,
+		}, {
+			name:          "tag and search",
+			request:       &model_http.CatalogueRequest{Tag: "science", Search: "momentum", PageSize: 10},
+			expected:      []string{"Physics of motion"},
+			expectedPages: 1,
+		}, {
+			name:          "tag no match",
+			request:       &model_http.CatalogueRequest{Tag: "history", PageSize: 10},
+			expected:      nil,
+			expectedPages: 1,
+		}, {
+			name:          "invalid cursor",
+			request:       &model_http.CatalogueRequest{Tag: "science", PageCursor: []byte("invalid"), PageSize: 10},
+			expected:      []string{"Physics of motion", "Organic chemistry"},
+			expectedPages: 1,
+		},
+		// ----- test cases end ----- //
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			titles, pages := searchTitles(t, catalogue, testCase.request)
+			require.Equal(t, testCase.expected, titles, "quizzes mismatch")
+			require.Equal(t, testCase.expectedPages, pages, "number of pages mismatch")
+		})
+	}
+}
+
+func TestCatalogue_AddRemove(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	catalogue, err := NewCatalogue(mocks.NewMockCassandra(mockCtrl), zapLogger)
+	require.NoError(t, err, "failed to create catalogue")
+	quizzes := testCatalogueQuizzes()
+	for _, quiz := range quizzes {
+		catalogue.Add(quiz)
+	}
+
+	// Unpublished, deleted, and group restricted quizzes are not listed.
+	catalogue.Add(&model_cassandra.Quiz{QuizID: gocql.TimeUUID(), QuizCore: &model_cassandra.QuizCore{Title: "Draft algebra"}})
+	catalogue.Add(&model_cassandra.Quiz{QuizID: gocql.TimeUUID(), IsPublished: true, IsDeleted: true,
+		QuizCore: &model_cassandra.QuizCore{Title: "Deleted algebra"}})
+	catalogue.Add(&model_cassandra.Quiz{QuizID: gocql.TimeUUID(), IsPublished: true, Groups: []gocql.UUID{gocql.TimeUUID()},
+		QuizCore: &model_cassandra.QuizCore{Title: "Cohort algebra"}})
+	titles, _ := searchTitles(t, catalogue, &model_http.CatalogueRequest{Search: "algebra", PageSize: 10})
+	require.Equal(t, []string{"Advanced algebra", "Algebra basics"}, titles, "unlisted quizzes were found")
+
+	// Revising a quiz replaces its listing and index entries.
+	revision := *quizzes[0]
+	revision.QuizCore = &model_cassandra.QuizCore{Title: "Geometry basics", Tags: []string{"geometry"}}
+	revision.Version = 2
+	catalogue.Add(&revision)
+
+	titles, _ = searchTitles(t, catalogue, &model_http.CatalogueRequest{Search: "algebra", PageSize: 10})
+	require.Equal(t, []string{"Advanced algebra"}, titles, "revised quiz found by previous title")
+	titles, _ = searchTitles(t, catalogue, &model_http.CatalogueRequest{Tag: "math", PageSize: 10})
+	require.Equal(t, []string{"Advanced algebra"}, titles, "revised quiz found by previous tag")
+
+	response := catalogue.Search(&model_http.CatalogueRequest{Tag: "geometry", PageSize: 10})
+	require.Equal(t, 1, len(response.Records), "revised quiz not found by tag")
+	require.Equal(t, quizzes[0].QuizID.String(), response.Records[0].QuizID, "revised quiz id mismatch")
+	require.Equal(t, 2, response.Records[0].Version, "revised quiz version mismatch")
+
+	// Removed quizzes are no longer listed and a cursor to a removed quiz continues after it.
+	page := catalogue.Search(&model_http.CatalogueRequest{PageSize: 2})
+	catalogue.Remove(quizzes[2].QuizID)
+	catalogue.Remove(gocql.TimeUUID())
+	titles, _ = searchTitles(t, catalogue, &model_http.CatalogueRequest{PageCursor: page.PageCursor, PageSize: 10})
+	require.Equal(t, []string{"Organic chemistry", "Geometry basics"}, titles, "quizzes after removed cursor mismatch")
+
+	// Unpublishing a quiz removes it from the catalogue.
+	catalogue.Add(&model_cassandra.Quiz{QuizID: quizzes[1].QuizID, IsDeleted: true, QuizCore: quizzes[1].QuizCore})
+	titles, _ = searchTitles(t, catalogue, &model_http.CatalogueRequest{Tag: "science", PageSize: 10})
+	require.Equal(t, []string{"Physics of motion"}, titles, "deleted quiz is still listed")
+}
+
+func TestTokenize(t *testing.T) {
+	require.Equal(t, []string{"newton", "s", "laws", "2nd", "édition"},
+		tokenize("  Newton's LAWS, laws (2nd Édition)!"), "tokens mismatch")
+	require.Empty(t, tokenize(" ,.!? "), "punctuation should not be a term")
+}
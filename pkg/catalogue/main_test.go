@@ -0,0 +1,24 @@
+package catalogue
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/surahman/mcq-platform/pkg/logger"
+)
+
+// zapLogger is the Zap logger used strictly for the test suite in this package.
+var zapLogger *logger.Logger
+
+func TestMain(m *testing.M) {
+	var err error
+	// Configure logger.
+	if zapLogger, err = logger.NewTestLogger(); err != nil {
+		log.Printf("Test suite logger setup failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Run test suite.
+	os.Exit(m.Run())
+}
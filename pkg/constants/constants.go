@@ -13,6 +13,8 @@ const (
 	restConfigFileName      = "HTTPRESTConfig.yaml"
 	graphqlConfigFileName   = "GraphQLConfig.yaml"
 	redisConfigFileName     = "RedisConfig.yaml"
+	limitsConfigFileName    = "LimitsConfig.yaml"
+	schedulerConfigFileName = "SchedulerConfig.yaml"
 
 	// Environment variables
 	cassandraPrefix = "CASSANDRA"
@@ -22,6 +24,8 @@ const (
 	restPrefix      = "REST"
 	graphqlPrefix   = "GRAPHQL"
 	redisPrefix     = "REDIS"
+	limitsPrefix    = "LIMITS"
+	schedulerPrefix = "SCHEDULER"
 
 	// Misc.
 	integrationTestKeyspaceSuffix = "_integration_testing"
@@ -73,6 +77,16 @@ func GetRedisFileName() string {
 	return redisConfigFileName
 }
 
+// GetLimitsFileName returns the quiz and response limits configuration file name.
+func GetLimitsFileName() string {
+	return limitsConfigFileName
+}
+
+// GetSchedulerFileName returns the quiz scheduler configuration file name.
+func GetSchedulerFileName() string {
+	return schedulerConfigFileName
+}
+
 // GetCassandraPrefix returns the environment variable prefix for Cassandra.
 func GetCassandraPrefix() string {
 	return cassandraPrefix
@@ -103,6 +117,16 @@ func GetRedisPrefix() string {
 	return redisPrefix
 }
 
+// GetLimitsPrefix returns the environment variable prefix for the quiz and response limits.
+func GetLimitsPrefix() string {
+	return limitsPrefix
+}
+
+// GetSchedulerPrefix returns the environment variable prefix for the quiz scheduler.
+func GetSchedulerPrefix() string {
+	return schedulerPrefix
+}
+
 // GetIntegrationTestKeyspaceSuffix is the suffix attached to the clusters keyspace and is used for integration tests.
 func GetIntegrationTestKeyspaceSuffix() string {
 	return integrationTestKeyspaceSuffix
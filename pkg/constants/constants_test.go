@@ -77,3 +77,19 @@ func TestGetRedisFileName(t *testing.T) {
 func TestGetRedisPrefix(t *testing.T) {
 	require.Equal(t, redisPrefix, GetRedisPrefix(), "Incorrect Redis environment prefix")
 }
+
+func TestGetLimitsFileName(t *testing.T) {
+	require.Equal(t, limitsConfigFileName, GetLimitsFileName(), "Incorrect limits filename")
+}
+
+func TestGetLimitsPrefix(t *testing.T) {
+	require.Equal(t, limitsPrefix, GetLimitsPrefix(), "Incorrect limits environment prefix")
+}
+
+func TestGetSchedulerFileName(t *testing.T) {
+	require.Equal(t, schedulerConfigFileName, GetSchedulerFileName(), "Incorrect scheduler filename")
+}
+
+func TestGetSchedulerPrefix(t *testing.T) {
+	require.Equal(t, schedulerPrefix, GetSchedulerPrefix(), "Incorrect scheduler environment prefix")
+}
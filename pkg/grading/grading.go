@@ -4,9 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/validator"
 )
 
 // Mock Grading interface stub generation.
@@ -14,13 +19,79 @@ import (
 
 // Grading is the interface through which the test grading is facilitated. Created to support mock testing.
 type Grading interface {
-	// Grade will take grade or mark a quiz response using the answer key provided by the actual quiz.
-	Grade(*model_cassandra.QuizResponse, *model_cassandra.QuizCore) (float64, error)
+	// Grade will take grade or mark a quiz response using the answer key provided by the actual quiz. The score is returned
+	// along with the maximum achievable score for the quiz.
+	Grade(*model_cassandra.QuizResponse, *model_cassandra.QuizCore) (float64, float64, error)
+
+	// GradeQuestions will grade each question in a quiz response separately. The points awarded for every question are
+	// returned along with whether each question was answered completely correctly.
+	GradeQuestions(*model_cassandra.QuizResponse, *model_cassandra.QuizCore) ([]float64, []bool, error)
 }
 
 // Check to ensure the Cassandra interface has been implemented.
 var _ Grading = &gradingImpl{}
 
+// MarkingFunc grades the responses to a single question using the indices of the correct options in the answer key and the
+// number of options in the question. The mark should be out of a single point and will be scaled by the question's weight.
+type MarkingFunc func(responses []int32, answerKey map[int32]any, numOptions int) float64
+
+// noMarking is the reserved name of the marking type for quizzes that are not graded.
+const noMarking = "none"
+
+// markingSchemes is the registry of named marking functions. Names are stored in lowercase.
+var markingSchemes = struct {
+	sync.RWMutex
+	schemes map[string]MarkingFunc
+}{schemes: map[string]MarkingFunc{
+	"negative":     negativeMarking,
+	"non-negative": nonNegativeMarking,
+	"binary":       binaryMarking,
+}}
+
+// RegisterMarkingScheme will add a named marking scheme to the registry and make the name available as a quiz marking type.
+// Names are case-insensitive and must be unique. Schemes should be registered at startup before any quizzes are graded.
+func RegisterMarkingScheme(name string, markingFunc MarkingFunc) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) == 0 || markingFunc == nil {
+		return errors.New("marking scheme requires a name and a marking function")
+	}
+
+	markingSchemes.Lock()
+	defer markingSchemes.Unlock()
+
+	if _, ok := markingSchemes.schemes[name]; ok || name == noMarking {
+		return fmt.Errorf("marking scheme %s is already registered", name)
+	}
+	markingSchemes.schemes[name] = markingFunc
+	validator.RegisterMarkingType(name)
+
+	return nil
+}
+
+// MarkingSchemes will retrieve the sorted names of all the available marking types.
+func MarkingSchemes() []string {
+	markingSchemes.RLock()
+	defer markingSchemes.RUnlock()
+
+	names := make([]string, 0, len(markingSchemes.schemes)+1)
+	names = append(names, noMarking)
+	for name := range markingSchemes.schemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// getMarkingScheme will retrieve a marking function from the registry.
+func getMarkingScheme(name string) (MarkingFunc, bool) {
+	markingSchemes.RLock()
+	defer markingSchemes.RUnlock()
+	markingFunc, ok := markingSchemes.schemes[strings.ToLower(name)]
+
+	return markingFunc, ok
+}
+
 // gradingImpl implements the Grading interface and contains the logic for marking functionality.
 type gradingImpl struct {
 }
@@ -35,42 +106,270 @@ func newGradingImpl() *gradingImpl {
 	return &gradingImpl{}
 }
 
-// Grade will mark a quiz response based on the marking type and answer key in the question.
-func (g *gradingImpl) Grade(response *model_cassandra.QuizResponse, quiz *model_cassandra.QuizCore) (float64, error) {
+// Grade will mark a quiz response based on the marking type and answer key in the question. Each question's mark is scaled
+// by its weight in points. The maximum achievable score is the sum of all the question weights. Quizzes and responses that
+// exceed the configured limits will not be graded.
+func (g *gradingImpl) Grade(response *model_cassandra.QuizResponse, quiz *model_cassandra.QuizCore) (float64, float64, error) {
 	total := 0.0
 
 	// Configure the grading function.
-	var gradingFunc func([]int32, map[int32]any, int) float64
-	switch strings.ToLower(quiz.MarkingType) {
-	case "negative":
-		gradingFunc = negativeMarking
-	case "non-negative":
-		gradingFunc = nonNegativeMarking
-	case "binary":
-		gradingFunc = binaryMarking
-	case "none":
-		return math.NaN(), nil
-	default:
-		return math.NaN(), errors.New("invalid marking type")
+	if strings.ToLower(quiz.MarkingType) == noMarking {
+		return math.NaN(), 0, nil
+	}
+	gradingFunc, ok := getMarkingScheme(quiz.MarkingType)
+	if !ok {
+		return math.NaN(), 0, errors.New("invalid marking type")
+	}
+
+	points, _, err := gradeQuestions(response, quiz, gradingFunc)
+	if err != nil {
+		return math.NaN(), 0, err
+	}
+	for _, mark := range points {
+		total += mark
+	}
+
+	return total, maxScore(quiz), nil
+}
+
+// GradeQuestions will mark each question in a quiz response using the marking type of the quiz and scale the marks by the
+// question weights. Questions in quizzes that are not graded are marked using binary marking. A question is correct if
+// all of its answers and none of its incorrect options were selected.
+func (g *gradingImpl) GradeQuestions(response *model_cassandra.QuizResponse, quiz *model_cassandra.QuizCore) ([]float64, []bool, error) {
+	gradingFunc := MarkingFunc(binaryMarking)
+	if strings.ToLower(quiz.MarkingType) != noMarking {
+		var ok bool
+		if gradingFunc, ok = getMarkingScheme(quiz.MarkingType); !ok {
+			return nil, nil, errors.New("invalid marking type")
+		}
 	}
 
-	for idx, responses := range response.Responses {
+	return gradeQuestions(response, quiz, gradingFunc)
+}
+
+// gradeQuestions will enforce the configured limits on the size of the quiz and response, and then mark every question with
+// the grading function.
+func gradeQuestions(response *model_cassandra.QuizResponse, quiz *model_cassandra.QuizCore, gradingFunc MarkingFunc) (
+	points []float64, correct []bool, err error) {
+	// Enforce the configured limits on the size of the quiz and response.
+	limits := validator.GetLimits()
+	numQuestions := len(quiz.Questions)
+	if numQuestions > limits.MaxQuestions {
+		return nil, nil, fmt.Errorf("quiz exceeds the maximum of %d questions", limits.MaxQuestions)
+	}
+	if len(response.Responses) > numQuestions || len(response.TextResponses) > numQuestions {
+		return nil, nil, errors.New("more responses provided than there are questions")
+	}
+	for idx, row := range response.Responses {
+		if len(row) > limits.MaxOptions {
+			return nil, nil, fmt.Errorf("response to question %d exceeds the maximum of %d options", idx, limits.MaxOptions)
+		}
+	}
+
+	points = make([]float64, 0, numQuestions)
+	correct = make([]bool, 0, numQuestions)
+	for idx, question := range quiz.Questions {
+		responses, answerKey, numOptions, err := markingInputs(question, response, idx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Grade question.
+		points = append(points, QuestionWeight(question)*gradingFunc(responses, answerKey, numOptions))
+		correct = append(correct, binaryMarking(responses, answerKey, numOptions) == 1)
+	}
+
+	return points, correct, nil
+}
+
+// markingInputs prepares the responses, answer key, and number of options for a question to be graded by a marking scheme.
+// Numeric and text questions are treated as a question with two options where the first option is the correct answer.
+// Ordering and matching questions are converted to a question with an option for every correctly ordered entry or pair.
+func markingInputs(question *model_cassandra.Question, response *model_cassandra.QuizResponse, idx int) (
+	[]int32, map[int32]any, int, error) {
+	switch question.Type {
+	case "", model_cassandra.QuestionMultipleChoice, model_cassandra.QuestionTrueFalse:
+		var responses []int32
+		if idx < len(response.Responses) {
+			responses = response.Responses[idx]
+		}
+
 		answerKey := make(map[int32]any)
-		for _, val := range quiz.Questions[idx].Answers {
+		for _, val := range question.Answers {
 			answerKey[val] = nil
 		}
 
 		// Only one answer permitted but multiple provided.
 		if len(answerKey) == 1 && len(responses) > 1 {
-			errMsg := fmt.Sprintf("only one answer is permitted for: %v", quiz.Questions[idx].Description)
-			return math.NaN(), errors.New(errMsg)
+			return nil, nil, 0, fmt.Errorf("only one answer is permitted for: %v", question.Description)
 		}
 
-		// Grade question.
-		total += gradingFunc(responses, answerKey, len(quiz.Questions[idx].Options))
+		numOptions := len(question.Options)
+		if question.Type == model_cassandra.QuestionTrueFalse && numOptions == 0 {
+			numOptions = 2
+		}
+
+		return responses, answerKey, numOptions, nil
+
+	case model_cassandra.QuestionNumeric, model_cassandra.QuestionShortText, model_cassandra.QuestionRegex:
+		answerKey := map[int32]any{0: nil}
+
+		var text string
+		if idx < len(response.TextResponses) {
+			text = strings.TrimSpace(response.TextResponses[idx])
+		}
+		if len(text) == 0 {
+			return []int32{}, answerKey, 2, nil
+		}
+
+		correct, err := isTextCorrect(question, text)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		if correct {
+			return []int32{0}, answerKey, 2, nil
+		}
+		return []int32{1}, answerKey, 2, nil
+
+	case model_cassandra.QuestionOrdering:
+		var responses []int32
+		if idx < len(response.Responses) {
+			responses = response.Responses[idx]
+		}
+
+		// Repeated entries would allow a response to contain more than one ordering.
+		numEntries := len(question.Answers)
+		seen := make(map[int32]any, len(responses))
+		for _, val := range responses {
+			seen[val] = nil
+		}
+		if len(seen) != len(responses) || len(responses) > numEntries {
+			return nil, nil, 0, fmt.Errorf("each option may only be ordered once for: %v", question.Description)
+		}
+
+		// Award a correct option for every entry in the longest subsequence that is in the correct order.
+		correct := longestCommonSubsequence(responses, question.Answers)
+
+		return indexRange(0, correct), indexSet(numEntries), numEntries, nil
+
+	case model_cassandra.QuestionMatching:
+		var responses []int32
+		if idx < len(response.Responses) {
+			responses = response.Responses[idx]
+		}
+
+		numPairs := len(question.Answers)
+		if len(responses) > numPairs {
+			return nil, nil, 0, fmt.Errorf("only one match is permitted for each option in: %v", question.Description)
+		}
+
+		// Award a correct option for every correct pair and an incorrect option for every incorrect pair.
+		correct, incorrect := 0, 0
+		for option, match := range responses {
+			if match == question.Answers[option] {
+				correct++
+			} else {
+				incorrect++
+			}
+		}
+
+		return append(indexRange(0, correct), indexRange(numPairs, incorrect)...), indexSet(numPairs), 2 * numPairs, nil
+
+	default:
+		return nil, nil, 0, fmt.Errorf("unsupported question type %s for: %v", question.Type, question.Description)
+	}
+}
+
+// longestCommonSubsequence calculates the length of the longest common subsequence of two sequences of option indices.
+func longestCommonSubsequence(first, second []int32) int {
+	lengths := make([][]int, len(first)+1)
+	for idx := range lengths {
+		lengths[idx] = make([]int, len(second)+1)
+	}
+
+	for row := 1; row <= len(first); row++ {
+		for col := 1; col <= len(second); col++ {
+			if first[row-1] == second[col-1] {
+				lengths[row][col] = lengths[row-1][col-1] + 1
+			} else if lengths[row-1][col] > lengths[row][col-1] {
+				lengths[row][col] = lengths[row-1][col]
+			} else {
+				lengths[row][col] = lengths[row][col-1]
+			}
+		}
 	}
 
-	return total, nil
+	return lengths[len(first)][len(second)]
+}
+
+// indexRange generates count consecutive option indices starting at start.
+func indexRange(start, count int) []int32 {
+	indices := make([]int32, 0, count)
+	for idx := 0; idx < count; idx++ {
+		indices = append(indices, int32(start+idx))
+	}
+	return indices
+}
+
+// indexSet generates an answer key containing the option indices in the range [0, count).
+func indexSet(count int) map[int32]any {
+	answerKey := make(map[int32]any, count)
+	for idx := 0; idx < count; idx++ {
+		answerKey[int32(idx)] = nil
+	}
+	return answerKey
+}
+
+// isTextCorrect checks a numeric or text response against the answer key of a question.
+func isTextCorrect(question *model_cassandra.Question, text string) (bool, error) {
+	switch question.Type {
+	case model_cassandra.QuestionNumeric:
+		if question.NumericAnswer == nil {
+			return false, fmt.Errorf("no numeric answer for: %v", question.Description)
+		}
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return false, nil
+		}
+		return math.Abs(value-*question.NumericAnswer) <= question.Tolerance, nil
+
+	case model_cassandra.QuestionShortText:
+		for _, answer := range question.TextAnswers {
+			if strings.EqualFold(text, strings.TrimSpace(answer)) {
+				return true, nil
+			}
+		}
+
+	case model_cassandra.QuestionRegex:
+		for _, pattern := range question.TextAnswers {
+			expr, err := regexp.Compile("^(?:" + pattern + ")$")
+			if err != nil {
+				return false, fmt.Errorf("invalid regular expression answer for: %v", question.Description)
+			}
+			if expr.MatchString(text) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// QuestionWeight retrieves the number of points a question is worth. Questions without a weight are worth a single point.
+func QuestionWeight(question *model_cassandra.Question) float64 {
+	if question.Points > 0 {
+		return question.Points
+	}
+	return 1
+}
+
+// maxScore calculates the maximum achievable score on a quiz.
+func maxScore(quiz *model_cassandra.QuizCore) float64 {
+	total := 0.0
+	for _, question := range quiz.Questions {
+		total += QuestionWeight(question)
+	}
+	return total
 }
 
 // negativeMarking will grade a question by employing negative marking.
@@ -3,10 +3,12 @@ package grading
 import (
 	"math"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/validator"
 )
 
 func TestNewGrading(t *testing.T) {
@@ -105,6 +107,34 @@ func TestGradingImpl_Grade(t *testing.T) {
 	moonQuestion := model_cassandra.Question{Description: "The moon is a star",
 		Options: []string{"True", "False"},
 		Answers: []int32{1}}
+	weightedQuestion := model_cassandra.Question{Description: "Water boils at 100 degrees in",
+		Options: []string{"Kelvin", "Fahrenheit", "Celsius"},
+		Answers: []int32{2},
+		Points:  2.5}
+	gravity := 9.81
+	trueFalseQuestion := model_cassandra.Question{Description: "The earth orbits the sun",
+		Type:    model_cassandra.QuestionTrueFalse,
+		Answers: []int32{0}}
+	numericQuestion := model_cassandra.Question{Description: "Acceleration due to gravity on earth in m/s^2",
+		Type:          model_cassandra.QuestionNumeric,
+		NumericAnswer: &gravity,
+		Tolerance:     0.01}
+	shortTextQuestion := model_cassandra.Question{Description: "The capital of Canada",
+		Type:        model_cassandra.QuestionShortText,
+		TextAnswers: []string{"Ottawa"}}
+	regexQuestion := model_cassandra.Question{Description: "Spell the word for the property of an object that produces hues",
+		Type:        model_cassandra.QuestionRegex,
+		TextAnswers: []string{"colou?r"}}
+	orderingQuestion := model_cassandra.Question{Description: "Order the planets from the sun",
+		Type:    model_cassandra.QuestionOrdering,
+		Options: []string{"Earth", "Mercury", "Mars", "Venus"},
+		Answers: []int32{1, 3, 0, 2}}
+	matchingQuestion := model_cassandra.Question{Description: "Match the countries to their capitals",
+		Type:    model_cassandra.QuestionMatching,
+		Options: []string{"Canada", "France", "Japan"},
+		Matches: []string{"Paris", "Ottawa", "Berlin", "Tokyo"},
+		Answers: []int32{1, 0, 3}}
+	typedQuestions := []*model_cassandra.Question{&trueFalseQuestion, &numericQuestion, &shortTextQuestion, &regexQuestion}
 	grader := gradingImpl{}
 
 	testCases := []struct {
@@ -115,6 +145,7 @@ func TestGradingImpl_Grade(t *testing.T) {
 		responses    *model_cassandra.QuizResponse
 		expectErr    require.ErrorAssertionFunc
 		expectScore  []float64
+		expectMax    float64
 	}{
 		// ----- test cases start ----- //
 		{
@@ -125,6 +156,7 @@ func TestGradingImpl_Grade(t *testing.T) {
 			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{0, 1, 3}, {1}}},
 			expectErr:    require.Error,
 			expectScore:  []float64{0},
+			expectMax:    0,
 		}, {
 			name:         "invalid response - single option question",
 			expectErrMsg: "only one answer",
@@ -133,6 +165,7 @@ func TestGradingImpl_Grade(t *testing.T) {
 			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{0, 1, 3}, {0, 1}}},
 			expectErr:    require.Error,
 			expectScore:  []float64{0, 0, 0},
+			expectMax:    0,
 		}, {
 			name:         "no marking scheme",
 			expectErrMsg: "",
@@ -141,6 +174,7 @@ func TestGradingImpl_Grade(t *testing.T) {
 			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{0, 1, 3}, {0, 1}}},
 			expectErr:    require.NoError,
 			expectScore:  []float64{math.NaN()},
+			expectMax:    0,
 		}, {
 			name:         "equal correct and incorrect",
 			expectErrMsg: "",
@@ -149,6 +183,7 @@ func TestGradingImpl_Grade(t *testing.T) {
 			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{0, 1, 2, 4}, {1}}},
 			expectErr:    require.NoError,
 			expectScore:  []float64{1, 0.66, 1},
+			expectMax:    2,
 		}, {
 			name:         "all incorrect",
 			expectErrMsg: "",
@@ -157,6 +192,7 @@ func TestGradingImpl_Grade(t *testing.T) {
 			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{2, 4}, {0}}},
 			expectErr:    require.NoError,
 			expectScore:  []float64{0, -2, 0},
+			expectMax:    2,
 		}, {
 			name:         "all correct",
 			expectErrMsg: "",
@@ -165,6 +201,180 @@ func TestGradingImpl_Grade(t *testing.T) {
 			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{0, 1, 3}, {1}}},
 			expectErr:    require.NoError,
 			expectScore:  []float64{2, 2, 2},
+			expectMax:    2,
+		}, {
+			name:         "weighted all correct",
+			expectErrMsg: "",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    []*model_cassandra.Question{&temperatureQuestion, &moonQuestion, &weightedQuestion},
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{0, 1, 3}, {1}, {2}}},
+			expectErr:    require.NoError,
+			expectScore:  []float64{4.5, 4.5, 4.5},
+			expectMax:    4.5,
+		}, {
+			name:         "weighted all incorrect",
+			expectErrMsg: "",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    []*model_cassandra.Question{&temperatureQuestion, &moonQuestion, &weightedQuestion},
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{2, 4}, {0}, {0}}},
+			expectErr:    require.NoError,
+			expectScore:  []float64{0, -3.25, 0},
+			expectMax:    4.5,
+		}, {
+			name:         "weighted partial",
+			expectErrMsg: "",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    []*model_cassandra.Question{&temperatureQuestion, &moonQuestion, &weightedQuestion},
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{0, 1}, {1}, {2}}},
+			expectErr:    require.NoError,
+			expectScore:  []float64{3.5, 4.16, 4.16},
+			expectMax:    4.5,
+		}, {
+			name:         "too many responses",
+			expectErrMsg: "more responses",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    []*model_cassandra.Question{&moonQuestion},
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{1}, {1}}},
+			expectErr:    require.Error,
+			expectScore:  []float64{0, 0, 0},
+			expectMax:    0,
+		}, {
+			name:         "too many text responses",
+			expectErrMsg: "more responses",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    []*model_cassandra.Question{&numericQuestion},
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{}, TextResponses: []string{"9.81", "9.81"}},
+			expectErr:    require.Error,
+			expectScore:  []float64{0, 0, 0},
+			expectMax:    0,
+		}, {
+			name:         "typed all correct",
+			expectErrMsg: "",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    typedQuestions,
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{0}}, TextResponses: []string{"", "9.815", " ottawa ", "colour"}},
+			expectErr:    require.NoError,
+			expectScore:  []float64{4, 4, 4},
+			expectMax:    4,
+		}, {
+			name:         "typed all incorrect",
+			expectErrMsg: "",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    typedQuestions,
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{1}}, TextResponses: []string{"", "10", "Toronto", "colr"}},
+			expectErr:    require.NoError,
+			expectScore:  []float64{0, -4, 0},
+			expectMax:    4,
+		}, {
+			name:         "typed unanswered",
+			expectErrMsg: "",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    typedQuestions,
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{}},
+			expectErr:    require.NoError,
+			expectScore:  []float64{0, 0, 0},
+			expectMax:    4,
+		}, {
+			name:         "typed invalid number",
+			expectErrMsg: "",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    typedQuestions,
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{0}}, TextResponses: []string{"", "nine", "Ottawa", "color"}},
+			expectErr:    require.NoError,
+			expectScore:  []float64{3, 2, 3},
+			expectMax:    4,
+		}, {
+			name:         "typed single answer true/false question",
+			expectErrMsg: "only one answer",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    typedQuestions,
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{0, 1}}},
+			expectErr:    require.Error,
+			expectScore:  []float64{0, 0, 0},
+			expectMax:    0,
+		},
+		{
+			name:         "response exceeds maximum options",
+			expectErrMsg: "maximum of 5 options",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    []*model_cassandra.Question{&temperatureQuestion},
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{0, 1, 2, 3, 4, 0}}},
+			expectErr:    require.Error,
+			expectScore:  []float64{0, 0, 0},
+			expectMax:    0,
+		}, {
+			name:         "quiz exceeds maximum questions",
+			expectErrMsg: "maximum of 10 questions",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions: []*model_cassandra.Question{&moonQuestion, &moonQuestion, &moonQuestion, &moonQuestion, &moonQuestion,
+				&moonQuestion, &moonQuestion, &moonQuestion, &moonQuestion, &moonQuestion, &moonQuestion},
+			responses:   &model_cassandra.QuizResponse{Responses: [][]int32{{1}}},
+			expectErr:   require.Error,
+			expectScore: []float64{0, 0, 0},
+			expectMax:   0,
+		}, {
+			name:         "ordering and matching all correct",
+			expectErrMsg: "",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    []*model_cassandra.Question{&orderingQuestion, &matchingQuestion},
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{1, 3, 0, 2}, {1, 0, 3}}},
+			expectErr:    require.NoError,
+			expectScore:  []float64{2, 2, 2},
+			expectMax:    2,
+		}, {
+			name:         "ordering and matching mostly incorrect",
+			expectErrMsg: "",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    []*model_cassandra.Question{&orderingQuestion, &matchingQuestion},
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{2, 0, 3, 1}, {0, 1, 2}}},
+			expectErr:    require.NoError,
+			expectScore:  []float64{0, -0.75, 0.25},
+			expectMax:    2,
+		}, {
+			name:         "ordering and matching partial",
+			expectErrMsg: "",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    []*model_cassandra.Question{&orderingQuestion, &matchingQuestion},
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{3, 1, 0, 2}, {1, 2}}},
+			expectErr:    require.NoError,
+			expectScore:  []float64{0, 0.75, 0.75},
+			expectMax:    2,
+		}, {
+			name:         "ordering and matching partial no incorrect pairs",
+			expectErrMsg: "",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    []*model_cassandra.Question{&orderingQuestion, &matchingQuestion},
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{1, 3, 0, 2}, {1, 0}}},
+			expectErr:    require.NoError,
+			expectScore:  []float64{1, 1.66, 1.66},
+			expectMax:    2,
+		}, {
+			name:         "ordering and matching unanswered",
+			expectErrMsg: "",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    []*model_cassandra.Question{&orderingQuestion, &matchingQuestion},
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{}},
+			expectErr:    require.NoError,
+			expectScore:  []float64{0, 0, 0},
+			expectMax:    2,
+		}, {
+			name:         "ordering repeated option",
+			expectErrMsg: "ordered once",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    []*model_cassandra.Question{&orderingQuestion, &matchingQuestion},
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{1, 3, 1, 3}, {1, 0, 3}}},
+			expectErr:    require.Error,
+			expectScore:  []float64{0, 0, 0},
+			expectMax:    0,
+		}, {
+			name:         "matching too many pairs",
+			expectErrMsg: "only one match",
+			markingType:  []string{"binary", "negative", "non-negative"},
+			questions:    []*model_cassandra.Question{&orderingQuestion, &matchingQuestion},
+			responses:    &model_cassandra.QuizResponse{Responses: [][]int32{{1, 3, 0, 2}, {1, 0, 3, 2}}},
+			expectErr:    require.Error,
+			expectScore:  []float64{0, 0, 0},
+			expectMax:    0,
 		},
 		// ----- test cases end ----- //
 	}
@@ -178,7 +388,7 @@ func TestGradingImpl_Grade(t *testing.T) {
 
 			for idx, markingScheme := range testCase.markingType {
 				testQuiz.MarkingType = markingScheme
-				score, err := grader.Grade(testCase.responses, testQuiz)
+				score, max, err := grader.Grade(testCase.responses, testQuiz)
 				testCase.expectErr(t, err, "error expectation failed")
 
 				if err != nil {
@@ -187,7 +397,230 @@ func TestGradingImpl_Grade(t *testing.T) {
 				}
 
 				require.InDeltaf(t, testCase.expectScore[idx], score, 0.1, "score delta offset to large for marking scheme %s", markingScheme)
+				require.InDeltaf(t, testCase.expectMax, max, 0.01, "maximum score mismatch for marking scheme %s", markingScheme)
 			}
 		})
 	}
 }
+
+func TestGradingImpl_GradeQuestions(t *testing.T) {
+	temperatureQuestion := model_cassandra.Question{Description: "Temperature can be measured in",
+		Options: []string{"Kelvin", "Fahrenheit", "Gram", "Celsius", "Liters"},
+		Answers: []int32{0, 1, 3}}
+	weightedQuestion := model_cassandra.Question{Description: "Water boils at 100 degrees in",
+		Options: []string{"Kelvin", "Fahrenheit", "Celsius"},
+		Answers: []int32{2},
+		Points:  2.5}
+	shortTextQuestion := model_cassandra.Question{Description: "The capital of Canada",
+		Type:        model_cassandra.QuestionShortText,
+		TextAnswers: []string{"Ottawa"}}
+	questions := []*model_cassandra.Question{&temperatureQuestion, &weightedQuestion, &shortTextQuestion}
+	grader := gradingImpl{}
+
+	testCases := []struct {
+		name          string
+		markingType   string
+		responses     *model_cassandra.QuizResponse
+		expectErr     require.ErrorAssertionFunc
+		expectPoints  []float64
+		expectCorrect []bool
+	}{
+		// ----- test cases start ----- //
+		{
+			name:        "invalid marking type",
+			markingType: "invalid",
+			responses:   &model_cassandra.QuizResponse{Responses: [][]int32{{0, 1, 3}, {2}}},
+			expectErr:   require.Error,
+		}, {
+			name:        "too many responses",
+			markingType: "binary",
+			responses:   &model_cassandra.QuizResponse{Responses: [][]int32{{0}, {2}, {}, {1}}},
+			expectErr:   require.Error,
+		}, {
+			name:          "non-negative partial credit",
+			markingType:   "non-negative",
+			responses:     &model_cassandra.QuizResponse{Responses: [][]int32{{0, 1}, {2}}, TextResponses: []string{"", "", "ottawa"}},
+			expectErr:     require.NoError,
+			expectPoints:  []float64{2.0 / 3, 2.5, 1},
+			expectCorrect: []bool{false, true, true},
+		}, {
+			name:          "negative marking",
+			markingType:   "negative",
+			responses:     &model_cassandra.QuizResponse{Responses: [][]int32{{0, 1, 3}, {0}}, TextResponses: []string{"", "", "Toronto"}},
+			expectErr:     require.NoError,
+			expectPoints:  []float64{1, -1.25, -1},
+			expectCorrect: []bool{true, false, false},
+		}, {
+			name:          "no marking scheme uses binary marking",
+			markingType:   "none",
+			responses:     &model_cassandra.QuizResponse{Responses: [][]int32{{0, 1}, {2}}},
+			expectErr:     require.NoError,
+			expectPoints:  []float64{0, 2.5, 0},
+			expectCorrect: []bool{false, true, false},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			quiz := &model_cassandra.QuizCore{MarkingType: testCase.markingType, Questions: questions}
+
+			points, correct, err := grader.GradeQuestions(testCase.responses, quiz)
+			testCase.expectErr(t, err, "error expectation failed")
+			if err != nil {
+				return
+			}
+
+			require.InDeltaSlice(t, testCase.expectPoints, points, 1e-9, "points mismatch")
+			require.Equal(t, testCase.expectCorrect, correct, "correct questions mismatch")
+
+			// The points must sum to the score for graded quizzes.
+			if testCase.markingType != "none" {
+				score, _, err := grader.Grade(testCase.responses, quiz)
+				require.NoError(t, err, "grading failed")
+
+				total := 0.0
+				for _, mark := range points {
+					total += mark
+				}
+				require.InDelta(t, score, total, 1e-9, "points do not sum to the score")
+			}
+		})
+	}
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	testCases := []struct {
+		name     string
+		first    []int32
+		second   []int32
+		expected int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:     "empty",
+			first:    []int32{},
+			second:   []int32{0, 1, 2},
+			expected: 0,
+		}, {
+			name:     "identical",
+			first:    []int32{0, 1, 2, 3},
+			second:   []int32{0, 1, 2, 3},
+			expected: 4,
+		}, {
+			name:     "reversed",
+			first:    []int32{3, 2, 1, 0},
+			second:   []int32{0, 1, 2, 3},
+			expected: 1,
+		}, {
+			name:     "one displaced",
+			first:    []int32{3, 0, 1, 2},
+			second:   []int32{0, 1, 2, 3},
+			expected: 3,
+		}, {
+			name:     "partial sequence",
+			first:    []int32{0, 2},
+			second:   []int32{0, 1, 2, 3},
+			expected: 2,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, longestCommonSubsequence(testCase.first, testCase.second))
+		})
+	}
+}
+
+func TestQuestionWeight(t *testing.T) {
+	require.Equal(t, 1.0, QuestionWeight(&model_cassandra.Question{}), "unweighted questions are worth a single point")
+	require.Equal(t, 2.5, QuestionWeight(&model_cassandra.Question{Points: 2.5}), "weighted questions are worth their points")
+}
+
+func TestRegisterMarkingScheme(t *testing.T) {
+	cancellingMarking := func(responses []int32, answerKey map[int32]any, _ int) float64 {
+		mark := 0.0
+		for _, val := range responses {
+			if _, ok := answerKey[val]; ok {
+				mark++
+			} else {
+				mark--
+			}
+		}
+		return math.Max(mark, 0) / float64(len(answerKey))
+	}
+
+	testCases := []struct {
+		name        string
+		schemeName  string
+		markingFunc MarkingFunc
+		expectErr   require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:        "valid scheme",
+			schemeName:  "Cancelling",
+			markingFunc: cancellingMarking,
+			expectErr:   require.NoError,
+		}, {
+			name:        "duplicate scheme",
+			schemeName:  "cancelling",
+			markingFunc: cancellingMarking,
+			expectErr:   require.Error,
+		}, {
+			name:        "built-in scheme",
+			schemeName:  "Binary",
+			markingFunc: cancellingMarking,
+			expectErr:   require.Error,
+		}, {
+			name:        "reserved none scheme",
+			schemeName:  "None",
+			markingFunc: cancellingMarking,
+			expectErr:   require.Error,
+		}, {
+			name:        "empty name",
+			schemeName:  "  ",
+			markingFunc: cancellingMarking,
+			expectErr:   require.Error,
+		}, {
+			name:        "nil marking function",
+			schemeName:  "no-function",
+			markingFunc: nil,
+			expectErr:   require.Error,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.expectErr(t, RegisterMarkingScheme(testCase.schemeName, testCase.markingFunc))
+		})
+	}
+
+	// Registered scheme should be listed, accepted by the validator, and used for grading.
+	require.Contains(t, MarkingSchemes(), "cancelling", "registered marking scheme not listed")
+	require.NotContains(t, MarkingSchemes(), "no-function", "invalid marking scheme listed")
+
+	quiz := &model_cassandra.QuizCore{
+		Title:       "Custom marking scheme",
+		MarkingType: "CANCELLING",
+		Questions: []*model_cassandra.Question{{
+			Description: "Temperature can be measured in",
+			Options:     []string{"Kelvin", "Fahrenheit", "Gram", "Celsius", "Liters"},
+			Answers:     []int32{0, 1, 3},
+			Points:      3,
+		}},
+	}
+	require.NoError(t, validator.ValidateStruct(quiz), "registered marking scheme failed validation")
+
+	score, max, err := newGradingImpl().Grade(&model_cassandra.QuizResponse{Responses: [][]int32{{0, 1, 2}}}, quiz)
+	require.NoError(t, err, "failed to grade with registered marking scheme")
+	require.InDelta(t, 1, score, 0.01, "incorrect score from registered marking scheme")
+	require.InDelta(t, 3, max, 0.01, "incorrect maximum score from registered marking scheme")
+}
+
+func TestMarkingSchemes(t *testing.T) {
+	schemes := MarkingSchemes()
+	require.True(t, sort.StringsAreSorted(schemes), "marking schemes are not sorted")
+	for _, name := range []string{"none", "negative", "non-negative", "binary"} {
+		require.Contains(t, schemes, name, "built-in marking scheme missing")
+	}
+}
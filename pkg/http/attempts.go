@@ -0,0 +1,286 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+var (
+	// ErrAttemptsExhausted is returned when a user has used all the attempts allowed for a quiz.
+	ErrAttemptsExhausted = errors.New("no attempts remaining")
+
+	// ErrAttemptCooldown is returned when a user attempts a quiz before the cooldown since their last attempt has elapsed.
+	ErrAttemptCooldown = errors.New("attempt cooldown has not elapsed")
+
+	// ErrQuizNotTimed is returned when a user starts an attempt at a quiz that does not have a time limit.
+	ErrQuizNotTimed = errors.New("quiz does not have a time limit")
+
+	// ErrAttemptNotStarted is returned when a user submits an attempt at a timed quiz without starting it.
+	ErrAttemptNotStarted = errors.New("attempt has not been started")
+)
+
+// GetResponse will retrieve a user's response to a quiz. A user that has not taken the quiz yet will not have a response
+// and a nil response is returned.
+func GetResponse(username string, quizId gocql.UUID, db cassandra.Cassandra) (*model_cassandra.Response, error) {
+	request := &model_cassandra.QuizMutateRequest{Username: username, QuizID: quizId}
+	record, err := db.Execute(cassandra.ReadResponseQuery, request)
+	if err != nil {
+		if cassandraErr, ok := err.(*cassandra.Error); ok && cassandraErr.Status == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return record.(*model_cassandra.Response), nil
+}
+
+// AttemptHistory will retrieve all the attempts recorded in a response, oldest first. Responses recorded before multiple
+// attempts were supported are treated as a single attempt without a submission time.
+func AttemptHistory(response *model_cassandra.Response) []*model_cassandra.Attempt {
+	if response == nil {
+		return nil
+	}
+	if len(response.Attempts) > 0 {
+		return response.Attempts
+	}
+
+	attempt := &model_cassandra.Attempt{
+		Number:   1,
+		Score:    response.Score,
+		MaxScore: response.MaxScore,
+		Version:  response.Version,
+	}
+	if response.QuizResponse != nil {
+		attempt.Responses = response.Responses
+		attempt.TextResponses = response.TextResponses
+	}
+
+	return []*model_cassandra.Attempt{attempt}
+}
+
+// CheckAttempt will verify that a user may make another attempt at a quiz given their previous response, if any. Errors
+// wrap either ErrAttemptsExhausted or ErrAttemptCooldown.
+func CheckAttempt(quiz *model_cassandra.Quiz, previous *model_cassandra.Response, now time.Time) error {
+	attempts := AttemptHistory(previous)
+	if allowed := maxAttempts(quiz.QuizCore); len(attempts) >= allowed {
+		return fmt.Errorf("%w, all %d attempt(s) have been used", ErrAttemptsExhausted, allowed)
+	}
+
+	if len(attempts) > 0 && quiz.AttemptCooldown > 0 {
+		available := attempts[len(attempts)-1].SubmittedAt.Add(time.Duration(quiz.AttemptCooldown) * time.Second)
+		if now.Before(available) {
+			return fmt.Errorf("%w, the next attempt is available in %s", ErrAttemptCooldown, available.Sub(now).Round(time.Second))
+		}
+	}
+
+	return nil
+}
+
+// PresentQuiz will retrieve a quiz as it is presented to a user for their next attempt. Quizzes drawn from question banks
+// present the questions drawn for the attempt and shuffled quizzes are presented in the order seeded for the attempt. Both
+// are fixed the first time the attempt is viewed. Other quizzes are unchanged.
+func PresentQuiz(quiz *model_cassandra.Quiz, username string, db cassandra.Cassandra) (*model_cassandra.QuizCore, error) {
+	if !isDrawn(quiz.QuizCore) && !isShuffled(quiz.QuizCore) {
+		return quiz.QuizCore, nil
+	}
+
+	previous, err := GetResponse(username, quiz.QuizID, db)
+	if err != nil {
+		return nil, err
+	}
+	attempt := len(AttemptHistory(previous)) + 1
+
+	core := quiz.QuizCore
+	if isDrawn(core) {
+		if core, err = drawAttempt(quiz, username, attempt, db); err != nil {
+			return nil, err
+		}
+	}
+	if isShuffled(core) {
+		if core, err = shuffleAttempt(core, quiz.QuizID, username, attempt, db); err != nil {
+			return nil, err
+		}
+	}
+
+	return core, nil
+}
+
+// StartAttempt will start the user's next attempt at a timed quiz and record the deadline by which it must be submitted.
+// Starting an attempt that has already been started will return the existing session without extending its deadline.
+func StartAttempt(quiz *model_cassandra.Quiz, username string, previous *model_cassandra.Response, now time.Time,
+	db cassandra.Cassandra) (*model_cassandra.AttemptSession, error) {
+	if !isTimed(quiz.QuizCore) {
+		return nil, ErrQuizNotTimed
+	}
+
+	if err := CheckAttempt(quiz, previous, now); err != nil {
+		return nil, err
+	}
+
+	startedAt := now.UTC().Truncate(time.Millisecond)
+	session := &model_cassandra.AttemptSession{
+		Username:  username,
+		QuizID:    quiz.QuizID,
+		Attempt:   len(AttemptHistory(previous)) + 1,
+		StartedAt: startedAt,
+		Deadline:  startedAt.Add(time.Duration(quiz.TimeLimit) * time.Second),
+	}
+
+	record, err := db.Execute(cassandra.CreateAttemptSessionQuery, session)
+	if err != nil {
+		return nil, err
+	}
+
+	return record.(*model_cassandra.AttemptSession), nil
+}
+
+// CheckDeadline will verify that the user's next attempt at a timed quiz has been started and report whether it is being
+// submitted after its deadline and grace period. Untimed quizzes are never late. Attempts that were not started return an
+// error wrapping ErrAttemptNotStarted.
+func CheckDeadline(quiz *model_cassandra.Quiz, username string, previous *model_cassandra.Response, now time.Time,
+	db cassandra.Cassandra) (late bool, err error) {
+	if !isTimed(quiz.QuizCore) {
+		return false, nil
+	}
+
+	request := &model_cassandra.AttemptSessionRequest{
+		Username: username,
+		QuizID:   quiz.QuizID,
+		Attempt:  len(AttemptHistory(previous)) + 1,
+	}
+	record, err := db.Execute(cassandra.ReadAttemptSessionQuery, request)
+	if err != nil {
+		if cassandraErr, ok := err.(*cassandra.Error); ok && cassandraErr.Status == http.StatusNotFound {
+			return false, fmt.Errorf("%w, start attempt %d before submitting it", ErrAttemptNotStarted, request.Attempt)
+		}
+		return false, err
+	}
+
+	deadline := record.(*model_cassandra.AttemptSession).Deadline.Add(time.Duration(quiz.GracePeriod) * time.Second)
+	return now.After(deadline), nil
+}
+
+// RemainingTime is the number of whole seconds left before the deadline of an attempt session, excluding the grace period.
+// Sessions past their deadline have no time remaining.
+func RemainingTime(session *model_cassandra.AttemptSession, now time.Time) int64 {
+	if remaining := session.Deadline.Sub(now); remaining > 0 {
+		return int64(remaining / time.Second)
+	}
+	return 0
+}
+
+// RecordAttempt will append a graded attempt to a user's previous response, if any, and set the effective score of the
+// response using the attempt policy of the quiz. Late attempts are recorded with a score of zero.
+func RecordAttempt(quiz *model_cassandra.Quiz, username string, previous *model_cassandra.Response,
+	quizResponse *model_cassandra.QuizResponse, score, maxScore float64, late bool, now time.Time) *model_cassandra.Response {
+	if late {
+		score = 0
+	}
+
+	history := AttemptHistory(previous)
+	attempts := make([]*model_cassandra.Attempt, len(history), len(history)+1)
+	copy(attempts, history)
+	attempts = append(attempts, &model_cassandra.Attempt{
+		Number:        len(history) + 1,
+		Score:         score,
+		MaxScore:      maxScore,
+		Version:       quiz.Version,
+		Responses:     quizResponse.Responses,
+		TextResponses: quizResponse.TextResponses,
+		SubmittedAt:   now.UTC().Truncate(time.Millisecond),
+		Late:          late,
+	})
+
+	response := &model_cassandra.Response{
+		Username: username,
+		Author:   quiz.Author,
+		QuizID:   quiz.QuizID,
+		Attempts: attempts,
+	}
+	applyAttemptPolicy(response, quiz.QuizCore)
+
+	return response
+}
+
+// StoreAttempt will write a response containing a new attempt to the database. The first attempt creates the response
+// record and later attempts replace the attempts in the previous response. Attempts recorded concurrently will conflict.
+func StoreAttempt(response, previous *model_cassandra.Response, db cassandra.Cassandra) (err error) {
+	if previous == nil {
+		_, err = db.Execute(cassandra.CreateResponseQuery, response)
+		return
+	}
+
+	_, err = db.Execute(cassandra.UpdateResponseQuery,
+		&model_cassandra.ResponseUpdateRequest{Response: response, PreviousAttempts: previous.Attempts})
+	return
+}
+
+// applyAttemptPolicy will set the effective score, maximum score, version, and answers of a response from its attempts. The
+// answers and version are those of the effective attempt, or of the latest attempt when the scores are averaged.
+func applyAttemptPolicy(response *model_cassandra.Response, quiz *model_cassandra.QuizCore) {
+	effective := effectiveAttempt(response.Attempts, quiz)
+	if effective == nil {
+		return
+	}
+
+	response.Score = effective.Score
+	response.MaxScore = effective.MaxScore
+	response.Version = effective.Version
+	response.QuizResponse = &model_cassandra.QuizResponse{Responses: effective.Responses, TextResponses: effective.TextResponses}
+
+	if attemptPolicy(quiz) == model_cassandra.AttemptPolicyAverage {
+		var score, maxScore float64
+		for _, attempt := range response.Attempts {
+			score += attempt.Score
+			maxScore += attempt.MaxScore
+		}
+		response.Score = score / float64(len(response.Attempts))
+		response.MaxScore = maxScore / float64(len(response.Attempts))
+	}
+}
+
+// effectiveAttempt is the attempt whose answers and version count towards a user's score. This is the highest scoring
+// attempt for the best attempt policy and the latest attempt otherwise.
+func effectiveAttempt(attempts []*model_cassandra.Attempt, quiz *model_cassandra.QuizCore) *model_cassandra.Attempt {
+	if len(attempts) == 0 {
+		return nil
+	}
+
+	effective := attempts[len(attempts)-1]
+	if attemptPolicy(quiz) == model_cassandra.AttemptPolicyBest {
+		for _, attempt := range attempts {
+			if attempt.Score > effective.Score {
+				effective = attempt
+			}
+		}
+	}
+
+	return effective
+}
+
+// maxAttempts is the number of times a user may take a quiz. Quizzes without a limit allow a single attempt.
+func maxAttempts(quiz *model_cassandra.QuizCore) int {
+	if quiz == nil || quiz.MaxAttempts < 1 {
+		return 1
+	}
+	return quiz.MaxAttempts
+}
+
+// attemptPolicy is the attempt policy of a quiz. Quizzes without a policy count the best attempt.
+func attemptPolicy(quiz *model_cassandra.QuizCore) string {
+	if quiz == nil || quiz.AttemptPolicy == "" {
+		return model_cassandra.AttemptPolicyBest
+	}
+	return quiz.AttemptPolicy
+}
+
+// isTimed reports whether a quiz has a time limit. Attempts at timed quizzes must be started before they are submitted.
+func isTimed(quiz *model_cassandra.QuizCore) bool {
+	return quiz != nil && quiz.TimeLimit > 0
+}
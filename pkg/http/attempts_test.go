@@ -0,0 +1,430 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+func TestGetResponse(t *testing.T) {
+	testCases := []struct {
+		name          string
+		cassandraData *MockCassandraData
+		expectNil     require.ValueAssertionFunc
+		expectErr     require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:          "first attempt",
+			cassandraData: &MockCassandraData{OutputErr: &cassandra.Error{Message: "not found", Status: http.StatusNotFound}},
+			expectNil:     require.Nil,
+			expectErr:     require.NoError,
+		}, {
+			name:          "db failure",
+			cassandraData: &MockCassandraData{OutputErr: &cassandra.Error{Message: "failure", Status: http.StatusInternalServerError}},
+			expectNil:     require.Nil,
+			expectErr:     require.Error,
+		}, {
+			name:          "previous response",
+			cassandraData: &MockCassandraData{OutputParam: &model_cassandra.Response{Username: "username"}},
+			expectNil:     require.NotNil,
+			expectErr:     require.NoError,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+				testCase.cassandraData.OutputParam,
+				testCase.cassandraData.OutputErr,
+			).Times(1)
+
+			actual, err := GetResponse("username", gocql.TimeUUID(), mockCassandra)
+			testCase.expectErr(t, err, "error expectation failed")
+			testCase.expectNil(t, actual, "response expectation failed")
+		})
+	}
+}
+
+func TestAttemptHistory(t *testing.T) {
+	require.Nil(t, AttemptHistory(nil), "no response should have no attempts")
+
+	legacy := &model_cassandra.Response{Score: 2, MaxScore: 4, Version: 1,
+		QuizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{1}}, TextResponses: []string{"text"}}}
+	expected := []*model_cassandra.Attempt{
+		{Number: 1, Score: 2, MaxScore: 4, Version: 1, Responses: [][]int32{{1}}, TextResponses: []string{"text"}},
+	}
+	require.Equal(t, expected, AttemptHistory(legacy), "legacy response should be a single attempt")
+
+	attempts := []*model_cassandra.Attempt{{Number: 1}, {Number: 2}}
+	require.Equal(t, attempts, AttemptHistory(&model_cassandra.Response{Attempts: attempts}), "attempts should be returned")
+}
+
+func TestCheckAttempt(t *testing.T) {
+	now := time.Now()
+	previous := &model_cassandra.Response{Attempts: []*model_cassandra.Attempt{{Number: 1, SubmittedAt: now.Add(-time.Minute)}}}
+
+	testCases := []struct {
+		name        string
+		quiz        *model_cassandra.QuizCore
+		previous    *model_cassandra.Response
+		expectedErr error
+	}{
+		// ----- test cases start ----- //
+		{
+			name:     "first attempt, single attempt",
+			quiz:     &model_cassandra.QuizCore{},
+			previous: nil,
+		}, {
+			name:        "second attempt, single attempt",
+			quiz:        &model_cassandra.QuizCore{},
+			previous:    previous,
+			expectedErr: ErrAttemptsExhausted,
+		}, {
+			name:        "second attempt, legacy response",
+			quiz:        &model_cassandra.QuizCore{},
+			previous:    &model_cassandra.Response{QuizResponse: &model_cassandra.QuizResponse{}},
+			expectedErr: ErrAttemptsExhausted,
+		}, {
+			name:     "second attempt, multiple attempts",
+			quiz:     &model_cassandra.QuizCore{MaxAttempts: 2},
+			previous: previous,
+		}, {
+			name:        "second attempt, cooldown not elapsed",
+			quiz:        &model_cassandra.QuizCore{MaxAttempts: 2, AttemptCooldown: 120},
+			previous:    previous,
+			expectedErr: ErrAttemptCooldown,
+		}, {
+			name:     "second attempt, cooldown elapsed",
+			quiz:     &model_cassandra.QuizCore{MaxAttempts: 2, AttemptCooldown: 60},
+			previous: previous,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := CheckAttempt(&model_cassandra.Quiz{QuizCore: testCase.quiz}, testCase.previous, now)
+			if testCase.expectedErr == nil {
+				require.NoError(t, err, "attempt should be allowed")
+				return
+			}
+			require.True(t, errors.Is(err, testCase.expectedErr), "expected %v but got %v", testCase.expectedErr, err)
+		})
+	}
+}
+
+func TestRecordAttempt(t *testing.T) {
+	now := time.Now()
+	previous := &model_cassandra.Response{
+		Attempts: []*model_cassandra.Attempt{
+			{Number: 1, Score: 1, MaxScore: 4, Version: 1, Responses: [][]int32{{1}}},
+			{Number: 2, Score: 4, MaxScore: 4, Version: 1, Responses: [][]int32{{2}}},
+		},
+	}
+	answers := &model_cassandra.QuizResponse{Responses: [][]int32{{3}}}
+
+	testCases := []struct {
+		name              string
+		policy            string
+		previous          *model_cassandra.Response
+		late              bool
+		expectedScore     float64
+		expectedResponses [][]int32
+		expectedAttempts  int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:              "first attempt",
+			policy:            model_cassandra.AttemptPolicyLatest,
+			previous:          nil,
+			expectedScore:     2,
+			expectedResponses: [][]int32{{3}},
+			expectedAttempts:  1,
+		}, {
+			name:              "default policy is best",
+			policy:            "",
+			previous:          previous,
+			expectedScore:     4,
+			expectedResponses: [][]int32{{2}},
+			expectedAttempts:  3,
+		}, {
+			name:              "best",
+			policy:            model_cassandra.AttemptPolicyBest,
+			previous:          previous,
+			expectedScore:     4,
+			expectedResponses: [][]int32{{2}},
+			expectedAttempts:  3,
+		}, {
+			name:              "latest",
+			policy:            model_cassandra.AttemptPolicyLatest,
+			previous:          previous,
+			expectedScore:     2,
+			expectedResponses: [][]int32{{3}},
+			expectedAttempts:  3,
+		}, {
+			name:              "average",
+			policy:            model_cassandra.AttemptPolicyAverage,
+			previous:          previous,
+			expectedScore:     7.0 / 3,
+			expectedResponses: [][]int32{{3}},
+			expectedAttempts:  3,
+		}, {
+			name:              "late",
+			policy:            model_cassandra.AttemptPolicyLatest,
+			previous:          previous,
+			late:              true,
+			expectedScore:     0,
+			expectedResponses: [][]int32{{3}},
+			expectedAttempts:  3,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			quiz := &model_cassandra.Quiz{QuizID: gocql.TimeUUID(), Author: "author", Version: 2,
+				QuizCore: &model_cassandra.QuizCore{MaxAttempts: 3, AttemptPolicy: testCase.policy}}
+
+			actual := RecordAttempt(quiz, "username", testCase.previous, answers, 2, 4, testCase.late, now)
+
+			require.Equal(t, "username", actual.Username, "username mismatch")
+			require.Equal(t, quiz.Author, actual.Author, "author mismatch")
+			require.Equal(t, quiz.QuizID, actual.QuizID, "quiz id mismatch")
+			require.InDelta(t, testCase.expectedScore, actual.Score, 1e-9, "effective score mismatch")
+			require.Equal(t, float64(4), actual.MaxScore, "effective maximum score mismatch")
+			require.Equal(t, testCase.expectedResponses, actual.Responses, "effective answers mismatch")
+			require.Equal(t, testCase.expectedAttempts, len(actual.Attempts), "attempt count mismatch")
+
+			latest := actual.Attempts[len(actual.Attempts)-1]
+			require.Equal(t, testCase.expectedAttempts, latest.Number, "attempt number mismatch")
+			require.Equal(t, quiz.Version, latest.Version, "attempt version mismatch")
+			require.Equal(t, now.UTC().Truncate(time.Millisecond), latest.SubmittedAt, "submission time mismatch")
+			require.Equal(t, testCase.late, latest.Late, "late submission mismatch")
+		})
+	}
+
+	// The previous attempts must not be modified.
+	require.Equal(t, 2, len(previous.Attempts), "previous attempts were modified")
+}
+
+func TestStoreAttempt(t *testing.T) {
+	response := &model_cassandra.Response{Username: "username"}
+	previous := &model_cassandra.Response{Username: "username", Attempts: []*model_cassandra.Attempt{{Number: 1}}}
+
+	testCases := []struct {
+		name     string
+		previous *model_cassandra.Response
+		expected any
+	}{
+		// ----- test cases start ----- //
+		{
+			name:     "first attempt",
+			previous: nil,
+			expected: response,
+		}, {
+			name:     "later attempt",
+			previous: previous,
+			expected: &model_cassandra.ResponseUpdateRequest{Response: response, PreviousAttempts: previous.Attempts},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), testCase.expected).Return(nil, nil).Times(1)
+
+			require.NoError(t, StoreAttempt(response, testCase.previous, mockCassandra), "failed to store attempt")
+		})
+	}
+}
+
+func TestStartAttempt(t *testing.T) {
+	now := time.Now()
+	previous := &model_cassandra.Response{Attempts: []*model_cassandra.Attempt{{Number: 1, SubmittedAt: now.Add(-time.Hour)}}}
+	existing := &model_cassandra.AttemptSession{Username: "username", Attempt: 2, Deadline: now.Add(time.Minute)}
+
+	testCases := []struct {
+		name            string
+		quiz            *model_cassandra.QuizCore
+		cassandraData   *MockCassandraData
+		expectedAttempt int
+		expectedErr     error
+		expectErr       require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:          "untimed",
+			quiz:          &model_cassandra.QuizCore{MaxAttempts: 3},
+			cassandraData: &MockCassandraData{Times: 0},
+			expectedErr:   ErrQuizNotTimed,
+			expectErr:     require.Error,
+		}, {
+			name:          "attempts exhausted",
+			quiz:          &model_cassandra.QuizCore{TimeLimit: 600},
+			cassandraData: &MockCassandraData{Times: 0},
+			expectedErr:   ErrAttemptsExhausted,
+			expectErr:     require.Error,
+		}, {
+			name:          "cooldown not elapsed",
+			quiz:          &model_cassandra.QuizCore{MaxAttempts: 3, AttemptCooldown: 7200, TimeLimit: 600},
+			cassandraData: &MockCassandraData{Times: 0},
+			expectedErr:   ErrAttemptCooldown,
+			expectErr:     require.Error,
+		}, {
+			name: "db failure",
+			quiz: &model_cassandra.QuizCore{MaxAttempts: 3, TimeLimit: 600},
+			cassandraData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			expectErr: require.Error,
+		}, {
+			name:            "started",
+			quiz:            &model_cassandra.QuizCore{MaxAttempts: 3, TimeLimit: 600},
+			cassandraData:   &MockCassandraData{Times: 1},
+			expectedAttempt: 2,
+			expectErr:       require.NoError,
+		}, {
+			name:            "already started",
+			quiz:            &model_cassandra.QuizCore{MaxAttempts: 3, TimeLimit: 600},
+			cassandraData:   &MockCassandraData{OutputParam: existing, Times: 1},
+			expectedAttempt: 2,
+			expectErr:       require.NoError,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			quiz := &model_cassandra.Quiz{QuizID: gocql.TimeUUID(), QuizCore: testCase.quiz}
+
+			// A new session is returned as it was stored and an existing session is returned unchanged.
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+					if testCase.cassandraData.OutputParam != nil || testCase.cassandraData.OutputErr != nil {
+						return testCase.cassandraData.OutputParam, testCase.cassandraData.OutputErr
+					}
+					return params, nil
+				},
+			).Times(testCase.cassandraData.Times)
+
+			session, err := StartAttempt(quiz, "username", previous, now, mockCassandra)
+			testCase.expectErr(t, err, "error expectation failed")
+			if testCase.expectedErr != nil {
+				require.True(t, errors.Is(err, testCase.expectedErr), "expected %v but got %v", testCase.expectedErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			require.Equal(t, testCase.expectedAttempt, session.Attempt, "attempt number mismatch")
+			if testCase.cassandraData.OutputParam == nil {
+				require.Equal(t, quiz.QuizID, session.QuizID, "quiz id mismatch")
+				require.Equal(t, now.UTC().Truncate(time.Millisecond), session.StartedAt, "start time mismatch")
+				require.Equal(t, session.StartedAt.Add(10*time.Minute), session.Deadline, "deadline mismatch")
+			} else {
+				require.Equal(t, existing, session, "existing session was modified")
+			}
+		})
+	}
+}
+
+func TestCheckDeadline(t *testing.T) {
+	now := time.Now()
+	session := &model_cassandra.AttemptSession{Username: "username", Attempt: 1, Deadline: now.Add(-time.Minute)}
+
+	testCases := []struct {
+		name          string
+		quiz          *model_cassandra.QuizCore
+		cassandraData *MockCassandraData
+		expectedLate  bool
+		expectedErr   error
+		expectErr     require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:          "untimed",
+			quiz:          &model_cassandra.QuizCore{},
+			cassandraData: &MockCassandraData{Times: 0},
+			expectErr:     require.NoError,
+		}, {
+			name: "not started",
+			quiz: &model_cassandra.QuizCore{TimeLimit: 600},
+			cassandraData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			expectedErr: ErrAttemptNotStarted,
+			expectErr:   require.Error,
+		}, {
+			name: "db failure",
+			quiz: &model_cassandra.QuizCore{TimeLimit: 600},
+			cassandraData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			expectErr: require.Error,
+		}, {
+			name:          "within grace period",
+			quiz:          &model_cassandra.QuizCore{TimeLimit: 600, GracePeriod: 90},
+			cassandraData: &MockCassandraData{OutputParam: session, Times: 1},
+			expectErr:     require.NoError,
+		}, {
+			name:          "after grace period",
+			quiz:          &model_cassandra.QuizCore{TimeLimit: 600, GracePeriod: 30},
+			cassandraData: &MockCassandraData{OutputParam: session, Times: 1},
+			expectedLate:  true,
+			expectErr:     require.NoError,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+				testCase.cassandraData.OutputParam,
+				testCase.cassandraData.OutputErr,
+			).Times(testCase.cassandraData.Times)
+
+			quiz := &model_cassandra.Quiz{QuizID: gocql.TimeUUID(), QuizCore: testCase.quiz}
+			late, err := CheckDeadline(quiz, "username", nil, now, mockCassandra)
+			testCase.expectErr(t, err, "error expectation failed")
+			if testCase.expectedErr != nil {
+				require.True(t, errors.Is(err, testCase.expectedErr), "expected %v but got %v", testCase.expectedErr, err)
+			}
+			require.Equal(t, testCase.expectedLate, late, "late submission mismatch")
+		})
+	}
+}
+
+func TestRemainingTime(t *testing.T) {
+	now := time.Now()
+	require.Equal(t, int64(90), RemainingTime(&model_cassandra.AttemptSession{Deadline: now.Add(90500 * time.Millisecond)}, now),
+		"remaining time should be rounded down to whole seconds")
+	require.Equal(t, int64(0), RemainingTime(&model_cassandra.AttemptSession{Deadline: now.Add(-time.Minute)}, now),
+		"expired sessions should have no time remaining")
+}
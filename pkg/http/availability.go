@@ -0,0 +1,34 @@
+package http
+
+import (
+	"errors"
+	"time"
+
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+var (
+	// ErrQuizUnavailable is returned when a quiz is not published or has been deleted.
+	ErrQuizUnavailable = errors.New("quiz is unavailable")
+
+	// ErrQuizClosed is returned when a quiz has passed its closing time and can no longer be taken.
+	ErrQuizClosed = errors.New("quiz is closed")
+)
+
+// CheckAvailability will verify that a quiz can be taken at a given time. Quizzes are opened by being published, either
+// manually or by the scheduler at their opening time, and are closed once their closing time has passed. The closing time
+// is checked directly so that quizzes close on time even before the scheduler has marked them as closed.
+func CheckAvailability(quiz *model_cassandra.Quiz, now time.Time) error {
+	if !quiz.IsPublished || quiz.IsDeleted {
+		return ErrQuizUnavailable
+	}
+	if IsClosed(quiz, now) {
+		return ErrQuizClosed
+	}
+	return nil
+}
+
+// IsClosed reports whether a quiz has been closed or has passed its closing time.
+func IsClosed(quiz *model_cassandra.Quiz, now time.Time) bool {
+	return quiz.IsClosed || (quiz.ClosesAt != nil && !now.Before(*quiz.ClosesAt))
+}
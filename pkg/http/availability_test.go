@@ -0,0 +1,60 @@
+package http
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+func TestCheckAvailability(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	testCases := []struct {
+		name        string
+		quiz        *model_cassandra.Quiz
+		expectedErr error
+	}{
+		// ----- test cases start ----- //
+		{
+			name:        "unpublished",
+			quiz:        &model_cassandra.Quiz{},
+			expectedErr: ErrQuizUnavailable,
+		}, {
+			name:        "deleted",
+			quiz:        &model_cassandra.Quiz{IsPublished: true, IsDeleted: true},
+			expectedErr: ErrQuizUnavailable,
+		}, {
+			name: "published without closing time",
+			quiz: &model_cassandra.Quiz{IsPublished: true},
+		}, {
+			name: "published before closing time",
+			quiz: &model_cassandra.Quiz{IsPublished: true, ClosesAt: &future},
+		}, {
+			name:        "published after closing time",
+			quiz:        &model_cassandra.Quiz{IsPublished: true, ClosesAt: &past},
+			expectedErr: ErrQuizClosed,
+		}, {
+			name:        "published at closing time",
+			quiz:        &model_cassandra.Quiz{IsPublished: true, ClosesAt: &now},
+			expectedErr: ErrQuizClosed,
+		}, {
+			name:        "closed by scheduler",
+			quiz:        &model_cassandra.Quiz{IsPublished: true, IsClosed: true},
+			expectedErr: ErrQuizClosed,
+		}, {
+			name:        "unpublished after closing time",
+			quiz:        &model_cassandra.Quiz{ClosesAt: &past},
+			expectedErr: ErrQuizUnavailable,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expectedErr, CheckAvailability(testCase.quiz, now))
+		})
+	}
+}
@@ -2,15 +2,25 @@ package http
 
 import (
 	"fmt"
+	"math"
+	"net/http"
 	"strconv"
 
 	"github.com/gocql/gocql"
 	"github.com/surahman/mcq-platform/pkg/auth"
 	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/grading"
 	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
 	"github.com/surahman/mcq-platform/pkg/redis"
 )
 
+// RegradePageSize is the number of responses read from the database in each page of a regrade.
+const RegradePageSize = 100
+
+// scoreTolerance is the smallest difference between two scores that is considered a change.
+const scoreTolerance = 1e-9
+
 // GetQuiz will make a cache call for the quiz. Upon a cache miss it will call the database for the quiz and then load it into
 // the cache.
 func GetQuiz(quizId gocql.UUID, db cassandra.Cassandra, cache redis.Redis) (*model_cassandra.Quiz, error) {
@@ -40,23 +50,250 @@ func GetQuiz(quizId gocql.UUID, db cassandra.Cassandra, cache redis.Redis) (*mod
 	return &quiz, nil
 }
 
+// RemoveAnswerKeys will strip the answer keys, explanations, and feedback for all question types from a quiz before it is
+// presented to a test taker.
+func RemoveAnswerKeys(quiz *model_cassandra.QuizCore) {
+	for _, question := range quiz.Questions {
+		question.Answers = nil
+		question.NumericAnswer = nil
+		question.TextAnswers = nil
+		question.Explanation = ""
+		question.Feedback = nil
+	}
+}
+
 // PrepareStatsRequest will prepare the paged statistics request for the database query.
 func PrepareStatsRequest(auth auth.Auth, quizId gocql.UUID, cursor string, size string) (req *model_cassandra.StatsRequest, err error) {
 	req = &model_cassandra.StatsRequest{QuizID: quizId}
 
-	if req.PageSize, err = strconv.Atoi(size); err != nil {
-		return nil, fmt.Errorf("failed to convert page size: %s", err.Error())
+	if req.PageCursor, req.PageSize, err = preparePage(auth, cursor, size); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// PrepareAuthorQuizzesRequest will prepare the paged request for the quizzes created by an author for the database query.
+// An empty status will list quizzes of all statuses.
+func PrepareAuthorQuizzesRequest(auth auth.Auth, author string, status string, cursor string, size string) (req *model_cassandra.AuthorQuizzesRequest, err error) {
+	switch status {
+	case "", model_cassandra.QuizStatusDraft, model_cassandra.QuizStatusPublished, model_cassandra.QuizStatusDeleted:
+	default:
+		return nil, fmt.Errorf("invalid quiz status %s, must be one of draft, published, or deleted", status)
+	}
+
+	req = &model_cassandra.AuthorQuizzesRequest{Author: author, Status: status}
+
+	if req.PageCursor, req.PageSize, err = preparePage(auth, cursor, size); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// PrepareUserScoresRequest will prepare the paged request for the scores in a user's score history for the database query.
+func PrepareUserScoresRequest(auth auth.Auth, username string, cursor string, size string) (req *model_cassandra.UserScoresRequest, err error) {
+	req = &model_cassandra.UserScoresRequest{Username: username}
+
+	if req.PageCursor, req.PageSize, err = preparePage(auth, cursor, size); err != nil {
+		return nil, err
 	}
-	if req.PageSize < 1 {
-		req.PageSize = 10
+
+	return
+}
+
+// PrepareCatalogueRequest will prepare the paged request for the quizzes listed in the catalogue. Empty search terms and tag
+// will list all the quizzes in the catalogue.
+func PrepareCatalogueRequest(auth auth.Auth, search string, tag string, cursor string, size string) (req *model_http.CatalogueRequest, err error) {
+	req = &model_http.CatalogueRequest{Search: search, Tag: tag}
+
+	if req.PageCursor, req.PageSize, err = preparePage(auth, cursor, size); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// preparePage will convert the page size and decrypt the page cursor of a paged request for a database query.
+func preparePage(auth auth.Auth, cursor string, size string) (pageCursor []byte, pageSize int, err error) {
+	if pageSize, err = strconv.Atoi(size); err != nil {
+		return nil, 0, fmt.Errorf("failed to convert page size: %s", err.Error())
+	}
+	if pageSize < 1 {
+		pageSize = 10
 	}
 
 	// Null cursor must be set if there was no cursor in the URI.
 	if len(cursor) != 0 {
-		if req.PageCursor, err = auth.DecryptFromString(cursor); err != nil {
-			return nil, fmt.Errorf("failed to decrypt page cursor: %s", err.Error())
+		if pageCursor, err = auth.DecryptFromString(cursor); err != nil {
+			return nil, 0, fmt.Errorf("failed to decrypt page cursor: %s", err.Error())
 		}
 	}
 
 	return
 }
+
+// RegradeResponses will page through all the responses to a quiz, grade every attempt against the current version of the
+// quiz, and write back the scores that changed. Attempts at quizzes drawn from question banks are graded against the
+// questions drawn for them. Responses that cannot be graded against the current version, including attempts at a version
+// whose questions and options are laid out differently, are counted as failed and left unchanged. The progress function, if supplied, is called with the running summary after every page. The
+// summary so far is returned along with any database error so that a partial regrade can be reported.
+func RegradeResponses(quiz *model_cassandra.Quiz, db cassandra.Cassandra, grader grading.Grading,
+	progress func(*model_http.RegradeSummary)) (*model_http.RegradeSummary, error) {
+	var err error
+	var dbRecord any
+	summary := &model_http.RegradeSummary{Version: quiz.Version}
+	versions := map[int]*model_cassandra.QuizCore{quiz.Version: quiz.QuizCore}
+	request := &model_cassandra.StatsRequest{QuizID: quiz.QuizID, PageSize: RegradePageSize}
+
+	for {
+		if dbRecord, err = db.Execute(cassandra.ReadResponseStatisticsPageQuery, request); err != nil {
+			return summary, err
+		}
+		page := dbRecord.(*model_cassandra.StatsResponse)
+
+		for _, response := range page.Records {
+			if err = regradeResponse(response, quiz, versions, db, grader, summary); err != nil {
+				return summary, err
+			}
+			summary.Processed++
+		}
+
+		summary.Pages++
+		if progress != nil {
+			progress(summary)
+		}
+
+		if len(page.PageCursor) == 0 {
+			break
+		}
+		request.PageCursor = page.PageCursor
+	}
+
+	return summary, nil
+}
+
+// regradeResponse will grade every attempt in a single response against the quiz, write the response back if any attempt
+// changed, and update the summary with the change in the effective score. Responses recorded before multiple attempts were
+// supported are written back with their single attempt. A response that cannot be graded, has an attempt without drawn
+// questions, has an attempt at a version with a different layout or without a snapshot, or had another attempt recorded
+// whilst it was being regraded, is counted as failed and left unchanged. Versions read are cached in versions.
+func regradeResponse(response *model_cassandra.Response, quiz *model_cassandra.Quiz, versions map[int]*model_cassandra.QuizCore,
+	db cassandra.Cassandra, grader grading.Grading, summary *model_http.RegradeSummary) error {
+	regraded := &model_cassandra.Response{Username: response.Username, Author: response.Author, QuizID: response.QuizID}
+	changed := len(response.Attempts) == 0
+
+	for _, attempt := range AttemptHistory(response) {
+		graded := quiz.QuizCore
+		if isDrawn(graded) {
+			var err error
+			if graded, err = readAttemptDraw(quiz, response.Username, attempt.Number, db); err != nil {
+				if cassandraErr, ok := err.(*cassandra.Error); ok && cassandraErr.Status == http.StatusNotFound {
+					summary.Failed++
+					return nil
+				}
+				return err
+			}
+		} else {
+			// Answers are indexed by the questions and options of the version that was taken, so they can only be graded
+			// against the current version if it is laid out in the same way.
+			taken, err := readVersion(quiz, attempt.Version, versions, db)
+			if err != nil {
+				return err
+			}
+			if !sameLayout(taken, graded) {
+				summary.Failed++
+				return nil
+			}
+		}
+
+		answers := &model_cassandra.QuizResponse{Responses: attempt.Responses, TextResponses: attempt.TextResponses}
+		score, maxScore, err := grader.Grade(answers, graded)
+		if err != nil {
+			summary.Failed++
+			return nil
+		}
+		if attempt.Late {
+			score = 0
+		}
+
+		// Attempts must also record the version they are now graded against, even if the score has not changed.
+		changed = changed || math.Abs(score-attempt.Score) > scoreTolerance ||
+			math.Abs(maxScore-attempt.MaxScore) > scoreTolerance || attempt.Version != quiz.Version
+		regraded.Attempts = append(regraded.Attempts, &model_cassandra.Attempt{
+			Number:        attempt.Number,
+			Score:         score,
+			MaxScore:      maxScore,
+			Version:       quiz.Version,
+			Responses:     attempt.Responses,
+			TextResponses: attempt.TextResponses,
+			SubmittedAt:   attempt.SubmittedAt,
+			Late:          attempt.Late,
+		})
+	}
+	applyAttemptPolicy(regraded, quiz.QuizCore)
+
+	if changed {
+		request := &model_cassandra.ResponseUpdateRequest{Response: regraded, PreviousAttempts: response.Attempts}
+		if _, err := db.Execute(cassandra.UpdateResponseQuery, request); err != nil {
+			if cassandraErr, ok := err.(*cassandra.Error); ok && cassandraErr.Status == http.StatusConflict {
+				summary.Failed++
+				return nil
+			}
+			return err
+		}
+	}
+
+	delta := regraded.Score - response.Score
+	if math.Abs(delta) <= scoreTolerance {
+		summary.Unchanged++
+		return nil
+	}
+
+	summary.Changed++
+	summary.NetChange += delta
+	summary.LargestIncrease = math.Max(summary.LargestIncrease, delta)
+	summary.LargestDecrease = math.Max(summary.LargestDecrease, -delta)
+
+	return nil
+}
+
+// readVersion will read the snapshot of a version of a quiz and cache it in versions. Versions without a snapshot are
+// returned and cached as nil.
+func readVersion(quiz *model_cassandra.Quiz, version int, versions map[int]*model_cassandra.QuizCore,
+	db cassandra.Cassandra) (*model_cassandra.QuizCore, error) {
+	if quizCore, ok := versions[version]; ok {
+		return quizCore, nil
+	}
+
+	var quizCore *model_cassandra.QuizCore
+	dbRecord, err := db.Execute(cassandra.ReadQuizVersionQuery,
+		&model_cassandra.QuizVersionRequest{QuizID: quiz.QuizID, Version: version})
+	if err != nil {
+		if err = ignoreNotFound(err); err != nil {
+			return nil, err
+		}
+	} else {
+		quizCore = dbRecord.(*model_cassandra.QuizVersion).QuizCore
+	}
+	versions[version] = quizCore
+
+	return quizCore, nil
+}
+
+// sameLayout reports whether the answers to one quiz can be graded against another. The quizzes must have the same number
+// of questions, and each question must be of the same type with the same number of options and matches.
+func sameLayout(first, second *model_cassandra.QuizCore) bool {
+	if first == nil || second == nil || len(first.Questions) != len(second.Questions) {
+		return false
+	}
+
+	for idx, question := range first.Questions {
+		other := second.Questions[idx]
+		if question.Type != other.Type || len(question.Options) != len(other.Options) || len(question.Matches) != len(other.Matches) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/surahman/mcq-platform/pkg/cassandra"
 	"github.com/surahman/mcq-platform/pkg/mocks"
 	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
 	"github.com/surahman/mcq-platform/pkg/redis"
 )
 
@@ -252,3 +253,480 @@ func TestPrepareStatsRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestPrepareAuthorQuizzesRequest(t *testing.T) {
+	testCases := []struct {
+		name            string
+		status          string
+		pageCursor      string
+		pageSize        string
+		mockAuthData    *MockAuthData
+		expectPageSize  int
+		expectErr       require.ErrorAssertionFunc
+		expectNil       require.ValueAssertionFunc
+		expectNilCursor require.ValueAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:         "invalid status",
+			status:       "archived",
+			pageSize:     "3",
+			mockAuthData: &MockAuthData{Times: 0},
+			expectErr:    require.Error,
+			expectNil:    require.Nil,
+		}, {
+			name:         "non-numeric page size",
+			status:       model_cassandra.QuizStatusDraft,
+			pageSize:     "this should be a natural number",
+			mockAuthData: &MockAuthData{Times: 0},
+			expectErr:    require.Error,
+			expectNil:    require.Nil,
+		}, {
+			name:       "failed to decrypt cursor",
+			status:     model_cassandra.QuizStatusPublished,
+			pageCursor: "some page cursor string",
+			pageSize:   "3",
+			mockAuthData: &MockAuthData{
+				Times:        1,
+				OutputParam1: nil,
+				OutputErr:    fmt.Errorf("failure decrypting"),
+			},
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:            "success - all statuses",
+			pageSize:        "0",
+			mockAuthData:    &MockAuthData{Times: 0},
+			expectPageSize:  10,
+			expectErr:       require.NoError,
+			expectNil:       require.NotNil,
+			expectNilCursor: require.Nil,
+		}, {
+			name:       "success",
+			status:     model_cassandra.QuizStatusDeleted,
+			pageCursor: "some page cursor string",
+			pageSize:   "3",
+			mockAuthData: &MockAuthData{
+				Times:        1,
+				OutputParam1: []byte{1},
+			},
+			expectPageSize:  3,
+			expectErr:       require.NoError,
+			expectNil:       require.NotNil,
+			expectNilCursor: require.NotNil,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+
+			mockAuth.EXPECT().DecryptFromString(gomock.Any()).Return(
+				testCase.mockAuthData.OutputParam1,
+				testCase.mockAuthData.OutputErr,
+			).Times(testCase.mockAuthData.Times)
+
+			req, err := PrepareAuthorQuizzesRequest(mockAuth, "username", testCase.status, testCase.pageCursor, testCase.pageSize)
+			testCase.expectErr(t, err, "error expectation condition failed")
+			testCase.expectNil(t, req, "nil expectation condition failed")
+
+			if err == nil {
+				require.Equal(t, "username", req.Author, "expected author check failed")
+				require.Equal(t, testCase.status, req.Status, "expected status check failed")
+				require.Equal(t, testCase.expectPageSize, req.PageSize, "expected page size check failed")
+				testCase.expectNilCursor(t, req.PageCursor, "page cursor nil expectation failed")
+			}
+		})
+	}
+}
+
+func TestPrepareUserScoresRequest(t *testing.T) {
+	testCases := []struct {
+		name            string
+		pageCursor      string
+		pageSize        string
+		mockAuthData    *MockAuthData
+		expectPageSize  int
+		expectErr       require.ErrorAssertionFunc
+		expectNil       require.ValueAssertionFunc
+		expectNilCursor require.ValueAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:         "non-numeric page size",
+			pageSize:     "this should be a natural number",
+			mockAuthData: &MockAuthData{Times: 0},
+			expectErr:    require.Error,
+			expectNil:    require.Nil,
+		}, {
+			name:       "failed to decrypt cursor",
+			pageCursor: "some page cursor string",
+			pageSize:   "3",
+			mockAuthData: &MockAuthData{
+				Times:        1,
+				OutputParam1: nil,
+				OutputErr:    fmt.Errorf("failure decrypting"),
+			},
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:            "success - first page",
+			pageSize:        "0",
+			mockAuthData:    &MockAuthData{Times: 0},
+			expectPageSize:  10,
+			expectErr:       require.NoError,
+			expectNil:       require.NotNil,
+			expectNilCursor: require.Nil,
+		}, {
+			name:       "success",
+			pageCursor: "some page cursor string",
+			pageSize:   "3",
+			mockAuthData: &MockAuthData{
+				Times:        1,
+				OutputParam1: []byte{1},
+			},
+			expectPageSize:  3,
+			expectErr:       require.NoError,
+			expectNil:       require.NotNil,
+			expectNilCursor: require.NotNil,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+
+			mockAuth.EXPECT().DecryptFromString(gomock.Any()).Return(
+				testCase.mockAuthData.OutputParam1,
+				testCase.mockAuthData.OutputErr,
+			).Times(testCase.mockAuthData.Times)
+
+			req, err := PrepareUserScoresRequest(mockAuth, "username", testCase.pageCursor, testCase.pageSize)
+			testCase.expectErr(t, err, "error expectation condition failed")
+			testCase.expectNil(t, req, "nil expectation condition failed")
+
+			if err == nil {
+				require.Equal(t, "username", req.Username, "expected username check failed")
+				require.Equal(t, testCase.expectPageSize, req.PageSize, "expected page size check failed")
+				testCase.expectNilCursor(t, req.PageCursor, "page cursor nil expectation failed")
+			}
+		})
+	}
+}
+
+func TestPrepareCatalogueRequest(t *testing.T) {
+	testCases := []struct {
+		name            string
+		pageCursor      string
+		pageSize        string
+		mockAuthData    *MockAuthData
+		expectPageSize  int
+		expectErr       require.ErrorAssertionFunc
+		expectNil       require.ValueAssertionFunc
+		expectNilCursor require.ValueAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:         "non-numeric page size",
+			pageSize:     "this should be a natural number",
+			mockAuthData: &MockAuthData{Times: 0},
+			expectErr:    require.Error,
+			expectNil:    require.Nil,
+		}, {
+			name:       "failed to decrypt cursor",
+			pageCursor: "some page cursor string",
+			pageSize:   "3",
+			mockAuthData: &MockAuthData{
+				Times:        1,
+				OutputParam1: nil,
+				OutputErr:    fmt.Errorf("failure decrypting"),
+			},
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:            "success - first page",
+			pageSize:        "0",
+			mockAuthData:    &MockAuthData{Times: 0},
+			expectPageSize:  10,
+			expectErr:       require.NoError,
+			expectNil:       require.NotNil,
+			expectNilCursor: require.Nil,
+		}, {
+			name:       "success",
+			pageCursor: "some page cursor string",
+			pageSize:   "3",
+			mockAuthData: &MockAuthData{
+				Times:        1,
+				OutputParam1: []byte{1},
+			},
+			expectPageSize:  3,
+			expectErr:       require.NoError,
+			expectNil:       require.NotNil,
+			expectNilCursor: require.NotNil,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+
+			mockAuth.EXPECT().DecryptFromString(gomock.Any()).Return(
+				testCase.mockAuthData.OutputParam1,
+				testCase.mockAuthData.OutputErr,
+			).Times(testCase.mockAuthData.Times)
+
+			req, err := PrepareCatalogueRequest(mockAuth, "algebra", "math", testCase.pageCursor, testCase.pageSize)
+			testCase.expectErr(t, err, "error expectation condition failed")
+			testCase.expectNil(t, req, "nil expectation condition failed")
+
+			if err == nil {
+				require.Equal(t, "algebra", req.Search, "expected search terms check failed")
+				require.Equal(t, "math", req.Tag, "expected tag check failed")
+				require.Equal(t, testCase.expectPageSize, req.PageSize, "expected page size check failed")
+				testCase.expectNilCursor(t, req.PageCursor, "page cursor nil expectation failed")
+			}
+		})
+	}
+}
+
+func TestRemoveAnswerKeys(t *testing.T) {
+	numericAnswer := 3.14
+	quiz := &model_cassandra.QuizCore{
+		Title:       "Quiz with all question types",
+		MarkingType: "negative",
+		Questions: []*model_cassandra.Question{
+			{Description: "multiple choice", Options: []string{"one", "two"}, Answers: []int32{0}, Explanation: "one is correct",
+				Feedback: []string{"correct", "incorrect"}},
+			{Description: "true/false", Type: model_cassandra.QuestionTrueFalse, Answers: []int32{1}},
+			{Description: "numeric", Type: model_cassandra.QuestionNumeric, NumericAnswer: &numericAnswer, Tolerance: 0.1},
+			{Description: "short text", Type: model_cassandra.QuestionShortText, TextAnswers: []string{"answer"}},
+			{Description: "regex", Type: model_cassandra.QuestionRegex, TextAnswers: []string{"colou?r"}},
+		},
+	}
+
+	RemoveAnswerKeys(quiz)
+
+	for _, question := range quiz.Questions {
+		require.Nil(t, question.Answers, "answers not removed from %s question", question.Description)
+		require.Nil(t, question.NumericAnswer, "numeric answer not removed from %s question", question.Description)
+		require.Nil(t, question.TextAnswers, "text answers not removed from %s question", question.Description)
+		require.Empty(t, question.Explanation, "explanation not removed from %s question", question.Description)
+		require.Nil(t, question.Feedback, "feedback not removed from %s question", question.Description)
+	}
+	require.Equal(t, []string{"one", "two"}, quiz.Questions[0].Options, "options should not be removed")
+	require.Equal(t, 0.1, quiz.Questions[2].Tolerance, "tolerance should not be removed")
+}
+
+func TestRegradeResponses(t *testing.T) {
+	quiz := &model_cassandra.Quiz{QuizID: gocql.TimeUUID(), QuizCore: &model_cassandra.QuizCore{}, Version: 1}
+	newResponse := func(username string, score float64, version int) *model_cassandra.Response {
+		return &model_cassandra.Response{Username: username, QuizID: quiz.QuizID, Score: score, MaxScore: 5, Version: version,
+			QuizResponse: &model_cassandra.QuizResponse{},
+			Attempts:     []*model_cassandra.Attempt{{Number: 1, Score: score, MaxScore: 5, Version: version}}}
+	}
+	legacyResponse := newResponse("legacy-user", 2, 1)
+	legacyResponse.Attempts = nil
+	multipleAttempts := newResponse("user-1", 4, 1)
+	multipleAttempts.Attempts = []*model_cassandra.Attempt{
+		{Number: 1, Score: 1, MaxScore: 5, Version: 1},
+		{Number: 2, Score: 4, MaxScore: 5, Version: 1},
+	}
+	lateAttempt := newResponse("user-1", 2, 1)
+	lateAttempt.Attempts = []*model_cassandra.Attempt{
+		{Number: 1, Score: 2, MaxScore: 5, Version: 1},
+		{Number: 2, Score: 0, MaxScore: 5, Version: 1, Late: true},
+	}
+	reordered := &model_cassandra.QuizCore{Questions: []*model_cassandra.Question{{Description: "removed question"}}}
+	type gradeResult struct {
+		score float64
+		err   error
+	}
+
+	testCases := []struct {
+		name            string
+		pages           []*model_cassandra.StatsResponse
+		pageErr         error
+		grades          []gradeResult
+		updateErr       error
+		snapshots       map[int]*model_cassandra.QuizCore
+		versionErr      error
+		expectReads     int
+		expectUpdates   int
+		expectErr       require.ErrorAssertionFunc
+		expectedSummary *model_http.RegradeSummary
+	}{
+		// ----- test cases start ----- //
+		{
+			name: "single page, changed and unchanged",
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse("user-1", 1, 1), newResponse("user-2", 2, 1)}},
+			},
+			grades:          []gradeResult{{score: 3}, {score: 2}},
+			expectUpdates:   1,
+			expectErr:       require.NoError,
+			expectedSummary: &model_http.RegradeSummary{Version: 1, Pages: 1, Processed: 2, Changed: 1, Unchanged: 1, NetChange: 2, LargestIncrease: 2},
+		}, {
+			name: "multiple pages, version updated without score change",
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse("user-1", 4, 0)}, PageCursor: []byte("cursor")},
+				{Records: []*model_cassandra.Response{newResponse("user-2", 2, 0)}},
+			},
+			grades:          []gradeResult{{score: 1}, {score: 2}},
+			snapshots:       map[int]*model_cassandra.QuizCore{0: {}},
+			expectReads:     1,
+			expectUpdates:   2,
+			expectErr:       require.NoError,
+			expectedSummary: &model_http.RegradeSummary{Version: 1, Pages: 2, Processed: 2, Changed: 1, Unchanged: 1, NetChange: -3, LargestDecrease: 3},
+		}, {
+			name: "different layout",
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse("user-1", 4, 0), newResponse("user-2", 2, 1)}},
+			},
+			grades:          []gradeResult{{score: 2}},
+			snapshots:       map[int]*model_cassandra.QuizCore{0: reordered},
+			expectReads:     1,
+			expectUpdates:   0,
+			expectErr:       require.NoError,
+			expectedSummary: &model_http.RegradeSummary{Version: 1, Pages: 1, Processed: 2, Failed: 1, Unchanged: 1},
+		}, {
+			name: "version snapshot not found",
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse("user-1", 4, 0)}},
+			},
+			versionErr:      &cassandra.Error{Message: "version not found", Status: http.StatusNotFound},
+			expectReads:     1,
+			expectUpdates:   0,
+			expectErr:       require.NoError,
+			expectedSummary: &model_http.RegradeSummary{Version: 1, Pages: 1, Processed: 1, Failed: 1},
+		}, {
+			name: "version read failure",
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse("user-1", 4, 0)}},
+			},
+			versionErr:      &cassandra.Error{Message: "version read failure", Status: http.StatusInternalServerError},
+			expectReads:     1,
+			expectUpdates:   0,
+			expectErr:       require.Error,
+			expectedSummary: &model_http.RegradeSummary{Version: 1},
+		}, {
+			name: "grading failure",
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse("user-1", 1, 1)}},
+			},
+			grades:          []gradeResult{{err: fmt.Errorf("grading failure")}},
+			expectUpdates:   0,
+			expectErr:       require.NoError,
+			expectedSummary: &model_http.RegradeSummary{Version: 1, Pages: 1, Processed: 1, Failed: 1},
+		}, {
+			name: "legacy response migrated to a single attempt",
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{legacyResponse}},
+			},
+			grades:          []gradeResult{{score: 2}},
+			expectUpdates:   1,
+			expectErr:       require.NoError,
+			expectedSummary: &model_http.RegradeSummary{Version: 1, Pages: 1, Processed: 1, Unchanged: 1},
+		}, {
+			name: "multiple attempts, best attempt changes",
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{multipleAttempts}},
+			},
+			grades:          []gradeResult{{score: 3}, {score: 2}},
+			expectUpdates:   1,
+			expectErr:       require.NoError,
+			expectedSummary: &model_http.RegradeSummary{Version: 1, Pages: 1, Processed: 1, Changed: 1, NetChange: -1, LargestDecrease: 1},
+		}, {
+			name: "late attempt remains scored zero",
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{lateAttempt}},
+			},
+			grades:          []gradeResult{{score: 2}, {score: 5}},
+			expectUpdates:   0,
+			expectErr:       require.NoError,
+			expectedSummary: &model_http.RegradeSummary{Version: 1, Pages: 1, Processed: 1, Unchanged: 1},
+		}, {
+			name: "concurrent attempt conflict",
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse("user-1", 1, 1)}},
+			},
+			grades:          []gradeResult{{score: 3}},
+			updateErr:       &cassandra.Error{Message: "conflict", Status: http.StatusConflict},
+			expectUpdates:   1,
+			expectErr:       require.NoError,
+			expectedSummary: &model_http.RegradeSummary{Version: 1, Pages: 1, Processed: 1, Failed: 1},
+		}, {
+			name:            "page read failure",
+			pageErr:         &cassandra.Error{Message: "page read failure", Status: http.StatusInternalServerError},
+			expectErr:       require.Error,
+			expectedSummary: &model_http.RegradeSummary{Version: 1},
+		}, {
+			name: "update failure",
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse("user-1", 1, 1)}},
+			},
+			grades:          []gradeResult{{score: 3}},
+			updateErr:       &cassandra.Error{Message: "update failure", Status: http.StatusInternalServerError},
+			expectUpdates:   1,
+			expectErr:       require.Error,
+			expectedSummary: &model_http.RegradeSummary{Version: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockGrading := mocks.NewMockGrading(mockCtrl)
+
+			pageIdx, gradeIdx, reads, updates, progressCalls := 0, 0, 0, 0, 0
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+					switch request := params.(type) {
+					case *model_cassandra.StatsRequest:
+						if testCase.pageErr != nil {
+							return nil, testCase.pageErr
+						}
+						pageIdx++
+						return testCase.pages[pageIdx-1], nil
+					case *model_cassandra.QuizVersionRequest:
+						reads++
+						if testCase.versionErr != nil {
+							return nil, testCase.versionErr
+						}
+						return &model_cassandra.QuizVersion{QuizID: quiz.QuizID, Version: request.Version,
+							QuizCore: testCase.snapshots[request.Version]}, nil
+					default:
+						updates++
+						return nil, testCase.updateErr
+					}
+				}).AnyTimes()
+
+			mockGrading.EXPECT().Grade(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *model_cassandra.QuizResponse, _ *model_cassandra.QuizCore) (float64, float64, error) {
+					gradeIdx++
+					result := testCase.grades[gradeIdx-1]
+					return result.score, 5, result.err
+				}).Times(len(testCase.grades))
+
+			summary, err := RegradeResponses(quiz, mockCassandra, mockGrading, func(*model_http.RegradeSummary) { progressCalls++ })
+
+			testCase.expectErr(t, err, "error expectation failed")
+			require.Equal(t, testCase.expectedSummary, summary, "summary does not match expected")
+			require.Equal(t, testCase.expectReads, reads, "versions should be read once each")
+			require.Equal(t, testCase.expectUpdates, updates, "number of score updates does not match expected")
+			require.Equal(t, testCase.expectedSummary.Pages, progressCalls, "progress should be reported after every page")
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package http
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/redis"
+)
+
+// DraftTTL is the period for which a draft is kept in the cache after it was last saved.
+const DraftTTL = 24 * time.Hour
+
+// draftKey is the cache key for the draft of an attempt at a quiz by a user.
+func draftKey(username string, quizId gocql.UUID, attempt int) string {
+	return fmt.Sprintf("draft:%s:%s:%d", username, quizId, attempt)
+}
+
+// SaveDraft will save the partially completed answers to a user's next attempt at a quiz to the database and then to the
+// cache, replacing the draft previously saved for the attempt. SetTTL and Del methods will log errors, which are not
+// propagated to the user, and a draft that cannot be cached is evicted so that a stale draft is not resumed.
+func SaveDraft(username string, quizId gocql.UUID, previous *model_cassandra.Response, answers *model_cassandra.QuizResponse,
+	now time.Time, db cassandra.Cassandra, cache redis.Redis) (*model_cassandra.AnswerDraft, error) {
+	draft := &model_cassandra.AnswerDraft{
+		Username:     username,
+		QuizID:       quizId,
+		Attempt:      len(AttemptHistory(previous)) + 1,
+		QuizResponse: answers,
+		SavedAt:      now,
+	}
+
+	if _, err := db.Execute(cassandra.CreateAnswerDraftQuery, draft); err != nil {
+		return nil, err
+	}
+
+	key := draftKey(username, quizId, draft.Attempt)
+	if err := cache.SetTTL(key, draft, DraftTTL); err != nil {
+		_ = cache.Del(key)
+	}
+
+	return draft, nil
+}
+
+// GetDraft will make a cache call for the draft of a user's next attempt at a quiz. Upon a cache miss it will read the draft
+// from the database and then load it into the cache. Drafts saved for attempts that have since been submitted are not
+// retrieved.
+func GetDraft(username string, quizId gocql.UUID, previous *model_cassandra.Response, db cassandra.Cassandra,
+	cache redis.Redis) (*model_cassandra.AnswerDraft, error) {
+	attempt := len(AttemptHistory(previous)) + 1
+	key := draftKey(username, quizId, attempt)
+
+	// Cache call.
+	cached := model_cassandra.AnswerDraft{}
+	if err := cache.Get(key, &cached); err == nil {
+		return &cached, nil
+	}
+
+	// Cache miss. SetTTL method will log errors, which are not propagated to the user.
+	dbRecord, err := db.Execute(cassandra.ReadAnswerDraftQuery,
+		&model_cassandra.AnswerDraftRequest{Username: username, QuizID: quizId, Attempt: attempt})
+	if err != nil {
+		return nil, err
+	}
+	draft := dbRecord.(*model_cassandra.AnswerDraft)
+	_ = cache.SetTTL(key, draft, DraftTTL)
+
+	return draft, nil
+}
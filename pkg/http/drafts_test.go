@@ -0,0 +1,183 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/redis"
+)
+
+func TestSaveDraft(t *testing.T) {
+	quizId := gocql.TimeUUID()
+	now := time.Now()
+	answers := &model_cassandra.QuizResponse{Responses: [][]int32{{1}, {}}, TextResponses: []string{"", "answer"}}
+	previous := &model_cassandra.Response{Attempts: []*model_cassandra.Attempt{{Number: 1}}}
+
+	testCases := []struct {
+		name            string
+		previous        *model_cassandra.Response
+		expectedAttempt int
+		cassandraErr    error
+		redisSetData    *MockRedisData
+		redisDelTimes   int
+		expectErr       require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:            "first attempt",
+			expectedAttempt: 1,
+			redisSetData:    &MockRedisData{Times: 1},
+			expectErr:       require.NoError,
+		}, {
+			name:            "next attempt",
+			previous:        previous,
+			expectedAttempt: 2,
+			redisSetData:    &MockRedisData{Times: 1},
+			expectErr:       require.NoError,
+		}, {
+			name:            "db failure",
+			expectedAttempt: 1,
+			cassandraErr:    &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+			redisSetData:    &MockRedisData{Times: 0},
+			expectErr:       require.Error,
+		}, {
+			name:            "cache set failure",
+			expectedAttempt: 1,
+			redisSetData: &MockRedisData{
+				Err:   &redis.Error{Message: "cache failure", Code: redis.ErrorCacheSet},
+				Times: 1,
+			},
+			redisDelTimes: 1,
+			expectErr:     require.NoError,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			key := draftKey("username", quizId, testCase.expectedAttempt)
+
+			gomock.InOrder(
+				// Cassandra write.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					nil,
+					testCase.cassandraErr,
+				).Times(1),
+				// Cache set.
+				mockRedis.EXPECT().SetTTL(key, gomock.Any(), DraftTTL).Return(
+					testCase.redisSetData.Err,
+				).Times(testCase.redisSetData.Times),
+				// Cache eviction.
+				mockRedis.EXPECT().Del(key).Return(
+					nil,
+				).Times(testCase.redisDelTimes),
+			)
+
+			draft, err := SaveDraft("username", quizId, testCase.previous, answers, now, mockCassandra, mockRedis)
+			testCase.expectErr(t, err, "error expectation failed")
+			if err != nil {
+				return
+			}
+			require.Equal(t, testCase.expectedAttempt, draft.Attempt, "attempt number mismatch")
+			require.Equal(t, answers, draft.QuizResponse, "answers mismatch")
+			require.Equal(t, now, draft.SavedAt, "save time mismatch")
+		})
+	}
+}
+
+func TestGetDraft(t *testing.T) {
+	quizId := gocql.TimeUUID()
+	previous := &model_cassandra.Response{Attempts: []*model_cassandra.Attempt{{Number: 1}}}
+	stored := &model_cassandra.AnswerDraft{Username: "username", QuizID: quizId, Attempt: 2,
+		QuizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{1}}}}
+	cacheMiss := &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss}
+
+	testCases := []struct {
+		name              string
+		redisGetData      *MockRedisData
+		cassandraReadData *MockCassandraData
+		redisSetData      *MockRedisData
+		expectErr         require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:              "cache hit",
+			redisGetData:      &MockRedisData{Param2: *stored, Times: 1},
+			cassandraReadData: &MockCassandraData{Times: 0},
+			redisSetData:      &MockRedisData{Times: 0},
+			expectErr:         require.NoError,
+		}, {
+			name:         "cache miss, draft not saved",
+			redisGetData: &MockRedisData{Param2: model_cassandra.AnswerDraft{}, Err: cacheMiss, Times: 1},
+			cassandraReadData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "draft has not been saved", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			redisSetData: &MockRedisData{Times: 0},
+			expectErr:    require.Error,
+		}, {
+			name:              "cache miss, db read success, cache set failure",
+			redisGetData:      &MockRedisData{Param2: model_cassandra.AnswerDraft{}, Err: cacheMiss, Times: 1},
+			cassandraReadData: &MockCassandraData{OutputParam: stored, Times: 1},
+			redisSetData: &MockRedisData{
+				Err:   &redis.Error{Message: "cache failure", Code: redis.ErrorCacheSet},
+				Times: 1,
+			},
+			expectErr: require.NoError,
+		}, {
+			name:              "cache miss, db read success, cache set success",
+			redisGetData:      &MockRedisData{Param2: model_cassandra.AnswerDraft{}, Err: cacheMiss, Times: 1},
+			cassandraReadData: &MockCassandraData{OutputParam: stored, Times: 1},
+			redisSetData:      &MockRedisData{Times: 1},
+			expectErr:         require.NoError,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			key := draftKey("username", quizId, 2)
+
+			gomock.InOrder(
+				// Cache call.
+				mockRedis.EXPECT().Get(key, gomock.Any()).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
+				// Cassandra read.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+				// Cache set.
+				mockRedis.EXPECT().SetTTL(key, gomock.Any(), DraftTTL).Return(
+					testCase.redisSetData.Err,
+				).Times(testCase.redisSetData.Times),
+			)
+
+			draft, err := GetDraft("username", quizId, previous, mockCassandra, mockRedis)
+			testCase.expectErr(t, err, "error expectation failed")
+			if err != nil {
+				return
+			}
+			require.Equal(t, stored, draft, "draft mismatch")
+		})
+	}
+}
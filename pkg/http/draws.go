@@ -0,0 +1,198 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sort"
+
+	"github.com/gocql/gocql"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+// ErrInsufficientQuestions is returned when the question banks of a quiz do not have enough questions for a draw.
+var ErrInsufficientQuestions = errors.New("not enough questions in the question banks")
+
+// DrawnQuiz will retrieve a quiz with the questions that were drawn for the user's next attempt. Quizzes that are not drawn
+// from question banks are unchanged. Attempts at drawn quizzes that were not viewed return an error wrapping
+// ErrAttemptNotViewed.
+func DrawnQuiz(quiz *model_cassandra.Quiz, username string, previous *model_cassandra.Response,
+	db cassandra.Cassandra) (*model_cassandra.Quiz, error) {
+	if !isDrawn(quiz.QuizCore) {
+		return quiz, nil
+	}
+
+	attempt := len(AttemptHistory(previous)) + 1
+	core, err := readAttemptDraw(quiz, username, attempt, db)
+	if err != nil {
+		if cassandraErr, ok := err.(*cassandra.Error); ok && cassandraErr.Status == http.StatusNotFound {
+			return nil, fmt.Errorf("%w, view attempt %d before submitting it", ErrAttemptNotViewed, attempt)
+		}
+		return nil, err
+	}
+
+	drawn := *quiz
+	drawn.QuizCore = core
+	return &drawn, nil
+}
+
+// DrawQuestions will draw questions at random from a pool of questions using the seed. Stratified draws split the pool by
+// the first tag or the difficulty of the questions and draw from each stratum in proportion to its size. The drawn
+// questions are returned in a random order.
+func DrawQuestions(draw *model_cassandra.QuestionDraw, pool []*model_cassandra.Question, seed int64) ([]*model_cassandra.Question, error) {
+	if len(pool) < draw.Count {
+		return nil, fmt.Errorf("%w, %d of %d questions are available", ErrInsufficientQuestions, len(pool), draw.Count)
+	}
+
+	generator := rand.New(rand.NewSource(seed))
+	strata := stratify(pool, draw.StratifyBy)
+	quotas := allocate(strata, draw.Count, len(pool))
+
+	drawn := make([]*model_cassandra.Question, 0, draw.Count)
+	for idx, stratum := range strata {
+		for _, pick := range generator.Perm(len(stratum))[:quotas[idx]] {
+			drawn = append(drawn, stratum[pick])
+		}
+	}
+	generator.Shuffle(len(drawn), func(i, j int) { drawn[i], drawn[j] = drawn[j], drawn[i] })
+
+	return drawn, nil
+}
+
+// drawAttempt will retrieve the questions drawn for a user's attempt at a quiz. Questions are drawn from the quiz's banks
+// the first time the attempt is viewed and are presented again on later views.
+func drawAttempt(quiz *model_cassandra.Quiz, username string, attempt int, db cassandra.Cassandra) (*model_cassandra.QuizCore, error) {
+	core, err := readAttemptDraw(quiz, username, attempt, db)
+	if err == nil {
+		return core, nil
+	}
+	if cassandraErr, ok := err.(*cassandra.Error); !ok || cassandraErr.Status != http.StatusNotFound {
+		return nil, err
+	}
+
+	ids := make([]gocql.UUID, 0, len(quiz.Draw.Banks))
+	for _, bank := range quiz.Draw.Banks {
+		if id, err := gocql.ParseUUID(bank); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	var record any
+	if record, err = db.Execute(cassandra.ReadQuestionBanksQuery, ids); err != nil {
+		return nil, err
+	}
+
+	var questions []*model_cassandra.Question
+	if questions, err = DrawQuestions(quiz.Draw, drawPool(quiz, record.([]*model_cassandra.QuestionBank)), rand.Int63()); err != nil {
+		return nil, err
+	}
+
+	draw := &model_cassandra.AttemptDraw{Username: username, QuizID: quiz.QuizID, Attempt: attempt, Questions: questions}
+	if record, err = db.Execute(cassandra.CreateAttemptDrawQuery, draw); err != nil {
+		return nil, err
+	}
+
+	return withQuestions(quiz.QuizCore, record.(*model_cassandra.AttemptDraw).Questions), nil
+}
+
+// readAttemptDraw will retrieve a quiz with the questions that were drawn for a user's attempt.
+func readAttemptDraw(quiz *model_cassandra.Quiz, username string, attempt int, db cassandra.Cassandra) (*model_cassandra.QuizCore, error) {
+	request := &model_cassandra.AttemptDrawRequest{Username: username, QuizID: quiz.QuizID, Attempt: attempt}
+	record, err := db.Execute(cassandra.ReadAttemptDrawQuery, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return withQuestions(quiz.QuizCore, record.(*model_cassandra.AttemptDraw).Questions), nil
+}
+
+// drawPool will gather the questions that can be drawn for a quiz, in the order the banks are listed in the draw. Banks that
+// are deleted or were not created by the author of the quiz are excluded.
+func drawPool(quiz *model_cassandra.Quiz, banks []*model_cassandra.QuestionBank) []*model_cassandra.Question {
+	byID := make(map[string]*model_cassandra.QuestionBank, len(banks))
+	for _, bank := range banks {
+		byID[bank.BankID.String()] = bank
+	}
+
+	var pool []*model_cassandra.Question
+	for _, id := range quiz.Draw.Banks {
+		bank, ok := byID[id]
+		if !ok || bank.IsDeleted || bank.Author != quiz.Author || bank.QuestionBankCore == nil {
+			continue
+		}
+		pool = append(pool, bank.Questions...)
+	}
+
+	return pool
+}
+
+// stratify will split a pool of questions into strata by the attribute the draw is balanced across, in order of first
+// appearance. Questions without the attribute form their own stratum. Unstratified pools are a single stratum.
+func stratify(pool []*model_cassandra.Question, stratifyBy string) [][]*model_cassandra.Question {
+	var strata [][]*model_cassandra.Question
+	positions := make(map[string]int)
+
+	for _, question := range pool {
+		key := stratumKey(question, stratifyBy)
+		position, ok := positions[key]
+		if !ok {
+			position = len(strata)
+			positions[key] = position
+			strata = append(strata, nil)
+		}
+		strata[position] = append(strata[position], question)
+	}
+
+	return strata
+}
+
+// stratumKey is the value of the attribute a question is stratified by.
+func stratumKey(question *model_cassandra.Question, stratifyBy string) string {
+	switch stratifyBy {
+	case model_cassandra.StratifyByTag:
+		if len(question.Tags) > 0 {
+			return question.Tags[0]
+		}
+	case model_cassandra.StratifyByDifficulty:
+		return question.Difficulty
+	}
+	return ""
+}
+
+// allocate will divide the number of questions to draw between the strata in proportion to their sizes. Questions left over
+// after rounding down are given to the strata with the largest remainders, earliest stratum first.
+func allocate(strata [][]*model_cassandra.Question, count, total int) []int {
+	quotas := make([]int, len(strata))
+	remainders := make([]int, len(strata))
+	order := make([]int, len(strata))
+
+	allocated := 0
+	for idx, stratum := range strata {
+		quotas[idx] = count * len(stratum) / total
+		remainders[idx] = count * len(stratum) % total
+		order[idx] = idx
+		allocated += quotas[idx]
+	}
+
+	sort.SliceStable(order, func(i, j int) bool { return remainders[order[i]] > remainders[order[j]] })
+	for _, idx := range order[:count-allocated] {
+		quotas[idx]++
+	}
+
+	return quotas
+}
+
+// withQuestions will create a copy of a quiz that presents the supplied questions in place of its draw.
+func withQuestions(quiz *model_cassandra.QuizCore, questions []*model_cassandra.Question) *model_cassandra.QuizCore {
+	drawn := *quiz
+	drawn.Questions = questions
+	drawn.Draw = nil
+	return &drawn
+}
+
+// isDrawn reports whether the questions of a quiz are drawn from question banks for each user and attempt.
+func isDrawn(quiz *model_cassandra.QuizCore) bool {
+	return quiz != nil && quiz.Draw != nil
+}
@@ -0,0 +1,294 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+// drawTestBank will generate a question bank with questions of each difficulty, tagged by topic.
+func drawTestBank(author string) *model_cassandra.QuestionBank {
+	bank := &model_cassandra.QuestionBank{
+		QuestionBankCore: &model_cassandra.QuestionBankCore{Title: "Drawn bank"},
+		BankID:           gocql.TimeUUID(),
+		Author:           author,
+	}
+	for idx, difficulty := range []string{"easy", "easy", "easy", "easy", "medium", "medium", "medium", "hard", "hard", "hard"} {
+		bank.Questions = append(bank.Questions, &model_cassandra.Question{
+			Description: fmt.Sprintf("Question %d", idx),
+			Options:     []string{"option 1", "option 2"},
+			Answers:     []int32{0},
+			Tags:        []string{fmt.Sprintf("topic %d", idx%2)},
+			Difficulty:  difficulty,
+		})
+	}
+	return bank
+}
+
+func TestDrawQuestions(t *testing.T) {
+	pool := drawTestBank("author").Questions
+
+	t.Run("insufficient questions", func(t *testing.T) {
+		_, err := DrawQuestions(&model_cassandra.QuestionDraw{Count: len(pool) + 1}, pool, 42)
+		require.Error(t, err, "drew more questions than are in the pool")
+		require.True(t, errors.Is(err, ErrInsufficientQuestions), "error type mismatch")
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		draw := &model_cassandra.QuestionDraw{Count: 5}
+		first, err := DrawQuestions(draw, pool, 42)
+		require.NoError(t, err, "failed to draw questions")
+		second, err := DrawQuestions(draw, pool, 42)
+		require.NoError(t, err, "failed to redraw questions")
+		require.Equal(t, first, second, "draws with the same seed differ")
+	})
+
+	t.Run("unique questions", func(t *testing.T) {
+		for seed := int64(0); seed < 50; seed++ {
+			drawn, err := DrawQuestions(&model_cassandra.QuestionDraw{Count: len(pool)}, pool, seed)
+			require.NoError(t, err, "failed to draw questions")
+			require.ElementsMatch(t, pool, drawn, "entire pool not drawn for seed %d", seed)
+		}
+	})
+
+	testCases := []struct {
+		name       string
+		stratifyBy string
+		count      int
+		expected   map[string]int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:       "by difficulty",
+			stratifyBy: model_cassandra.StratifyByDifficulty,
+			count:      5,
+			expected:   map[string]int{"easy": 2, "medium": 2, "hard": 1},
+		}, {
+			name:       "by tag",
+			stratifyBy: model_cassandra.StratifyByTag,
+			count:      4,
+			expected:   map[string]int{"topic 0": 2, "topic 1": 2},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			draw := &model_cassandra.QuestionDraw{Count: testCase.count, StratifyBy: testCase.stratifyBy}
+			for seed := int64(0); seed < 50; seed++ {
+				drawn, err := DrawQuestions(draw, pool, seed)
+				require.NoError(t, err, "failed to draw questions")
+				require.Equal(t, testCase.count, len(drawn), "number of questions drawn mismatch")
+
+				actual := make(map[string]int)
+				for _, question := range drawn {
+					actual[stratumKey(question, testCase.stratifyBy)]++
+				}
+				require.Equal(t, testCase.expected, actual, "strata mismatch for seed %d", seed)
+			}
+		})
+	}
+}
+
+func TestDrawPool(t *testing.T) {
+	owned := drawTestBank("author")
+	other := drawTestBank("other")
+	deleted := drawTestBank("author")
+	deleted.IsDeleted = true
+
+	quiz := &model_cassandra.Quiz{
+		QuizCore: &model_cassandra.QuizCore{Draw: &model_cassandra.QuestionDraw{
+			Banks: []string{owned.BankID.String(), other.BankID.String(), deleted.BankID.String(), gocql.TimeUUID().String()},
+			Count: 1,
+		}},
+		Author: "author",
+	}
+
+	pool := drawPool(quiz, []*model_cassandra.QuestionBank{deleted, other, owned})
+	require.Equal(t, owned.Questions, pool, "pool must only contain the questions of the author's banks")
+}
+
+func TestPresentQuiz_Drawn(t *testing.T) {
+	bank := drawTestBank("author")
+	quiz := &model_cassandra.Quiz{
+		QuizCore: &model_cassandra.QuizCore{Title: "Drawn quiz", MarkingType: "binary",
+			Draw: &model_cassandra.QuestionDraw{Banks: []string{bank.BankID.String()}, Count: 3}},
+		QuizID: gocql.TimeUUID(),
+		Author: "author",
+	}
+	stored := &model_cassandra.AttemptDraw{Username: "username", QuizID: quiz.QuizID, Attempt: 1, Questions: bank.Questions[:3]}
+	expected := withQuestions(quiz.QuizCore, stored.Questions)
+	notFound := &cassandra.Error{Message: "not found", Status: http.StatusNotFound}
+	failure := &cassandra.Error{Message: "failure", Status: http.StatusInternalServerError}
+
+	testCases := []struct {
+		name      string
+		responses []*MockCassandraData
+		expected  *model_cassandra.QuizCore
+		expectErr require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name: "already drawn",
+			responses: []*MockCassandraData{
+				{OutputErr: notFound},
+				{OutputParam: stored},
+			},
+			expected:  expected,
+			expectErr: require.NoError,
+		}, {
+			name: "first view",
+			responses: []*MockCassandraData{
+				{OutputErr: notFound},
+				{OutputErr: notFound},
+				{OutputParam: []*model_cassandra.QuestionBank{bank}},
+				{OutputParam: stored},
+			},
+			expected:  expected,
+			expectErr: require.NoError,
+		}, {
+			name: "draw read db failure",
+			responses: []*MockCassandraData{
+				{OutputErr: notFound},
+				{OutputErr: failure},
+			},
+			expectErr: require.Error,
+		}, {
+			name: "bank read db failure",
+			responses: []*MockCassandraData{
+				{OutputErr: notFound},
+				{OutputErr: notFound},
+				{OutputErr: failure},
+			},
+			expectErr: require.Error,
+		}, {
+			name: "insufficient questions",
+			responses: []*MockCassandraData{
+				{OutputErr: notFound},
+				{OutputErr: notFound},
+				{OutputParam: []*model_cassandra.QuestionBank{}},
+			},
+			expectErr: require.Error,
+		}, {
+			name: "draw create db failure",
+			responses: []*MockCassandraData{
+				{OutputErr: notFound},
+				{OutputErr: notFound},
+				{OutputParam: []*model_cassandra.QuestionBank{bank}},
+				{OutputErr: failure},
+			},
+			expectErr: require.Error,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			calls := make([]*gomock.Call, 0, len(testCase.responses))
+			for _, response := range testCase.responses {
+				calls = append(calls, mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					response.OutputParam,
+					response.OutputErr,
+				).Times(1))
+			}
+			gomock.InOrder(calls...)
+
+			presented, err := PresentQuiz(quiz, "username", mockCassandra)
+			testCase.expectErr(t, err, "error expectation failed")
+			require.Equal(t, testCase.expected, presented, "presented quiz mismatch")
+			if presented != nil {
+				require.Nil(t, presented.Draw, "presented quiz must not contain the draw")
+			}
+		})
+	}
+}
+
+func TestDrawnQuiz(t *testing.T) {
+	bank := drawTestBank("author")
+	quiz := &model_cassandra.Quiz{
+		QuizCore: &model_cassandra.QuizCore{Title: "Drawn quiz", MarkingType: "binary",
+			Draw: &model_cassandra.QuestionDraw{Banks: []string{bank.BankID.String()}, Count: 3}},
+		QuizID: gocql.TimeUUID(),
+		Author: "author",
+	}
+	fixed := &model_cassandra.Quiz{QuizID: gocql.TimeUUID(), QuizCore: testQuizData["myPubQuiz"].QuizCore}
+	stored := &model_cassandra.AttemptDraw{Username: "username", QuizID: quiz.QuizID, Attempt: 2, Questions: bank.Questions[:3]}
+
+	testCases := []struct {
+		name          string
+		quiz          *model_cassandra.Quiz
+		cassandraData *MockCassandraData
+		expected      *model_cassandra.QuizCore
+		expectedErr   error
+		expectErr     require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:          "not drawn",
+			quiz:          fixed,
+			cassandraData: &MockCassandraData{Times: 0},
+			expected:      fixed.QuizCore,
+			expectErr:     require.NoError,
+		}, {
+			name: "not viewed",
+			quiz: quiz,
+			cassandraData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			expectedErr: ErrAttemptNotViewed,
+			expectErr:   require.Error,
+		}, {
+			name: "db failure",
+			quiz: quiz,
+			cassandraData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			expectErr: require.Error,
+		}, {
+			name:          "drawn",
+			quiz:          quiz,
+			cassandraData: &MockCassandraData{OutputParam: stored, Times: 1},
+			expected:      withQuestions(quiz.QuizCore, stored.Questions),
+			expectErr:     require.NoError,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+					require.Equal(t, 2, params.(*model_cassandra.AttemptDrawRequest).Attempt, "attempt mismatch")
+					return testCase.cassandraData.OutputParam, testCase.cassandraData.OutputErr
+				}).Times(testCase.cassandraData.Times)
+
+			previous := &model_cassandra.Response{Attempts: []*model_cassandra.Attempt{{Number: 1}}}
+			drawn, err := DrawnQuiz(testCase.quiz, "username", previous, mockCassandra)
+			testCase.expectErr(t, err, "error expectation failed")
+			if testCase.expectedErr != nil {
+				require.True(t, errors.Is(err, testCase.expectedErr), "error type mismatch")
+			}
+			if err == nil {
+				require.Equal(t, testCase.expected, drawn.QuizCore, "drawn quiz mismatch")
+				require.Equal(t, testCase.quiz.QuizID, drawn.QuizID, "quiz id mismatch")
+			}
+		})
+	}
+}
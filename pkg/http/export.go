@@ -0,0 +1,216 @@
+package http
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/grading"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+)
+
+// ExportPageSize is the number of responses read from the database in each page of an export.
+const ExportPageSize = 100
+
+// Export formats are the content types the results of a quiz can be exported in.
+const (
+	ExportFormatCSV    = "text/csv"             // Comma separated values with a header row.
+	ExportFormatNDJSON = "application/x-ndjson" // Newline delimited JSON with a result record per line.
+)
+
+// ErrExportCorrectness is returned when the correctness of the answers is requested in an export of a quiz drawn from
+// question banks, where the questions differ between attempts.
+var ErrExportCorrectness = errors.New("correctness is unavailable for quizzes drawn from question banks")
+
+// resultsEncoder writes result records to an export in a specific format.
+type resultsEncoder interface {
+	encode(record *model_http.ResultRecord) error
+	flush() error
+}
+
+// ExportResults will page through all the responses to a quiz and write their effective answers and scores in the export
+// format. The correctness of every answer is graded against the version of the quiz the response was graded against, and
+// is left empty if that version is no longer available. The writer is flushed after every page and nothing is written
+// until the first page has been read.
+func ExportResults(quiz *model_cassandra.Quiz, db cassandra.Cassandra, grader grading.Grading, format string, correctness bool,
+	writer io.Writer) error {
+	if correctness && isDrawn(quiz.QuizCore) {
+		return ErrExportCorrectness
+	}
+
+	encoder, err := newResultsEncoder(format, writer, numExportQuestions(quiz.QuizCore), correctness)
+	if err != nil {
+		return err
+	}
+
+	versions := map[int]*model_cassandra.QuizCore{quiz.Version: quiz.QuizCore}
+	request := &model_cassandra.StatsRequest{QuizID: quiz.QuizID, PageSize: ExportPageSize}
+
+	for {
+		dbRecord, err := db.Execute(cassandra.ReadResponseStatisticsPageQuery, request)
+		if err != nil {
+			return err
+		}
+		page := dbRecord.(*model_cassandra.StatsResponse)
+
+		for _, response := range page.Records {
+			record := &model_http.ResultRecord{Username: response.Username, Score: response.Score, MaxScore: response.MaxScore,
+				Version: response.Version, Attempts: len(AttemptHistory(response))}
+			if response.QuizResponse != nil {
+				record.Responses = response.Responses
+				record.TextResponses = response.TextResponses
+			}
+
+			if correctness {
+				if record.Correct, err = gradeCorrectness(quiz, versions, db, grader, response); err != nil {
+					return err
+				}
+			}
+
+			if err = encoder.encode(record); err != nil {
+				return err
+			}
+		}
+
+		if err = encoder.flush(); err != nil {
+			return err
+		}
+		if flusher, ok := writer.(http.Flusher); ok {
+			flusher.Flush()
+		}
+
+		if len(page.PageCursor) == 0 {
+			break
+		}
+		request.PageCursor = page.PageCursor
+	}
+
+	return nil
+}
+
+// numExportQuestions is the number of questions answered in each response to a quiz.
+func numExportQuestions(quiz *model_cassandra.QuizCore) int {
+	if isDrawn(quiz) {
+		return quiz.Draw.Count
+	}
+	return len(quiz.Questions)
+}
+
+// gradeCorrectness will grade every question in a response against the version of the quiz it was graded against. Versions
+// are read from the database once per export. Responses to versions that are no longer available, or that can no longer be
+// graded, have no correctness.
+func gradeCorrectness(quiz *model_cassandra.Quiz, versions map[int]*model_cassandra.QuizCore, db cassandra.Cassandra,
+	grader grading.Grading, response *model_cassandra.Response) ([]bool, error) {
+	quizCore, err := readVersion(quiz, response.Version, versions, db)
+	if err != nil {
+		return nil, err
+	}
+
+	if quizCore == nil || response.QuizResponse == nil {
+		return nil, nil
+	}
+
+	_, correct, err := grader.GradeQuestions(response.QuizResponse, quizCore)
+	if err != nil {
+		return nil, nil
+	}
+
+	return correct, nil
+}
+
+// newResultsEncoder will create an encoder for the export format. CSV exports write their header row once the first page
+// is flushed.
+func newResultsEncoder(format string, writer io.Writer, numQuestions int, correctness bool) (resultsEncoder, error) {
+	switch format {
+	case ExportFormatCSV:
+		encoder := &csvResultsEncoder{writer: csv.NewWriter(writer), numQuestions: numQuestions, correctness: correctness}
+		return encoder, encoder.header()
+	case ExportFormatNDJSON:
+		return &ndjsonResultsEncoder{encoder: json.NewEncoder(writer)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %s", format)
+	}
+}
+
+// csvResultsEncoder writes result records as rows with a column for the answer to every question.
+type csvResultsEncoder struct {
+	writer       *csv.Writer
+	numQuestions int
+	correctness  bool
+}
+
+// header writes the header row. Questions are numbered from one.
+func (e *csvResultsEncoder) header() error {
+	row := []string{"username", "score", "max_score", "version", "attempts"}
+	for idx := 1; idx <= e.numQuestions; idx++ {
+		row = append(row, fmt.Sprintf("q%d", idx))
+	}
+	for idx := 1; e.correctness && idx <= e.numQuestions; idx++ {
+		row = append(row, fmt.Sprintf("q%d_correct", idx))
+	}
+	return e.writer.Write(row)
+}
+
+// encode writes a result record as a row. Selected options are separated by semicolons, and questions without any selected
+// options contain the numeric or text answer.
+func (e *csvResultsEncoder) encode(record *model_http.ResultRecord) error {
+	row := []string{
+		record.Username,
+		strconv.FormatFloat(record.Score, 'f', -1, 64),
+		strconv.FormatFloat(record.MaxScore, 'f', -1, 64),
+		strconv.Itoa(record.Version),
+		strconv.Itoa(record.Attempts),
+	}
+
+	for idx := 0; idx < e.numQuestions; idx++ {
+		var answer string
+		if idx < len(record.Responses) && len(record.Responses[idx]) > 0 {
+			options := make([]string, len(record.Responses[idx]))
+			for option, selected := range record.Responses[idx] {
+				options[option] = strconv.Itoa(int(selected))
+			}
+			answer = strings.Join(options, ";")
+		} else if idx < len(record.TextResponses) {
+			answer = record.TextResponses[idx]
+		}
+		row = append(row, answer)
+	}
+
+	for idx := 0; e.correctness && idx < e.numQuestions; idx++ {
+		var correct string
+		if idx < len(record.Correct) {
+			correct = strconv.FormatBool(record.Correct[idx])
+		}
+		row = append(row, correct)
+	}
+
+	return e.writer.Write(row)
+}
+
+// flush writes the buffered rows.
+func (e *csvResultsEncoder) flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// ndjsonResultsEncoder writes result records as JSON objects on separate lines.
+type ndjsonResultsEncoder struct {
+	encoder *json.Encoder
+}
+
+// encode writes a result record followed by a newline.
+func (e *ndjsonResultsEncoder) encode(record *model_http.ResultRecord) error {
+	return e.encoder.Encode(record)
+}
+
+// flush has nothing to write because records are written as they are encoded.
+func (e *ndjsonResultsEncoder) flush() error {
+	return nil
+}
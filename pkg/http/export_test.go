@@ -0,0 +1,186 @@
+package http
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+func TestExportResults(t *testing.T) {
+	quizId := gocql.TimeUUID()
+	quiz := &model_cassandra.Quiz{QuizID: quizId, Version: 2, QuizCore: &model_cassandra.QuizCore{
+		Questions: []*model_cassandra.Question{
+			{Description: "choice", Options: []string{"a", "b", "c"}, Answers: []int32{0}},
+			{Description: "numeric", Type: model_cassandra.QuestionNumeric},
+		},
+	}}
+	drawnQuiz := &model_cassandra.Quiz{QuizID: quizId, Version: 1, QuizCore: &model_cassandra.QuizCore{
+		Draw: &model_cassandra.QuestionDraw{Count: 1},
+	}}
+	newResponse := func(username string, version int, options []int32, text string) *model_cassandra.Response {
+		return &model_cassandra.Response{Username: username, QuizID: quizId, Score: 1, MaxScore: 2, Version: version,
+			Attempts:     []*model_cassandra.Attempt{{Number: 1}},
+			QuizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{options}, TextResponses: []string{"", text}}}
+	}
+
+	testCases := []struct {
+		name        string
+		quiz        *model_cassandra.Quiz
+		format      string
+		correctness bool
+		pages       []*model_cassandra.StatsResponse
+		pageErr     error
+		versionErr  error
+		expectErr   require.ErrorAssertionFunc
+		expected    string
+	}{
+		// ----- test cases start ----- //
+		{
+			name:        "correctness of drawn quiz",
+			quiz:        drawnQuiz,
+			format:      ExportFormatCSV,
+			correctness: true,
+			expectErr:   require.Error,
+		}, {
+			name:      "unsupported format",
+			quiz:      quiz,
+			format:    "application/xml",
+			expectErr: require.Error,
+		}, {
+			name:      "page read failure",
+			quiz:      quiz,
+			format:    ExportFormatCSV,
+			pageErr:   &cassandra.Error{Message: "page read failure", Status: http.StatusInternalServerError},
+			expectErr: require.Error,
+		}, {
+			name:      "csv no responses",
+			quiz:      quiz,
+			format:    ExportFormatCSV,
+			pages:     []*model_cassandra.StatsResponse{{}},
+			expectErr: require.NoError,
+			expected:  "username,score,max_score,version,attempts,q1,q2\n",
+		}, {
+			name:   "csv drawn quiz",
+			quiz:   drawnQuiz,
+			format: ExportFormatCSV,
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse("user1", 1, []int32{0, 2}, "")}},
+			},
+			expectErr: require.NoError,
+			expected: "username,score,max_score,version,attempts,q1\n" +
+				"user1,1,2,1,1,0;2\n",
+		}, {
+			name:   "csv response recorded before attempts",
+			quiz:   quiz,
+			format: ExportFormatCSV,
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{{Username: "user1", QuizID: quizId, Score: 1, MaxScore: 2, Version: 1,
+					QuizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{0}}, TextResponses: []string{"", "3.14"}}}}},
+			},
+			expectErr: require.NoError,
+			expected: "username,score,max_score,version,attempts,q1,q2\n" +
+				"user1,1,2,1,1,0,3.14\n",
+		}, {
+			name:        "csv multiple pages with correctness",
+			quiz:        quiz,
+			format:      ExportFormatCSV,
+			correctness: true,
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse("user1", 2, []int32{0}, "3.14")}, PageCursor: []byte("cursor")},
+				{Records: []*model_cassandra.Response{newResponse("user2", 1, []int32{1}, "2, 71"), newResponse("user3", 1, nil, "")}},
+			},
+			expectErr: require.NoError,
+			expected: "username,score,max_score,version,attempts,q1,q2,q1_correct,q2_correct\n" +
+				"user1,1,2,2,1,0,3.14,true,false\n" +
+				"user2,1,2,1,1,1,\"2, 71\",false,false\n" +
+				"user3,1,2,1,1,,,false,false\n",
+		}, {
+			name:        "csv with unavailable version",
+			quiz:        quiz,
+			format:      ExportFormatCSV,
+			correctness: true,
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse("user1", 1, []int32{0}, "")}},
+			},
+			versionErr: &cassandra.Error{Message: "version not found", Status: http.StatusNotFound},
+			expectErr:  require.NoError,
+			expected: "username,score,max_score,version,attempts,q1,q2,q1_correct,q2_correct\n" +
+				"user1,1,2,1,1,0,,,\n",
+		}, {
+			name:        "version read failure",
+			quiz:        quiz,
+			format:      ExportFormatCSV,
+			correctness: true,
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse("user1", 1, []int32{0}, "")}},
+			},
+			versionErr: &cassandra.Error{Message: "version read failure", Status: http.StatusInternalServerError},
+			expectErr:  require.Error,
+		}, {
+			name:        "ndjson with correctness",
+			quiz:        quiz,
+			format:      ExportFormatNDJSON,
+			correctness: true,
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse("user1", 2, []int32{0}, "3.14")}},
+			},
+			expectErr: require.NoError,
+			expected: `{"username":"user1","score":1,"max_score":2,"version":2,"attempts":1,"responses":[[0]],` +
+				`"text_responses":["","3.14"],"correct":[true,false]}` + "\n",
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockGrader := mocks.NewMockGrading(mockCtrl)
+
+			pageIdx := 0
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+					switch request := params.(type) {
+					case *model_cassandra.StatsRequest:
+						require.Equal(t, ExportPageSize, request.PageSize, "page size mismatch")
+						if testCase.pageErr != nil {
+							return nil, testCase.pageErr
+						}
+						pageIdx++
+						return testCase.pages[pageIdx-1], nil
+					case *model_cassandra.QuizVersionRequest:
+						if testCase.versionErr != nil {
+							return nil, testCase.versionErr
+						}
+						return &model_cassandra.QuizVersion{QuizID: quizId, Version: request.Version, QuizCore: quiz.QuizCore}, nil
+					}
+					return nil, errors.New("unexpected query")
+				}).AnyTimes()
+
+			// The first question is answered correctly with the first option.
+			mockGrader.EXPECT().GradeQuestions(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(response *model_cassandra.QuizResponse, _ *model_cassandra.QuizCore) ([]float64, []bool, error) {
+					correct := len(response.Responses[0]) > 0 && response.Responses[0][0] == 0
+					return []float64{0, 0}, []bool{correct, false}, nil
+				}).AnyTimes()
+
+			buffer := &bytes.Buffer{}
+			err := ExportResults(testCase.quiz, mockCassandra, mockGrader, testCase.format, testCase.correctness, buffer)
+			testCase.expectErr(t, err, "error expectation failed")
+			if err != nil {
+				return
+			}
+
+			require.Equal(t, testCase.expected, buffer.String(), "export does not match expected")
+		})
+	}
+}
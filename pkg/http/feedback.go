@@ -0,0 +1,134 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/grading"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+)
+
+// RevealAnswers reports whether the answer key, explanations, and feedback of a quiz are revealed to the users that took it
+// at a given time. Quizzes without a reveal policy never reveal their answers.
+func RevealAnswers(quiz *model_cassandra.Quiz, now time.Time) bool {
+	if quiz == nil || quiz.QuizCore == nil {
+		return false
+	}
+
+	switch quiz.RevealPolicy {
+	case model_cassandra.RevealImmediately:
+		return true
+	case model_cassandra.RevealAfterClose:
+		return IsClosed(quiz, now)
+	default:
+		return false
+	}
+}
+
+// BreakdownAttempt will grade every question in the answers to an attempt and pair the answers with the answer key, the
+// points awarded, the explanation, and the feedback for the selected options. The answers must be in the canonical order
+// of the quiz. Late attempts are awarded no points.
+func BreakdownAttempt(quiz *model_cassandra.QuizCore, answers *model_cassandra.QuizResponse, late bool,
+	grader grading.Grading) ([]*model_http.QuestionBreakdown, error) {
+	if answers == nil {
+		answers = &model_cassandra.QuizResponse{}
+	}
+
+	points, correct, err := grader.GradeQuestions(answers, quiz)
+	if err != nil {
+		return nil, err
+	}
+
+	breakdown := make([]*model_http.QuestionBreakdown, 0, len(quiz.Questions))
+	for idx, question := range quiz.Questions {
+		entry := &model_http.QuestionBreakdown{
+			Question:      idx,
+			Description:   question.Description,
+			Type:          question.Type,
+			Options:       question.Options,
+			Matches:       question.Matches,
+			Answers:       question.Answers,
+			NumericAnswer: question.NumericAnswer,
+			TextAnswers:   question.TextAnswers,
+			Correct:       correct[idx],
+			Points:        points[idx],
+			MaxPoints:     grading.QuestionWeight(question),
+			Explanation:   question.Explanation,
+		}
+		if late {
+			entry.Points = 0
+		}
+		if idx < len(answers.Responses) {
+			entry.Selected = answers.Responses[idx]
+		}
+		if idx < len(answers.TextResponses) {
+			entry.TextResponse = answers.TextResponses[idx]
+		}
+		if len(question.Feedback) > 0 {
+			for _, option := range entry.Selected {
+				feedback := ""
+				if option >= 0 && int(option) < len(question.Feedback) {
+					feedback = question.Feedback[option]
+				}
+				entry.Feedback = append(entry.Feedback, feedback)
+			}
+		}
+		breakdown = append(breakdown, entry)
+	}
+
+	return breakdown, nil
+}
+
+// ScoreBreakdown will break down the effective attempt in a user's response to a quiz if the answers to the quiz are
+// revealed. The attempt is graded against the version of the quiz it was graded against and, for quizzes drawn from
+// question banks, the questions drawn for it. No breakdown is returned if the answers are not revealed, or if the version
+// or drawn questions are no longer available or can no longer be graded.
+func ScoreBreakdown(quiz *model_cassandra.Quiz, response *model_cassandra.Response, db cassandra.Cassandra,
+	grader grading.Grading, now time.Time) ([]*model_http.QuestionBreakdown, error) {
+	if !RevealAnswers(quiz, now) {
+		return nil, nil
+	}
+
+	attempt := effectiveAttempt(AttemptHistory(response), quiz.QuizCore)
+	if attempt == nil {
+		return nil, nil
+	}
+
+	graded := quiz
+	if attempt.Version != quiz.Version {
+		dbRecord, err := db.Execute(cassandra.ReadQuizVersionQuery,
+			&model_cassandra.QuizVersionRequest{QuizID: quiz.QuizID, Version: attempt.Version})
+		if err != nil {
+			return nil, ignoreNotFound(err)
+		}
+		version := *quiz
+		version.QuizCore = dbRecord.(*model_cassandra.QuizVersion).QuizCore
+		graded = &version
+	}
+
+	core := graded.QuizCore
+	if isDrawn(core) {
+		var err error
+		if core, err = readAttemptDraw(graded, response.Username, attempt.Number, db); err != nil {
+			return nil, ignoreNotFound(err)
+		}
+	}
+
+	answers := &model_cassandra.QuizResponse{Responses: attempt.Responses, TextResponses: attempt.TextResponses}
+	breakdown, err := BreakdownAttempt(core, answers, attempt.Late, grader)
+	if err != nil {
+		return nil, nil
+	}
+
+	return breakdown, nil
+}
+
+// ignoreNotFound will discard database errors for records that were not found.
+func ignoreNotFound(err error) error {
+	if cassandraErr, ok := err.(*cassandra.Error); ok && cassandraErr.Status == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
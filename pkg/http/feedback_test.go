@@ -0,0 +1,230 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/grading"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+// feedbackTestQuiz is a published quiz with explanations and option feedback that reveals its answers using the policy.
+func feedbackTestQuiz(policy string) *model_cassandra.Quiz {
+	return &model_cassandra.Quiz{QuizID: gocql.TimeUUID(), Author: "author", IsPublished: true, Version: 2,
+		QuizCore: &model_cassandra.QuizCore{Title: "Feedback quiz", MarkingType: "binary", RevealPolicy: policy,
+			Questions: []*model_cassandra.Question{
+				{Description: "Pick the prime", Options: []string{"4", "5", "6"}, Answers: []int32{1}, Points: 2,
+					Explanation: "5 has no divisors other than 1 and itself", Feedback: []string{"4 is even", "Correct", "6 is even"}},
+				{Description: "Capital of France?", Type: model_cassandra.QuestionShortText, TextAnswers: []string{"Paris"},
+					Explanation: "Paris has been the capital since 987"},
+			}}}
+}
+
+func TestRevealAnswers(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	testCases := []struct {
+		name     string
+		quiz     *model_cassandra.Quiz
+		expected bool
+	}{
+		// ----- test cases start ----- //
+		{
+			name: "no policy",
+			quiz: &model_cassandra.Quiz{QuizCore: &model_cassandra.QuizCore{}},
+		}, {
+			name: "never",
+			quiz: &model_cassandra.Quiz{QuizCore: &model_cassandra.QuizCore{RevealPolicy: model_cassandra.RevealNever}, IsClosed: true},
+		}, {
+			name:     "immediately",
+			quiz:     &model_cassandra.Quiz{QuizCore: &model_cassandra.QuizCore{RevealPolicy: model_cassandra.RevealImmediately}},
+			expected: true,
+		}, {
+			name: "after close while open",
+			quiz: &model_cassandra.Quiz{QuizCore: &model_cassandra.QuizCore{RevealPolicy: model_cassandra.RevealAfterClose},
+				ClosesAt: &future},
+		}, {
+			name: "after close past closing time",
+			quiz: &model_cassandra.Quiz{QuizCore: &model_cassandra.QuizCore{RevealPolicy: model_cassandra.RevealAfterClose},
+				ClosesAt: &past},
+			expected: true,
+		}, {
+			name:     "after close closed by scheduler",
+			quiz:     &model_cassandra.Quiz{QuizCore: &model_cassandra.QuizCore{RevealPolicy: model_cassandra.RevealAfterClose}, IsClosed: true},
+			expected: true,
+		}, {
+			name: "missing quiz core",
+			quiz: &model_cassandra.Quiz{IsClosed: true},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, RevealAnswers(testCase.quiz, now), "reveal mismatch")
+		})
+	}
+}
+
+func TestBreakdownAttempt(t *testing.T) {
+	quiz := feedbackTestQuiz(model_cassandra.RevealImmediately)
+	grader := grading.NewGrading()
+
+	answers := &model_cassandra.QuizResponse{Responses: [][]int32{{0}}, TextResponses: []string{"", "paris"}}
+	breakdown, err := BreakdownAttempt(quiz.QuizCore, answers, false, grader)
+	require.NoError(t, err, "breakdown should not fail")
+	require.Len(t, breakdown, 2, "breakdown should have every question")
+
+	first := breakdown[0]
+	require.Equal(t, 0, first.Question, "question index mismatch")
+	require.Equal(t, []int32{0}, first.Selected, "selected options mismatch")
+	require.Equal(t, []int32{1}, first.Answers, "answer key mismatch")
+	require.False(t, first.Correct, "incorrect answer marked correct")
+	require.Equal(t, 0.0, first.Points, "incorrect answer awarded points")
+	require.Equal(t, 2.0, first.MaxPoints, "question weight mismatch")
+	require.Equal(t, quiz.Questions[0].Explanation, first.Explanation, "explanation mismatch")
+	require.Equal(t, []string{"4 is even"}, first.Feedback, "feedback should be for the selected option")
+
+	second := breakdown[1]
+	require.Equal(t, "paris", second.TextResponse, "text response mismatch")
+	require.Equal(t, []string{"Paris"}, second.TextAnswers, "text answers mismatch")
+	require.True(t, second.Correct, "correct answer marked incorrect")
+	require.Equal(t, 1.0, second.Points, "correct answer not awarded points")
+	require.Equal(t, 1.0, second.MaxPoints, "unweighted question should be worth a point")
+	require.Nil(t, second.Feedback, "questions without feedback should not have feedback")
+
+	// Late attempts are broken down without any points.
+	breakdown, err = BreakdownAttempt(quiz.QuizCore, answers, true, grader)
+	require.NoError(t, err, "late breakdown should not fail")
+	require.True(t, breakdown[1].Correct, "late answers should still be marked")
+	require.Equal(t, 0.0, breakdown[1].Points, "late answers should not be awarded points")
+
+	// Unanswered quizzes are broken down without selections.
+	breakdown, err = BreakdownAttempt(quiz.QuizCore, nil, false, grader)
+	require.NoError(t, err, "unanswered breakdown should not fail")
+	require.Nil(t, breakdown[0].Selected, "unanswered question should not have selections")
+	require.Nil(t, breakdown[0].Feedback, "unanswered question should not have feedback")
+
+	_, err = BreakdownAttempt(quiz.QuizCore, &model_cassandra.QuizResponse{Responses: [][]int32{{0, 1}}}, false, grader)
+	require.Error(t, err, "ungradable answers should fail")
+}
+
+func TestScoreBreakdown(t *testing.T) {
+	now := time.Now()
+	quiz := feedbackTestQuiz(model_cassandra.RevealImmediately)
+	previous := feedbackTestQuiz(model_cassandra.RevealImmediately).QuizCore
+	previous.Questions = previous.Questions[:1]
+	drawn := feedbackTestQuiz(model_cassandra.RevealImmediately)
+	drawn.Questions = nil
+	drawn.Draw = &model_cassandra.QuestionDraw{Banks: []string{gocql.TimeUUID().String()}, Count: 1}
+	notFound := &cassandra.Error{Message: "not found", Status: http.StatusNotFound}
+	failure := &cassandra.Error{Message: "failure", Status: http.StatusInternalServerError}
+
+	newResponse := func(attempts ...*model_cassandra.Attempt) *model_cassandra.Response {
+		return &model_cassandra.Response{Username: "username", QuizID: quiz.QuizID, Attempts: attempts}
+	}
+	best := &model_cassandra.Attempt{Number: 1, Score: 3, Version: 2, Responses: [][]int32{{1}}, TextResponses: []string{"", "Paris"}}
+	worst := &model_cassandra.Attempt{Number: 2, Score: 0, Version: 2, Responses: [][]int32{{0}}}
+	oldVersion := &model_cassandra.Attempt{Number: 1, Score: 2, Version: 1, Responses: [][]int32{{2}}}
+	drawnAttempt := &model_cassandra.Attempt{Number: 1, Score: 2, Version: 2, Responses: [][]int32{{1}}}
+
+	testCases := []struct {
+		name          string
+		quiz          *model_cassandra.Quiz
+		response      *model_cassandra.Response
+		cassandraData []*MockCassandraData
+		expectedLen   int
+		expectedPick  []int32
+		expectErr     require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "not revealed",
+			quiz:      feedbackTestQuiz(model_cassandra.RevealNever),
+			response:  newResponse(best),
+			expectErr: require.NoError,
+		}, {
+			name:         "best attempt",
+			quiz:         quiz,
+			response:     newResponse(best, worst),
+			expectedLen:  2,
+			expectedPick: []int32{1},
+			expectErr:    require.NoError,
+		}, {
+			name:         "previous version",
+			quiz:         quiz,
+			response:     newResponse(oldVersion),
+			expectedLen:  1,
+			expectedPick: []int32{2},
+			cassandraData: []*MockCassandraData{
+				{OutputParam: &model_cassandra.QuizVersion{QuizID: quiz.QuizID, Version: 1, QuizCore: previous}},
+			},
+			expectErr: require.NoError,
+		}, {
+			name:          "previous version unavailable",
+			quiz:          quiz,
+			response:      newResponse(oldVersion),
+			cassandraData: []*MockCassandraData{{OutputErr: notFound}},
+			expectErr:     require.NoError,
+		}, {
+			name:          "previous version db failure",
+			quiz:          quiz,
+			response:      newResponse(oldVersion),
+			cassandraData: []*MockCassandraData{{OutputErr: failure}},
+			expectErr:     require.Error,
+		}, {
+			name:         "drawn questions",
+			quiz:         drawn,
+			response:     newResponse(drawnAttempt),
+			expectedLen:  1,
+			expectedPick: []int32{1},
+			cassandraData: []*MockCassandraData{
+				{OutputParam: &model_cassandra.AttemptDraw{Questions: quiz.Questions[:1]}},
+			},
+			expectErr: require.NoError,
+		}, {
+			name:          "drawn questions unavailable",
+			quiz:          drawn,
+			response:      newResponse(drawnAttempt),
+			cassandraData: []*MockCassandraData{{OutputErr: notFound}},
+			expectErr:     require.NoError,
+		}, {
+			name:      "ungradable attempt",
+			quiz:      quiz,
+			response:  newResponse(&model_cassandra.Attempt{Number: 1, Version: 2, Responses: [][]int32{{0, 1}}}),
+			expectErr: require.NoError,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			calls := make([]*gomock.Call, 0, len(testCase.cassandraData))
+			for _, data := range testCase.cassandraData {
+				calls = append(calls, mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					data.OutputParam,
+					data.OutputErr,
+				).Times(1))
+			}
+			gomock.InOrder(calls...)
+
+			breakdown, err := ScoreBreakdown(testCase.quiz, testCase.response, mockCassandra, grading.NewGrading(), now)
+			testCase.expectErr(t, err, "error expectation failed")
+			require.Len(t, breakdown, testCase.expectedLen, "breakdown length mismatch")
+			if testCase.expectedLen > 0 {
+				require.Equal(t, testCase.expectedPick, breakdown[0].Selected, "effective attempt mismatch")
+			}
+		})
+	}
+}
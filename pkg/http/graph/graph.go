@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/afero"
 	"github.com/surahman/mcq-platform/pkg/auth"
 	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/catalogue"
 	"github.com/surahman/mcq-platform/pkg/grading"
 	"github.com/surahman/mcq-platform/pkg/http/graph/resolvers"
 	"github.com/surahman/mcq-platform/pkg/logger"
@@ -26,18 +27,19 @@ import (
 
 // Server is the HTTP GraphQL server.
 type Server struct {
-	auth    auth.Auth
-	cache   redis.Redis
-	db      cassandra.Cassandra
-	grading grading.Grading
-	conf    *config
-	logger  *logger.Logger
-	router  *gin.Engine
-	wg      *sync.WaitGroup
+	auth      auth.Auth
+	cache     redis.Redis
+	catalogue catalogue.Catalogue
+	db        cassandra.Cassandra
+	grading   grading.Grading
+	conf      *config
+	logger    *logger.Logger
+	router    *gin.Engine
+	wg        *sync.WaitGroup
 }
 
 // NewServer will create a new REST server instance in a non-running state.
-func NewServer(fs *afero.Fs, auth auth.Auth, cassandra cassandra.Cassandra, redis redis.Redis,
+func NewServer(fs *afero.Fs, auth auth.Auth, cassandra cassandra.Cassandra, redis redis.Redis, catalogue catalogue.Catalogue,
 	grading grading.Grading, logger *logger.Logger, wg *sync.WaitGroup) (server *Server, err error) {
 	// Load configurations.
 	conf := newConfig()
@@ -46,13 +48,14 @@ func NewServer(fs *afero.Fs, auth auth.Auth, cassandra cassandra.Cassandra, redi
 	}
 
 	return &Server{
-			conf:    conf,
-			auth:    auth,
-			cache:   redis,
-			db:      cassandra,
-			grading: grading,
-			logger:  logger,
-			wg:      wg,
+			conf:      conf,
+			auth:      auth,
+			cache:     redis,
+			catalogue: catalogue,
+			db:        cassandra,
+			grading:   grading,
+			logger:    logger,
+			wg:        wg,
 		},
 		err
 }
@@ -105,6 +108,6 @@ func (s *Server) initialize() {
 	api := s.router.Group(s.conf.Server.BasePath)
 	api.Use(graphql_resolvers.GinContextToContextMiddleware())
 
-	api.POST(s.conf.Server.QueryPath, graphql_resolvers.QueryHandler(s.conf.Authorization.HeaderKey, s.auth, s.cache, s.db, s.grading, s.logger))
+	api.POST(s.conf.Server.QueryPath, graphql_resolvers.QueryHandler(s.conf.Authorization.HeaderKey, s.auth, s.cache, s.catalogue, s.db, s.grading, s.logger))
 	api.GET(s.conf.Server.PlaygroundPath, graphql_resolvers.PlaygroundHandler(s.conf.Server.BasePath, s.conf.Server.QueryPath))
 }
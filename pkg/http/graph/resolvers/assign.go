@@ -0,0 +1,95 @@
+package graphql_resolvers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/redis"
+	"go.uber.org/zap"
+)
+
+// assignQuiz will assign a quiz to, or unassign it from, a group with the supplied database query.
+func (r *Resolver) assignQuiz(ctx context.Context, groupID, quizID string, query func(cassandra.Cassandra, any) (any, error),
+	action string) (string, error) {
+	var err error
+	var username, role string
+	var groupId, quizId gocql.UUID
+	var quiz *model_cassandra.Quiz
+	var response any
+
+	if groupId, err = gocql.ParseUUID(groupID); err != nil {
+		return "", errors.New("invalid group id supplied, must be a valid UUID")
+	}
+
+	if quizId, err = gocql.ParseUUID(quizID); err != nil {
+		return "", errors.New("invalid quiz id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return "", err
+	}
+
+	if err = http_common.CheckGroupOwner(groupId, username, role, r.DB); err != nil {
+		return "", err
+	}
+
+	if quiz, err = http_common.GetQuiz(quizId, r.DB, r.Cache); err != nil {
+		return "", err
+	}
+
+	if !http_common.CanManage(quiz.Author, username, role) {
+		return "", errors.New("requester does not manage the quiz")
+	}
+
+	// Evict from cache, if present.
+	// This step must be executed before the assignment to ensure the end user is able to reattempt the command in the event of failure.
+	// It must not be the case that a quiz with stale group restrictions remains in the cache till LRU eviction or TTL expiration.
+	if err = r.Cache.Del(quizId.String()); err != nil && err.(*redis.Error).Code != redis.ErrorCacheMiss {
+		r.Logger.Error("failed to evict data from cache", zap.Error(err))
+		return "", errors.New("please retry the command at a later time")
+	}
+
+	request := model_cassandra.QuizAssignRequest{
+		Author:     quiz.Author,
+		AssignedBy: username,
+		GroupID:    groupId,
+		QuizID:     quizId,
+		AssignedAt: time.Now(),
+	}
+	if quiz.QuizCore != nil {
+		request.Title = quiz.Title
+	}
+	if _, err = r.DB.Execute(query, &request); err != nil {
+		return "", err
+	}
+
+	// Success message should be set here because the assignment succeeded.
+	// Any failures below this point are catalogue and cache related and should be logged but not propagated to the end user.
+	returnMsg := fmt.Sprintf("%sed quiz with id %s", action, quizId.String())
+
+	// Update quiz in catalogue and cache.
+	// [1] Retrieve the quiz from Cassandra.
+	// [2] List in or remove from catalogue.
+	// [3] Place into Redis.
+	if response, err = r.DB.Execute(cassandra.ReadQuizQuery, quizId); err != nil {
+		r.Logger.Error("error retrieving quiz from database to be placed in catalogue and cache post "+action+"ment", zap.Error(err))
+		return returnMsg, nil
+	}
+	quiz = response.(*model_cassandra.Quiz)
+
+	r.Catalogue.Add(quiz)
+
+	if err = r.Cache.Set(quizId.String(), quiz); err != nil {
+		r.Logger.Error("error placing quiz in cache after "+action+"ment", zap.Error(err))
+		return returnMsg, nil
+	}
+
+	return returnMsg, nil
+}
@@ -0,0 +1,93 @@
+package graphql_resolvers
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	model_http "github.com/surahman/mcq-platform/pkg/model/http"
+)
+
+// submitAttempt will grade and record an attempt at a quiz. A nil answer card submits the draft saved for the attempt.
+func (r *Resolver) submitAttempt(username, role string, quizId gocql.UUID, quizResponse *model_cassandra.QuizResponse) (*model_http.ScoreCard, error) {
+	var err error
+	var quiz *model_cassandra.Quiz
+	var previous *model_cassandra.Response
+	var canonical *model_cassandra.QuizResponse
+	var breakdown []*model_http.QuestionBreakdown
+	var score, maxScore float64
+	var late bool
+
+	// Get quiz:
+	// [1] Cache call.
+	// [2] Cache miss: read from the database and store it in the cache.
+	if quiz, err = http_common.GetQuiz(quizId, r.DB, r.Cache); err != nil {
+		return nil, err
+	}
+
+	// Check to see if the quiz is deleted, unpublished, or closed.
+	now := time.Now()
+	if err = http_common.CheckAvailability(quiz, now); err != nil {
+		return nil, err
+	}
+
+	// Check to see if the quiz is restricted to groups the requester is not a member of.
+	if err = http_common.CheckGroupAccess(quiz, username, role, r.DB); err != nil {
+		return nil, err
+	}
+
+	// Check the previous attempts against the attempt limit and cooldown.
+	if previous, err = http_common.GetResponse(username, quizId, r.DB); err != nil {
+		return nil, err
+	}
+
+	if err = http_common.CheckAttempt(quiz, previous, now); err != nil {
+		return nil, err
+	}
+
+	// Load the answers saved in the draft of the attempt when submitting a draft.
+	if quizResponse == nil {
+		var draft *model_cassandra.AnswerDraft
+		if draft, err = http_common.GetDraft(username, quizId, previous, r.DB, r.Cache); err != nil {
+			return nil, err
+		}
+		quizResponse = draft.QuizResponse
+	}
+
+	// Check that attempts at timed quizzes were started and whether they are submitted after the deadline.
+	if late, err = http_common.CheckDeadline(quiz, username, previous, now, r.DB); err != nil {
+		return nil, err
+	}
+
+	// Retrieve the questions drawn for attempts at quizzes drawn from question banks.
+	if quiz, err = http_common.DrawnQuiz(quiz, username, previous, r.DB); err != nil {
+		return nil, err
+	}
+
+	// Translate responses to shuffled quizzes to the canonical order of the questions and options.
+	if canonical, err = http_common.UnshuffleResponse(quiz, username, previous, quizResponse, r.DB); err != nil {
+		return nil, err
+	}
+
+	// Grade the quizResponse.
+	if score, maxScore, err = r.Grading.Grade(canonical, quiz.QuizCore); err != nil {
+		return nil, err
+	}
+
+	// Break down the attempt if the answers are revealed.
+	if http_common.RevealAnswers(quiz, now) {
+		if breakdown, err = http_common.BreakdownAttempt(quiz.QuizCore, canonical, late, r.Grading); err != nil {
+			return nil, err
+		}
+	}
+
+	// Insert or update the record with the new attempt.
+	response := http_common.RecordAttempt(quiz, username, previous, canonical, score, maxScore, late, now)
+	if err = http_common.StoreAttempt(response, previous, r.DB); err != nil {
+		return nil, err
+	}
+	http_common.InvalidateStatsSummary(quizId, r.Cache)
+
+	return &model_http.ScoreCard{Response: response, Breakdown: breakdown}, nil
+}
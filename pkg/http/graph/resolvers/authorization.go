@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/surahman/mcq-platform/pkg/auth"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
 	"github.com/surahman/mcq-platform/pkg/logger"
 )
 
@@ -27,22 +28,27 @@ func GinContextFromContext(ctx context.Context, logger *logger.Logger) (*gin.Con
 	return ginContext, nil
 }
 
-// AuthorizationCheck will validate the JWT payload for valid authorization information.
-func AuthorizationCheck(auth auth.Auth, logger *logger.Logger, authHeaderKey string, ctx context.Context) (string, int64, error) {
+// AuthorizationCheck will validate the JWT payload for valid authorization information. The role in the JWT must grant all
+// the permissions required by the operation.
+func AuthorizationCheck(auth auth.Auth, logger *logger.Logger, authHeaderKey string, ctx context.Context,
+	permissions ...http_common.Permission) (string, int64, string, error) {
 	ginContext, err := GinContextFromContext(ctx, logger)
 	if err != nil {
-		return "", -1, err
+		return "", -1, "", err
 	}
 
 	tokenString := ginContext.GetHeader(authHeaderKey)
 	if tokenString == "" {
-		return "", -1, errors.New("request does not contain an access token")
+		return "", -1, "", errors.New("request does not contain an access token")
 	}
 
-	var username string
+	var username, role string
 	var expiresAt int64
-	if username, expiresAt, err = auth.ValidateJWT(tokenString); err != nil {
-		return username, expiresAt, err
+	if username, expiresAt, role, err = auth.ValidateJWT(tokenString); err != nil {
+		return username, expiresAt, role, err
 	}
-	return username, expiresAt, nil
+	if err = http_common.CheckPermissions(role, permissions...); err != nil {
+		return username, expiresAt, role, err
+	}
+	return username, expiresAt, role, nil
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	http_common "github.com/surahman/mcq-platform/pkg/http"
 	"github.com/surahman/mcq-platform/pkg/mocks"
+	model_cassandra "github.com/surahman/mcq-platform/pkg/model/cassandra"
 )
 
 func TestGinContextFromContext(t *testing.T) {
@@ -64,6 +65,7 @@ func TestAuthorizationCheck(t *testing.T) {
 		expectedMsg         string
 		expectErr           require.ErrorAssertionFunc
 		ctx                 context.Context
+		permissions         []http_common.Permission
 		authValidateJWTData *http_common.MockAuthData
 	}{
 		// ----- test cases start ----- //
@@ -121,6 +123,31 @@ func TestAuthorizationCheck(t *testing.T) {
 				OutputErr:    nil,
 				Times:        1,
 			},
+		}, {
+			name:        "permission denied",
+			expectedMsg: "permission denied",
+			expectErr:   require.Error,
+			ctx:         context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			permissions: []http_common.Permission{http_common.PermissionCreateQuizzes},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "successful token refresh",
+				OutputParam2: int64(999),
+				OutputParam3: model_cassandra.RoleStudent,
+				OutputErr:    nil,
+				Times:        1,
+			},
+		}, {
+			name:        "permission granted",
+			expectErr:   require.NoError,
+			ctx:         context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			permissions: []http_common.Permission{http_common.PermissionCreateQuizzes},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "successful token refresh",
+				OutputParam2: int64(999),
+				OutputParam3: model_cassandra.RoleInstructor,
+				OutputErr:    nil,
+				Times:        1,
+			},
 		},
 		// ----- test cases end ----- //
 	}
@@ -134,13 +161,16 @@ func TestAuthorizationCheck(t *testing.T) {
 			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 				testCase.authValidateJWTData.OutputParam1,
 				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
 				testCase.authValidateJWTData.OutputErr,
 			).Times(testCase.authValidateJWTData.Times)
 
-			username, expiresAt, err := AuthorizationCheck(mockAuth, zapLogger, testAuthHeaderKey, testCase.ctx)
+			username, expiresAt, role, err := AuthorizationCheck(mockAuth, zapLogger, testAuthHeaderKey, testCase.ctx,
+				testCase.permissions...)
 
 			require.Equal(t, testCase.authValidateJWTData.OutputParam1, username, "expected username does not match")
 			require.Equal(t, testCase.authValidateJWTData.OutputParam2, expiresAt, "expected expiration time does not match")
+			require.Equal(t, testCase.authValidateJWTData.OutputParam3, role, "expected role does not match")
 
 			testCase.expectErr(t, err, "error expectation failed")
 			if err != nil {
@@ -0,0 +1,129 @@
+package graphql_resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gocql/gocql"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
+	model_cassandra "github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/validator"
+)
+
+// CreateQuestionBank is the resolver for the createQuestionBank field.
+func (r *mutationResolver) CreateQuestionBank(ctx context.Context, input model_cassandra.QuestionBankCore) (string, error) {
+	var err error
+	var username string
+
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx, http_common.PermissionCreateQuizzes); err != nil {
+		return "", err
+	}
+
+	if err = validator.ValidateStruct(&input); err != nil {
+		return "", err
+	}
+
+	// Prepare question bank by adding username and generating bank id, then insert record.
+	bank := model_cassandra.QuestionBank{
+		QuestionBankCore: &input,
+		BankID:           gocql.TimeUUID(),
+		Author:           username,
+	}
+	if _, err = r.DB.Execute(cassandra.CreateQuestionBankQuery, &bank); err != nil {
+		return "", err
+	}
+
+	return bank.BankID.String(), nil
+}
+
+// UpdateQuestionBank is the resolver for the updateQuestionBank field.
+func (r *mutationResolver) UpdateQuestionBank(ctx context.Context, bankID string, bank model_cassandra.QuestionBankCore) (string, error) {
+	var err error
+	var username string
+	var bankUUID gocql.UUID
+
+	if bankUUID, err = gocql.ParseUUID(bankID); err != nil {
+		return "", errors.New("invalid bank id supplied, must be a valid UUID")
+	}
+
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return "", err
+	}
+
+	if err = validator.ValidateStruct(&bank); err != nil {
+		return "", err
+	}
+
+	updateRequest := model_cassandra.QuestionBankMutateRequest{
+		Username: username,
+		BankID:   bankUUID,
+		Bank: &model_cassandra.QuestionBank{
+			QuestionBankCore: &bank,
+		},
+	}
+	if _, err = r.DB.Execute(cassandra.UpdateQuestionBankQuery, &updateRequest); err != nil {
+		return "", err
+	}
+
+	return bankUUID.String(), nil
+}
+
+// DeleteQuestionBank is the resolver for the deleteQuestionBank field.
+func (r *mutationResolver) DeleteQuestionBank(ctx context.Context, bankID string) (string, error) {
+	var err error
+	var username string
+	var bankUUID gocql.UUID
+
+	if bankUUID, err = gocql.ParseUUID(bankID); err != nil {
+		return "", errors.New("invalid bank id supplied, must be a valid UUID")
+	}
+
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return "", err
+	}
+
+	request := model_cassandra.QuestionBankMutateRequest{
+		Username: username,
+		BankID:   bankUUID,
+	}
+	if _, err = r.DB.Execute(cassandra.DeleteQuestionBankQuery, &request); err != nil {
+		return "", err
+	}
+
+	return bankUUID.String(), nil
+}
+
+// ViewQuestionBank is the resolver for the viewQuestionBank field.
+func (r *queryResolver) ViewQuestionBank(ctx context.Context, bankID string) (*model_cassandra.QuestionBankCore, error) {
+	var err error
+	var username string
+	var bankUUID gocql.UUID
+	var response any
+
+	if bankUUID, err = gocql.ParseUUID(bankID); err != nil {
+		return nil, errors.New("invalid bank id supplied, must be a valid UUID")
+	}
+
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	if response, err = r.DB.Execute(cassandra.ReadQuestionBankQuery, bankUUID); err != nil {
+		return nil, err
+	}
+	bank := response.(*model_cassandra.QuestionBank)
+
+	// Question banks contain answer keys and are only visible to their author.
+	if bank.IsDeleted {
+		return nil, errors.New("question bank not found")
+	}
+	if bank.Author != username {
+		return nil, errors.New("question bank is not available")
+	}
+
+	return bank.QuestionBankCore, nil
+}
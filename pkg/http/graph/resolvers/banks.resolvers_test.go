@@ -0,0 +1,479 @@
+package graphql_resolvers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+func TestMutationResolver_CreateQuestionBank(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	testCases := []struct {
+		name                string
+		path                string
+		query               string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		cassandraCreateData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "empty token",
+			path:                "/create-bank/empty-token",
+			query:               testBanksQuery["create_valid"],
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", OutputErr: errors.New("invalid token"), Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "student",
+			path:                "/create-bank/student",
+			query:               testBanksQuery["create_valid"],
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", OutputParam3: model_cassandra.RoleStudent, Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "invalid bank",
+			path:                "/create-bank/invalid-bank",
+			query:               testBanksQuery["create_invalid"],
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", OutputParam3: model_cassandra.RoleInstructor, Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "db failure",
+			path:                "/create-bank/db-failure",
+			query:               testBanksQuery["create_valid"],
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", OutputParam3: model_cassandra.RoleInstructor, Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusConflict},
+				Times:     1,
+			},
+		}, {
+			name:                "success",
+			path:                "/create-bank/success",
+			query:               testBanksQuery["create_valid"],
+			expectErr:           false,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", OutputParam3: model_cassandra.RoleInstructor, Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Check authorization.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Send data to Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+						bank := params.(*model_cassandra.QuestionBank)
+						require.Equal(t, "author", bank.Author, "author mismatch")
+						require.Equal(t, []string{"algebra"}, bank.Questions[0].Tags, "tags mismatch")
+						require.Equal(t, "easy", bank.Questions[0].Difficulty, "difficulty mismatch")
+						return testCase.cassandraCreateData.OutputParam, testCase.cassandraCreateData.OutputErr
+					}).Times(testCase.cassandraCreateData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				// Bank ID is expected.
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				_, err := gocql.ParseUUID(data.(map[string]any)["createQuestionBank"].(string))
+				require.NoError(t, err, "did not receive a bank id")
+			}
+		})
+	}
+}
+
+func TestMutationResolver_UpdateQuestionBank(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	testCases := []struct {
+		name                string
+		path                string
+		bankId              string
+		query               string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		cassandraUpdateData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid bank id",
+			path:                "/update-bank/invalid-bank-id",
+			bankId:              "not a valid uuid",
+			query:               testBanksQuery["update_valid"],
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "empty token",
+			path:                "/update-bank/empty-token",
+			bankId:              gocql.TimeUUID().String(),
+			query:               testBanksQuery["update_valid"],
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", OutputErr: errors.New("invalid token"), Times: 1},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "request validate failure",
+			path:                "/update-bank/request-validate-failure",
+			bankId:              gocql.TimeUUID().String(),
+			query:               testBanksQuery["update_invalid"],
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "db unauthorized",
+			path:                "/update-bank/db-unauthorized",
+			bankId:              gocql.TimeUUID().String(),
+			query:               testBanksQuery["update_valid"],
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraUpdateData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusForbidden},
+				Times:     1,
+			},
+		}, {
+			name:                "success",
+			path:                "/update-bank/success",
+			bankId:              gocql.TimeUUID().String(),
+			query:               testBanksQuery["update_valid"],
+			expectErr:           false,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Check authorization.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Send data to Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraUpdateData.OutputParam,
+					testCase.cassandraUpdateData.OutputErr,
+				).Times(testCase.cassandraUpdateData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testCase.query, testCase.bankId)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				bankID := data.(map[string]any)["updateQuestionBank"].(string)
+				require.Equal(t, testCase.bankId, bankID, "actual and expected bank ids did not match")
+			}
+		})
+	}
+}
+
+func TestMutationResolver_DeleteQuestionBank(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	testCases := []struct {
+		name                string
+		path                string
+		bankId              string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		cassandraDeleteData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid bank id",
+			path:                "/delete-bank/invalid-bank-id",
+			bankId:              "not a valid uuid",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			cassandraDeleteData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "empty token",
+			path:                "/delete-bank/empty-token",
+			bankId:              gocql.TimeUUID().String(),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", OutputErr: errors.New("invalid token"), Times: 1},
+			cassandraDeleteData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "db unauthorized",
+			path:                "/delete-bank/db-unauthorized",
+			bankId:              gocql.TimeUUID().String(),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraDeleteData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusForbidden},
+				Times:     1,
+			},
+		}, {
+			name:                "success",
+			path:                "/delete-bank/success",
+			bankId:              gocql.TimeUUID().String(),
+			expectErr:           false,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraDeleteData: &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Check authorization.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Mark as deleted in Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraDeleteData.OutputParam,
+					testCase.cassandraDeleteData.OutputErr,
+				).Times(testCase.cassandraDeleteData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testBanksQuery["delete"], testCase.bankId)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				bankID := data.(map[string]any)["deleteQuestionBank"].(string)
+				require.Equal(t, testCase.bankId, bankID, "actual and expected bank ids did not match")
+			}
+		})
+	}
+}
+
+func TestQueryResolver_ViewQuestionBank(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	bank := &model_cassandra.QuestionBank{
+		QuestionBankCore: &model_cassandra.QuestionBankCore{Title: "bank", Questions: testQuizData["myPubQuiz"].Questions},
+		BankID:           gocql.TimeUUID(),
+		Author:           "author",
+	}
+	deleted := *bank
+	deleted.IsDeleted = true
+
+	testCases := []struct {
+		name                string
+		path                string
+		bankId              string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		cassandraReadData   *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid bank id",
+			path:                "/view-bank/invalid-bank-id",
+			bankId:              "not a valid uuid",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "empty token",
+			path:                "/view-bank/empty-token",
+			bankId:              bank.BankID.String(),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", OutputErr: errors.New("invalid token"), Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "db failure",
+			path:                "/view-bank/db-failure",
+			bankId:              bank.BankID.String(),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
+			},
+		}, {
+			name:                "deleted",
+			path:                "/view-bank/deleted",
+			bankId:              bank.BankID.String(),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{OutputParam: &deleted, Times: 1},
+		}, {
+			name:                "not author",
+			path:                "/view-bank/not-author",
+			bankId:              bank.BankID.String(),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "not author", Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{OutputParam: bank, Times: 1},
+		}, {
+			name:                "success",
+			path:                "/view-bank/success",
+			bankId:              bank.BankID.String(),
+			expectErr:           false,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{OutputParam: bank, Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Check authorization.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Read from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testBanksQuery["view"], testCase.bankId)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				view := data.(map[string]any)["viewQuestionBank"].(map[string]any)
+				require.Equal(t, bank.Title, view["title"], "title mismatch")
+				require.Equal(t, len(bank.Questions), len(view["questions"].([]any)), "number of questions mismatch")
+			}
+		})
+	}
+}
@@ -0,0 +1,520 @@
+package graphql_resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
+	graphql_generated "github.com/surahman/mcq-platform/pkg/http/graph/generated"
+	model_cassandra "github.com/surahman/mcq-platform/pkg/model/cassandra"
+	model_http "github.com/surahman/mcq-platform/pkg/model/http"
+	"github.com/surahman/mcq-platform/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// GroupID is the resolver for the groupID field.
+func (r *groupResolver) GroupID(ctx context.Context, obj *model_cassandra.Group) (string, error) {
+	return obj.GroupID.String(), nil
+}
+
+// GroupID is the resolver for the groupID field.
+func (r *groupInvitationResolver) GroupID(ctx context.Context, obj *model_cassandra.GroupInvitation) (string, error) {
+	return obj.GroupID.String(), nil
+}
+
+// QuizID is the resolver for the quizID field.
+func (r *groupQuizResolver) QuizID(ctx context.Context, obj *model_cassandra.GroupQuiz) (string, error) {
+	return obj.QuizID.String(), nil
+}
+
+// CreateGroup is the resolver for the createGroup field.
+func (r *mutationResolver) CreateGroup(ctx context.Context, input model_cassandra.GroupCore) (*model_cassandra.Group, error) {
+	var err error
+	var username string
+
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx, http_common.PermissionCreateGroups); err != nil {
+		return nil, err
+	}
+
+	if err = validator.ValidateStruct(&input); err != nil {
+		return nil, err
+	}
+
+	// Prepare group by adding username and generating the group id and join code, then insert record.
+	group := model_cassandra.Group{
+		GroupCore: &input,
+		GroupID:   gocql.TimeUUID(),
+		Creator:   username,
+	}
+	if group.JoinCode, err = http_common.NewJoinCode(); err != nil {
+		r.Logger.Error("failed to generate join code in create group resolver", zap.Error(err))
+		return nil, errors.New("unable to generate join code")
+	}
+
+	if _, err = r.DB.Execute(cassandra.CreateGroupQuery, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// UpdateGroup is the resolver for the updateGroup field.
+func (r *mutationResolver) UpdateGroup(ctx context.Context, groupID string, group model_cassandra.GroupCore) (string, error) {
+	var err error
+	var username, role string
+	var groupId gocql.UUID
+
+	if groupId, err = gocql.ParseUUID(groupID); err != nil {
+		return "", errors.New("invalid group id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return "", err
+	}
+
+	if err = validator.ValidateStruct(&group); err != nil {
+		return "", err
+	}
+
+	if err = http_common.CheckGroupOwner(groupId, username, role, r.DB); err != nil {
+		return "", err
+	}
+
+	if _, err = r.DB.Execute(cassandra.UpdateGroupQuery, &model_cassandra.GroupMutateRequest{GroupID: groupId, Group: &group}); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("updated group with id %s", groupId.String()), nil
+}
+
+// DeleteGroup is the resolver for the deleteGroup field.
+func (r *mutationResolver) DeleteGroup(ctx context.Context, groupID string) (string, error) {
+	var err error
+	var username, role string
+	var groupId gocql.UUID
+	var response any
+
+	if groupId, err = gocql.ParseUUID(groupID); err != nil {
+		return "", errors.New("invalid group id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return "", err
+	}
+
+	if err = http_common.CheckGroupOwner(groupId, username, role, r.DB); err != nil {
+		return "", err
+	}
+
+	// Quizzes assigned to the group would otherwise remain restricted to members that have been removed.
+	if response, err = r.DB.Execute(cassandra.ReadGroupQuizzesQuery, groupId); err != nil {
+		return "", err
+	}
+	if len(response.([]*model_cassandra.GroupQuiz)) > 0 {
+		return "", errors.New("quizzes must be unassigned from the group before it is deleted")
+	}
+
+	if _, err = r.DB.Execute(cassandra.DeleteGroupQuery, groupId); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("successfully deleted %s", groupId.String()), nil
+}
+
+// RemoveGroupMember is the resolver for the removeGroupMember field.
+func (r *mutationResolver) RemoveGroupMember(ctx context.Context, groupID string, username string) (string, error) {
+	var err error
+	var requester, role string
+	var groupId gocql.UUID
+	var response any
+
+	if groupId, err = gocql.ParseUUID(groupID); err != nil {
+		return "", errors.New("invalid group id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if requester, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return "", err
+	}
+
+	// Members may leave a group, but only owners may remove other members.
+	if username != requester {
+		if err = http_common.CheckGroupOwner(groupId, requester, role, r.DB); err != nil {
+			return "", err
+		}
+	}
+
+	if response, err = r.DB.Execute(cassandra.ReadGroupQuery, groupId); err != nil {
+		return "", err
+	}
+	if response.(*model_cassandra.Group).Creator == username {
+		return "", errors.New("the creator of a group cannot be removed")
+	}
+
+	if _, err = r.DB.Execute(cassandra.DeleteGroupMemberQuery, &model_cassandra.GroupMemberRequest{GroupID: groupId, Username: username}); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("removed %s from group with id %s", username, groupId.String()), nil
+}
+
+// InviteToGroup is the resolver for the inviteToGroup field.
+func (r *mutationResolver) InviteToGroup(ctx context.Context, groupID string, input model_cassandra.GroupInviteRequest) (string, error) {
+	var err error
+	var username, role string
+	var groupId gocql.UUID
+	var response any
+
+	if groupId, err = gocql.ParseUUID(groupID); err != nil {
+		return "", errors.New("invalid group id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return "", err
+	}
+
+	if err = validator.ValidateStruct(&input); err != nil {
+		return "", err
+	}
+
+	if err = http_common.CheckGroupOwner(groupId, username, role, r.DB); err != nil {
+		return "", err
+	}
+
+	if response, err = r.DB.Execute(cassandra.ReadGroupQuery, groupId); err != nil {
+		return "", err
+	}
+	group := response.(*model_cassandra.Group)
+
+	// Check to see if the invitee is an active user who is not already a member.
+	if response, err = r.DB.Execute(cassandra.ReadUserQuery, input.Username); err != nil || response.(*model_cassandra.User).IsDeleted {
+		return "", errors.New("user not found")
+	}
+
+	if _, err = r.DB.Execute(cassandra.ReadGroupMemberQuery, &model_cassandra.GroupMemberRequest{GroupID: groupId, Username: input.Username}); err == nil {
+		return "", errors.New("user is already a member")
+	}
+
+	invitation := model_cassandra.GroupInvitation{
+		Username:  input.Username,
+		GroupID:   groupId,
+		Name:      group.Name,
+		InvitedBy: username,
+		IsOwner:   input.IsOwner,
+		InvitedAt: time.Now(),
+	}
+	if _, err = r.DB.Execute(cassandra.CreateGroupInvitationQuery, &invitation); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("invited %s to group with id %s", input.Username, groupId.String()), nil
+}
+
+// AcceptGroupInvitation is the resolver for the acceptGroupInvitation field.
+func (r *mutationResolver) AcceptGroupInvitation(ctx context.Context, groupID string) (string, error) {
+	var err error
+	var username string
+	var groupId gocql.UUID
+	var response any
+
+	if groupId, err = gocql.ParseUUID(groupID); err != nil {
+		return "", errors.New("invalid group id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return "", err
+	}
+
+	request := model_cassandra.GroupMemberRequest{GroupID: groupId, Username: username}
+	if response, err = r.DB.Execute(cassandra.ReadGroupInvitationQuery, &request); err != nil {
+		return "", err
+	}
+	invitation := response.(*model_cassandra.GroupInvitation)
+
+	member := model_cassandra.GroupMember{GroupID: groupId, Username: username, IsOwner: invitation.IsOwner, JoinedAt: time.Now()}
+	if _, err = r.DB.Execute(cassandra.CreateGroupMemberQuery, &member); err != nil {
+		return "", err
+	}
+
+	// Joining succeeded, so failing to remove the invitation should be logged but not propagated to the end user.
+	if _, err = r.DB.Execute(cassandra.DeleteGroupInvitationQuery, &request); err != nil {
+		r.Logger.Error("error removing group invitation after joining", zap.Error(err))
+	}
+
+	return fmt.Sprintf("joined group with id %s", groupId.String()), nil
+}
+
+// DeclineGroupInvitation is the resolver for the declineGroupInvitation field.
+func (r *mutationResolver) DeclineGroupInvitation(ctx context.Context, groupID string) (string, error) {
+	var err error
+	var username string
+	var groupId gocql.UUID
+
+	if groupId, err = gocql.ParseUUID(groupID); err != nil {
+		return "", errors.New("invalid group id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return "", err
+	}
+
+	if _, err = r.DB.Execute(cassandra.DeleteGroupInvitationQuery, &model_cassandra.GroupMemberRequest{GroupID: groupId, Username: username}); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("declined invitation to group with id %s", groupId.String()), nil
+}
+
+// JoinGroup is the resolver for the joinGroup field.
+func (r *mutationResolver) JoinGroup(ctx context.Context, joinCode string) (string, error) {
+	var err error
+	var username string
+	var response any
+
+	// Get username from JWT.
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return "", err
+	}
+
+	if response, err = r.DB.Execute(cassandra.ReadGroupJoinCodeQuery, strings.ToUpper(joinCode)); err != nil {
+		return "", err
+	}
+	groupId := response.(gocql.UUID)
+
+	member := model_cassandra.GroupMember{GroupID: groupId, Username: username, JoinedAt: time.Now()}
+	if _, err = r.DB.Execute(cassandra.CreateGroupMemberQuery, &member); err != nil {
+		return "", err
+	}
+
+	return groupId.String(), nil
+}
+
+// ResetGroupJoinCode is the resolver for the resetGroupJoinCode field.
+func (r *mutationResolver) ResetGroupJoinCode(ctx context.Context, groupID string) (string, error) {
+	var err error
+	var username, role string
+	var groupId gocql.UUID
+	var response any
+
+	if groupId, err = gocql.ParseUUID(groupID); err != nil {
+		return "", errors.New("invalid group id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return "", err
+	}
+
+	if err = http_common.CheckGroupOwner(groupId, username, role, r.DB); err != nil {
+		return "", err
+	}
+
+	if response, err = r.DB.Execute(cassandra.ReadGroupQuery, groupId); err != nil {
+		return "", err
+	}
+
+	request := model_cassandra.GroupJoinCodeRequest{GroupID: groupId, PreviousCode: response.(*model_cassandra.Group).JoinCode}
+	if request.JoinCode, err = http_common.NewJoinCode(); err != nil {
+		r.Logger.Error("failed to generate join code in reset group join code resolver", zap.Error(err))
+		return "", errors.New("unable to generate join code")
+	}
+
+	if _, err = r.DB.Execute(cassandra.UpdateGroupJoinCodeQuery, &request); err != nil {
+		return "", err
+	}
+
+	return request.JoinCode, nil
+}
+
+// AssignQuiz is the resolver for the assignQuiz field.
+func (r *mutationResolver) AssignQuiz(ctx context.Context, groupID string, quizID string) (string, error) {
+	return r.assignQuiz(ctx, groupID, quizID, cassandra.AssignQuizQuery, "assign")
+}
+
+// UnassignQuiz is the resolver for the unassignQuiz field.
+func (r *mutationResolver) UnassignQuiz(ctx context.Context, groupID string, quizID string) (string, error) {
+	return r.assignQuiz(ctx, groupID, quizID, cassandra.UnassignQuizQuery, "unassign")
+}
+
+// ViewGroup is the resolver for the viewGroup field.
+func (r *queryResolver) ViewGroup(ctx context.Context, groupID string) (*model_cassandra.Group, error) {
+	var err error
+	var username, role string
+	var groupId gocql.UUID
+	var member *model_cassandra.GroupMember
+	var response any
+
+	if groupId, err = gocql.ParseUUID(groupID); err != nil {
+		return nil, errors.New("invalid group id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	if member, err = http_common.GroupMembership(groupId, username, role, r.DB); err != nil {
+		return nil, err
+	}
+
+	if response, err = r.DB.Execute(cassandra.ReadGroupQuery, groupId); err != nil {
+		return nil, err
+	}
+	group := response.(*model_cassandra.Group)
+
+	// Only owners may share the join code.
+	if !member.IsOwner {
+		group.JoinCode = ""
+	}
+
+	return group, nil
+}
+
+// GroupMembers is the resolver for the groupMembers field.
+func (r *queryResolver) GroupMembers(ctx context.Context, groupID string) ([]*model_cassandra.GroupMember, error) {
+	var err error
+	var username, role string
+	var groupId gocql.UUID
+	var response any
+
+	if groupId, err = gocql.ParseUUID(groupID); err != nil {
+		return nil, errors.New("invalid group id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	if err = http_common.CheckGroupOwner(groupId, username, role, r.DB); err != nil {
+		return nil, err
+	}
+
+	if response, err = r.DB.Execute(cassandra.ReadGroupMembersQuery, groupId); err != nil {
+		return nil, err
+	}
+
+	return response.([]*model_cassandra.GroupMember), nil
+}
+
+// GroupInvitations is the resolver for the groupInvitations field.
+func (r *queryResolver) GroupInvitations(ctx context.Context) ([]*model_cassandra.GroupInvitation, error) {
+	var err error
+	var username string
+	var response any
+
+	// Get username from JWT.
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	if response, err = r.DB.Execute(cassandra.ReadGroupInvitationsQuery, username); err != nil {
+		return nil, err
+	}
+
+	return response.([]*model_cassandra.GroupInvitation), nil
+}
+
+// MyGroups is the resolver for the myGroups field.
+func (r *queryResolver) MyGroups(ctx context.Context) ([]*model_cassandra.UserGroup, error) {
+	var err error
+	var username string
+	var response any
+
+	// Get username from JWT.
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	if response, err = r.DB.Execute(cassandra.ReadUserGroupsQuery, username); err != nil {
+		return nil, err
+	}
+
+	return response.([]*model_cassandra.UserGroup), nil
+}
+
+// GroupQuizzes is the resolver for the groupQuizzes field.
+func (r *queryResolver) GroupQuizzes(ctx context.Context, groupID string) ([]*model_cassandra.GroupQuiz, error) {
+	var err error
+	var username, role string
+	var groupId gocql.UUID
+	var response any
+
+	if groupId, err = gocql.ParseUUID(groupID); err != nil {
+		return nil, errors.New("invalid group id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err = http_common.GroupMembership(groupId, username, role, r.DB); err != nil {
+		return nil, err
+	}
+
+	if response, err = r.DB.Execute(cassandra.ReadGroupQuizzesQuery, groupId); err != nil {
+		return nil, err
+	}
+
+	return response.([]*model_cassandra.GroupQuiz), nil
+}
+
+// GroupScores is the resolver for the groupScores field.
+func (r *queryResolver) GroupScores(ctx context.Context, groupID string) (*model_http.GroupRollup, error) {
+	var err error
+	var username, role string
+	var groupId gocql.UUID
+
+	if groupId, err = gocql.ParseUUID(groupID); err != nil {
+		return nil, errors.New("invalid group id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	if err = http_common.CheckGroupOwner(groupId, username, role, r.DB); err != nil {
+		return nil, err
+	}
+
+	return http_common.RollupGroup(groupId, r.DB)
+}
+
+// GroupID is the resolver for the groupID field.
+func (r *userGroupResolver) GroupID(ctx context.Context, obj *model_cassandra.UserGroup) (string, error) {
+	return obj.GroupID.String(), nil
+}
+
+// Group returns graphql_generated.GroupResolver implementation.
+func (r *Resolver) Group() graphql_generated.GroupResolver { return &groupResolver{r} }
+
+// GroupInvitation returns graphql_generated.GroupInvitationResolver implementation.
+func (r *Resolver) GroupInvitation() graphql_generated.GroupInvitationResolver {
+	return &groupInvitationResolver{r}
+}
+
+// GroupQuiz returns graphql_generated.GroupQuizResolver implementation.
+func (r *Resolver) GroupQuiz() graphql_generated.GroupQuizResolver { return &groupQuizResolver{r} }
+
+// UserGroup returns graphql_generated.UserGroupResolver implementation.
+func (r *Resolver) UserGroup() graphql_generated.UserGroupResolver { return &userGroupResolver{r} }
+
+type groupResolver struct{ *Resolver }
+type groupInvitationResolver struct{ *Resolver }
+type groupQuizResolver struct{ *Resolver }
+type userGroupResolver struct{ *Resolver }
@@ -0,0 +1,990 @@
+package graphql_resolvers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/redis"
+)
+
+// expectGroupCalls will configure the database calls made by a group resolver in the order they are expected.
+func expectGroupCalls(mockCassandra *mocks.MockCassandra, cassandraData []*http_common.MockCassandraData) []*gomock.Call {
+	calls := make([]*gomock.Call, 0, len(cassandraData))
+	for _, data := range cassandraData {
+		calls = append(calls, mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+			data.OutputParam,
+			data.OutputErr,
+		).Times(data.Times))
+	}
+	return calls
+}
+
+func TestMutationResolver_CreateGroup(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	testCases := []struct {
+		name                string
+		path                string
+		query               string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		cassandraCreateData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "empty token",
+			path:                "/create-group/empty-token",
+			query:               testGroupsQuery["create_valid"],
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", OutputErr: errors.New("invalid token"), Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "student",
+			path:                "/create-group/student",
+			query:               testGroupsQuery["create_valid"],
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", OutputParam3: model_cassandra.RoleStudent, Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "invalid group",
+			path:                "/create-group/invalid-group",
+			query:               testGroupsQuery["create_invalid"],
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", OutputParam3: model_cassandra.RoleInstructor, Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "db failure",
+			path:                "/create-group/db-failure",
+			query:               testGroupsQuery["create_valid"],
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", OutputParam3: model_cassandra.RoleInstructor, Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusConflict},
+				Times:     1,
+			},
+		}, {
+			name:                "success",
+			path:                "/create-group/success",
+			query:               testGroupsQuery["create_valid"],
+			expectErr:           false,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", OutputParam3: model_cassandra.RoleInstructor, Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Check authorization.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Send data to Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+						group := params.(*model_cassandra.Group)
+						require.Equal(t, "owner", group.Creator, "creator mismatch")
+						require.Equal(t, "Algebra 101", group.Name, "name mismatch")
+						require.Len(t, group.JoinCode, http_common.JoinCodeLength, "join code not generated")
+						return testCase.cassandraCreateData.OutputParam, testCase.cassandraCreateData.OutputErr
+					}).Times(testCase.cassandraCreateData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				// Group is expected.
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				group := data.(map[string]any)["createGroup"].(map[string]any)
+				_, err := gocql.ParseUUID(group["groupID"].(string))
+				require.NoError(t, err, "did not receive a group id")
+				require.Len(t, group["joinCode"], http_common.JoinCodeLength, "did not receive a join code")
+			}
+		})
+	}
+}
+
+func TestQueryResolver_ViewGroup(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	group := func() *model_cassandra.Group {
+		return &model_cassandra.Group{GroupCore: &model_cassandra.GroupCore{Name: "class"}, Creator: "owner", JoinCode: "ABCD2345"}
+	}
+
+	testCases := []struct {
+		name                string
+		path                string
+		groupId             string
+		expectErr           bool
+		expectedJoinCode    string
+		authValidateJWTData *http_common.MockAuthData
+		cassandraData       []*http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid group id",
+			path:                "/view-group/invalid-group-id",
+			groupId:             "not a valid uuid",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:                "not a member",
+			path:                "/view-group/not-a-member",
+			groupId:             gocql.TimeUUID().String(),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputErr: &cassandra.Error{Message: "group member not found", Status: http.StatusNotFound}, Times: 1},
+			},
+		}, {
+			name:                "member",
+			path:                "/view-group/member",
+			groupId:             gocql.TimeUUID().String(),
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "username"}, Times: 1},
+				{OutputParam: group(), Times: 1},
+			},
+		}, {
+			name:                "owner",
+			path:                "/view-group/owner",
+			groupId:             gocql.TimeUUID().String(),
+			expectedJoinCode:    "ABCD2345",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "owner", IsOwner: true}, Times: 1},
+				{OutputParam: group(), Times: 1},
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(append([]*gomock.Call{
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+			}, expectGroupCalls(mockCassandra, testCase.cassandraData)...)...)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testGroupsQuery["view"], testCase.groupId)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				// Join code is only shared with owners.
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				group := data.(map[string]any)["viewGroup"].(map[string]any)
+				require.Equal(t, "class", group["name"], "name mismatch")
+				require.Equal(t, testCase.expectedJoinCode, group["joinCode"], "join code mismatch")
+			}
+		})
+	}
+}
+
+func TestMutationResolver_RemoveGroupMember(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	group := &model_cassandra.Group{GroupCore: &model_cassandra.GroupCore{Name: "class"}, Creator: "owner"}
+
+	testCases := []struct {
+		name                string
+		path                string
+		member              string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		cassandraData       []*http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "member removing another",
+			path:                "/remove-group-member/member-removing-another",
+			member:              "student-2",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "student", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "student"}, Times: 1},
+			},
+		}, {
+			name:                "removing creator",
+			path:                "/remove-group-member/removing-creator",
+			member:              "owner",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: group, Times: 1},
+			},
+		}, {
+			name:                "leaving",
+			path:                "/remove-group-member/leaving",
+			member:              "student",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "student", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: group, Times: 1},
+				{Times: 1},
+			},
+		}, {
+			name:                "owner removing member",
+			path:                "/remove-group-member/owner-removing-member",
+			member:              "student",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "owner", IsOwner: true}, Times: 1},
+				{OutputParam: group, Times: 1},
+				{Times: 1},
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(append([]*gomock.Call{
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+			}, expectGroupCalls(mockCassandra, testCase.cassandraData)...)...)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			query := fmt.Sprintf(testGroupsQuery["remove_member"], gocql.TimeUUID().String(), testCase.member)
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				require.Contains(t, data.(map[string]any)["removeGroupMember"], testCase.member, "member not in response")
+			}
+		})
+	}
+}
+
+func TestMutationResolver_AcceptGroupInvitation(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	testCases := []struct {
+		name                string
+		path                string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		cassandraData       []*http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "no invitation",
+			path:                "/accept-group-invitation/no-invitation",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "student", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputErr: &cassandra.Error{Message: "group invitation not found", Status: http.StatusNotFound}, Times: 1},
+			},
+		}, {
+			name:                "db failure",
+			path:                "/accept-group-invitation/db-failure",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "student", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupInvitation{Username: "student"}, Times: 1},
+				{OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError}, Times: 1},
+			},
+		}, {
+			name:                "invitation removal failure",
+			path:                "/accept-group-invitation/invitation-removal-failure",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "student", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupInvitation{Username: "student"}, Times: 1},
+				{Times: 1},
+				{OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError}, Times: 1},
+			},
+		}, {
+			name:                "success",
+			path:                "/accept-group-invitation/success",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "student", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupInvitation{Username: "student", IsOwner: true}, Times: 1},
+				{Times: 1},
+				{Times: 1},
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(append([]*gomock.Call{
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+			}, expectGroupCalls(mockCassandra, testCase.cassandraData)...)...)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			query := fmt.Sprintf(testGroupsQuery["accept"], gocql.TimeUUID().String())
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				require.NotEmpty(t, data.(map[string]any)["acceptGroupInvitation"], "expected a response")
+			}
+		})
+	}
+}
+
+func TestMutationResolver_JoinGroup(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	groupId := gocql.TimeUUID()
+
+	testCases := []struct {
+		name                string
+		path                string
+		joinCode            string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		joinCodeData        *http_common.MockCassandraData
+		joinData            *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid join code",
+			path:                "/join-group/invalid-join-code",
+			joinCode:            "ZZZZZZZZ",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "student", Times: 1},
+			joinCodeData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "join code not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			joinData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "db failure",
+			path:                "/join-group/db-failure",
+			joinCode:            "ABCD2345",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "student", Times: 1},
+			joinCodeData:        &http_common.MockCassandraData{OutputParam: groupId, Times: 1},
+			joinData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+		}, {
+			name:                "lower case join code",
+			path:                "/join-group/lower-case-join-code",
+			joinCode:            "abcd2345",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "student", Times: 1},
+			joinCodeData:        &http_common.MockCassandraData{OutputParam: groupId, Times: 1},
+			joinData:            &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Look up the group by its upper case join code.
+				mockCassandra.EXPECT().Execute(gomock.Any(), strings.ToUpper(testCase.joinCode)).Return(
+					testCase.joinCodeData.OutputParam,
+					testCase.joinCodeData.OutputErr,
+				).Times(testCase.joinCodeData.Times),
+
+				// Add the requester as a member.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+						member := params.(*model_cassandra.GroupMember)
+						require.Equal(t, groupId, member.GroupID, "group id mismatch")
+						require.Equal(t, "student", member.Username, "username mismatch")
+						require.False(t, member.IsOwner, "members joining by code must not be owners")
+						return testCase.joinData.OutputParam, testCase.joinData.OutputErr
+					}).Times(testCase.joinData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			query := fmt.Sprintf(testGroupsQuery["join"], testCase.joinCode)
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				require.Equal(t, groupId.String(), data.(map[string]any)["joinGroup"], "group id mismatch")
+			}
+		})
+	}
+}
+
+func TestMutationResolver_AssignQuiz(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	ownerData := &http_common.MockCassandraData{OutputParam: &model_cassandra.GroupMember{Username: "user-2", IsOwner: true}, Times: 1}
+	assigned := cassandra.GetTestQuizzes()["myPubQuiz"]
+	assigned.Groups = []gocql.UUID{gocql.TimeUUID()}
+
+	testCases := []struct {
+		name                string
+		path                string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		ownerData           *http_common.MockCassandraData
+		redisGetData        *http_common.MockRedisData
+		redisDelData        *http_common.MockRedisData
+		assignData          *http_common.MockCassandraData
+		quizReadData        *http_common.MockCassandraData
+		catalogueAddTimes   int
+		redisSetData        *http_common.MockRedisData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "not an owner",
+			path:                "/assign-quiz/not-an-owner",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-2", Times: 1},
+			ownerData:           &http_common.MockCassandraData{OutputParam: &model_cassandra.GroupMember{Username: "user-2"}, Times: 1},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+			assignData:          &http_common.MockCassandraData{Times: 0},
+			quizReadData:        &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "not quiz author",
+			path:                "/assign-quiz/not-quiz-author",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			ownerData:           &http_common.MockCassandraData{OutputParam: &model_cassandra.GroupMember{Username: "owner", IsOwner: true}, Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *testQuizData["myPubQuiz"], Times: 1},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+			assignData:          &http_common.MockCassandraData{Times: 0},
+			quizReadData:        &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "cache eviction failure",
+			path:                "/assign-quiz/cache-eviction-failure",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-2", Times: 1},
+			ownerData:           ownerData,
+			redisGetData:        &http_common.MockRedisData{Param2: *testQuizData["myPubQuiz"], Times: 1},
+			redisDelData: &http_common.MockRedisData{
+				Err:   &redis.Error{Message: "cache delete error", Code: redis.ErrorCacheDel},
+				Times: 1,
+			},
+			assignData:   &http_common.MockCassandraData{Times: 0},
+			quizReadData: &http_common.MockCassandraData{Times: 0},
+			redisSetData: &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "db failure",
+			path:                "/assign-quiz/db-failure",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-2", Times: 1},
+			ownerData:           ownerData,
+			redisGetData:        &http_common.MockRedisData{Param2: *testQuizData["myPubQuiz"], Times: 1},
+			redisDelData:        &http_common.MockRedisData{Times: 1},
+			assignData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "not the author", Status: http.StatusForbidden},
+				Times:     1,
+			},
+			quizReadData: &http_common.MockCassandraData{Times: 0},
+			redisSetData: &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "cache set failure",
+			path:                "/assign-quiz/cache-set-failure",
+			expectErr:           false,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-2", Times: 1},
+			ownerData:           ownerData,
+			redisGetData:        &http_common.MockRedisData{Param2: *testQuizData["myPubQuiz"], Times: 1},
+			redisDelData:        &http_common.MockRedisData{Times: 1},
+			assignData:          &http_common.MockCassandraData{Times: 1},
+			quizReadData:        &http_common.MockCassandraData{OutputParam: assigned, Times: 1},
+			catalogueAddTimes:   1,
+			redisSetData: &http_common.MockRedisData{
+				Err:   &redis.Error{Message: "cache set error", Code: redis.ErrorCacheSet},
+				Times: 1,
+			},
+		}, {
+			name:                "success",
+			path:                "/assign-quiz/success",
+			expectErr:           false,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-2", Times: 1},
+			ownerData:           ownerData,
+			redisGetData:        &http_common.MockRedisData{Param2: *testQuizData["myPubQuiz"], Times: 1},
+			redisDelData: &http_common.MockRedisData{
+				Err:   &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss},
+				Times: 1,
+			},
+			assignData:        &http_common.MockCassandraData{Times: 1},
+			quizReadData:      &http_common.MockCassandraData{OutputParam: assigned, Times: 1},
+			catalogueAddTimes: 1,
+			redisSetData:      &http_common.MockRedisData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrading := mocks.NewMockGrading(mockCtrl) // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Check group ownership.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.ownerData.OutputParam,
+					testCase.ownerData.OutputErr,
+				).Times(testCase.ownerData.Times),
+
+				// Get quiz from Redis.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+					testCase.redisGetData.Err,
+				).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Times(testCase.redisGetData.Times),
+
+				// Evict quiz from Redis.
+				mockRedis.EXPECT().Del(gomock.Any()).Return(
+					testCase.redisDelData.Err,
+				).Times(testCase.redisDelData.Times),
+
+				// Assign quiz on behalf of its author.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+						request := params.(*model_cassandra.QuizAssignRequest)
+						require.Equal(t, "user-2", request.Author, "author mismatch")
+						require.Equal(t, testCase.authValidateJWTData.OutputParam1, request.AssignedBy, "assigned by mismatch")
+						return testCase.assignData.OutputParam, testCase.assignData.OutputErr
+					}).Times(testCase.assignData.Times),
+
+				// Read the assigned quiz.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.quizReadData.OutputParam,
+					testCase.quizReadData.OutputErr,
+				).Times(testCase.quizReadData.Times),
+
+				// Relist the quiz in the catalogue.
+				mockCatalogue.EXPECT().Add(gomock.Any()).Times(testCase.catalogueAddTimes),
+
+				// Place quiz into Redis.
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any()).Return(
+					testCase.redisSetData.Err,
+				).Times(testCase.redisSetData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			query := fmt.Sprintf(testGroupsQuery["assign"], gocql.TimeUUID().String(), gocql.TimeUUID().String())
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				require.Contains(t, data.(map[string]any)["assignQuiz"], "assigned quiz", "unexpected response")
+			}
+		})
+	}
+}
+
+func TestQueryResolver_GroupScores(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	groupId := gocql.TimeUUID()
+	quizId := gocql.TimeUUID()
+
+	testCases := []struct {
+		name                string
+		path                string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		cassandraData       []*http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "member",
+			path:                "/group-scores/member",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "student", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "student"}, Times: 1},
+			},
+		}, {
+			name:                "db failure",
+			path:                "/group-scores/db-failure",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "owner", IsOwner: true}, Times: 1},
+				{OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError}, Times: 1},
+			},
+		}, {
+			name:                "success",
+			path:                "/group-scores/success",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "owner", IsOwner: true}, Times: 1},
+				{OutputParam: []*model_cassandra.GroupMember{{Username: "owner", IsOwner: true}, {Username: "student"}}, Times: 1},
+				{OutputParam: []*model_cassandra.GroupQuiz{{GroupID: groupId, QuizID: quizId, Title: "quiz"}}, Times: 1},
+				{
+					OutputParam: &model_cassandra.StatsResponse{Records: []*model_cassandra.Response{
+						{Username: "student", Score: 3, MaxScore: 4},
+					}},
+					Times: 1,
+				},
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(append([]*gomock.Call{
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+			}, expectGroupCalls(mockCassandra, testCase.cassandraData)...)...)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			query := fmt.Sprintf(testGroupsQuery["scores"], groupId.String())
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				rollup := data.(map[string]any)["groupScores"].(map[string]any)
+				require.Equal(t, 1.0, rollup["numMembers"], "owners should not be counted")
+				quiz := rollup["quizzes"].([]any)[0].(map[string]any)
+				require.Equal(t, quizId.String(), quiz["quizID"], "quiz id mismatch")
+				require.Equal(t, 75.0, quiz["meanPercentage"], "mean percentage mismatch")
+			}
+		})
+	}
+}
+
+func TestGroupRestrictedQuiz(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	groupId := gocql.TimeUUID()
+	restricted := cassandra.GetTestQuizzes()["myPubQuiz"]
+	restricted.Groups = []gocql.UUID{groupId}
+
+	testCases := []struct {
+		name           string
+		path           string
+		query          string
+		username       string
+		expectErr      bool
+		userGroupsData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "view, not a member",
+			path:      "/restricted-quiz/view-not-a-member",
+			query:     fmt.Sprintf(testQuizQuery["view"], restricted.QuizID),
+			username:  "student",
+			expectErr: true,
+			userGroupsData: &http_common.MockCassandraData{
+				OutputParam: []*model_cassandra.UserGroup{{GroupID: gocql.TimeUUID()}},
+				Times:       1,
+			},
+		}, {
+			name:     "view, member",
+			path:     "/restricted-quiz/view-member",
+			query:    fmt.Sprintf(testQuizQuery["view"], restricted.QuizID),
+			username: "student",
+			userGroupsData: &http_common.MockCassandraData{
+				OutputParam: []*model_cassandra.UserGroup{{GroupID: groupId}},
+				Times:       1,
+			},
+		}, {
+			name:           "view, author",
+			path:           "/restricted-quiz/view-author",
+			query:          fmt.Sprintf(testQuizQuery["view"], restricted.QuizID),
+			username:       "user-2",
+			userGroupsData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:      "view, db failure",
+			path:      "/restricted-quiz/view-db-failure",
+			query:     fmt.Sprintf(testQuizQuery["view"], restricted.QuizID),
+			username:  "student",
+			expectErr: true,
+			userGroupsData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+		}, {
+			name:      "take, not a member",
+			path:      "/restricted-quiz/take-not-a-member",
+			query:     fmt.Sprintf(testQuizQuery["take"], restricted.QuizID, "[[0], [1]]"),
+			username:  "student",
+			expectErr: true,
+			userGroupsData: &http_common.MockCassandraData{
+				OutputParam: []*model_cassandra.UserGroup{},
+				Times:       1,
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.username, int64(0), model_cassandra.RoleStudent, nil,
+				).Times(1),
+
+				// Get quiz from Redis.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil).SetArg(1, *restricted).Times(1),
+
+				// Get the requester's groups from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), testCase.username).Return(
+					testCase.userGroupsData.OutputParam,
+					testCase.userGroupsData.OutputErr,
+				).Times(testCase.userGroupsData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				require.NotNil(t, data.(map[string]any)["viewQuiz"], "quiz expected")
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/surahman/mcq-platform/pkg/auth"
 	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/catalogue"
 	"github.com/surahman/mcq-platform/pkg/grading"
 	"github.com/surahman/mcq-platform/pkg/http/graph/generated"
 	"github.com/surahman/mcq-platform/pkg/logger"
@@ -16,7 +17,7 @@ import (
 )
 
 // QueryHandler is the endpoint through which GraphQL can be accessed.
-func QueryHandler(authHeaderKey string, auth auth.Auth, cache redis.Redis, db cassandra.Cassandra,
+func QueryHandler(authHeaderKey string, auth auth.Auth, cache redis.Redis, catalogue catalogue.Catalogue, db cassandra.Cassandra,
 	grading grading.Grading, logger *logger.Logger) gin.HandlerFunc {
 	h := handler.NewDefaultServer(graphql_generated.NewExecutableSchema(
 		graphql_generated.Config{
@@ -24,6 +25,7 @@ func QueryHandler(authHeaderKey string, auth auth.Auth, cache redis.Redis, db ca
 				AuthHeaderKey: authHeaderKey,
 				Auth:          auth,
 				Cache:         cache,
+				Catalogue:     catalogue,
 				DB:            db,
 				Grading:       grading,
 				Logger:        logger,
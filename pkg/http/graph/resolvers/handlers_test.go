@@ -19,8 +19,9 @@ func TestQueryHandler(t *testing.T) {
 	mockCassandra := mocks.NewMockCassandra(mockCtrl)
 	mockRedis := mocks.NewMockRedis(mockCtrl)
 	mockGrader := mocks.NewMockGrading(mockCtrl)
+	mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
 
-	handler := QueryHandler("Authorization", mockAuth, mockRedis, mockCassandra, mockGrader, zapLogger)
+	handler := QueryHandler("Authorization", mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrader, zapLogger)
 
 	require.NotNil(t, handler, "failed to create graphql endpoint handler")
 }
@@ -70,7 +70,8 @@ func TestQueryResolver_Healthcheck(t *testing.T) {
 			mockAuth := mocks.NewMockAuth(mockCtrl) // Not called.
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
 			mockRedis := mocks.NewMockRedis(mockCtrl)
-			mockGrading := mocks.NewMockGrading(mockCtrl) // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
 
 			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
 				testCase.cassandraHealthData.OutputParam,
@@ -82,7 +83,7 @@ func TestQueryResolver_Healthcheck(t *testing.T) {
 			).Times(testCase.redisHealthData.Times)
 
 			// Endpoint setup for test.
-			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCassandra, mockGrading, zapLogger))
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
 
 			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(query))
 			req.Header.Set("Content-Type", "application/json")
@@ -32,9 +32,15 @@ var testUserQuery = getUsersQuery()
 // testQuizData is the test quiz queries.
 var testQuizQuery = getQuizzesQuery()
 
+// testBanksQuery is the test question bank queries.
+var testBanksQuery = getBanksQuery()
+
 // testScoresQuery is the test scores queries.
 var testScoresQuery = getScoresQuery()
 
+// testGroupsQuery is the test group queries.
+var testGroupsQuery = getGroupsQuery()
+
 func TestMain(m *testing.M) {
 	var err error
 	// Configure logger.
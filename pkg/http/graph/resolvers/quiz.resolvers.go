@@ -7,23 +7,32 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/grading"
 	http_common "github.com/surahman/mcq-platform/pkg/http"
 	graphql_generated "github.com/surahman/mcq-platform/pkg/http/graph/generated"
 	model_cassandra "github.com/surahman/mcq-platform/pkg/model/cassandra"
+	model_http "github.com/surahman/mcq-platform/pkg/model/http"
 	"github.com/surahman/mcq-platform/pkg/redis"
 	"github.com/surahman/mcq-platform/pkg/validator"
 	"go.uber.org/zap"
 )
 
+// QuizID is the resolver for the quizID field.
+func (r *authorQuizResolver) QuizID(ctx context.Context, obj *model_cassandra.AuthorQuiz) (string, error) {
+	return obj.QuizID.String(), nil
+}
+
 // CreateQuiz is the resolver for the createQuiz field.
 func (r *mutationResolver) CreateQuiz(ctx context.Context, input model_cassandra.QuizCore) (string, error) {
 	var err error
 	var username string
 
-	if username, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx, http_common.PermissionCreateQuizzes); err != nil {
 		return "", err
 	}
 
@@ -49,14 +58,19 @@ func (r *mutationResolver) CreateQuiz(ctx context.Context, input model_cassandra
 // UpdateQuiz is the resolver for the updateQuiz field.
 func (r *mutationResolver) UpdateQuiz(ctx context.Context, quizID string, quiz model_cassandra.QuizCore) (string, error) {
 	var err error
-	var username string
+	var username, role, owner string
 	var quizUUID gocql.UUID
 
 	if quizUUID, err = gocql.ParseUUID(quizID); err != nil {
 		return "", errors.New("invalid quiz id supplied, must be a valid UUID")
 	}
 
-	if username, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return "", err
+	}
+
+	// Administrators manage the quiz on behalf of its author.
+	if owner, err = http_common.QuizOwner(quizUUID, username, role, r.DB); err != nil {
 		return "", err
 	}
 
@@ -66,7 +80,7 @@ func (r *mutationResolver) UpdateQuiz(ctx context.Context, quizID string, quiz m
 
 	// Prepare quiz by adding username and provided quiz UUID, then insert record.
 	updateRequest := model_cassandra.QuizMutateRequest{
-		Username: username,
+		Username: owner,
 		QuizID:   quizUUID,
 		Quiz: &model_cassandra.Quiz{
 			QuizCore: &quiz,
@@ -82,7 +96,7 @@ func (r *mutationResolver) UpdateQuiz(ctx context.Context, quizID string, quiz m
 // PublishQuiz is the resolver for the publishQuiz field.
 func (r *mutationResolver) PublishQuiz(ctx context.Context, quizID string) (string, error) {
 	var err error
-	var username string
+	var username, role, owner string
 	var quizId gocql.UUID
 	var response any
 	var quiz *model_cassandra.Quiz
@@ -92,13 +106,18 @@ func (r *mutationResolver) PublishQuiz(ctx context.Context, quizID string) (stri
 	}
 
 	// Get username from JWT.
-	if username, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return "", err
+	}
+
+	// Administrators manage the quiz on behalf of its author.
+	if owner, err = http_common.QuizOwner(quizId, username, role, r.DB); err != nil {
 		return "", err
 	}
 
 	// Publish quiz record in database.
 	request := model_cassandra.QuizMutateRequest{
-		Username: username,
+		Username: owner,
 		QuizID:   quizId,
 	}
 	if _, err = r.DB.Execute(cassandra.PublishQuizQuery, &request); err != nil {
@@ -109,9 +128,10 @@ func (r *mutationResolver) PublishQuiz(ctx context.Context, quizID string) (stri
 	// Any failures below this point are cache related and should be logged but not propagated to the end user.
 	returnMsg := fmt.Sprintf("published quiz with id %s", quizId.String())
 
-	// Place quiz in cache.
+	// Place quiz in catalogue and cache.
 	// [1] Retrieve the quiz from Cassandra.
-	// [2] Place into Redis.
+	// [2] List in the catalogue.
+	// [3] Place into Redis.
 
 	// Get quiz record from database.
 	if response, err = r.DB.Execute(cassandra.ReadQuizQuery, quizId); err != nil {
@@ -120,6 +140,8 @@ func (r *mutationResolver) PublishQuiz(ctx context.Context, quizID string) (stri
 	}
 	quiz = response.(*model_cassandra.Quiz)
 
+	r.Catalogue.Add(quiz)
+
 	if err = r.Cache.Set(quizId.String(), quiz); err != nil {
 		r.Logger.Error("error placing quiz in cache after publishing", zap.Error(err))
 		return returnMsg, nil
@@ -128,10 +150,106 @@ func (r *mutationResolver) PublishQuiz(ctx context.Context, quizID string) (stri
 	return returnMsg, nil
 }
 
+// ReviseQuiz is the resolver for the reviseQuiz field.
+func (r *mutationResolver) ReviseQuiz(ctx context.Context, quizID string, quiz model_cassandra.QuizCore) (int, error) {
+	var err error
+	var username, role, owner string
+	var quizUUID gocql.UUID
+	var response any
+
+	if quizUUID, err = gocql.ParseUUID(quizID); err != nil {
+		return 0, errors.New("invalid quiz id supplied, must be a valid UUID")
+	}
+
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return 0, err
+	}
+
+	// Administrators manage the quiz on behalf of its author.
+	if owner, err = http_common.QuizOwner(quizUUID, username, role, r.DB); err != nil {
+		return 0, err
+	}
+
+	if err = validator.ValidateStruct(&quiz); err != nil {
+		return 0, err
+	}
+
+	// Publish the revision in the database.
+	reviseRequest := model_cassandra.QuizMutateRequest{
+		Username: owner,
+		QuizID:   quizUUID,
+		Quiz: &model_cassandra.Quiz{
+			QuizCore: &quiz,
+		},
+	}
+	if response, err = r.DB.Execute(cassandra.ReviseQuizQuery, &reviseRequest); err != nil {
+		return 0, err
+	}
+
+	// Evict the previous version from the cache. It will be reloaded on the next cache miss.
+	// Failures are cache related and should be logged but not propagated to the end user.
+	if err = r.Cache.Del(quizUUID.String()); err != nil && err.(*redis.Error).Code != redis.ErrorCacheMiss {
+		r.Logger.Error("failed to evict previous quiz version from cache after revision", zap.Error(err))
+	}
+
+	// Replace the previous version's listing in the catalogue. The quiz record is retrieved from the database because the
+	// groups the quiz is restricted to are not part of the revision.
+	version := response.(int)
+	if response, err = r.DB.Execute(cassandra.ReadQuizQuery, quizUUID); err != nil {
+		r.Logger.Error("error retrieving quiz from database to be placed in catalogue after revision", zap.Error(err))
+		return version, nil
+	}
+	r.Catalogue.Add(response.(*model_cassandra.Quiz))
+
+	return version, nil
+}
+
+// ScheduleQuiz is the resolver for the scheduleQuiz field.
+func (r *mutationResolver) ScheduleQuiz(ctx context.Context, quizID string, schedule model_cassandra.QuizSchedule) (string, error) {
+	var err error
+	var username, role, owner string
+	var quizUUID gocql.UUID
+
+	if quizUUID, err = gocql.ParseUUID(quizID); err != nil {
+		return "", errors.New("invalid quiz id supplied, must be a valid UUID")
+	}
+
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return "", err
+	}
+
+	// Administrators manage the quiz on behalf of its author.
+	if owner, err = http_common.QuizOwner(quizUUID, username, role, r.DB); err != nil {
+		return "", err
+	}
+
+	if err = validator.ValidateStruct(&schedule); err != nil {
+		return "", err
+	}
+
+	// Schedule quiz record in database.
+	scheduleRequest := model_cassandra.QuizScheduleRequest{
+		Username:     owner,
+		QuizID:       quizUUID,
+		QuizSchedule: &schedule,
+	}
+	if _, err = r.DB.Execute(cassandra.ScheduleQuizQuery, &scheduleRequest); err != nil {
+		return "", err
+	}
+
+	// Evict the quiz from the cache so that the new window is used. It will be reloaded on the next cache miss.
+	// Failures are cache related and should be logged but not propagated to the end user.
+	if err = r.Cache.Del(quizUUID.String()); err != nil && err.(*redis.Error).Code != redis.ErrorCacheMiss {
+		r.Logger.Error("failed to evict quiz from cache after scheduling", zap.Error(err))
+	}
+
+	return fmt.Sprintf("successfully scheduled %s", quizUUID.String()), nil
+}
+
 // DeleteQuiz is the resolver for the deleteQuiz field.
 func (r *mutationResolver) DeleteQuiz(ctx context.Context, quizID string) (string, error) {
 	var err error
-	var username string
+	var username, role, owner string
 	var quizId gocql.UUID
 	var cachedQuiz model_cassandra.Quiz
 
@@ -140,7 +258,12 @@ func (r *mutationResolver) DeleteQuiz(ctx context.Context, quizID string) (strin
 	}
 
 	// Get username from JWT.
-	if username, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return "", err
+	}
+
+	// Administrators manage the quiz on behalf of its author.
+	if owner, err = http_common.QuizOwner(quizId, username, role, r.DB); err != nil {
 		return "", err
 	}
 
@@ -158,7 +281,7 @@ func (r *mutationResolver) DeleteQuiz(ctx context.Context, quizID string) (strin
 	}
 	if err == nil {
 		// Check authorization.
-		if username != cachedQuiz.Author {
+		if owner != cachedQuiz.Author {
 			return "", errors.New("unauthorized")
 		}
 		// Attempt to remove from cache. There should be no cache miss here so an error must cause a failure.
@@ -170,13 +293,16 @@ func (r *mutationResolver) DeleteQuiz(ctx context.Context, quizID string) (strin
 
 	// Delete quiz record from database.
 	request := model_cassandra.QuizMutateRequest{
-		Username: username,
+		Username: owner,
 		QuizID:   quizId,
 	}
 	if _, err = r.DB.Execute(cassandra.DeleteQuizQuery, &request); err != nil {
 		return "", err
 	}
 
+	// Remove from the catalogue of published quizzes.
+	r.Catalogue.Remove(quizId)
+
 	return fmt.Sprintf("successfully deleted %s", quizId.String()), nil
 }
 
@@ -184,7 +310,7 @@ func (r *mutationResolver) DeleteQuiz(ctx context.Context, quizID string) (strin
 func (r *queryResolver) ViewQuiz(ctx context.Context, quizID string) (*model_cassandra.QuizCore, error) {
 	var err error
 	var quiz *model_cassandra.Quiz
-	var username string
+	var username, role string
 	var quizUUID gocql.UUID
 
 	if quizUUID, err = gocql.ParseUUID(quizID); err != nil {
@@ -192,7 +318,7 @@ func (r *queryResolver) ViewQuiz(ctx context.Context, quizID string) (*model_cas
 	}
 
 	// Get username from JWT.
-	if username, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
 		return nil, err
 	}
 
@@ -204,24 +330,198 @@ func (r *queryResolver) ViewQuiz(ctx context.Context, quizID string) (*model_cas
 	}
 
 	// Check to see if quiz can be set to requester.
-	// [1] Requested quiz is NOT published OR IS deleted
-	// [2] Requester is not the author
+	// [1] Requested quiz is NOT published OR IS deleted. Closed quizzes remain available for review.
+	// [2] Requester does not manage the quiz
 	// FAIL
-	if (!quiz.IsPublished || quiz.IsDeleted) && username != quiz.Author {
+	if errors.Is(http_common.CheckAvailability(quiz, time.Now()), http_common.ErrQuizUnavailable) && !http_common.CanManage(quiz.Author, username, role) {
 		return nil, errors.New("quiz is not available")
 	}
 
-	// If the requester is not the author remove the answer key.
-	if username != quiz.Author {
-		for idx := range quiz.Questions {
-			quiz.Questions[idx].Answers = nil
+	// Check to see if the quiz is restricted to groups the requester is not a member of.
+	if err = http_common.CheckGroupAccess(quiz, username, role, r.DB); err != nil {
+		return nil, err
+	}
+
+	// If the requester does not manage the quiz present the quiz as drawn and ordered for their next attempt and remove the answer key.
+	if !http_common.CanManage(quiz.Author, username, role) {
+		if quiz.QuizCore, err = http_common.PresentQuiz(quiz, username, r.DB); err != nil {
+			return nil, err
 		}
+		http_common.RemoveAnswerKeys(quiz.QuizCore)
 	}
 
 	return quiz.QuizCore, nil
 }
 
+// ListQuizVersions is the resolver for the listQuizVersions field.
+func (r *queryResolver) ListQuizVersions(ctx context.Context, quizID string) ([]*model_cassandra.QuizVersion, error) {
+	var err error
+	var quiz *model_cassandra.Quiz
+	var username, role string
+	var quizUUID gocql.UUID
+	var response any
+
+	if quizUUID, err = gocql.ParseUUID(quizID); err != nil {
+		return nil, errors.New("invalid quiz id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	// Get quiz to check if the versions can be sent to the requester.
+	if quiz, err = http_common.GetQuiz(quizUUID, r.DB, r.Cache); err != nil {
+		return nil, err
+	}
+
+	if (!quiz.IsPublished || quiz.IsDeleted) && !http_common.CanManage(quiz.Author, username, role) {
+		return nil, errors.New("quiz is not available")
+	}
+
+	// Check to see if the quiz is restricted to groups the requester is not a member of.
+	if err = http_common.CheckGroupAccess(quiz, username, role, r.DB); err != nil {
+		return nil, err
+	}
+
+	if response, err = r.DB.Execute(cassandra.ReadQuizVersionsQuery, quizUUID); err != nil {
+		return nil, err
+	}
+	versions := response.([]*model_cassandra.QuizVersion)
+
+	if len(versions) == 0 {
+		return nil, errors.New("quiz has no published versions")
+	}
+
+	// If the requester does not manage the quiz remove the answer keys.
+	if !http_common.CanManage(quiz.Author, username, role) {
+		for _, version := range versions {
+			http_common.RemoveAnswerKeys(version.QuizCore)
+		}
+	}
+
+	return versions, nil
+}
+
+// ViewQuizVersion is the resolver for the viewQuizVersion field.
+func (r *queryResolver) ViewQuizVersion(ctx context.Context, quizID string, version int) (*model_cassandra.QuizVersion, error) {
+	var err error
+	var quiz *model_cassandra.Quiz
+	var username, role string
+	var quizUUID gocql.UUID
+	var response any
+
+	if quizUUID, err = gocql.ParseUUID(quizID); err != nil {
+		return nil, errors.New("invalid quiz id supplied, must be a valid UUID")
+	}
+
+	if version < 0 {
+		return nil, errors.New("invalid version supplied, must be a non-negative integer")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	// Get quiz to check if the version can be sent to the requester.
+	if quiz, err = http_common.GetQuiz(quizUUID, r.DB, r.Cache); err != nil {
+		return nil, err
+	}
+
+	if (!quiz.IsPublished || quiz.IsDeleted) && !http_common.CanManage(quiz.Author, username, role) {
+		return nil, errors.New("quiz is not available")
+	}
+
+	// Check to see if the quiz is restricted to groups the requester is not a member of.
+	if err = http_common.CheckGroupAccess(quiz, username, role, r.DB); err != nil {
+		return nil, err
+	}
+
+	request := model_cassandra.QuizVersionRequest{QuizID: quizUUID, Version: version}
+	if response, err = r.DB.Execute(cassandra.ReadQuizVersionQuery, &request); err != nil {
+		return nil, err
+	}
+	quizVersion := response.(*model_cassandra.QuizVersion)
+
+	// If the requester does not manage the quiz remove the answer keys.
+	if !http_common.CanManage(quiz.Author, username, role) {
+		http_common.RemoveAnswerKeys(quizVersion.QuizCore)
+	}
+
+	return quizVersion, nil
+}
+
+// MarkingSchemes is the resolver for the markingSchemes field.
+func (r *queryResolver) MarkingSchemes(ctx context.Context) ([]string, error) {
+	if _, _, _, err := AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	return grading.MarkingSchemes(), nil
+}
+
+// MyQuizzes is the resolver for the myQuizzes field.
+func (r *queryResolver) MyQuizzes(ctx context.Context, status *string, pageSize *int, cursor *string) (*model_http.AuthorQuizzesResponseGraphQL, error) {
+	var err error
+	var dbRecord any
+	var request *model_cassandra.AuthorQuizzesRequest
+	var username string
+
+	// Get username from JWT.
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	// Prepare author quizzes page request for database.
+	if request, err = http_common.PrepareAuthorQuizzesRequest(r.Auth, username, *status, *cursor, strconv.Itoa(*pageSize)); err != nil {
+		return nil, fmt.Errorf("malformed query request %v", err)
+	}
+
+	// Get author quiz record page from database.
+	if dbRecord, err = r.DB.Execute(cassandra.ReadAuthorQuizzesPageQuery, request); err != nil {
+		return nil, err
+	}
+
+	// Prepare GraphQL response.
+	return prepareAuthorQuizzesResponse(r.Auth, dbRecord.(*model_cassandra.AuthorQuizzesResponse), *status)
+}
+
+// Catalogue is the resolver for the catalogue field.
+func (r *queryResolver) Catalogue(ctx context.Context, search *string, tag *string, pageSize *int, cursor *string) (*model_http.CatalogueResponseGraphQL, error) {
+	var err error
+	var request *model_http.CatalogueRequest
+
+	// Verify the JWT.
+	if _, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	// Prepare catalogue page request.
+	if request, err = http_common.PrepareCatalogueRequest(r.Auth, *search, *tag, *cursor, strconv.Itoa(*pageSize)); err != nil {
+		return nil, fmt.Errorf("malformed query request %v", err)
+	}
+
+	// Prepare GraphQL response.
+	return prepareCatalogueResponse(r.Auth, r.Resolver.Catalogue.Search(request), request)
+}
+
+// QuizID is the resolver for the quizID field.
+func (r *quizVersionResolver) QuizID(ctx context.Context, obj *model_cassandra.QuizVersion) (string, error) {
+	return obj.QuizID.String(), nil
+}
+
+// AuthorQuiz returns graphql_generated.AuthorQuizResolver implementation.
+func (r *Resolver) AuthorQuiz() graphql_generated.AuthorQuizResolver { return &authorQuizResolver{r} }
+
 // Query returns graphql_generated.QueryResolver implementation.
 func (r *Resolver) Query() graphql_generated.QueryResolver { return &queryResolver{r} }
 
+// QuizVersion returns graphql_generated.QuizVersionResolver implementation.
+func (r *Resolver) QuizVersion() graphql_generated.QuizVersionResolver {
+	return &quizVersionResolver{r}
+}
+
+type authorQuizResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type quizVersionResolver struct{ *Resolver }
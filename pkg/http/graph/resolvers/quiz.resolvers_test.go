@@ -13,9 +13,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/grading"
 	http_common "github.com/surahman/mcq-platform/pkg/http"
 	"github.com/surahman/mcq-platform/pkg/mocks"
 	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
 	"github.com/surahman/mcq-platform/pkg/redis"
 )
 
@@ -46,6 +48,20 @@ func TestMutationResolver_CreateQuiz(t *testing.T) {
 			cassandraCreateData: &http_common.MockCassandraData{
 				Times: 0,
 			},
+		}, {
+			name:      "student",
+			path:      "/create/student",
+			query:     testQuizQuery["create_valid"],
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputParam3: model_cassandra.RoleStudent,
+				OutputErr:    nil,
+				Times:        1,
+			},
+			cassandraCreateData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:      "empty quiz",
 			path:      "/create/empty-quiz",
@@ -53,6 +69,7 @@ func TestMutationResolver_CreateQuiz(t *testing.T) {
 			expectErr: true,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
+				OutputParam3: model_cassandra.RoleInstructor,
 				OutputErr:    nil,
 				Times:        1,
 			},
@@ -66,6 +83,7 @@ func TestMutationResolver_CreateQuiz(t *testing.T) {
 			expectErr: false,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
+				OutputParam3: model_cassandra.RoleInstructor,
 				OutputErr:    nil,
 				Times:        1,
 			},
@@ -79,6 +97,21 @@ func TestMutationResolver_CreateQuiz(t *testing.T) {
 			expectErr: true,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
+				OutputParam3: model_cassandra.RoleInstructor,
+				OutputErr:    nil,
+				Times:        1,
+			},
+			cassandraCreateData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:      "invalid attempt policy",
+			path:      "/create/invalid-attempt-policy",
+			query:     testQuizQuery["create_invalid_attempts"],
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputParam3: model_cassandra.RoleInstructor,
 				OutputErr:    nil,
 				Times:        1,
 			},
@@ -92,6 +125,7 @@ func TestMutationResolver_CreateQuiz(t *testing.T) {
 			expectErr: true,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
+				OutputParam3: model_cassandra.RoleInstructor,
 				OutputErr:    nil,
 				Times:        1,
 			},
@@ -109,6 +143,7 @@ func TestMutationResolver_CreateQuiz(t *testing.T) {
 			expectErr: true,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
+				OutputParam3: model_cassandra.RoleInstructor,
 				OutputErr:    nil,
 				Times:        1,
 			},
@@ -129,14 +164,16 @@ func TestMutationResolver_CreateQuiz(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)     // Not called.
-			mockGrading := mocks.NewMockGrading(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				// Check JWT.
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 					testCase.authValidateJWTData.OutputParam1,
 					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
 					testCase.authValidateJWTData.OutputErr,
 				).Times(testCase.authValidateJWTData.Times),
 
@@ -148,7 +185,7 @@ func TestMutationResolver_CreateQuiz(t *testing.T) {
 			)
 
 			// Endpoint setup for test.
-			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCassandra, mockGrading, zapLogger))
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
 
 			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.query))
 			req.Header.Set("Content-Type", "application/json")
@@ -290,14 +327,16 @@ func TestMutationResolver_UpdateQuiz(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)     // Not called.
-			mockGrading := mocks.NewMockGrading(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				// Check authorization.
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 					testCase.authValidateJWTData.OutputParam1,
 					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
 					testCase.authValidateJWTData.OutputErr,
 				).Times(testCase.authValidateJWTData.Times),
 
@@ -309,7 +348,7 @@ func TestMutationResolver_UpdateQuiz(t *testing.T) {
 			)
 
 			// Endpoint setup for test.
-			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCassandra, mockGrading, zapLogger))
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
 
 			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testCase.query, testCase.quizId)))
 			req.Header.Set("Content-Type", "application/json")
@@ -341,6 +380,10 @@ func TestQueryResolver_ViewQuiz(t *testing.T) {
 	// Configure router and middleware that loads the Gin context for the resolvers.
 	router := http_common.GetTestRouter()
 	router.Use(GinContextToContextMiddleware())
+	closedQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	closedQuiz.IsClosed = true
+	shuffledQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	shuffledQuiz.Shuffle = true
 
 	testCases := []struct {
 		name                string
@@ -353,6 +396,8 @@ func TestQueryResolver_ViewQuiz(t *testing.T) {
 		cassandraReadData   *http_common.MockCassandraData
 		redisGetData        *http_common.MockRedisData
 		redisSetData        *http_common.MockRedisData
+		responseReadData    *http_common.MockCassandraData
+		shuffleData         *http_common.MockCassandraData
 	}{
 		// ----- test cases start ----- //
 		{
@@ -375,6 +420,12 @@ func TestQueryResolver_ViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:      "invalid quiz id",
 			path:      "/view/invalid-quiz-id",
@@ -394,6 +445,12 @@ func TestQueryResolver_ViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:      "db failure",
 			path:      "/view/db-failure/",
@@ -419,6 +476,12 @@ func TestQueryResolver_ViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:      "unpublished not owner",
 			path:      "/view/unpublished-not-owner/",
@@ -445,6 +508,12 @@ func TestQueryResolver_ViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:      "published but deleted not owner",
 			path:      "/view/published-but-deleted-not-owner/",
@@ -471,6 +540,12 @@ func TestQueryResolver_ViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:          "published not owner",
 			path:          "/view/published-not-owner/",
@@ -498,6 +573,45 @@ func TestQueryResolver_ViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 1,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:          "closed not owner",
+			path:          "/view/closed-not-owner/",
+			quizId:        gocql.TimeUUID().String(),
+			query:         testQuizQuery["view"],
+			expectErr:     false,
+			expectAnswers: require.Nil,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss error",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: closedQuiz,
+				OutputErr:   nil,
+				Times:       1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 1,
+			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:          "published owner",
 			path:          "/view/published-owner/",
@@ -525,6 +639,12 @@ func TestQueryResolver_ViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 1,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:          "unpublished owner",
 			path:          "/view/unpublished-owner/",
@@ -552,6 +672,12 @@ func TestQueryResolver_ViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:          "published deleted owner",
 			path:          "/view/published-deleted-owner/",
@@ -579,6 +705,12 @@ func TestQueryResolver_ViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:          "cache set failure",
 			path:          "/view/cache-set-failure/",
@@ -610,6 +742,12 @@ func TestQueryResolver_ViewQuiz(t *testing.T) {
 				},
 				Times: 1,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:          "cache hit",
 			path:          "/view/cache-hit/",
@@ -631,6 +769,96 @@ func TestQueryResolver_ViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:          "shuffled not owner",
+			path:          "/view/shuffled-not-owner/",
+			quizId:        gocql.TimeUUID().String(),
+			query:         testQuizQuery["view"],
+			expectErr:     false,
+			expectAnswers: require.Nil,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *shuffledQuiz,
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			responseReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AttemptShuffle{Username: "not owner", Attempt: 1, Seed: 42},
+				Times:       1,
+			},
+		}, {
+			name:      "shuffled not owner failure",
+			path:      "/view/shuffled-not-owner-failure/",
+			quizId:    gocql.TimeUUID().String(),
+			query:     testQuizQuery["view"],
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *shuffledQuiz,
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			responseReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+		}, {
+			name:          "shuffled owner",
+			path:          "/view/shuffled-owner/",
+			quizId:        gocql.TimeUUID().String(),
+			query:         testQuizQuery["view"],
+			expectErr:     false,
+			expectAnswers: require.NotNil,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "user-2",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *shuffledQuiz,
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		},
 		// ----- test cases end ----- //
 	}
@@ -642,13 +870,15 @@ func TestQueryResolver_ViewQuiz(t *testing.T) {
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
 			mockRedis := mocks.NewMockRedis(mockCtrl)
-			mockGrading := mocks.NewMockGrading(mockCtrl) // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				// Check JWT.
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 					testCase.authValidateJWTData.OutputParam1,
 					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
 					testCase.authValidateJWTData.OutputErr,
 				).Times(testCase.authValidateJWTData.Times),
 
@@ -670,10 +900,22 @@ func TestQueryResolver_ViewQuiz(t *testing.T) {
 				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any()).Return(
 					testCase.redisSetData.Err,
 				).Times(testCase.redisSetData.Times),
+
+				// Get previous attempts from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.responseReadData.OutputParam,
+					testCase.responseReadData.OutputErr,
+				).Times(testCase.responseReadData.Times),
+
+				// Create attempt order in Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.shuffleData.OutputParam,
+					testCase.shuffleData.OutputErr,
+				).Times(testCase.shuffleData.Times),
 			)
 
 			// Endpoint setup for test.
-			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCassandra, mockGrading, zapLogger))
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
 
 			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testCase.query, testCase.quizId)))
 			req.Header.Set("Content-Type", "application/json")
@@ -944,12 +1186,20 @@ func TestMutationResolver_DeleteQuiz(t *testing.T) {
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
 			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockGrading := mocks.NewMockGrading(mockCtrl) // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl)
+
+			// Remove from the catalogue after a successful deletion.
+			catalogueRemoveTimes := 0
+			if !testCase.expectErr {
+				catalogueRemoveTimes = 1
+			}
 
 			gomock.InOrder(
 				// Check authorization.
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 					testCase.authValidateJWTData.OutputParam1,
 					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
 					testCase.authValidateJWTData.OutputErr,
 				).Times(testCase.authValidateJWTData.Times),
 
@@ -971,10 +1221,13 @@ func TestMutationResolver_DeleteQuiz(t *testing.T) {
 					testCase.cassandraDeleteData.OutputParam,
 					testCase.cassandraDeleteData.OutputErr,
 				).Times(testCase.cassandraDeleteData.Times),
+
+				// Remove from the catalogue.
+				mockCatalogue.EXPECT().Remove(gomock.Any()).Times(catalogueRemoveTimes),
 			)
 
 			// Endpoint setup for test.
-			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCassandra, mockGrading, zapLogger))
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
 
 			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testCase.query, testCase.quizId)))
 			req.Header.Set("Content-Type", "application/json")
@@ -1165,10 +1418,12 @@ func TestMutationResolver_PublishQuiz(t *testing.T) {
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
 			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockGrading := mocks.NewMockGrading(mockCtrl) // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl)
 
 			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 				testCase.authValidateJWTData.OutputParam1,
 				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
 				testCase.authValidateJWTData.OutputErr,
 			).Times(testCase.authValidateJWTData.Times)
 
@@ -1185,12 +1440,14 @@ func TestMutationResolver_PublishQuiz(t *testing.T) {
 				).Times(testCase.cassandraGetData.Times),
 			)
 
+			mockCatalogue.EXPECT().Add(gomock.Any()).Times(testCase.redisSetData.Times)
+
 			mockRedis.EXPECT().Set(gomock.Any(), gomock.Any()).Return(
 				testCase.redisSetData.Err,
 			).Times(testCase.redisSetData.Times)
 
 			// Endpoint setup for test.
-			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCassandra, mockGrading, zapLogger))
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
 
 			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testCase.query, testCase.quizId)))
 			req.Header.Set("Content-Type", "application/json")
@@ -1217,3 +1474,1241 @@ func TestMutationResolver_PublishQuiz(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryResolver_MarkingSchemes(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	testCases := []struct {
+		name                string
+		path                string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "empty token",
+			path:      "/marking-schemes/empty-token",
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+		}, {
+			name:      "success",
+			path:      "/marking-schemes/success",
+			expectErr: false,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "some username",
+				Times:        1,
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+				testCase.authValidateJWTData.OutputParam1,
+				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
+				testCase.authValidateJWTData.OutputErr,
+			).Times(testCase.authValidateJWTData.Times)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testQuizQuery["marking_schemes"]))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				schemes, ok := data.(map[string]any)["markingSchemes"].([]any)
+				require.True(t, ok, "failed to extract marking schemes")
+				require.Equal(t, len(grading.MarkingSchemes()), len(schemes), "incorrect number of marking schemes")
+			}
+		})
+	}
+}
+
+func TestQueryResolver_MyQuizzes(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	quizUUID := gocql.TimeUUID()
+
+	testCases := []struct {
+		name                string
+		path                string
+		query               string
+		expectCursor        string
+		expectPageSize      int
+		expectNumRecords    int
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		authDecryptData     *http_common.MockAuthData
+		cassandraReadData   *http_common.MockCassandraData
+		authEncryptData     *http_common.MockAuthData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "empty token",
+			path:      "/my-quizzes/empty-token",
+			query:     fmt.Sprintf(testQuizQuery["my_quizzes"], model_cassandra.QuizStatusDraft, 3, "PaGeCuRs0R"),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			authDecryptData:   &http_common.MockAuthData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			authEncryptData:   &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:      "invalid status",
+			path:      "/my-quizzes/invalid-status",
+			query:     fmt.Sprintf(testQuizQuery["my_quizzes"], "archived", 3, "PaGeCuRs0R"),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData:   &http_common.MockAuthData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			authEncryptData:   &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:      "cursor decryption failure",
+			path:      "/my-quizzes/cursor-decryption-failure",
+			query:     fmt.Sprintf(testQuizQuery["my_quizzes"], model_cassandra.QuizStatusDraft, 3, "PaGeCuRs0R"),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{
+				OutputErr: errors.New("decrypting cursor failed"),
+				Times:     1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			authEncryptData:   &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:      "db read failure",
+			path:      "/my-quizzes/db-read-failure",
+			query:     fmt.Sprintf(testQuizQuery["my_quizzes"], model_cassandra.QuizStatusDraft, 3, "PaGeCuRs0R"),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:      "cursor encryption failure",
+			path:      "/my-quizzes/cursor-encryption-failure",
+			query:     testQuizQuery["my_quizzes_all"],
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AuthorQuizzesResponse{
+					PageCursor: []byte("cursor to next page"),
+					Records:    []*model_cassandra.AuthorQuiz{{QuizID: quizUUID}},
+					PageSize:   10,
+				},
+				Times: 1,
+			},
+			authEncryptData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("encrypting cursor failed"),
+				Times:        1,
+			},
+		}, {
+			name:             "success",
+			path:             "/my-quizzes/success",
+			query:            fmt.Sprintf(testQuizQuery["my_quizzes"], model_cassandra.QuizStatusDraft, 3, "PaGeCuRs0R"),
+			expectCursor:     "tHisIsAnEnCrYPtEdCUrS0r",
+			expectPageSize:   3,
+			expectNumRecords: 3,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AuthorQuizzesResponse{
+					PageCursor: []byte("cursor to next page"),
+					Records: []*model_cassandra.AuthorQuiz{
+						{QuizID: quizUUID, Status: model_cassandra.QuizStatusDraft},
+						{QuizID: quizUUID, Status: model_cassandra.QuizStatusDraft},
+						{QuizID: quizUUID, Status: model_cassandra.QuizStatusDraft},
+					},
+					PageSize: 3,
+				},
+				Times: 1,
+			},
+			authEncryptData: &http_common.MockAuthData{
+				OutputParam1: "tHisIsAnEnCrYPtEdCUrS0r",
+				Times:        1,
+			},
+		}, {
+			name:             "success all statuses no cursor",
+			path:             "/my-quizzes/success-all-statuses-no-cursor",
+			query:            testQuizQuery["my_quizzes_all"],
+			expectNumRecords: 2,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AuthorQuizzesResponse{
+					Records: []*model_cassandra.AuthorQuiz{
+						{QuizID: quizUUID, Status: model_cassandra.QuizStatusPublished},
+						{QuizID: quizUUID, Status: model_cassandra.QuizStatusDeleted},
+					},
+					PageSize: 10,
+				},
+				Times: 1,
+			},
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).Return(
+					testCase.authDecryptData.OutputParam1,
+					testCase.authDecryptData.OutputErr,
+				).Times(testCase.authDecryptData.Times),
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).Return(
+					testCase.authEncryptData.OutputParam1,
+					testCase.authEncryptData.OutputErr,
+				).Times(testCase.authEncryptData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+
+				myQuizzes := data.(map[string]any)["myQuizzes"].(map[string]any)
+
+				records := myQuizzes["records"].([]any)
+				require.Equal(t, testCase.expectNumRecords, len(records), "record count does not match expected")
+				require.Equal(t, quizUUID.String(), records[0].(map[string]any)["quizID"], "quiz id did not match expected")
+
+				metadata := myQuizzes["metadata"].(map[string]any)
+				require.Equal(t, testCase.expectNumRecords, int(metadata["numRecords"].(float64)), "metadata record count does not match expected")
+
+				nextPage := myQuizzes["nextPage"].(map[string]any)
+				require.Equal(t, testCase.expectPageSize, int(nextPage["pageSize"].(float64)), "page size does not match expected")
+				require.Equal(t, testCase.expectCursor, nextPage["cursor"].(string), "cursor does not match expected")
+			}
+		})
+	}
+}
+
+func TestQueryResolver_Catalogue(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	quizUUID := gocql.TimeUUID()
+
+	testCases := []struct {
+		name                string
+		path                string
+		query               string
+		expectCursor        string
+		expectPageSize      int
+		expectNumRecords    int
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		authDecryptData     *http_common.MockAuthData
+		catalogueData       *model_http.CataloguePage
+		catalogueTimes      int
+		authEncryptData     *http_common.MockAuthData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "empty token",
+			path:      "/catalogue/empty-token",
+			query:     fmt.Sprintf(testQuizQuery["catalogue"], "algebra", "math", 3, "PaGeCuRs0R"),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 0},
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:      "cursor decryption failure",
+			path:      "/catalogue/cursor-decryption-failure",
+			query:     fmt.Sprintf(testQuizQuery["catalogue"], "algebra", "math", 3, "PaGeCuRs0R"),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{
+				OutputErr: errors.New("decrypting cursor failed"),
+				Times:     1,
+			},
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:      "cursor encryption failure",
+			path:      "/catalogue/cursor-encryption-failure",
+			query:     testQuizQuery["catalogue_all"],
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 0},
+			catalogueData: &model_http.CataloguePage{
+				PageCursor: []byte("cursor to next page"),
+				Records:    []*model_http.CatalogueQuiz{{QuizID: quizUUID.String()}},
+				PageSize:   10,
+			},
+			catalogueTimes: 1,
+			authEncryptData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("encrypting cursor failed"),
+				Times:        1,
+			},
+		}, {
+			name:             "success",
+			path:             "/catalogue/success",
+			query:            fmt.Sprintf(testQuizQuery["catalogue"], "algebra", "math", 3, "PaGeCuRs0R"),
+			expectCursor:     "tHisIsAnEnCrYPtEdCUrS0r",
+			expectPageSize:   3,
+			expectNumRecords: 3,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 1},
+			catalogueData: &model_http.CataloguePage{
+				PageCursor: []byte("cursor to next page"),
+				Records: []*model_http.CatalogueQuiz{
+					{QuizID: quizUUID.String(), Tags: []string{"math"}},
+					{QuizID: quizUUID.String(), Tags: []string{"math"}},
+					{QuizID: quizUUID.String(), Tags: []string{"math"}},
+				},
+				PageSize: 3,
+			},
+			catalogueTimes: 1,
+			authEncryptData: &http_common.MockAuthData{
+				OutputParam1: "tHisIsAnEnCrYPtEdCUrS0r",
+				Times:        1,
+			},
+		}, {
+			name:             "success all quizzes no cursor",
+			path:             "/catalogue/success-all-quizzes-no-cursor",
+			query:            testQuizQuery["catalogue_all"],
+			expectNumRecords: 2,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 0},
+			catalogueData: &model_http.CataloguePage{
+				Records: []*model_http.CatalogueQuiz{
+					{QuizID: quizUUID.String()},
+					{QuizID: quizUUID.String()},
+				},
+				PageSize: 10,
+			},
+			catalogueTimes:  1,
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).Return(
+					testCase.authDecryptData.OutputParam1,
+					testCase.authDecryptData.OutputErr,
+				).Times(testCase.authDecryptData.Times),
+				mockCatalogue.EXPECT().Search(gomock.Any()).Return(
+					testCase.catalogueData,
+				).Times(testCase.catalogueTimes),
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).Return(
+					testCase.authEncryptData.OutputParam1,
+					testCase.authEncryptData.OutputErr,
+				).Times(testCase.authEncryptData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+
+				catalogue := data.(map[string]any)["catalogue"].(map[string]any)
+
+				records := catalogue["records"].([]any)
+				require.Equal(t, testCase.expectNumRecords, len(records), "record count does not match expected")
+				require.Equal(t, quizUUID.String(), records[0].(map[string]any)["quizID"], "quiz id did not match expected")
+
+				metadata := catalogue["metadata"].(map[string]any)
+				require.Equal(t, testCase.expectNumRecords, int(metadata["numRecords"].(float64)), "metadata record count does not match expected")
+
+				nextPage := catalogue["nextPage"].(map[string]any)
+				require.Equal(t, testCase.expectPageSize, int(nextPage["pageSize"].(float64)), "page size does not match expected")
+				require.Equal(t, testCase.expectCursor, nextPage["cursor"].(string), "cursor does not match expected")
+			}
+		})
+	}
+}
+
+func TestMutationResolver_ReviseQuiz(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	// The revised quiz record carries the groups the quiz is restricted to.
+	revisedQuiz := &model_cassandra.Quiz{
+		QuizCore:    testQuizData["myPubQuiz"].QuizCore,
+		QuizID:      gocql.TimeUUID(),
+		Author:      "username1",
+		Version:     2,
+		IsPublished: true,
+		Groups:      []gocql.UUID{gocql.TimeUUID()},
+	}
+
+	testCases := []struct {
+		name                string
+		path                string
+		quizId              string
+		query               string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		cassandraReviseData *http_common.MockCassandraData
+		redisDelData        *http_common.MockRedisData
+		cassandraReadData   *http_common.MockCassandraData
+		catalogueAddTimes   int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "empty token",
+			path:      "/revise/empty-token/",
+			quizId:    gocql.TimeUUID().String(),
+			query:     testQuizQuery["revise_valid"],
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{Times: 0},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:      "invalid quiz id",
+			path:      "/revise/invalid-quiz-id",
+			quizId:    "not a valid uuid",
+			query:     testQuizQuery["revise_valid"],
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        0,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{Times: 0},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:      "invalid quiz",
+			path:      "/revise/invalid-quiz",
+			quizId:    gocql.TimeUUID().String(),
+			query:     testQuizQuery["revise_invalid"],
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{Times: 0},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:      "db failure",
+			path:      "/revise/db-failure",
+			quizId:    gocql.TimeUUID().String(),
+			query:     testQuizQuery["revise_valid"],
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusForbidden},
+				Times:     1,
+			},
+			redisDelData:      &http_common.MockRedisData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:      "success - cache evict failure",
+			path:      "/revise/success-cache-evict-failure",
+			quizId:    gocql.TimeUUID().String(),
+			query:     testQuizQuery["revise_valid"],
+			expectErr: false,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{
+				OutputParam: 2,
+				Times:       1,
+			},
+			redisDelData: &http_common.MockRedisData{
+				Err:   &redis.Error{Message: "cache failure", Code: redis.ErrorCacheDel},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{OutputParam: revisedQuiz, Times: 1},
+			catalogueAddTimes: 1,
+		}, {
+			name:      "success",
+			path:      "/revise/success",
+			quizId:    gocql.TimeUUID().String(),
+			query:     testQuizQuery["revise_valid"],
+			expectErr: false,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{
+				OutputParam: 2,
+				Times:       1,
+			},
+			redisDelData:      &http_common.MockRedisData{Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{OutputParam: revisedQuiz, Times: 1},
+			catalogueAddTimes: 1,
+		}, {
+			name:      "success - db read failure",
+			path:      "/revise/success-db-read-failure",
+			quizId:    gocql.TimeUUID().String(),
+			query:     testQuizQuery["revise_valid"],
+			expectErr: false,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{
+				OutputParam: 2,
+				Times:       1,
+			},
+			redisDelData: &http_common.MockRedisData{Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			catalogueAddTimes: 0,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrading := mocks.NewMockGrading(mockCtrl) // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl)
+
+			gomock.InOrder(
+				// Check authorization.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Send data to Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReviseData.OutputParam,
+					testCase.cassandraReviseData.OutputErr,
+				).Times(testCase.cassandraReviseData.Times),
+
+				// Evict from Redis.
+				mockRedis.EXPECT().Del(gomock.Any()).Return(
+					testCase.redisDelData.Err,
+				).Times(testCase.redisDelData.Times),
+
+				// Retrieve the revised quiz record.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+
+				// List the revision in the catalogue, including the groups it is restricted to.
+				mockCatalogue.EXPECT().Add(gomock.Any()).Do(func(quiz *model_cassandra.Quiz) {
+					require.Equal(t, revisedQuiz.Groups, quiz.Groups, "catalogue listing does not carry the quiz groups")
+				}).Times(testCase.catalogueAddTimes),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testCase.query, testCase.quizId)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				// New version is expected.
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				version := data.(map[string]any)["reviseQuiz"].(float64)
+				require.Equal(t, float64(2), version, "actual and expected versions did not match")
+			}
+		})
+	}
+}
+
+func TestMutationResolver_ScheduleQuiz(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	testCases := []struct {
+		name                  string
+		path                  string
+		quizId                string
+		query                 string
+		expectErr             bool
+		authValidateJWTData   *http_common.MockAuthData
+		cassandraScheduleData *http_common.MockCassandraData
+		redisDelData          *http_common.MockRedisData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "empty token",
+			path:      "/schedule/empty-token/",
+			quizId:    gocql.TimeUUID().String(),
+			query:     testQuizQuery["schedule_valid"],
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			cassandraScheduleData: &http_common.MockCassandraData{Times: 0},
+			redisDelData:          &http_common.MockRedisData{Times: 0},
+		}, {
+			name:      "invalid quiz id",
+			path:      "/schedule/invalid-quiz-id",
+			quizId:    "not a valid uuid",
+			query:     testQuizQuery["schedule_valid"],
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        0,
+			},
+			cassandraScheduleData: &http_common.MockCassandraData{Times: 0},
+			redisDelData:          &http_common.MockRedisData{Times: 0},
+		}, {
+			name:      "closes before opening",
+			path:      "/schedule/closes-before-opening",
+			quizId:    gocql.TimeUUID().String(),
+			query:     testQuizQuery["schedule_invalid"],
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraScheduleData: &http_common.MockCassandraData{Times: 0},
+			redisDelData:          &http_common.MockRedisData{Times: 0},
+		}, {
+			name:      "db failure",
+			path:      "/schedule/db-failure",
+			quizId:    gocql.TimeUUID().String(),
+			query:     testQuizQuery["schedule_valid"],
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraScheduleData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusForbidden},
+				Times:     1,
+			},
+			redisDelData: &http_common.MockRedisData{Times: 0},
+		}, {
+			name:      "success - cache evict failure",
+			path:      "/schedule/success-cache-evict-failure",
+			quizId:    gocql.TimeUUID().String(),
+			query:     testQuizQuery["schedule_valid"],
+			expectErr: false,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraScheduleData: &http_common.MockCassandraData{Times: 1},
+			redisDelData: &http_common.MockRedisData{
+				Err:   &redis.Error{Message: "cache failure", Code: redis.ErrorCacheDel},
+				Times: 1,
+			},
+		}, {
+			name:      "success",
+			path:      "/schedule/success",
+			quizId:    gocql.TimeUUID().String(),
+			query:     testQuizQuery["schedule_valid"],
+			expectErr: false,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraScheduleData: &http_common.MockCassandraData{Times: 1},
+			redisDelData:          &http_common.MockRedisData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Check authorization.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Send data to Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraScheduleData.OutputParam,
+					testCase.cassandraScheduleData.OutputErr,
+				).Times(testCase.cassandraScheduleData.Times),
+
+				// Evict from Redis.
+				mockRedis.EXPECT().Del(gomock.Any()).Return(
+					testCase.redisDelData.Err,
+				).Times(testCase.redisDelData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testCase.query, testCase.quizId)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				message := data.(map[string]any)["scheduleQuiz"].(string)
+				require.Equal(t, fmt.Sprintf("successfully scheduled %s", testCase.quizId), message, "response message mismatch")
+			}
+		})
+	}
+}
+
+func TestQueryResolver_ListQuizVersions(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	// getVersions will generate the versions of a quiz with the answer keys present.
+	getVersions := func(key string) []*model_cassandra.QuizVersion {
+		quiz := cassandra.GetTestQuizzes()[key]
+		return []*model_cassandra.QuizVersion{
+			{QuizCore: quiz.QuizCore, QuizID: quiz.QuizID, Version: 1, Author: quiz.Author},
+			{QuizCore: cassandra.GetTestQuizzes()[key].QuizCore, QuizID: quiz.QuizID, Version: 2, Author: quiz.Author},
+		}
+	}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		quizId               string
+		expectErr            bool
+		expectAnswers        require.ValueAssertionFunc
+		authValidateJWTData  *http_common.MockAuthData
+		redisGetData         *http_common.MockRedisData
+		cassandraVersionData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "invalid quiz id",
+			path:      "/list-versions/invalid-quiz-id",
+			quizId:    "not a valid uuid",
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        0,
+			},
+			redisGetData:         &http_common.MockRedisData{Times: 0},
+			cassandraVersionData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:      "empty token",
+			path:      "/list-versions/empty-token",
+			quizId:    gocql.TimeUUID().String(),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisGetData:         &http_common.MockRedisData{Times: 0},
+			cassandraVersionData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:      "unpublished not owner",
+			path:      "/list-versions/unpublished-not-owner",
+			quizId:    gocql.TimeUUID().String(),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myNoPubQuiz"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:      "no versions",
+			path:      "/list-versions/no-versions",
+			quizId:    gocql.TimeUUID().String(),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "user-3",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myNoPubQuiz"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{
+				OutputParam: []*model_cassandra.QuizVersion(nil),
+				Times:       1,
+			},
+		}, {
+			name:          "published not owner",
+			path:          "/list-versions/published-not-owner",
+			quizId:        gocql.TimeUUID().String(),
+			expectErr:     false,
+			expectAnswers: require.Nil,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{
+				OutputParam: getVersions("myPubQuiz"),
+				Times:       1,
+			},
+		}, {
+			name:          "published owner",
+			path:          "/list-versions/published-owner",
+			quizId:        gocql.TimeUUID().String(),
+			expectErr:     false,
+			expectAnswers: require.NotNil,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "user-2",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{
+				OutputParam: getVersions("myPubQuiz"),
+				Times:       1,
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Check JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Get quiz from Redis.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+					testCase.redisGetData.Err,
+				).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Times(testCase.redisGetData.Times),
+
+				// Get versions from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraVersionData.OutputParam,
+					testCase.cassandraVersionData.OutputErr,
+				).Times(testCase.cassandraVersionData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testQuizQuery["list_versions"], testCase.quizId)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				// Versions are expected.
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				versions := data.(map[string]any)["listQuizVersions"].([]any)
+				require.Equal(t, 2, len(versions), "expected two versions")
+				for idx, version := range versions {
+					require.Equal(t, float64(idx+1), version.(map[string]any)["version"], "version mismatch")
+					questions := version.(map[string]any)["quizCore"].(map[string]any)["questions"].([]any)
+					testCase.expectAnswers(t, questions[0].(map[string]any)["answers"], "answers expectation failed")
+				}
+			}
+		})
+	}
+}
+
+func TestQueryResolver_ViewQuizVersion(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	// getVersion will generate a version of a quiz with the answer keys present.
+	getVersion := func(key string) *model_cassandra.QuizVersion {
+		quiz := cassandra.GetTestQuizzes()[key]
+		return &model_cassandra.QuizVersion{QuizCore: quiz.QuizCore, QuizID: quiz.QuizID, Version: 1, Author: quiz.Author}
+	}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		quizId               string
+		version              int
+		expectErr            bool
+		expectAnswers        require.ValueAssertionFunc
+		authValidateJWTData  *http_common.MockAuthData
+		redisGetData         *http_common.MockRedisData
+		cassandraVersionData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "invalid quiz id",
+			path:      "/view-version/invalid-quiz-id",
+			quizId:    "not a valid uuid",
+			version:   1,
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        0,
+			},
+			redisGetData:         &http_common.MockRedisData{Times: 0},
+			cassandraVersionData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:      "negative version",
+			path:      "/view-version/negative-version",
+			quizId:    gocql.TimeUUID().String(),
+			version:   -1,
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        0,
+			},
+			redisGetData:         &http_common.MockRedisData{Times: 0},
+			cassandraVersionData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:      "published but deleted not owner",
+			path:      "/view-version/published-but-deleted-not-owner",
+			quizId:    gocql.TimeUUID().String(),
+			version:   1,
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuizDeleted"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:      "version not found",
+			path:      "/view-version/version-not-found",
+			quizId:    gocql.TimeUUID().String(),
+			version:   7,
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "quiz version not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+		}, {
+			name:          "published not owner",
+			path:          "/view-version/published-not-owner",
+			quizId:        gocql.TimeUUID().String(),
+			version:       1,
+			expectErr:     false,
+			expectAnswers: require.Nil,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{
+				OutputParam: getVersion("myPubQuiz"),
+				Times:       1,
+			},
+		}, {
+			name:          "published owner",
+			path:          "/view-version/published-owner",
+			quizId:        gocql.TimeUUID().String(),
+			version:       1,
+			expectErr:     false,
+			expectAnswers: require.NotNil,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "user-2",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{
+				OutputParam: getVersion("myPubQuiz"),
+				Times:       1,
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Check JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Get quiz from Redis.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+					testCase.redisGetData.Err,
+				).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Times(testCase.redisGetData.Times),
+
+				// Get version from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraVersionData.OutputParam,
+					testCase.cassandraVersionData.OutputErr,
+				).Times(testCase.cassandraVersionData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path,
+				bytes.NewBufferString(fmt.Sprintf(testQuizQuery["view_version"], testCase.quizId, testCase.version)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				// Version is expected.
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				version := data.(map[string]any)["viewQuizVersion"].(map[string]any)
+				require.Equal(t, float64(testCase.version), version["version"], "version mismatch")
+				require.NotEmpty(t, version["quizID"], "quiz id not returned")
+				questions := version["quizCore"].(map[string]any)["questions"].([]any)
+				testCase.expectAnswers(t, questions[0].(map[string]any)["answers"], "answers expectation failed")
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package graphql_resolvers
+
+import (
+	"github.com/surahman/mcq-platform/pkg/auth"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	model_http "github.com/surahman/mcq-platform/pkg/model/http"
+)
+
+// prepareAuthorQuizzesResponse will prepare the GraphQL response struct for a page of the quizzes created by the requester.
+func prepareAuthorQuizzesResponse(auth auth.Auth, dbResponse *model_cassandra.AuthorQuizzesResponse, status string) (response *model_http.AuthorQuizzesResponseGraphQL, err error) {
+	response = &model_http.AuthorQuizzesResponseGraphQL{Records: dbResponse.Records}
+	response.Metadata.Status = status
+	response.Metadata.NumRecords = len(dbResponse.Records)
+
+	// Encrypt page cursor link.
+	if len(dbResponse.PageCursor) != 0 {
+		if response.NextPage.Cursor, err = auth.EncryptToString(dbResponse.PageCursor); err != nil {
+			return nil, err
+		}
+	}
+
+	// Construct page size link segment.
+	if len(dbResponse.PageCursor) != 0 && dbResponse.PageSize > 0 {
+		response.NextPage.PageSize = dbResponse.PageSize
+	}
+
+	return
+}
+
+// prepareCatalogueResponse will prepare the GraphQL response struct for a page of the quizzes listed in the catalogue.
+func prepareCatalogueResponse(auth auth.Auth, page *model_http.CataloguePage, request *model_http.CatalogueRequest) (response *model_http.CatalogueResponseGraphQL, err error) {
+	response = &model_http.CatalogueResponseGraphQL{Records: page.Records}
+	response.Metadata.Search = request.Search
+	response.Metadata.Tag = request.Tag
+	response.Metadata.NumRecords = len(page.Records)
+
+	// Encrypt page cursor link.
+	if len(page.PageCursor) != 0 {
+		if response.NextPage.Cursor, err = auth.EncryptToString(page.PageCursor); err != nil {
+			return nil, err
+		}
+	}
+
+	// Construct page size link segment.
+	if len(page.PageCursor) != 0 && page.PageSize > 0 {
+		response.NextPage.PageSize = page.PageSize
+	}
+
+	return
+}
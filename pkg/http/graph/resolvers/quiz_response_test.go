@@ -0,0 +1,170 @@
+package graphql_resolvers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+)
+
+func TestQueryResolver_prepareAuthorQuizzesResponse(t *testing.T) {
+	encryptedCursor := "encrypted-page-cursor-byte-string"
+
+	testCases := []struct {
+		name             string
+		status           string
+		expectedCursor   []byte
+		expectedPageSize int
+		dbResponse       *model_cassandra.AuthorQuizzesResponse
+		mockAuthData     *http_common.MockAuthData
+		expectErr        require.ErrorAssertionFunc
+		expectNil        require.ValueAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:             "single page only",
+			expectedCursor:   []byte{},
+			expectedPageSize: 0,
+			dbResponse: &model_cassandra.AuthorQuizzesResponse{
+				PageCursor: nil,
+				Records:    []*model_cassandra.AuthorQuiz{{Title: "quiz"}},
+				PageSize:   3,
+			},
+			mockAuthData: &http_common.MockAuthData{Times: 0, OutputParam1: ""},
+			expectErr:    require.NoError,
+			expectNil:    require.NotNil,
+		}, {
+			name:             "cursor and page",
+			status:           model_cassandra.QuizStatusDraft,
+			expectedCursor:   []byte(encryptedCursor),
+			expectedPageSize: 3,
+			dbResponse: &model_cassandra.AuthorQuizzesResponse{
+				PageCursor: []byte("page-cursor-byte-string"),
+				Records:    []*model_cassandra.AuthorQuiz{{Title: "quiz"}},
+				PageSize:   3,
+			},
+			mockAuthData: &http_common.MockAuthData{Times: 1, OutputParam1: encryptedCursor},
+			expectErr:    require.NoError,
+			expectNil:    require.NotNil,
+		}, {
+			name: "encryption failure",
+			dbResponse: &model_cassandra.AuthorQuizzesResponse{
+				PageCursor: []byte("page-cursor-byte-string"),
+				PageSize:   3,
+			},
+			mockAuthData: &http_common.MockAuthData{Times: 1, OutputParam1: "", OutputErr: errors.New("encryption failure")},
+			expectErr:    require.Error,
+			expectNil:    require.Nil,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+
+			mockAuth.EXPECT().EncryptToString(gomock.Any()).Return(
+				testCase.mockAuthData.OutputParam1,
+				testCase.mockAuthData.OutputErr,
+			).Times(testCase.mockAuthData.Times)
+
+			resp, err := prepareAuthorQuizzesResponse(mockAuth, testCase.dbResponse, testCase.status)
+			testCase.expectErr(t, err, "error expectation condition failed")
+			testCase.expectNil(t, resp, "nil expectation condition failed")
+
+			if err == nil {
+				require.Equal(t, testCase.expectedCursor, []byte(resp.Cursor), "page cursor mismatch")
+				require.Equal(t, testCase.expectedPageSize, resp.PageSize, "page size mismatch")
+				require.Equal(t, testCase.status, resp.Metadata.Status, "status mismatch")
+				require.Equal(t, len(testCase.dbResponse.Records), resp.Metadata.NumRecords, "number of records mismatch")
+			}
+		})
+	}
+}
+
+func TestQueryResolver_prepareCatalogueResponse(t *testing.T) {
+	encryptedCursor := "encrypted-page-cursor-byte-string"
+
+	testCases := []struct {
+		name             string
+		request          *model_http.CatalogueRequest
+		expectedCursor   []byte
+		expectedPageSize int
+		page             *model_http.CataloguePage
+		mockAuthData     *http_common.MockAuthData
+		expectErr        require.ErrorAssertionFunc
+		expectNil        require.ValueAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:             "single page only",
+			request:          &model_http.CatalogueRequest{PageSize: 3},
+			expectedCursor:   []byte{},
+			expectedPageSize: 0,
+			page: &model_http.CataloguePage{
+				PageCursor: nil,
+				Records:    []*model_http.CatalogueQuiz{{Title: "quiz"}},
+				PageSize:   3,
+			},
+			mockAuthData: &http_common.MockAuthData{Times: 0, OutputParam1: ""},
+			expectErr:    require.NoError,
+			expectNil:    require.NotNil,
+		}, {
+			name:             "cursor, page, search, and tag",
+			request:          &model_http.CatalogueRequest{Search: "linear algebra", Tag: "math", PageSize: 3},
+			expectedCursor:   []byte(encryptedCursor),
+			expectedPageSize: 3,
+			page: &model_http.CataloguePage{
+				PageCursor: []byte("page-cursor-byte-string"),
+				Records:    []*model_http.CatalogueQuiz{{Title: "quiz"}},
+				PageSize:   3,
+			},
+			mockAuthData: &http_common.MockAuthData{Times: 1, OutputParam1: encryptedCursor},
+			expectErr:    require.NoError,
+			expectNil:    require.NotNil,
+		}, {
+			name:    "encryption failure",
+			request: &model_http.CatalogueRequest{PageSize: 3},
+			page: &model_http.CataloguePage{
+				PageCursor: []byte("page-cursor-byte-string"),
+				PageSize:   3,
+			},
+			mockAuthData: &http_common.MockAuthData{Times: 1, OutputParam1: "", OutputErr: errors.New("encryption failure")},
+			expectErr:    require.Error,
+			expectNil:    require.Nil,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+
+			mockAuth.EXPECT().EncryptToString(gomock.Any()).Return(
+				testCase.mockAuthData.OutputParam1,
+				testCase.mockAuthData.OutputErr,
+			).Times(testCase.mockAuthData.Times)
+
+			resp, err := prepareCatalogueResponse(mockAuth, testCase.page, testCase.request)
+			testCase.expectErr(t, err, "error expectation condition failed")
+			testCase.expectNil(t, resp, "nil expectation condition failed")
+
+			if err == nil {
+				require.Equal(t, testCase.expectedCursor, []byte(resp.Cursor), "page cursor mismatch")
+				require.Equal(t, testCase.expectedPageSize, resp.PageSize, "page size mismatch")
+				require.Equal(t, testCase.request.Search, resp.Metadata.Search, "search terms mismatch")
+				require.Equal(t, testCase.request.Tag, resp.Metadata.Tag, "tag mismatch")
+				require.Equal(t, len(testCase.page.Records), resp.Metadata.NumRecords, "number of records mismatch")
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package graphql_resolvers
 import (
 	"github.com/surahman/mcq-platform/pkg/auth"
 	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/catalogue"
 	"github.com/surahman/mcq-platform/pkg/grading"
 	"github.com/surahman/mcq-platform/pkg/logger"
 	"github.com/surahman/mcq-platform/pkg/redis"
@@ -16,6 +17,7 @@ type Resolver struct {
 	AuthHeaderKey string
 	Auth          auth.Auth
 	Cache         redis.Redis
+	Catalogue     catalogue.Catalogue
 	DB            cassandra.Cassandra
 	Grading       grading.Grading
 	Logger        *logger.Logger
@@ -6,29 +6,126 @@ package graphql_resolvers
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/gocql/gocql"
-	"github.com/surahman/mcq-platform/pkg/cassandra"
 	http_common "github.com/surahman/mcq-platform/pkg/http"
 	graphql_generated "github.com/surahman/mcq-platform/pkg/http/graph/generated"
 	model_cassandra "github.com/surahman/mcq-platform/pkg/model/cassandra"
+	model_http "github.com/surahman/mcq-platform/pkg/model/http"
 	"github.com/surahman/mcq-platform/pkg/validator"
 )
 
+// QuizID is the resolver for the quizID field.
+func (r *answerDraftResolver) QuizID(ctx context.Context, obj *model_cassandra.AnswerDraft) (string, error) {
+	return obj.QuizID.String(), nil
+}
+
+// Responses is the resolver for the responses field.
+func (r *answerDraftResolver) Responses(ctx context.Context, obj *model_cassandra.AnswerDraft) ([][]int32, error) {
+	if obj.QuizResponse == nil {
+		return nil, nil
+	}
+	return obj.QuizResponse.Responses, nil
+}
+
+// TextResponses is the resolver for the textResponses field.
+func (r *answerDraftResolver) TextResponses(ctx context.Context, obj *model_cassandra.AnswerDraft) ([]string, error) {
+	if obj.QuizResponse == nil {
+		return nil, nil
+	}
+	return obj.QuizResponse.TextResponses, nil
+}
+
+// QuizID is the resolver for the quizID field.
+func (r *attemptSessionResolver) QuizID(ctx context.Context, obj *model_http.AttemptSession) (string, error) {
+	return obj.QuizID.String(), nil
+}
+
+// StartQuiz is the resolver for the startQuiz field.
+func (r *mutationResolver) StartQuiz(ctx context.Context, quizID string) (*model_http.AttemptSession, error) {
+	var err error
+	var username, role string
+	var quiz *model_cassandra.Quiz
+	var previous *model_cassandra.Response
+	var session *model_cassandra.AttemptSession
+	var quizId gocql.UUID
+
+	if quizId, err = gocql.ParseUUID(quizID); err != nil {
+		return nil, errors.New("invalid quiz id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	// Get quiz:
+	// [1] Cache call.
+	// [2] Cache miss: read from the database and store it in the cache.
+	if quiz, err = http_common.GetQuiz(quizId, r.DB, r.Cache); err != nil {
+		return nil, err
+	}
+
+	// Check to see if the quiz is deleted, unpublished, or closed.
+	now := time.Now()
+	if err = http_common.CheckAvailability(quiz, now); err != nil {
+		return nil, err
+	}
+
+	// Check to see if the quiz is restricted to groups the requester is not a member of.
+	if err = http_common.CheckGroupAccess(quiz, username, role, r.DB); err != nil {
+		return nil, err
+	}
+
+	// Check the previous attempts against the attempt limit and cooldown.
+	if previous, err = http_common.GetResponse(username, quizId, r.DB); err != nil {
+		return nil, err
+	}
+
+	if session, err = http_common.StartAttempt(quiz, username, previous, now, r.DB); err != nil {
+		return nil, err
+	}
+
+	return &model_http.AttemptSession{AttemptSession: session, RemainingTime: http_common.RemainingTime(session, now)}, nil
+}
+
 // TakeQuiz is the resolver for the takeQuiz field.
-func (r *mutationResolver) TakeQuiz(ctx context.Context, quizID string, input model_cassandra.QuizResponse) (*model_cassandra.Response, error) {
+func (r *mutationResolver) TakeQuiz(ctx context.Context, quizID string, input model_cassandra.QuizResponse) (*model_http.ScoreCard, error) {
 	var err error
-	var username string
+	var username, role string
+	var quizId gocql.UUID
+
+	if quizId, err = gocql.ParseUUID(quizID); err != nil {
+		return nil, errors.New("invalid quiz id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	if err = validator.ValidateStruct(&input); err != nil {
+		return nil, err
+	}
+
+	return r.submitAttempt(username, role, quizId, &input)
+}
+
+// SaveDraft is the resolver for the saveDraft field.
+func (r *mutationResolver) SaveDraft(ctx context.Context, quizID string, input model_cassandra.QuizResponse) (*model_cassandra.AnswerDraft, error) {
+	var err error
+	var username, role string
 	var quiz *model_cassandra.Quiz
+	var previous *model_cassandra.Response
 	var quizId gocql.UUID
-	var score float64
 
 	if quizId, err = gocql.ParseUUID(quizID); err != nil {
 		return nil, errors.New("invalid quiz id supplied, must be a valid UUID")
 	}
 
 	// Get username from JWT.
-	if username, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
 		return nil, err
 	}
 
@@ -43,28 +140,123 @@ func (r *mutationResolver) TakeQuiz(ctx context.Context, quizID string, input mo
 		return nil, err
 	}
 
-	// Check to see if the quiz is deleted or unpublished.
-	if !quiz.IsPublished || quiz.IsDeleted {
+	// Check to see if the quiz is deleted, unpublished, or closed.
+	now := time.Now()
+	if err = http_common.CheckAvailability(quiz, now); err != nil {
 		return nil, err
 	}
 
-	// Grade the quizResponse.
-	if score, err = r.Grading.Grade(&input, quiz.QuizCore); err != nil {
+	// Check to see if the quiz is restricted to groups the requester is not a member of.
+	if err = http_common.CheckGroupAccess(quiz, username, role, r.DB); err != nil {
 		return nil, err
 	}
 
-	// Insert updated record.
-	response := model_cassandra.Response{
-		Username:     username,
-		Score:        score,
-		QuizResponse: &input,
-		QuizID:       quizId,
+	// Check the previous attempts against the attempt limit and cooldown.
+	if previous, err = http_common.GetResponse(username, quizId, r.DB); err != nil {
+		return nil, err
 	}
-	if _, err = r.DB.Execute(cassandra.CreateResponseQuery, &response); err != nil {
+
+	if err = http_common.CheckAttempt(quiz, previous, now); err != nil {
 		return nil, err
 	}
 
-	return &response, nil
+	return http_common.SaveDraft(username, quizId, previous, &input, now, r.DB, r.Cache)
+}
+
+// SubmitDraft is the resolver for the submitDraft field.
+func (r *mutationResolver) SubmitDraft(ctx context.Context, quizID string) (*model_http.ScoreCard, error) {
+	var err error
+	var username, role string
+	var quizId gocql.UUID
+
+	if quizId, err = gocql.ParseUUID(quizID); err != nil {
+		return nil, errors.New("invalid quiz id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	return r.submitAttempt(username, role, quizId, nil)
+}
+
+// PracticeQuiz is the resolver for the practiceQuiz field.
+func (r *queryResolver) PracticeQuiz(ctx context.Context, quizID string, input model_http.PracticeCheck) (*model_http.QuestionBreakdown, error) {
+	var err error
+	var username, role string
+	var quiz *model_cassandra.Quiz
+	var quizId gocql.UUID
+
+	if quizId, err = gocql.ParseUUID(quizID); err != nil {
+		return nil, errors.New("invalid quiz id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	if err = validator.ValidateStruct(&input); err != nil {
+		return nil, err
+	}
+
+	// Count the check against the requester's practice limit.
+	if err = http_common.CheckPracticeRate(username, r.Cache); err != nil {
+		return nil, err
+	}
+
+	// Get quiz:
+	// [1] Cache call.
+	// [2] Cache miss: read from the database and store it in the cache.
+	if quiz, err = http_common.GetQuiz(quizId, r.DB, r.Cache); err != nil {
+		return nil, err
+	}
+
+	// Check to see if the quiz is deleted or unpublished. Closed quizzes remain available for practice.
+	now := time.Now()
+	if err = http_common.CheckAvailability(quiz, now); errors.Is(err, http_common.ErrQuizUnavailable) {
+		return nil, err
+	}
+
+	// Check to see if the quiz is restricted to groups the requester is not a member of.
+	if err = http_common.CheckGroupAccess(quiz, username, role, r.DB); err != nil {
+		return nil, err
+	}
+
+	// Authors and administrators practice the quiz in its original order, whereas all other users check the quiz as drawn
+	// and ordered for their next attempt.
+	if !http_common.CanManage(quiz.Author, username, role) {
+		if quiz.QuizCore, err = http_common.PresentQuiz(quiz, username, r.DB); err != nil {
+			return nil, err
+		}
+	}
+
+	return http_common.CheckPractice(quiz.QuizCore, &input, http_common.RevealAnswers(quiz, now), r.Grading)
+}
+
+// ResumeDraft is the resolver for the resumeDraft field.
+func (r *queryResolver) ResumeDraft(ctx context.Context, quizID string) (*model_cassandra.AnswerDraft, error) {
+	var err error
+	var username string
+	var previous *model_cassandra.Response
+	var quizId gocql.UUID
+
+	if quizId, err = gocql.ParseUUID(quizID); err != nil {
+		return nil, errors.New("invalid quiz id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	// Get the previous attempts to locate the draft of the next attempt.
+	if previous, err = http_common.GetResponse(username, quizId, r.DB); err != nil {
+		return nil, err
+	}
+
+	return http_common.GetDraft(username, quizId, previous, r.DB, r.Cache)
 }
 
 // QuizResponse is the resolver for the QuizResponse field.
@@ -75,12 +267,60 @@ func (r *responseResolver) QuizResponse(ctx context.Context, obj *model_cassandr
 	return obj.QuizResponse.Responses, nil
 }
 
+// TextResponses is the resolver for the textResponses field.
+func (r *responseResolver) TextResponses(ctx context.Context, obj *model_cassandra.Response) ([]string, error) {
+	if obj.QuizResponse == nil {
+		return nil, nil
+	}
+	return obj.QuizResponse.TextResponses, nil
+}
+
 // QuizID is the resolver for the QuizID field.
 func (r *responseResolver) QuizID(ctx context.Context, obj *model_cassandra.Response) (string, error) {
 	return obj.QuizID.String(), nil
 }
 
+// QuizResponse is the resolver for the quizResponse field.
+func (r *scoreCardResolver) QuizResponse(ctx context.Context, obj *model_http.ScoreCard) ([][]int32, error) {
+	if obj.Response == nil || obj.Response.QuizResponse == nil {
+		return nil, nil
+	}
+	return obj.Response.QuizResponse.Responses, nil
+}
+
+// TextResponses is the resolver for the textResponses field.
+func (r *scoreCardResolver) TextResponses(ctx context.Context, obj *model_http.ScoreCard) ([]string, error) {
+	if obj.Response == nil || obj.Response.QuizResponse == nil {
+		return nil, nil
+	}
+	return obj.Response.QuizResponse.TextResponses, nil
+}
+
+// QuizID is the resolver for the quizID field.
+func (r *scoreCardResolver) QuizID(ctx context.Context, obj *model_http.ScoreCard) (string, error) {
+	if obj.Response == nil {
+		return "", nil
+	}
+	return obj.Response.QuizID.String(), nil
+}
+
+// AnswerDraft returns graphql_generated.AnswerDraftResolver implementation.
+func (r *Resolver) AnswerDraft() graphql_generated.AnswerDraftResolver {
+	return &answerDraftResolver{r}
+}
+
+// AttemptSession returns graphql_generated.AttemptSessionResolver implementation.
+func (r *Resolver) AttemptSession() graphql_generated.AttemptSessionResolver {
+	return &attemptSessionResolver{r}
+}
+
 // Response returns graphql_generated.ResponseResolver implementation.
 func (r *Resolver) Response() graphql_generated.ResponseResolver { return &responseResolver{r} }
 
+// ScoreCard returns graphql_generated.ScoreCardResolver implementation.
+func (r *Resolver) ScoreCard() graphql_generated.ScoreCardResolver { return &scoreCardResolver{r} }
+
+type answerDraftResolver struct{ *Resolver }
+type attemptSessionResolver struct{ *Resolver }
 type responseResolver struct{ *Resolver }
+type scoreCardResolver struct{ *Resolver }
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/golang/mock/gomock"
@@ -17,6 +18,7 @@ import (
 	http_common "github.com/surahman/mcq-platform/pkg/http"
 	"github.com/surahman/mcq-platform/pkg/mocks"
 	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
 	"github.com/surahman/mcq-platform/pkg/redis"
 )
 
@@ -108,31 +110,67 @@ func TestResponseResolver_QuizID(t *testing.T) {
 	}
 }
 
-func TestMutationResolver_TakeQuiz(t *testing.T) {
+func TestAttemptSessionResolver_QuizID(t *testing.T) {
+	resolver := attemptSessionResolver{}
+	session := &model_http.AttemptSession{AttemptSession: &model_cassandra.AttemptSession{QuizID: gocql.TimeUUID()}}
+
+	quizID, err := resolver.QuizID(context.TODO(), session)
+	require.NoError(t, err, "failed to resolve quiz id")
+	require.Equal(t, session.QuizID.String(), quizID, "quiz id mismatch")
+}
+
+func TestAnswerDraftResolver(t *testing.T) {
+	resolver := answerDraftResolver{}
+	draft := &model_cassandra.AnswerDraft{QuizID: gocql.TimeUUID(),
+		QuizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{0}, {1}}, TextResponses: []string{"", "answer"}}}
+
+	quizID, err := resolver.QuizID(context.TODO(), draft)
+	require.NoError(t, err, "failed to resolve quiz id")
+	require.Equal(t, draft.QuizID.String(), quizID, "quiz id mismatch")
+
+	responses, err := resolver.Responses(context.TODO(), draft)
+	require.NoError(t, err, "failed to resolve responses")
+	require.Equal(t, draft.Responses, responses, "responses mismatch")
+
+	textResponses, err := resolver.TextResponses(context.TODO(), draft)
+	require.NoError(t, err, "failed to resolve text responses")
+	require.Equal(t, draft.TextResponses, textResponses, "text responses mismatch")
+
+	// Drafts without any answers.
+	empty := &model_cassandra.AnswerDraft{}
+	responses, err = resolver.Responses(context.TODO(), empty)
+	require.NoError(t, err, "failed to resolve empty responses")
+	require.Nil(t, responses, "empty draft should not have responses")
+
+	textResponses, err = resolver.TextResponses(context.TODO(), empty)
+	require.NoError(t, err, "failed to resolve empty text responses")
+	require.Nil(t, textResponses, "empty draft should not have text responses")
+}
+
+func TestMutationResolver_StartQuiz(t *testing.T) {
 	// Configure router and middleware that loads the Gin context for the resolvers.
 	router := http_common.GetTestRouter()
 	router.Use(GinContextToContextMiddleware())
+	closesAt := time.Now().Add(-time.Hour)
 
 	testCases := []struct {
-		name                string
-		path                string
-		quizId              string
-		expectErr           bool
-		quizResponse        *model_cassandra.QuizResponse
-		authValidateJWTData *http_common.MockAuthData
-		redisGetData        *http_common.MockRedisData
-		cassandraReadData   *http_common.MockCassandraData
-		redisSetData        *http_common.MockRedisData
-		cassandraTakeData   *http_common.MockCassandraData
-		graderData          *http_common.MockGraderData
+		name                  string
+		path                  string
+		quizId                string
+		expectErr             bool
+		authValidateJWTData   *http_common.MockAuthData
+		redisGetData          *http_common.MockRedisData
+		cassandraReadData     *http_common.MockCassandraData
+		redisSetData          *http_common.MockRedisData
+		cassandraPreviousData *http_common.MockCassandraData
+		cassandraSessionData  *http_common.MockCassandraData
 	}{
 		// ----- test cases start ----- //
 		{
-			name:         "empty token",
-			path:         "/take/empty-token/",
-			quizId:       gocql.TimeUUID().String(),
-			expectErr:    true,
-			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			name:      "empty token",
+			path:      "/start/empty-token/",
+			quizId:    gocql.TimeUUID().String(),
+			expectErr: true,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				OutputErr:    errors.New("invalid token"),
@@ -147,18 +185,17 @@ func TestMutationResolver_TakeQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
+			cassandraPreviousData: &http_common.MockCassandraData{
 				Times: 0,
 			},
-			graderData: &http_common.MockGraderData{
+			cassandraSessionData: &http_common.MockCassandraData{
 				Times: 0,
 			},
 		}, {
-			name:         "invalid quiz id",
-			path:         "/take/invalid-quiz-id",
-			quizId:       "not a valid uuid",
-			expectErr:    true,
-			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			name:      "invalid quiz id",
+			path:      "/start/invalid-quiz-id/",
+			quizId:    "not a valid uuid",
+			expectErr: true,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        0,
@@ -172,43 +209,17 @@ func TestMutationResolver_TakeQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
-				Times: 0,
-			},
-			graderData: &http_common.MockGraderData{
-				Times: 0,
-			},
-		}, {
-			name:         "request validate failure",
-			path:         "/take/request-validate-failure/",
-			quizId:       gocql.TimeUUID().String(),
-			expectErr:    true,
-			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{-1}, {1, 2, 3, 4}}},
-			authValidateJWTData: &http_common.MockAuthData{
-				OutputParam1: "",
-				Times:        1,
-			},
-			redisGetData: &http_common.MockRedisData{
-				Times: 0,
-			},
-			cassandraReadData: &http_common.MockCassandraData{
-				Times: 0,
-			},
-			redisSetData: &http_common.MockRedisData{
-				Times: 0,
-			},
-			cassandraTakeData: &http_common.MockCassandraData{
+			cassandraPreviousData: &http_common.MockCassandraData{
 				Times: 0,
 			},
-			graderData: &http_common.MockGraderData{
+			cassandraSessionData: &http_common.MockCassandraData{
 				Times: 0,
 			},
 		}, {
-			name:         "db read unauthorized",
-			path:         "/take/db-read-unauthorized/",
-			quizId:       gocql.TimeUUID().String(),
-			expectErr:    true,
-			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			name:      "db read failure",
+			path:      "/start/db-read-failure/",
+			quizId:    gocql.TimeUUID().String(),
+			expectErr: true,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
@@ -222,327 +233,1938 @@ func TestMutationResolver_TakeQuiz(t *testing.T) {
 				Times: 1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputErr: &cassandra.Error{
-					Message: "",
-					Status:  http.StatusForbidden,
-				},
-				Times: 1,
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
 			},
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
+			cassandraPreviousData: &http_common.MockCassandraData{
 				Times: 0,
 			},
-			graderData: &http_common.MockGraderData{
+			cassandraSessionData: &http_common.MockCassandraData{
 				Times: 0,
 			},
 		}, {
-			name:         "db read failure",
-			path:         "/take/db-read-failure/",
-			quizId:       gocql.TimeUUID().String(),
-			expectErr:    true,
-			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			name:      "quiz unpublished",
+			path:      "/start/quiz-unpublished/",
+			quizId:    gocql.TimeUUID().String(),
+			expectErr: true,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
+				Param2: model_cassandra.Quiz{QuizCore: &model_cassandra.QuizCore{TimeLimit: 600}},
+				Times:  1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputErr: &cassandra.Error{
-					Message: "",
-					Status:  http.StatusInternalServerError,
-				},
-				Times: 1,
+				Times: 0,
 			},
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
+			cassandraPreviousData: &http_common.MockCassandraData{
 				Times: 0,
 			},
-			graderData: &http_common.MockGraderData{
+			cassandraSessionData: &http_common.MockCassandraData{
 				Times: 0,
 			},
 		}, {
-			name:         "quiz unpublished",
-			path:         "/take/quiz-unpublished/",
-			quizId:       gocql.TimeUUID().String(),
-			expectErr:    true,
-			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			name:      "quiz closed",
+			path:      "/start/quiz-closed/",
+			quizId:    gocql.TimeUUID().String(),
+			expectErr: true,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
+				Param2: model_cassandra.Quiz{IsPublished: true, ClosesAt: &closesAt, QuizCore: &model_cassandra.QuizCore{TimeLimit: 600}},
+				Times:  1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputParam: &model_cassandra.Quiz{
-					IsPublished: false,
-				},
-				Times: 1,
+				Times: 0,
 			},
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
+			cassandraPreviousData: &http_common.MockCassandraData{
 				Times: 0,
 			},
-			graderData: &http_common.MockGraderData{
+			cassandraSessionData: &http_common.MockCassandraData{
 				Times: 0,
 			},
 		}, {
-			name:         "quiz deleted",
-			path:         "/take/quiz-deleted/",
-			quizId:       gocql.TimeUUID().String(),
-			expectErr:    true,
-			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			name:      "previous attempts read failure",
+			path:      "/start/previous-attempts-read-failure/",
+			quizId:    gocql.TimeUUID().String(),
+			expectErr: true,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{MaxAttempts: 2, AttemptCooldown: 3600, TimeLimit: 600}},
+				Times:  1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputParam: &model_cassandra.Quiz{
-					IsPublished: true,
-					IsDeleted:   true,
-				},
-				Times: 1,
+				Times: 0,
 			},
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
-				Times: 0,
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
 			},
-			graderData: &http_common.MockGraderData{
+			cassandraSessionData: &http_common.MockCassandraData{
 				Times: 0,
 			},
 		}, {
-			name:         "grader failure",
-			path:         "/take/grader-failure/",
-			quizId:       gocql.TimeUUID().String(),
-			expectErr:    true,
-			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			name:      "quiz not timed",
+			path:      "/start/quiz-not-timed/",
+			quizId:    gocql.TimeUUID().String(),
+			expectErr: true,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{}},
+				Times:  1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputParam: &model_cassandra.Quiz{IsPublished: true},
-				OutputErr:   nil,
-				Times:       1,
+				Times: 0,
 			},
 			redisSetData: &http_common.MockRedisData{
-				Times: 1,
-			},
-			cassandraTakeData: &http_common.MockCassandraData{
 				Times: 0,
 			},
-			graderData: &http_common.MockGraderData{
-				OutputErr: errors.New("grader failure"),
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
 				Times:     1,
 			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
-			name:         "db take unauthorized",
-			path:         "/take/db-take-unauthorized/",
-			quizId:       gocql.TimeUUID().String(),
-			expectErr:    true,
-			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			name:      "attempt cooldown",
+			path:      "/start/attempt-cooldown/",
+			quizId:    gocql.TimeUUID().String(),
+			expectErr: true,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{MaxAttempts: 2, AttemptCooldown: 3600, TimeLimit: 600}},
+				Times:  1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputParam: &model_cassandra.Quiz{IsPublished: true},
-				OutputErr:   nil,
-				Times:       1,
+				Times: 0,
 			},
 			redisSetData: &http_common.MockRedisData{
-				Times: 1,
+				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
-				OutputErr: &cassandra.Error{
-					Message: "db take auth failure",
-					Status:  http.StatusForbidden,
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Response{
+					Attempts: []*model_cassandra.Attempt{{Number: 1, SubmittedAt: time.Now().Add(-time.Minute)}},
 				},
 				Times: 1,
 			},
-			graderData: &http_common.MockGraderData{
-				OutputParam: 1.333,
-				Times:       1,
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
 			},
 		}, {
-			name:         "db take failure",
-			path:         "/take/db-take-failure/",
-			quizId:       gocql.TimeUUID().String(),
-			expectErr:    true,
-			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			name:      "db start failure",
+			path:      "/start/db-start-failure/",
+			quizId:    gocql.TimeUUID().String(),
+			expectErr: true,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{MaxAttempts: 2, AttemptCooldown: 3600, TimeLimit: 600}},
+				Times:  1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputParam: &model_cassandra.Quiz{IsPublished: true},
-				OutputErr:   nil,
-				Times:       1,
+				Times: 0,
 			},
 			redisSetData: &http_common.MockRedisData{
-				Times: 1,
+				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
-				OutputErr: &cassandra.Error{
-					Message: "db take auth failure",
-					Status:  http.StatusInternalServerError,
-				},
-				Times: 1,
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
 			},
-			graderData: &http_common.MockGraderData{
-				OutputParam: 1.333,
-				Times:       1,
+			cassandraSessionData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
 			},
 		}, {
-			name:         "success",
-			path:         "/take/success/",
-			quizId:       gocql.TimeUUID().String(),
-			expectErr:    false,
-			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			name:      "success",
+			path:      "/start/success/",
+			quizId:    gocql.TimeUUID().String(),
+			expectErr: false,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{MaxAttempts: 2, AttemptCooldown: 3600, TimeLimit: 600}},
+				Times:  1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputParam: &model_cassandra.Quiz{IsPublished: true},
-				OutputErr:   nil,
-				Times:       1,
+				Times: 0,
 			},
 			redisSetData: &http_common.MockRedisData{
-				Times: 1,
-			},
-			cassandraTakeData: &http_common.MockCassandraData{
-				Times: 1,
-			},
-			graderData: &http_common.MockGraderData{
-				OutputParam: 1.333,
-				Times:       1,
-			},
-		}, {
-			name:         "success - cache set failure",
-			path:         "/take/success-cache-set-failure/",
-			quizId:       gocql.TimeUUID().String(),
-			expectErr:    false,
-			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
-			authValidateJWTData: &http_common.MockAuthData{
-				OutputParam1: "",
-				Times:        1,
+				Times: 0,
 			},
-			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
 			},
-			cassandraReadData: &http_common.MockCassandraData{
-				OutputParam: &model_cassandra.Quiz{IsPublished: true},
-				OutputErr:   nil,
+			cassandraSessionData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AttemptSession{Attempt: 1, StartedAt: time.Now(), Deadline: time.Now().Add(10 * time.Minute)},
 				Times:       1,
 			},
-			redisSetData: &http_common.MockRedisData{
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrader := mocks.NewMockGrading(mockCtrl)
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Validate JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
+
+				// Read quiz.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+
+				// Cache set.
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any()).Return(
+					testCase.redisSetData.Err,
+				).Times(testCase.redisSetData.Times),
+
+				// Read previous attempts.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraPreviousData.OutputParam,
+					testCase.cassandraPreviousData.OutputErr,
+				).Times(testCase.cassandraPreviousData.Times),
+
+				// Start attempt session.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraSessionData.OutputParam,
+					testCase.cassandraSessionData.OutputErr,
+				).Times(testCase.cassandraSessionData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrader, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testQuizQuery["start"], testCase.quizId)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				// Attempt session is expected.
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				session := data.(map[string]any)["startQuiz"].(map[string]any)
+				require.Equal(t, float64(1), session["attempt"], "attempt number mismatch")
+				require.InDelta(t, 600, session["remainingTime"], 1, "remaining time mismatch")
+			}
+		})
+	}
+}
+
+func TestMutationResolver_TakeQuiz(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+	closesAt := time.Now().Add(-time.Hour)
+	shuffledQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	shuffledQuiz.Shuffle = true
+	shuffledResponse := &model_cassandra.QuizResponse{Responses: [][]int32{{0}, {1}}}
+	revealedQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	revealedQuiz.RevealPolicy = model_cassandra.RevealImmediately
+
+	testCases := []struct {
+		name                  string
+		path                  string
+		quizId                string
+		expectErr             bool
+		quizResponse          *model_cassandra.QuizResponse
+		authValidateJWTData   *http_common.MockAuthData
+		redisGetData          *http_common.MockRedisData
+		cassandraReadData     *http_common.MockCassandraData
+		redisSetData          *http_common.MockRedisData
+		cassandraPreviousData *http_common.MockCassandraData
+		cassandraSessionData  *http_common.MockCassandraData
+		cassandraShuffleData  *http_common.MockCassandraData
+		cassandraTakeData     *http_common.MockCassandraData
+		graderData            *http_common.MockGraderData
+		breakdownTimes        int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:         "empty token",
+			path:         "/take/empty-token/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    true,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "invalid quiz id",
+			path:         "/take/invalid-quiz-id",
+			quizId:       "not a valid uuid",
+			expectErr:    true,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        0,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "request validate failure",
+			path:         "/take/request-validate-failure/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    true,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{-1}, {1, 2, 3, 4}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "db read unauthorized",
+			path:         "/take/db-read-unauthorized/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    true,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
 				Err: &redis.Error{
-					Message: "internal error",
-					Code:    redis.ErrorUnknown,
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{
+					Message: "",
+					Status:  http.StatusForbidden,
 				},
 				Times: 1,
 			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
 			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "db read failure",
+			path:         "/take/db-read-failure/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    true,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{
+					Message: "",
+					Status:  http.StatusInternalServerError,
+				},
 				Times: 1,
 			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 			graderData: &http_common.MockGraderData{
-				OutputParam: 1.333,
-				Times:       1,
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "quiz unpublished",
+			path:         "/take/quiz-unpublished/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    true,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
 			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Quiz{
+					IsPublished: false,
+				},
+				Times: 1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "quiz deleted",
+			path:         "/take/quiz-deleted/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    true,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Quiz{
+					IsPublished: true,
+					IsDeleted:   true,
+				},
+				Times: 1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "quiz closed",
+			path:         "/take/quiz-closed/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    true,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true, ClosesAt: &closesAt},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "grader failure",
+			path:         "/take/grader-failure/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    true,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Quiz{IsPublished: true},
+				OutputErr:   nil,
+				Times:       1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputErr: errors.New("grader failure"),
+				Times:     1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "db take unauthorized",
+			path:         "/take/db-take-unauthorized/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    true,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Quiz{IsPublished: true},
+				OutputErr:   nil,
+				Times:       1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{
+					Message: "db take auth failure",
+					Status:  http.StatusForbidden,
+				},
+				Times: 1,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1.333,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "db take failure",
+			path:         "/take/db-take-failure/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    true,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Quiz{IsPublished: true},
+				OutputErr:   nil,
+				Times:       1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{
+					Message: "db take auth failure",
+					Status:  http.StatusInternalServerError,
+				},
+				Times: 1,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1.333,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "success",
+			path:         "/take/success/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    false,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Quiz{IsPublished: true},
+				OutputErr:   nil,
+				Times:       1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1.333,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "success - cache set failure",
+			path:         "/take/success-cache-set-failure/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    false,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Quiz{IsPublished: true},
+				OutputErr:   nil,
+				Times:       1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Err: &redis.Error{
+					Message: "internal error",
+					Code:    redis.ErrorUnknown,
+				},
+				Times: 1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1.333,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "success - cache hit",
+			path:         "/take/success-cache-hit/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    false,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: nil,
+				Times:     0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1.333,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "success - answers revealed",
+			path:         "/take/success-answers-revealed/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    false,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{0}, {1}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *revealedQuiz,
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			breakdownTimes: 1,
+		}, {
+			name:         "previous attempts read failure",
+			path:         "/take/previous-attempts-read-failure/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    true,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "attempts exhausted",
+			path:         "/take/attempts-exhausted/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    true,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{MaxAttempts: 2}},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Response{Attempts: []*model_cassandra.Attempt{{Number: 1}, {Number: 2}}},
+				Times:       1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "attempt cooldown",
+			path:         "/take/attempt-cooldown/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    true,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{MaxAttempts: 2, AttemptCooldown: 3600}},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Response{Attempts: []*model_cassandra.Attempt{{Number: 1, SubmittedAt: time.Now()}}},
+				Times:       1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "success - second attempt",
+			path:         "/take/success-second-attempt/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    false,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{MaxAttempts: 2, AttemptCooldown: 60}},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Response{
+					Attempts: []*model_cassandra.Attempt{{Number: 1, Score: 0.5, MaxScore: 2, SubmittedAt: time.Now().Add(-time.Hour)}},
+				},
+				Times: 1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1.333,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "timed attempt not started",
+			path:         "/take/timed-attempt-not-started/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    true,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{TimeLimit: 600, GracePeriod: 30}},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "success - timed attempt within grace period",
+			path:         "/take/success-timed-attempt-grace/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    false,
+			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{TimeLimit: 600, GracePeriod: 30}},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AttemptSession{Attempt: 1, Deadline: time.Now().Add(-10 * time.Second)},
+				Times:       1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1.333,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:         "shuffled attempt not viewed",
+			path:         "/take/shuffled-attempt-not-viewed/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    true,
+			quizResponse: shuffledResponse,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *shuffledQuiz,
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "attempt has not been viewed", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+		}, {
+			name:         "success - shuffled attempt",
+			path:         "/take/success-shuffled-attempt/",
+			quizId:       gocql.TimeUUID().String(),
+			expectErr:    false,
+			quizResponse: shuffledResponse,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *shuffledQuiz,
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AttemptShuffle{Attempt: 1, Seed: 42},
+				Times:       1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+			graderData: &http_common.MockGraderData{
+				InputQuizResp: http_common.CanonicalResponse(shuffledQuiz.QuizCore, shuffledResponse, 42),
+				OutputParam:   1,
+				OutputMax:     2,
+				Times:         1,
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrader := mocks.NewMockGrading(mockCtrl)
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			summaryEvictions := 0
+			if !testCase.expectErr {
+				summaryEvictions = 1
+			}
+
+			// Shuffled responses must be graded in the canonical order.
+			var gradedResponse gomock.Matcher = gomock.Any()
+			if testCase.graderData.InputQuizResp != nil {
+				gradedResponse = gomock.Eq(testCase.graderData.InputQuizResp)
+			}
+
+			gomock.InOrder(
+				// Validate JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
+
+				// Read quizResponse.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+
+				// Cache set.
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any()).Return(
+					testCase.redisSetData.Err,
+				).Times(testCase.redisSetData.Times),
+
+				// Read previous attempts.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraPreviousData.OutputParam,
+					testCase.cassandraPreviousData.OutputErr,
+				).Times(testCase.cassandraPreviousData.Times),
+
+				// Read attempt session.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraSessionData.OutputParam,
+					testCase.cassandraSessionData.OutputErr,
+				).Times(testCase.cassandraSessionData.Times),
+
+				// Read attempt order.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraShuffleData.OutputParam,
+					testCase.cassandraShuffleData.OutputErr,
+				).Times(testCase.cassandraShuffleData.Times),
+
+				// Grade quiz.
+				mockGrader.EXPECT().Grade(gradedResponse, gomock.Any()).Return(
+					testCase.graderData.OutputParam,
+					testCase.graderData.OutputMax,
+					testCase.graderData.OutputErr,
+				).Times(testCase.graderData.Times),
+
+				// Break down the attempt if the answers are revealed.
+				mockGrader.EXPECT().GradeQuestions(gomock.Any(), gomock.Any()).Return(
+					[]float64{1, 0},
+					[]bool{true, false},
+					nil,
+				).Times(testCase.breakdownTimes),
+
+				// Submit response.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraTakeData.OutputParam,
+					testCase.cassandraTakeData.OutputErr,
+				).Times(testCase.cassandraTakeData.Times),
+
+				// Evict the statistics summary once the response is recorded.
+				mockRedis.EXPECT().Del(gomock.Any()).Return(nil).Times(summaryEvictions),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrader, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testQuizQuery["take"], testCase.quizId, testCase.quizResponse.Responses)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				// Quiz ID is expected.
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				gradingResponse := model_cassandra.Response{}
+				jsonStr, err := json.Marshal(data.(map[string]any)["takeQuiz"])
+				require.NoError(t, err, "failed to generate JSON string")
+				require.NoError(t, json.Unmarshal(jsonStr, &gradingResponse), "failed to unmarshall to quiz response")
+				require.InDelta(t, testCase.graderData.OutputParam, gradingResponse.Score, 0.01, "incorrect score received")
+				require.InDelta(t, testCase.graderData.OutputMax, data.(map[string]any)["takeQuiz"].(map[string]any)["maxScore"], 0.01, "incorrect maximum score received")
+				require.NotEmpty(t, data.(map[string]any)["takeQuiz"].(map[string]any)["attempts"], "attempt history expected but not set")
+
+				breakdown := data.(map[string]any)["takeQuiz"].(map[string]any)["breakdown"]
+				if testCase.breakdownTimes > 0 {
+					require.Len(t, breakdown, len(revealedQuiz.Questions), "breakdown should have every question")
+				} else {
+					require.Nil(t, breakdown, "answers should not be revealed")
+				}
+			}
+		})
+	}
+}
+
+func TestQueryResolver_PracticeQuiz(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+	revealedQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	revealedQuiz.RevealPolicy = model_cassandra.RevealImmediately
+	shuffledQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	shuffledQuiz.Shuffle = true
+	drawnQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	drawnQuiz.Questions = nil
+	drawnQuiz.Draw = &model_cassandra.QuestionDraw{Banks: []string{gocql.TimeUUID().String()}, Count: 1}
+	admin := &http_common.MockAuthData{OutputParam1: "admin", OutputParam3: model_cassandra.RoleAdmin, Times: 1}
+
+	testCases := []struct {
+		name                string
+		path                string
+		quizId              string
+		question            int
+		expectErr           bool
+		expectAnswers       bool
+		authValidateJWTData *http_common.MockAuthData
+		practiceCount       int64
+		redisIncrTimes      int
+		redisGetData        *http_common.MockRedisData
+		graderTimes         int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "empty token",
+			path:      "/practice/empty-token/",
+			quizId:    gocql.TimeUUID().String(),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "invalid quiz id",
+			path:                "/practice/invalid-quiz-id/",
+			quizId:              "not a valid uuid",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "rate limited",
+			path:                "/practice/rate-limited/",
+			quizId:              gocql.TimeUUID().String(),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			practiceCount:       http_common.PracticeRateLimit + 1,
+			redisIncrTimes:      1,
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "unpublished quiz",
+			path:                "/practice/unpublished-quiz/",
+			quizId:              gocql.TimeUUID().String(),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			practiceCount:       1,
+			redisIncrTimes:      1,
+			redisGetData:        &http_common.MockRedisData{Param2: *cassandra.GetTestQuizzes()["myNoPubQuiz"], Times: 1},
+		}, {
+			name:                "question out of range",
+			path:                "/practice/question-out-of-range/",
+			quizId:              gocql.TimeUUID().String(),
+			question:            2,
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			practiceCount:       1,
+			redisIncrTimes:      1,
+			redisGetData:        &http_common.MockRedisData{Param2: *cassandra.GetTestQuizzes()["myPubQuiz"], Times: 1},
+		}, {
+			name:                "success",
+			path:                "/practice/success/",
+			quizId:              gocql.TimeUUID().String(),
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			practiceCount:       1,
+			redisIncrTimes:      1,
+			redisGetData:        &http_common.MockRedisData{Param2: *cassandra.GetTestQuizzes()["myPubQuiz"], Times: 1},
+			graderTimes:         1,
+		}, {
+			name:                "success - answers revealed",
+			path:                "/practice/success-answers-revealed/",
+			quizId:              gocql.TimeUUID().String(),
+			expectAnswers:       true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			practiceCount:       1,
+			redisIncrTimes:      1,
+			redisGetData:        &http_common.MockRedisData{Param2: *revealedQuiz, Times: 1},
+			graderTimes:         1,
+		}, {
+			name:                "success - administrator shuffled quiz",
+			path:                "/practice/success-administrator-shuffled-quiz/",
+			quizId:              gocql.TimeUUID().String(),
+			authValidateJWTData: admin,
+			practiceCount:       1,
+			redisIncrTimes:      1,
+			redisGetData:        &http_common.MockRedisData{Param2: *shuffledQuiz, Times: 1},
+			graderTimes:         1,
+		}, {
+			name:                "administrator drawn quiz",
+			path:                "/practice/administrator-drawn-quiz/",
+			quizId:              gocql.TimeUUID().String(),
+			expectErr:           true,
+			authValidateJWTData: admin,
+			practiceCount:       1,
+			redisIncrTimes:      1,
+			redisGetData:        &http_common.MockRedisData{Param2: *drawnQuiz, Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrader := mocks.NewMockGrading(mockCtrl)
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Validate JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Count the practice check.
+				mockRedis.EXPECT().Incr(gomock.Any(), http_common.PracticeRateWindow).Return(
+					testCase.practiceCount,
+					nil,
+				).Times(testCase.redisIncrTimes),
+
+				// Cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
+
+				// Grade the question.
+				mockGrader.EXPECT().GradeQuestions(gomock.Any(), gomock.Any()).Return(
+					[]float64{1},
+					[]bool{true},
+					nil,
+				).Times(testCase.graderTimes),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrader, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testQuizQuery["practice"], testCase.quizId, testCase.question, []int32{2})))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				result := data.(map[string]any)["practiceQuiz"].(map[string]any)
+				require.Equal(t, true, result["correct"], "correctness mismatch")
+				require.InDelta(t, 1, result["points"], 0.01, "points mismatch")
+				if testCase.expectAnswers {
+					require.NotNil(t, result["answers"], "answer key should be revealed")
+				} else {
+					require.Nil(t, result["answers"], "answer key should not be revealed")
+				}
+			}
+		})
+	}
+}
+
+func TestMutationResolver_SaveDraft(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+	notFound := &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound}
+
+	testCases := []struct {
+		name                string
+		path                string
+		quizId              string
+		responses           [][]int32
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		redisGetData        *http_common.MockRedisData
+		responseReadData    *http_common.MockCassandraData
+		draftSaveData       *http_common.MockCassandraData
+		redisSetTTLTimes    int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid quiz id",
+			path:                "/save-draft/invalid-quiz-id/",
+			quizId:              "not a valid uuid",
+			responses:           [][]int32{{2}, {}},
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			draftSaveData:       &http_common.MockCassandraData{Times: 0},
 		}, {
-			name:         "success - cache hit",
-			path:         "/take/success-cache-hit/",
-			quizId:       gocql.TimeUUID().String(),
-			expectErr:    false,
-			quizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			name:      "empty token",
+			path:      "/save-draft/empty-token/",
+			quizId:    gocql.TimeUUID().String(),
+			responses: [][]int32{{2}, {}},
+			expectErr: true,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
 				Times:        1,
 			},
-			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{IsPublished: true},
-				Times:  1,
+			redisGetData:     &http_common.MockRedisData{Times: 0},
+			responseReadData: &http_common.MockCassandraData{Times: 0},
+			draftSaveData:    &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "validation failure",
+			path:                "/save-draft/validation-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			responses:           [][]int32{{9}},
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			draftSaveData:       &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "quiz unpublished",
+			path:                "/save-draft/quiz-unpublished/",
+			quizId:              gocql.TimeUUID().String(),
+			responses:           [][]int32{{2}, {}},
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *cassandra.GetTestQuizzes()["myNoPubQuiz"], Times: 1},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			draftSaveData:       &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "draft save failure",
+			path:                "/save-draft/draft-save-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			responses:           [][]int32{{2}, {}},
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *cassandra.GetTestQuizzes()["myPubQuiz"], Times: 1},
+			responseReadData:    &http_common.MockCassandraData{OutputErr: notFound, Times: 1},
+			draftSaveData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "failed to save draft", Status: http.StatusInternalServerError},
+				Times:     1,
 			},
-			cassandraReadData: &http_common.MockCassandraData{
-				OutputErr: nil,
-				Times:     0,
+		}, {
+			name:                "success",
+			path:                "/save-draft/success/",
+			quizId:              gocql.TimeUUID().String(),
+			responses:           [][]int32{{2}, {}},
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *cassandra.GetTestQuizzes()["myPubQuiz"], Times: 1},
+			responseReadData:    &http_common.MockCassandraData{OutputErr: notFound, Times: 1},
+			draftSaveData:       &http_common.MockCassandraData{Times: 1},
+			redisSetTTLTimes:    1,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrader := mocks.NewMockGrading(mockCtrl)      // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Validate JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
+
+				// Read previous attempts.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.responseReadData.OutputParam,
+					testCase.responseReadData.OutputErr,
+				).Times(testCase.responseReadData.Times),
+
+				// Save draft.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.draftSaveData.OutputParam,
+					testCase.draftSaveData.OutputErr,
+				).Times(testCase.draftSaveData.Times),
+
+				// Cache draft.
+				mockRedis.EXPECT().SetTTL(gomock.Any(), gomock.Any(), http_common.DraftTTL).Return(
+					nil,
+				).Times(testCase.redisSetTTLTimes),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrader, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testQuizQuery["save_draft"], testCase.quizId, testCase.responses)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				draft := data.(map[string]any)["saveDraft"].(map[string]any)
+				require.Equal(t, float64(1), draft["attempt"], "attempt number mismatch")
+				require.Equal(t, testCase.quizId, draft["quizID"], "quiz id mismatch")
+				require.Len(t, draft["responses"], 2, "draft responses mismatch")
+			}
+		})
+	}
+}
+
+func TestQueryResolver_ResumeDraft(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+	notFound := &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound}
+	cacheMiss := &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss}
+	draft := model_cassandra.AnswerDraft{Attempt: 1, SavedAt: time.Now(),
+		QuizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{2}, {}}}}
+
+	testCases := []struct {
+		name                string
+		path                string
+		quizId              string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		responseReadData    *http_common.MockCassandraData
+		redisGetData        *http_common.MockRedisData
+		draftReadData       *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid quiz id",
+			path:                "/resume-draft/invalid-quiz-id/",
+			quizId:              "not a valid uuid",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			draftReadData:       &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:      "empty token",
+			path:      "/resume-draft/empty-token/",
+			quizId:    gocql.TimeUUID().String(),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
 			},
-			redisSetData: &http_common.MockRedisData{
-				Times: 0,
+			responseReadData: &http_common.MockCassandraData{Times: 0},
+			redisGetData:     &http_common.MockRedisData{Times: 0},
+			draftReadData:    &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "draft not saved",
+			path:                "/resume-draft/draft-not-saved/",
+			quizId:              gocql.TimeUUID().String(),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			responseReadData:    &http_common.MockCassandraData{OutputErr: notFound, Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: model_cassandra.AnswerDraft{}, Err: cacheMiss, Times: 1},
+			draftReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "draft has not been saved", Status: http.StatusNotFound},
+				Times:     1,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
-				Times: 1,
+		}, {
+			name:                "success",
+			path:                "/resume-draft/success/",
+			quizId:              gocql.TimeUUID().String(),
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			responseReadData:    &http_common.MockCassandraData{OutputErr: notFound, Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: draft, Times: 1},
+			draftReadData:       &http_common.MockCassandraData{Times: 0},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrader := mocks.NewMockGrading(mockCtrl)      // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Validate JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Read previous attempts.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.responseReadData.OutputParam,
+					testCase.responseReadData.OutputErr,
+				).Times(testCase.responseReadData.Times),
+
+				// Cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
+
+				// Read draft.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.draftReadData.OutputParam,
+					testCase.draftReadData.OutputErr,
+				).Times(testCase.draftReadData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrader, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testQuizQuery["resume_draft"], testCase.quizId)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				resumed := data.(map[string]any)["resumeDraft"].(map[string]any)
+				require.Equal(t, float64(1), resumed["attempt"], "attempt number mismatch")
+				require.Len(t, resumed["responses"], 2, "draft responses mismatch")
+			}
+		})
+	}
+}
+
+func TestMutationResolver_SubmitDraft(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+	notFound := &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound}
+	cacheMiss := &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss}
+	draft := model_cassandra.AnswerDraft{Attempt: 1, QuizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{2}, {0}}}}
+
+	testCases := []struct {
+		name                string
+		path                string
+		quizId              string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		redisGetData        *http_common.MockRedisData
+		responseReadData    *http_common.MockCassandraData
+		redisDraftData      *http_common.MockRedisData
+		draftReadData       *http_common.MockCassandraData
+		graderData          *http_common.MockGraderData
+		responseStoreData   *http_common.MockCassandraData
+		redisDelTimes       int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid quiz id",
+			path:                "/submit-draft/invalid-quiz-id/",
+			quizId:              "not a valid uuid",
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			redisDraftData:      &http_common.MockRedisData{Times: 0},
+			draftReadData:       &http_common.MockCassandraData{Times: 0},
+			graderData:          &http_common.MockGraderData{Times: 0},
+			responseStoreData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:      "empty token",
+			path:      "/submit-draft/empty-token/",
+			quizId:    gocql.TimeUUID().String(),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
 			},
-			graderData: &http_common.MockGraderData{
-				OutputParam: 1.333,
-				Times:       1,
+			redisGetData:      &http_common.MockRedisData{Times: 0},
+			responseReadData:  &http_common.MockCassandraData{Times: 0},
+			redisDraftData:    &http_common.MockRedisData{Times: 0},
+			draftReadData:     &http_common.MockCassandraData{Times: 0},
+			graderData:        &http_common.MockGraderData{Times: 0},
+			responseStoreData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "draft not saved",
+			path:                "/submit-draft/draft-not-saved/",
+			quizId:              gocql.TimeUUID().String(),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *cassandra.GetTestQuizzes()["myPubQuiz"], Times: 1},
+			responseReadData:    &http_common.MockCassandraData{OutputErr: notFound, Times: 1},
+			redisDraftData:      &http_common.MockRedisData{Param2: model_cassandra.AnswerDraft{}, Err: cacheMiss, Times: 1},
+			draftReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "draft has not been saved", Status: http.StatusNotFound},
+				Times:     1,
 			},
+			graderData:        &http_common.MockGraderData{Times: 0},
+			responseStoreData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "success",
+			path:                "/submit-draft/success/",
+			quizId:              gocql.TimeUUID().String(),
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *cassandra.GetTestQuizzes()["myPubQuiz"], Times: 1},
+			responseReadData:    &http_common.MockCassandraData{OutputErr: notFound, Times: 1},
+			redisDraftData:      &http_common.MockRedisData{Param2: draft, Times: 1},
+			draftReadData:       &http_common.MockCassandraData{Times: 0},
+			graderData:          &http_common.MockGraderData{OutputParam: 2, OutputMax: 3, Times: 1},
+			responseStoreData:   &http_common.MockCassandraData{Times: 1},
+			redisDelTimes:       1,
 		},
 		// ----- test cases end ----- //
 	}
@@ -555,16 +2177,18 @@ func TestMutationResolver_TakeQuiz(t *testing.T) {
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
 			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockGrader := mocks.NewMockGrading(mockCtrl)
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				// Validate JWT.
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 					testCase.authValidateJWTData.OutputParam1,
 					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
 					testCase.authValidateJWTData.OutputErr,
 				).Times(testCase.authValidateJWTData.Times),
 
-				// Cache call.
+				// Cache call for the quiz.
 				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
 					1,
 					testCase.redisGetData.Param2,
@@ -572,34 +2196,49 @@ func TestMutationResolver_TakeQuiz(t *testing.T) {
 					testCase.redisGetData.Err,
 				).Times(testCase.redisGetData.Times),
 
-				// Read quizResponse.
+				// Read previous attempts.
 				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
-					testCase.cassandraReadData.OutputParam,
-					testCase.cassandraReadData.OutputErr,
-				).Times(testCase.cassandraReadData.Times),
+					testCase.responseReadData.OutputParam,
+					testCase.responseReadData.OutputErr,
+				).Times(testCase.responseReadData.Times),
 
-				// Cache set.
-				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any()).Return(
-					testCase.redisSetData.Err,
-				).Times(testCase.redisSetData.Times),
+				// Cache call for the draft.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisDraftData.Param2,
+				).Return(
+					testCase.redisDraftData.Err,
+				).Times(testCase.redisDraftData.Times),
 
-				// Grade quiz.
-				mockGrader.EXPECT().Grade(gomock.Any(), gomock.Any()).Return(
+				// Read draft.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.draftReadData.OutputParam,
+					testCase.draftReadData.OutputErr,
+				).Times(testCase.draftReadData.Times),
+
+				// Grade the draft.
+				mockGrader.EXPECT().Grade(draft.QuizResponse, gomock.Any()).Return(
 					testCase.graderData.OutputParam,
+					testCase.graderData.OutputMax,
 					testCase.graderData.OutputErr,
 				).Times(testCase.graderData.Times),
 
-				// Submit response.
+				// Store the attempt.
 				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
-					testCase.cassandraTakeData.OutputParam,
-					testCase.cassandraTakeData.OutputErr,
-				).Times(testCase.cassandraTakeData.Times),
+					testCase.responseStoreData.OutputParam,
+					testCase.responseStoreData.OutputErr,
+				).Times(testCase.responseStoreData.Times),
+
+				// Evict the statistics summary.
+				mockRedis.EXPECT().Del(gomock.Any()).Return(
+					nil,
+				).Times(testCase.redisDelTimes),
 			)
 
 			// Endpoint setup for test.
-			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCassandra, mockGrader, zapLogger))
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrader, zapLogger))
 
-			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testQuizQuery["take"], testCase.quizId, testCase.quizResponse.Responses)))
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testQuizQuery["submit_draft"], testCase.quizId)))
 			req.Header.Set("Content-Type", "application/json")
 			req.Header.Set("Authorization", "some auth token")
 			w := httptest.NewRecorder()
@@ -615,14 +2254,11 @@ func TestMutationResolver_TakeQuiz(t *testing.T) {
 			if testCase.expectErr {
 				verifyErrorReturned(t, response)
 			} else {
-				// Quiz ID is expected.
 				data, ok := response["data"]
 				require.True(t, ok, "data key expected but not set")
-				gradingResponse := model_cassandra.Response{}
-				jsonStr, err := json.Marshal(data.(map[string]any)["takeQuiz"])
-				require.NoError(t, err, "failed to generate JSON string")
-				require.NoError(t, json.Unmarshal(jsonStr, &gradingResponse), "failed to unmarshall to quiz response")
-				require.InDelta(t, testCase.graderData.OutputParam, gradingResponse.Score, 0.01, "incorrect score received")
+				scoreCard := data.(map[string]any)["submitDraft"].(map[string]any)
+				require.InDelta(t, testCase.graderData.OutputParam, scoreCard["score"], 0.01, "score mismatch")
+				require.Len(t, scoreCard["attempts"], 1, "attempt history mismatch")
 			}
 		})
 	}
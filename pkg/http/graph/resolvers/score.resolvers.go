@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/surahman/mcq-platform/pkg/cassandra"
@@ -15,6 +16,7 @@ import (
 	graphql_generated "github.com/surahman/mcq-platform/pkg/http/graph/generated"
 	model_cassandra "github.com/surahman/mcq-platform/pkg/model/cassandra"
 	model_http "github.com/surahman/mcq-platform/pkg/model/http"
+	"go.uber.org/zap"
 )
 
 // QuizID is the resolver for the QuizID field.
@@ -25,11 +27,53 @@ func (r *metadataResolver) QuizID(ctx context.Context, obj *model_http.Metadata)
 	return obj.QuizID.String(), nil
 }
 
+// RegradeScores is the resolver for the regradeScores field.
+func (r *mutationResolver) RegradeScores(ctx context.Context, quizID string) (*model_http.RegradeSummary, error) {
+	var err error
+	var quiz *model_cassandra.Quiz
+	var summary *model_http.RegradeSummary
+	var username, role string
+	var quizId gocql.UUID
+
+	if quizId, err = gocql.ParseUUID(quizID); err != nil {
+		return nil, errors.New("invalid quiz id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	// Get the current version of the quiz and verify authorization.
+	if quiz, err = http_common.GetQuiz(quizId, r.DB, r.Cache); err != nil {
+		return nil, errors.New("error retrieving quiz")
+	}
+	if !http_common.CanManage(quiz.Author, username, role) {
+		return nil, errors.New("error verifying quiz author")
+	}
+
+	// Regrade all responses, logging the progress after every page.
+	progress := func(summary *model_http.RegradeSummary) {
+		r.Logger.Info("regrading quiz responses", zap.String("quiz_id", quizId.String()),
+			zap.Int("pages", summary.Pages), zap.Int("processed", summary.Processed), zap.Int("changed", summary.Changed))
+	}
+	summary, err = http_common.RegradeResponses(quiz, r.DB, r.Grading, progress)
+	http_common.InvalidateStatsSummary(quizId, r.Cache)
+	if err != nil {
+		r.Logger.Error("failed to regrade quiz responses", zap.String("quiz_id", quizId.String()), zap.Error(err))
+		return nil, fmt.Errorf("error regrading responses after %d were processed, please retry", summary.Processed)
+	}
+
+	return summary, nil
+}
+
 // GetScore is the resolver for the getScore field.
-func (r *queryResolver) GetScore(ctx context.Context, quizID string) (*model_cassandra.Response, error) {
+func (r *queryResolver) GetScore(ctx context.Context, quizID string) (*model_http.ScoreCard, error) {
 	var err error
 	var dbRecord any
 	var response *model_cassandra.Response
+	var quiz *model_cassandra.Quiz
+	var breakdown []*model_http.QuestionBreakdown
 	var username string
 	var quizId gocql.UUID
 
@@ -38,7 +82,7 @@ func (r *queryResolver) GetScore(ctx context.Context, quizID string) (*model_cas
 	}
 
 	// Get username from JWT.
-	if username, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
 		return nil, err
 	}
 
@@ -51,8 +95,17 @@ func (r *queryResolver) GetScore(ctx context.Context, quizID string) (*model_cas
 		return nil, err
 	}
 	response = dbRecord.(*model_cassandra.Response)
+	response.Attempts = http_common.AttemptHistory(response)
 
-	return response, nil
+	// Break down the effective attempt if the answers are revealed.
+	if quiz, err = http_common.GetQuiz(quizId, r.DB, r.Cache); err != nil {
+		return nil, err
+	}
+	if breakdown, err = http_common.ScoreBreakdown(quiz, response, r.DB, r.Grading, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return &model_http.ScoreCard{Response: response, Breakdown: breakdown}, nil
 }
 
 // GetStats is the resolver for the getStats field.
@@ -60,7 +113,7 @@ func (r *queryResolver) GetStats(ctx context.Context, quizID string, pageSize *i
 	var err error
 	var dbRecord any
 	var statRequest *model_cassandra.StatsRequest
-	var username string
+	var username, role string
 	var quizId gocql.UUID
 
 	if quizId, err = gocql.ParseUUID(quizID); err != nil {
@@ -68,7 +121,7 @@ func (r *queryResolver) GetStats(ctx context.Context, quizID string, pageSize *i
 	}
 
 	// Get username from JWT.
-	if username, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
 		return nil, err
 	}
 
@@ -87,7 +140,7 @@ func (r *queryResolver) GetStats(ctx context.Context, quizID string, pageSize *i
 	if len(statsResponse.Records) == 0 {
 		return nil, errors.New("could not locate results")
 	}
-	if username != statsResponse.Records[0].Author {
+	if !http_common.CanManage(statsResponse.Records[0].Author, username, role) {
 		return nil, errors.New("error verifying quiz author")
 	}
 
@@ -95,7 +148,111 @@ func (r *queryResolver) GetStats(ctx context.Context, quizID string, pageSize *i
 	return prepareStatsResponse(r.Auth, statsResponse, quizId)
 }
 
+// GetStatsSummary is the resolver for the getStatsSummary field.
+func (r *queryResolver) GetStatsSummary(ctx context.Context, quizID string) (*model_http.StatsSummary, error) {
+	var err error
+	var quiz *model_cassandra.Quiz
+	var summary *model_http.StatsSummary
+	var username, role string
+	var quizId gocql.UUID
+
+	if quizId, err = gocql.ParseUUID(quizID); err != nil {
+		return nil, errors.New("invalid quiz id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	// Get the quiz and verify authorization.
+	if quiz, err = http_common.GetQuiz(quizId, r.DB, r.Cache); err != nil {
+		return nil, errors.New("error retrieving quiz")
+	}
+	if !http_common.CanManage(quiz.Author, username, role) {
+		return nil, errors.New("error verifying quiz author")
+	}
+
+	// Get the statistics summary from the cache or summarize the responses in the database.
+	if summary, err = http_common.GetStatsSummary(quizId, r.DB, r.Cache); err != nil {
+		return nil, errors.New("error summarizing score cards")
+	}
+
+	return summary, nil
+}
+
+// GetItemAnalysis is the resolver for the getItemAnalysis field.
+func (r *queryResolver) GetItemAnalysis(ctx context.Context, quizID string) (*model_http.ItemAnalysis, error) {
+	var err error
+	var quiz *model_cassandra.Quiz
+	var analysis *model_http.ItemAnalysis
+	var username, role string
+	var quizId gocql.UUID
+
+	if quizId, err = gocql.ParseUUID(quizID); err != nil {
+		return nil, errors.New("invalid quiz id supplied, must be a valid UUID")
+	}
+
+	// Get username from JWT.
+	if username, _, role, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	// Get the quiz and verify authorization.
+	if quiz, err = http_common.GetQuiz(quizId, r.DB, r.Cache); err != nil {
+		return nil, errors.New("error retrieving quiz")
+	}
+	if !http_common.CanManage(quiz.Author, username, role) {
+		return nil, errors.New("error verifying quiz author")
+	}
+
+	// Grade every question in the responses and analyze the items.
+	if analysis, err = http_common.AnalyzeItems(quiz, r.DB, r.Grading); err != nil {
+		if errors.Is(err, http_common.ErrItemAnalysisUnavailable) {
+			return nil, err
+		}
+		return nil, errors.New("error analyzing score cards")
+	}
+
+	return analysis, nil
+}
+
+// MyScores is the resolver for the myScores field.
+func (r *queryResolver) MyScores(ctx context.Context, pageSize *int, cursor *string) (*model_http.UserScoresResponseGraphQL, error) {
+	var err error
+	var dbRecord any
+	var request *model_cassandra.UserScoresRequest
+	var username string
+
+	// Get username from JWT.
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+		return nil, err
+	}
+
+	// Prepare user scores page request for database.
+	if request, err = http_common.PrepareUserScoresRequest(r.Auth, username, *cursor, strconv.Itoa(*pageSize)); err != nil {
+		return nil, fmt.Errorf("malformed query request %v", err)
+	}
+
+	// Get user score record page from database.
+	if dbRecord, err = r.DB.Execute(cassandra.ReadUserScoresPageQuery, request); err != nil {
+		return nil, err
+	}
+
+	// Prepare GraphQL response.
+	return prepareUserScoresResponse(r.Auth, dbRecord.(*model_cassandra.UserScoresResponse))
+}
+
+// QuizID is the resolver for the quizID field.
+func (r *userScoreResolver) QuizID(ctx context.Context, obj *model_cassandra.UserScore) (string, error) {
+	return obj.QuizID.String(), nil
+}
+
 // Metadata returns graphql_generated.MetadataResolver implementation.
 func (r *Resolver) Metadata() graphql_generated.MetadataResolver { return &metadataResolver{r} }
 
+// UserScore returns graphql_generated.UserScoreResolver implementation.
+func (r *Resolver) UserScore() graphql_generated.UserScoreResolver { return &userScoreResolver{r} }
+
 type metadataResolver struct{ *Resolver }
+type userScoreResolver struct{ *Resolver }
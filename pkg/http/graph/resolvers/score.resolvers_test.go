@@ -18,6 +18,7 @@ import (
 	"github.com/surahman/mcq-platform/pkg/mocks"
 	model_cassandra "github.com/surahman/mcq-platform/pkg/model/cassandra"
 	"github.com/surahman/mcq-platform/pkg/model/http"
+	"github.com/surahman/mcq-platform/pkg/redis"
 )
 
 func TestMetadataResolver_QuizID(t *testing.T) {
@@ -58,6 +59,8 @@ func TestQueryResolver_GetScore(t *testing.T) {
 	router.Use(GinContextToContextMiddleware())
 
 	quizUUID := gocql.TimeUUID()
+	revealedQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	revealedQuiz.RevealPolicy = model_cassandra.RevealImmediately
 
 	testCases := []struct {
 		name                string
@@ -66,6 +69,9 @@ func TestQueryResolver_GetScore(t *testing.T) {
 		expectErr           bool
 		authValidateJWTData *http_common.MockAuthData
 		cassandraReadData   *http_common.MockCassandraData
+		redisGetData        *http_common.MockRedisData
+		cassandraQuizData   *http_common.MockCassandraData
+		breakdownTimes      int
 	}{
 		// ----- test cases start ----- //
 		{
@@ -81,6 +87,12 @@ func TestQueryResolver_GetScore(t *testing.T) {
 			cassandraReadData: &http_common.MockCassandraData{
 				Times: 0,
 			},
+			redisGetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraQuizData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:      "invalid quiz id",
 			path:      "/score/invalid-quiz-id",
@@ -93,6 +105,12 @@ func TestQueryResolver_GetScore(t *testing.T) {
 			cassandraReadData: &http_common.MockCassandraData{
 				Times: 0,
 			},
+			redisGetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraQuizData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:      "db read not found",
 			path:      "/score/db-read-not-found/",
@@ -109,6 +127,37 @@ func TestQueryResolver_GetScore(t *testing.T) {
 				},
 				Times: 1,
 			},
+			redisGetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraQuizData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:      "quiz read failure",
+			path:      "/score/quiz-read-failure/",
+			quizId:    quizUUID.String(),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Response{
+					Username: "mock response card",
+					QuizID:   quizUUID,
+				},
+				Times: 1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err:    &redis.Error{Message: "cache miss error", Code: redis.ErrorCacheMiss},
+				Times:  1,
+			},
+			cassandraQuizData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
 		}, {
 			name:      "success",
 			path:      "/score/success/",
@@ -127,6 +176,41 @@ func TestQueryResolver_GetScore(t *testing.T) {
 				},
 				Times: 1,
 			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *cassandra.GetTestQuizzes()["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraQuizData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:      "success - answers revealed",
+			path:      "/score/success-answers-revealed/",
+			quizId:    quizUUID.String(),
+			expectErr: false,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Response{
+					Username: "mock response card",
+					Score:    99.99,
+					QuizID:   quizUUID,
+					QuizResponse: &model_cassandra.QuizResponse{
+						Responses: [][]int32{{0}, {1}},
+					},
+				},
+				Times: 1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *revealedQuiz,
+				Times:  1,
+			},
+			cassandraQuizData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			breakdownTimes: 1,
 		},
 		// ----- test cases end ----- //
 	}
@@ -137,22 +221,48 @@ func TestQueryResolver_GetScore(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
-			mockGrader := mocks.NewMockGrading(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrader := mocks.NewMockGrading(mockCtrl)
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Score card read.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
 
-			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
-				testCase.cassandraReadData.OutputParam,
-				testCase.cassandraReadData.OutputErr,
-			).Times(testCase.cassandraReadData.Times)
+				// Quiz cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
 
-			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
-				testCase.authValidateJWTData.OutputParam1,
-				testCase.authValidateJWTData.OutputParam2,
-				testCase.authValidateJWTData.OutputErr,
-			).Times(testCase.authValidateJWTData.Times)
+				// Quiz read on cache miss.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraQuizData.OutputParam,
+					testCase.cassandraQuizData.OutputErr,
+				).Times(testCase.cassandraQuizData.Times),
+
+				// Break down the effective attempt if the answers are revealed.
+				mockGrader.EXPECT().GradeQuestions(gomock.Any(), gomock.Any()).Return(
+					[]float64{1, 0},
+					[]bool{true, false},
+					nil,
+				).Times(testCase.breakdownTimes),
+			)
 
 			// Endpoint setup for test.
-			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCassandra, mockGrader, zapLogger))
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrader, zapLogger))
 
 			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(fmt.Sprintf(testScoresQuery["score"], testCase.quizId)))
 			req.Header.Set("Content-Type", "application/json")
@@ -183,6 +293,13 @@ func TestQueryResolver_GetScore(t *testing.T) {
 
 				actualUUID := responseMap.(map[string]any)["quizID"]
 				require.Equal(t, expectedResponse.QuizID.String(), actualUUID, "quiz id mismatch")
+
+				breakdown := responseMap.(map[string]any)["breakdown"]
+				if testCase.breakdownTimes > 0 {
+					require.Len(t, breakdown, len(revealedQuiz.Questions), "breakdown should have every question")
+				} else {
+					require.Nil(t, breakdown, "answers should not be revealed")
+				}
 			}
 		})
 	}
@@ -473,14 +590,16 @@ func TestQueryResolver_GetStats(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
-			mockGrader := mocks.NewMockGrading(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrader := mocks.NewMockGrading(mockCtrl)      // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				// Validate JWT.
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 					testCase.authValidateJWTData.OutputParam1,
 					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
 					testCase.authValidateJWTData.OutputErr,
 				).Times(testCase.authValidateJWTData.Times),
 				// Decrypt cursor page.
@@ -501,7 +620,7 @@ func TestQueryResolver_GetStats(t *testing.T) {
 			)
 
 			// Endpoint setup for test.
-			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCassandra, mockGrader, zapLogger))
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrader, zapLogger))
 
 			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.query))
 			req.Header.Set("Content-Type", "application/json")
@@ -541,3 +660,722 @@ func TestQueryResolver_GetStats(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryResolver_GetStatsSummary(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	quiz := testQuizData["myPubQuiz"]
+	quizUUID := gocql.TimeUUID().String()
+	cacheMiss := &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss}
+
+	testCases := []struct {
+		name                string
+		path                string
+		query               string
+		expectErr           bool
+		expectedResponses   int
+		authValidateJWTData *http_common.MockAuthData
+		redisQuizData       *http_common.MockRedisData
+		redisSummaryData    *http_common.MockRedisData
+		cassandraPageData   *http_common.MockCassandraData
+		redisSetData        *http_common.MockRedisData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "bad uuid",
+			path:                "/stats-summary/bad-uuid/",
+			query:               fmt.Sprintf(testScoresQuery["stats_summary"], "face palm"),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisQuizData:       &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			redisSummaryData:    &http_common.MockRedisData{Param2: model_http.StatsSummary{}, Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:      "empty token",
+			path:      "/stats-summary/empty-token/",
+			query:     fmt.Sprintf(testScoresQuery["stats_summary"], quizUUID),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisQuizData:     &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			redisSummaryData:  &http_common.MockRedisData{Param2: model_http.StatsSummary{}, Times: 0},
+			cassandraPageData: &http_common.MockCassandraData{Times: 0},
+			redisSetData:      &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "not author",
+			path:                "/stats-summary/not-author/",
+			query:               fmt.Sprintf(testScoresQuery["stats_summary"], quizUUID),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "not the author", Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			redisSummaryData:    &http_common.MockRedisData{Param2: model_http.StatsSummary{}, Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "page read failure",
+			path:                "/stats-summary/page-read-failure/",
+			query:               fmt.Sprintf(testScoresQuery["stats_summary"], quizUUID),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			redisSummaryData:    &http_common.MockRedisData{Param2: model_http.StatsSummary{}, Err: cacheMiss, Times: 1},
+			cassandraPageData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			redisSetData: &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "success cache hit",
+			path:                "/stats-summary/success-cache-hit/",
+			query:               fmt.Sprintf(testScoresQuery["stats_summary"], quizUUID),
+			expectErr:           false,
+			expectedResponses:   3,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			redisSummaryData: &http_common.MockRedisData{
+				Param2: model_http.StatsSummary{NumResponses: 3, Histogram: []*model_http.HistogramBin{{Upper: 10, Count: 3}}},
+				Times:  1,
+			},
+			cassandraPageData: &http_common.MockCassandraData{Times: 0},
+			redisSetData:      &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "success cache miss",
+			path:                "/stats-summary/success-cache-miss/",
+			query:               fmt.Sprintf(testScoresQuery["stats_summary"], quizUUID),
+			expectErr:           false,
+			expectedResponses:   2,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			redisSummaryData:    &http_common.MockRedisData{Param2: model_http.StatsSummary{}, Err: cacheMiss, Times: 1},
+			cassandraPageData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.StatsResponse{
+					Records: []*model_cassandra.Response{{Score: 1, MaxScore: 2}, {Score: 2, MaxScore: 2}},
+				},
+				Times: 1,
+			},
+			redisSetData: &http_common.MockRedisData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrader := mocks.NewMockGrading(mockCtrl)      // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Validate JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+				// Quiz cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisQuizData.Param2,
+				).Return(
+					testCase.redisQuizData.Err,
+				).Times(testCase.redisQuizData.Times),
+				// Summary cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisSummaryData.Param2,
+				).Return(
+					testCase.redisSummaryData.Err,
+				).Times(testCase.redisSummaryData.Times),
+				// Response page read on cache miss.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraPageData.OutputParam,
+					testCase.cassandraPageData.OutputErr,
+				).Times(testCase.cassandraPageData.Times),
+				// Summary cache set.
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any()).Return(
+					testCase.redisSetData.Err,
+				).Times(testCase.redisSetData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrader, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+
+				summary := data.(map[string]any)["getStatsSummary"].(map[string]any)
+				require.Equal(t, testCase.expectedResponses, int(summary["numResponses"].(float64)), "response count does not match expected")
+				require.NotEmpty(t, summary["histogram"], "histogram expected but not set")
+			}
+		})
+	}
+}
+
+func TestQueryResolver_GetItemAnalysis(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	quiz := testQuizData["myPubQuiz"]
+	drawnQuiz := *quiz
+	drawnQuiz.QuizCore = &model_cassandra.QuizCore{Title: "drawn", Draw: &model_cassandra.QuestionDraw{}}
+	quizUUID := gocql.TimeUUID().String()
+
+	testCases := []struct {
+		name                string
+		path                string
+		query               string
+		expectErr           bool
+		expectedResponses   int
+		authValidateJWTData *http_common.MockAuthData
+		redisQuizData       *http_common.MockRedisData
+		cassandraPageData   *http_common.MockCassandraData
+		gradeTimes          int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "bad uuid",
+			path:                "/item-analysis/bad-uuid/",
+			query:               fmt.Sprintf(testScoresQuery["item_analysis"], "face palm"),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisQuizData:       &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:      "empty token",
+			path:      "/item-analysis/empty-token/",
+			query:     fmt.Sprintf(testScoresQuery["item_analysis"], quizUUID),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisQuizData:     &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			cassandraPageData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "not author",
+			path:                "/item-analysis/not-author/",
+			query:               fmt.Sprintf(testScoresQuery["item_analysis"], quizUUID),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "not the author", Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "drawn quiz",
+			path:                "/item-analysis/drawn-quiz/",
+			query:               fmt.Sprintf(testScoresQuery["item_analysis"], quizUUID),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: drawnQuiz, Times: 1},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "page read failure",
+			path:                "/item-analysis/page-read-failure/",
+			query:               fmt.Sprintf(testScoresQuery["item_analysis"], quizUUID),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraPageData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+		}, {
+			name:                "success",
+			path:                "/item-analysis/success/",
+			query:               fmt.Sprintf(testScoresQuery["item_analysis"], quizUUID),
+			expectErr:           false,
+			expectedResponses:   2,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraPageData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.StatsResponse{
+					Records: []*model_cassandra.Response{
+						{Version: quiz.Version, QuizResponse: &model_cassandra.QuizResponse{}},
+						{Version: quiz.Version, QuizResponse: &model_cassandra.QuizResponse{}},
+					},
+				},
+				Times: 1,
+			},
+			gradeTimes: 2,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrader := mocks.NewMockGrading(mockCtrl)
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Validate JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+				// Quiz cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisQuizData.Param2,
+				).Return(
+					testCase.redisQuizData.Err,
+				).Times(testCase.redisQuizData.Times),
+				// Response page read.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraPageData.OutputParam,
+					testCase.cassandraPageData.OutputErr,
+				).Times(testCase.cassandraPageData.Times),
+			)
+
+			// Grade every question in the responses.
+			mockGrader.EXPECT().GradeQuestions(gomock.Any(), gomock.Any()).Return(
+				make([]float64, len(quiz.Questions)),
+				make([]bool, len(quiz.Questions)),
+				nil,
+			).Times(testCase.gradeTimes)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrader, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+
+				analysis := data.(map[string]any)["getItemAnalysis"].(map[string]any)
+				require.Equal(t, testCase.expectedResponses, int(analysis["numResponses"].(float64)), "response count does not match expected")
+				require.Equal(t, len(quiz.Questions), len(analysis["items"].([]any)), "item count does not match expected")
+			}
+		})
+	}
+}
+
+func TestQueryResolver_MyScores(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	quizUUID := gocql.TimeUUID()
+
+	testCases := []struct {
+		name                string
+		path                string
+		query               string
+		expectCursor        string
+		expectPageSize      int
+		expectNumRecords    int
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		authDecryptData     *http_common.MockAuthData
+		cassandraReadData   *http_common.MockCassandraData
+		authEncryptData     *http_common.MockAuthData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "empty token",
+			path:      "/my-scores/empty-token",
+			query:     fmt.Sprintf(testScoresQuery["my_scores"], 3, "PaGeCuRs0R"),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			authDecryptData:   &http_common.MockAuthData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			authEncryptData:   &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:      "cursor decryption failure",
+			path:      "/my-scores/cursor-decryption-failure",
+			query:     fmt.Sprintf(testScoresQuery["my_scores"], 3, "PaGeCuRs0R"),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{
+				OutputErr: errors.New("decrypting cursor failed"),
+				Times:     1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			authEncryptData:   &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:      "db read failure",
+			path:      "/my-scores/db-read-failure",
+			query:     fmt.Sprintf(testScoresQuery["my_scores"], 3, "PaGeCuRs0R"),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:      "cursor encryption failure",
+			path:      "/my-scores/cursor-encryption-failure",
+			query:     testScoresQuery["my_scores_all"],
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.UserScoresResponse{
+					PageCursor: []byte("cursor to next page"),
+					Records:    []*model_cassandra.UserScore{{QuizID: quizUUID}},
+					PageSize:   10,
+				},
+				Times: 1,
+			},
+			authEncryptData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("encrypting cursor failed"),
+				Times:        1,
+			},
+		}, {
+			name:             "success",
+			path:             "/my-scores/success",
+			query:            fmt.Sprintf(testScoresQuery["my_scores"], 3, "PaGeCuRs0R"),
+			expectCursor:     "tHisIsAnEnCrYPtEdCUrS0r",
+			expectPageSize:   3,
+			expectNumRecords: 3,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.UserScoresResponse{
+					PageCursor: []byte("cursor to next page"),
+					Records: []*model_cassandra.UserScore{
+						{QuizID: quizUUID, Title: "first quiz", Score: 1, MaxScore: 2},
+						{QuizID: quizUUID, Title: "second quiz", Score: 2, MaxScore: 2},
+						{QuizID: quizUUID, Title: "third quiz", Score: 0, MaxScore: 2},
+					},
+					PageSize: 3,
+				},
+				Times: 1,
+			},
+			authEncryptData: &http_common.MockAuthData{
+				OutputParam1: "tHisIsAnEnCrYPtEdCUrS0r",
+				Times:        1,
+			},
+		}, {
+			name:             "success no cursor",
+			path:             "/my-scores/success-no-cursor",
+			query:            testScoresQuery["my_scores_all"],
+			expectNumRecords: 2,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.UserScoresResponse{
+					Records: []*model_cassandra.UserScore{
+						{QuizID: quizUUID, Title: "first quiz", Score: 1, MaxScore: 2},
+						{QuizID: quizUUID, Title: "second quiz", Score: 2, MaxScore: 2},
+					},
+					PageSize: 10,
+				},
+				Times: 1,
+			},
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).Return(
+					testCase.authDecryptData.OutputParam1,
+					testCase.authDecryptData.OutputErr,
+				).Times(testCase.authDecryptData.Times),
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).Return(
+					testCase.authEncryptData.OutputParam1,
+					testCase.authEncryptData.OutputErr,
+				).Times(testCase.authEncryptData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+
+				myScores := data.(map[string]any)["myScores"].(map[string]any)
+
+				records := myScores["records"].([]any)
+				require.Equal(t, testCase.expectNumRecords, len(records), "record count does not match expected")
+				require.Equal(t, quizUUID.String(), records[0].(map[string]any)["quizID"], "quiz id did not match expected")
+				require.Equal(t, "first quiz", records[0].(map[string]any)["title"], "quiz title did not match expected")
+
+				metadata := myScores["metadata"].(map[string]any)
+				require.Equal(t, testCase.expectNumRecords, int(metadata["numRecords"].(float64)), "metadata record count does not match expected")
+
+				nextPage := myScores["nextPage"].(map[string]any)
+				require.Equal(t, testCase.expectPageSize, int(nextPage["pageSize"].(float64)), "page size does not match expected")
+				require.Equal(t, testCase.expectCursor, nextPage["cursor"].(string), "cursor does not match expected")
+			}
+		})
+	}
+}
+
+func TestMutationResolver_RegradeScores(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	quiz := testQuizData["myPubQuiz"]
+	quizUUID := gocql.TimeUUID().String()
+
+	testCases := []struct {
+		name                string
+		path                string
+		query               string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		redisGetData        *http_common.MockRedisData
+		cassandraPageData   *http_common.MockCassandraData
+		gradingData         *http_common.MockGraderData
+		cassandraUpdateData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "bad uuid",
+			path:                "/regrade/bad-uuid/",
+			query:               fmt.Sprintf(testScoresQuery["regrade"], "face palm"),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisGetData:        &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+			gradingData:         &http_common.MockGraderData{Times: 0},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:      "empty token",
+			path:      "/regrade/empty-token/",
+			query:     fmt.Sprintf(testScoresQuery["regrade"], quizUUID),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisGetData:        &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+			gradingData:         &http_common.MockGraderData{Times: 0},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "not author",
+			path:                "/regrade/not-author/",
+			query:               fmt.Sprintf(testScoresQuery["regrade"], quizUUID),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "not the author", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+			gradingData:         &http_common.MockGraderData{Times: 0},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "score update failure",
+			path:                "/regrade/score-update-failure/",
+			query:               fmt.Sprintf(testScoresQuery["regrade"], quizUUID),
+			expectErr:           true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraPageData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.StatsResponse{
+					Records: []*model_cassandra.Response{{Username: "username 1", Author: quiz.Author, Score: 1, MaxScore: 2}},
+				},
+				Times: 1,
+			},
+			gradingData: &http_common.MockGraderData{OutputParam: 2, OutputMax: 2, Times: 1},
+			cassandraUpdateData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+		}, {
+			name:                "success",
+			path:                "/regrade/success/",
+			query:               fmt.Sprintf(testScoresQuery["regrade"], quizUUID),
+			expectErr:           false,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraPageData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.StatsResponse{
+					Records: []*model_cassandra.Response{{Username: "username 1", Author: quiz.Author, Score: 1, MaxScore: 2}},
+				},
+				Times: 1,
+			},
+			gradingData:         &http_common.MockGraderData{OutputParam: 2, OutputMax: 2, Times: 1},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrader := mocks.NewMockGrading(mockCtrl)
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			summaryEvictions := 0
+			if testCase.cassandraPageData.Times > 0 {
+				summaryEvictions = 1
+			}
+
+			gomock.InOrder(
+				// Validate JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+				// Cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
+				// Response page read.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraPageData.OutputParam,
+					testCase.cassandraPageData.OutputErr,
+				).Times(testCase.cassandraPageData.Times),
+				// Grade response.
+				mockGrader.EXPECT().Grade(gomock.Any(), gomock.Any()).Return(
+					testCase.gradingData.OutputParam,
+					testCase.gradingData.OutputMax,
+					testCase.gradingData.OutputErr,
+				).Times(testCase.gradingData.Times),
+				// Score update.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraUpdateData.OutputParam,
+					testCase.cassandraUpdateData.OutputErr,
+				).Times(testCase.cassandraUpdateData.Times),
+				// Evict the statistics summary once the responses are regraded, even partially.
+				mockRedis.EXPECT().Del(gomock.Any()).Return(nil).Times(summaryEvictions),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrader, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some auth token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+
+				summary := data.(map[string]any)["regradeScores"].(map[string]any)
+				require.Equal(t, float64(1), summary["processed"], "processed count does not match expected")
+				require.Equal(t, float64(1), summary["changed"], "changed count does not match expected")
+				require.Equal(t, float64(1), summary["netChange"], "net change does not match expected")
+			}
+		})
+	}
+}
@@ -27,3 +27,23 @@ func prepareStatsResponse(auth auth.Auth, dbResponse *model_cassandra.StatsRespo
 
 	return
 }
+
+// prepareUserScoresResponse will prepare the GraphQL response struct for a page of the scores in the requester's score history.
+func prepareUserScoresResponse(auth auth.Auth, dbResponse *model_cassandra.UserScoresResponse) (response *model_http.UserScoresResponseGraphQL, err error) {
+	response = &model_http.UserScoresResponseGraphQL{Records: dbResponse.Records}
+	response.Metadata.NumRecords = len(dbResponse.Records)
+
+	// Encrypt page cursor link.
+	if len(dbResponse.PageCursor) != 0 {
+		if response.NextPage.Cursor, err = auth.EncryptToString(dbResponse.PageCursor); err != nil {
+			return nil, err
+		}
+	}
+
+	// Construct page size link segment.
+	if len(dbResponse.PageCursor) != 0 && dbResponse.PageSize > 0 {
+		response.NextPage.PageSize = dbResponse.PageSize
+	}
+
+	return
+}
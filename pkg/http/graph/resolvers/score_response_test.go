@@ -1,6 +1,7 @@
 package graphql_resolvers
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/gocql/gocql"
@@ -116,3 +117,75 @@ func TestQueryResolver_prepareStatsResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryResolver_prepareUserScoresResponse(t *testing.T) {
+	encryptedCursor := "encrypted-page-cursor-byte-string"
+
+	testCases := []struct {
+		name             string
+		expectedCursor   []byte
+		expectedPageSize int
+		expectedRecords  int
+		dbResponse       *model_cassandra.UserScoresResponse
+		mockAuthData     *http_common.MockAuthData
+		expectErr        require.ErrorAssertionFunc
+		expectNil        require.ValueAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:             "nil cursor",
+			expectedCursor:   []byte{},
+			expectedPageSize: 0,
+			dbResponse:       &model_cassandra.UserScoresResponse{PageCursor: nil, PageSize: 3},
+			mockAuthData:     &http_common.MockAuthData{Times: 0, OutputParam1: ""},
+			expectErr:        require.NoError,
+			expectNil:        require.NotNil,
+		}, {
+			name:             "cursor and page",
+			expectedCursor:   []byte(encryptedCursor),
+			expectedPageSize: 3,
+			expectedRecords:  2,
+			dbResponse: &model_cassandra.UserScoresResponse{
+				PageCursor: []byte("page-cursor-byte-string"),
+				Records:    []*model_cassandra.UserScore{{}, {}},
+				PageSize:   3,
+			},
+			mockAuthData: &http_common.MockAuthData{Times: 1, OutputParam1: encryptedCursor},
+			expectErr:    require.NoError,
+			expectNil:    require.NotNil,
+		}, {
+			name: "encryption failure",
+			dbResponse: &model_cassandra.UserScoresResponse{
+				PageCursor: []byte("page-cursor-byte-string"),
+				PageSize:   3,
+			},
+			mockAuthData: &http_common.MockAuthData{Times: 1, OutputParam1: "", OutputErr: errors.New("encryption failure")},
+			expectErr:    require.Error,
+			expectNil:    require.Nil,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+
+			mockAuth.EXPECT().EncryptToString(gomock.Any()).Return(
+				testCase.mockAuthData.OutputParam1,
+				testCase.mockAuthData.OutputErr,
+			).Times(testCase.mockAuthData.Times)
+
+			resp, err := prepareUserScoresResponse(mockAuth, testCase.dbResponse)
+			testCase.expectErr(t, err, "error expectation condition failed")
+			testCase.expectNil(t, resp, "nil expectation condition failed")
+
+			if err == nil {
+				require.Equal(t, testCase.expectedCursor, []byte(resp.Cursor), "page cursor mismatch")
+				require.Equal(t, testCase.expectedPageSize, resp.PageSize, "page size mismatch")
+				require.Equal(t, testCase.expectedRecords, resp.Metadata.NumRecords, "record count mismatch")
+			}
+		})
+	}
+}
@@ -16,20 +16,34 @@ func getUsersQuery() map[string]string {
 		"delete": `{
   "query": "mutation { deleteUser(input: { username: \"%s\" password: \"%s\" confirmation:\"I understand the consequences, delete my user account %s\" })}"
 }`,
+
+		"set_role": `{
+  "query": "mutation { setUserRole(input: { username: \"%s\" role: \"%s\" })}"
+}`,
+
+		"delete_any": `{
+  "query": "mutation { deleteAnyUser(username: \"%s\")}"
+}`,
 	}
 }
 
 // getQuizzesQuery is a map of test quiz queries.
 func getQuizzesQuery() map[string]string {
 	return map[string]string{
+		"marking_schemes": `{
+    "query": "query { markingSchemes }"
+}`,
 		"create_empty": `{
     "query": "mutation { createQuiz(input: { title: \"\" markingType: \"\" questions: [ { description: \"\" asset: \"\" options: [\"Option 1\", \"\", \"\"] answers: [] } { description: \"\" asset: \"\" options: [\"\", \"\"] answers: [] } ] } )}"
 }`,
 		"create_valid": `{
-    "query": "mutation { createQuiz(input: { title: \"Sample quiz title\" markingType: \"Negative\" questions: [ { description: \"Sample quiz description\" asset: \"http://url-of-asset.com/asset.txt\" options: [\"Option 1\", \"Option 2\", \"Option 3\"] answers: [2] } { description: \"Another question\" asset: \"http://url-of-another-asset.com/img.jpg\" options: [\"Another opt 1\", \"Another opt 2\"] answers: [1] } ] } )}"
+    "query": "mutation { createQuiz(input: { title: \"Sample quiz title\" markingType: \"Negative\" questions: [ { description: \"Sample quiz description\" asset: \"http://url-of-asset.com/asset.txt\" options: [\"Option 1\", \"Option 2\", \"Option 3\"] answers: [2] } { description: \"Another question\" asset: \"http://url-of-another-asset.com/img.jpg\" options: [\"Another opt 1\", \"Another opt 2\"] answers: [1] points: 2.5 } ] maxAttempts: 3 attemptCooldown: 60 attemptPolicy: \"latest\" timeLimit: 600 gracePeriod: 30 } )}"
 }`,
 		"create_invalid": `{
     "query": "mutation { createQuiz(input: { title: \"Sample quiz title\" markingType: \"Negative\" questions: [ { description: \"Sample quiz description\" asset: \"http://url-of-asset.com/asset.txt\" options: [\"Option 1\", \"Option 2\", \"Option 3\"] answers: [2] } { description: \"This question only has one option and is invalid\" asset: \"http://url-of-another-asset.com/img.jpg\" options: [\"Another opt 1\"] answers: [0] } ] } )}"
+}`,
+		"create_invalid_attempts": `{
+    "query": "mutation { createQuiz(input: { title: \"Sample quiz title\" markingType: \"Negative\" questions: [ { description: \"Sample quiz description\" asset: \"http://url-of-asset.com/asset.txt\" options: [\"Option 1\", \"Option 2\", \"Option 3\"] answers: [2] } ] maxAttempts: 0 attemptCooldown: -1 attemptPolicy: \"first\" } )}"
 }`,
 		"update_valid": `{
     "query": "mutation { updateQuiz( quizID: \"%s\" quiz: { title: \"Sample quiz title\" markingType: \"Negative\" questions: [ { description: \"Sample quiz description\" asset: \"http://url-of-asset.com/asset.txt\" options: [\"Option 1\", \"Option 2\", \"Option 3\"] answers: [2] } { description: \"Another question\" asset: \"http://url-of-another-asset.com/img.jpg\" options: [\"Another opt 1\", \"Another opt 2\"] answers: [1] } ] } )}"
@@ -38,26 +52,95 @@ func getQuizzesQuery() map[string]string {
     "query": "mutation { updateQuiz( quizID: \"%s\" quiz: { title: \"\" markingType: \"\" questions: [ { description: \"\" asset: \"\" options: [\"\", \"\", \"\"] answers: [] } { description: \"\" asset: \"\" options: [\"\", \"\"] answers: [] } ] } )}"
 }`,
 		"view": `{
-  	"query": "query { viewQuiz(quizID: \"%s\"){ title markingType questions { description asset options answers } }}"
+  	"query": "query { viewQuiz(quizID: \"%s\"){ title markingType shuffle questions { description asset type options answers matches numericAnswer tolerance textAnswers points } }}"
 }`,
 		"delete": `{
 	"query": "mutation { deleteQuiz(quizID:\"%s\")}"
 }`,
 		"publish": `{
 	"query": "mutation { publishQuiz(quizID:\"%s\")}"
+}`,
+		"revise_valid": `{
+    "query": "mutation { reviseQuiz( quizID: \"%s\" quiz: { title: \"Sample quiz title\" markingType: \"Negative\" questions: [ { description: \"Sample quiz description\" asset: \"http://url-of-asset.com/asset.txt\" options: [\"Option 1\", \"Option 2\", \"Option 3\"] answers: [2] } { description: \"Another question\" asset: \"http://url-of-another-asset.com/img.jpg\" options: [\"Another opt 1\", \"Another opt 2\"] answers: [1] } ] } )}"
+}`,
+		"revise_invalid": `{
+    "query": "mutation { reviseQuiz( quizID: \"%s\" quiz: { title: \"\" markingType: \"\" questions: [ { description: \"\" asset: \"\" options: [\"\", \"\", \"\"] answers: [] } { description: \"\" asset: \"\" options: [\"\", \"\"] answers: [] } ] } )}"
+}`,
+		"schedule_valid": `{
+    "query": "mutation { scheduleQuiz( quizID: \"%s\" schedule: { opensAt: \"2030-01-01T09:00:00Z\" closesAt: \"2030-01-01T10:00:00Z\" } )}"
+}`,
+		"schedule_invalid": `{
+    "query": "mutation { scheduleQuiz( quizID: \"%s\" schedule: { opensAt: \"2030-01-01T10:00:00Z\" closesAt: \"2030-01-01T09:00:00Z\" } )}"
+}`,
+		"list_versions": `{
+    "query": "query { listQuizVersions(quizID: \"%s\"){ quizID version author quizCore { title markingType questions { description options answers } } }}"
+}`,
+		"view_version": `{
+    "query": "query { viewQuizVersion(quizID: \"%s\", version: %d){ quizID version author quizCore { title markingType questions { description options answers } } }}"
 }`,
 		"take": `{
-    "query": "mutation { takeQuiz( quizID:\"%s\" input: { responses: %v } ) { username author score quizResponse quizID }}"
+    "query": "mutation { takeQuiz( quizID:\"%s\" input: { responses: %v } ) { username author score maxScore quizResponse textResponses quizID version attempts { number score maxScore version responses textResponses submittedAt late } breakdown { question selected answers correct points maxPoints explanation feedback } }}"
+}`,
+		"save_draft": `{
+    "query": "mutation { saveDraft( quizID:\"%s\" input: { responses: %v } ) { username quizID attempt responses textResponses savedAt }}"
+}`,
+		"resume_draft": `{
+    "query": "query { resumeDraft( quizID:\"%s\" ) { username quizID attempt responses textResponses savedAt }}"
+}`,
+		"submit_draft": `{
+    "query": "mutation { submitDraft( quizID:\"%s\" ) { username author score maxScore quizResponse quizID version attempts { number score maxScore submittedAt } }}"
+}`,
+		"practice": `{
+    "query": "query { practiceQuiz( quizID:\"%s\" input: { question: %d, response: %v } ) { question correct points maxPoints answers explanation feedback }}"
+}`,
+		"start": `{
+    "query": "mutation { startQuiz( quizID:\"%s\" ) { username quizID attempt startedAt deadline remainingTime }}"
+}`,
+		"my_quizzes": `{
+    "query": "query { myQuizzes(status: \"%s\", pageSize: %d, cursor: \"%s\") { records { quizID title status version } metadata { status numRecords } nextPage { pageSize cursor } }}"
+}`,
+		"my_quizzes_all": `{
+    "query": "query { myQuizzes { records { quizID title status version } metadata { status numRecords } nextPage { pageSize cursor } }}"
+}`,
+		"catalogue": `{
+    "query": "query { catalogue(search: \"%s\", tag: \"%s\", pageSize: %d, cursor: \"%s\") { records { quizID author title description tags version } metadata { search tag numRecords } nextPage { pageSize cursor } }}"
+}`,
+		"catalogue_all": `{
+    "query": "query { catalogue { records { quizID author title description tags version } metadata { search tag numRecords } nextPage { pageSize cursor } }}"
 }`,
 	}
 
 }
 
+// getBanksQuery is a map of test question bank queries.
+func getBanksQuery() map[string]string {
+	return map[string]string{
+		"create_valid": `{
+    "query": "mutation { createQuestionBank(input: { title: \"Sample bank title\" questions: [ { description: \"Sample question\" asset: \"\" options: [\"Option 1\", \"Option 2\"] answers: [1] tags: [\"algebra\"] difficulty: \"easy\" } ] } )}"
+}`,
+		"create_invalid": `{
+    "query": "mutation { createQuestionBank(input: { title: \"\" questions: [ { description: \"Sample question\" asset: \"\" options: [\"Option 1\", \"Option 2\"] answers: [1] difficulty: \"extreme\" } ] } )}"
+}`,
+		"update_valid": `{
+    "query": "mutation { updateQuestionBank(bankID: \"%s\" bank: { title: \"Sample bank title\" questions: [ { description: \"Sample question\" asset: \"\" options: [\"Option 1\", \"Option 2\"] answers: [1] } ] } )}"
+}`,
+		"update_invalid": `{
+    "query": "mutation { updateQuestionBank(bankID: \"%s\" bank: { title: \"Sample bank title\" questions: [] } )}"
+}`,
+		"delete": `{
+    "query": "mutation { deleteQuestionBank(bankID: \"%s\")}"
+}`,
+		"view": `{
+    "query": "query { viewQuestionBank(bankID: \"%s\"){ title questions { description options answers tags difficulty } }}"
+}`,
+	}
+}
+
 // getScoresQuery is a map of test scores queries.
 func getScoresQuery() map[string]string {
 	return map[string]string{
 		"score": `{
-  	"query": "query { getScore(quizID:\"%s\") { username author score quizResponse quizID }}"
+  	"query": "query { getScore(quizID:\"%s\") { username author score maxScore quizResponse textResponses quizID version attempts { number score maxScore version responses textResponses submittedAt } breakdown { question selected answers correct points maxPoints explanation feedback } }}"
 }`,
 		"stats": `{
     "query": "query { getStats(quizID:\"%s\", pageSize: %d, cursor:\"%s\") { records { username author score quizResponse quizID } metadata { quizID numRecords } nextPage { pageSize cursor } }}"
@@ -67,6 +150,60 @@ func getScoresQuery() map[string]string {
 }`,
 		"stats_page_size": `{
     "query": "query { getStats(quizID:\"%s\", pageSize: %d) { records { username author score quizResponse quizID } metadata { quizID numRecords } nextPage { pageSize cursor } }}"
+}`,
+		"stats_summary": `{
+    "query": "query { getStatsSummary(quizID:\"%s\") { quizID numResponses mean median standardDeviation min max histogram { lower upper count } }}"
+}`,
+		"item_analysis": `{
+    "query": "query { getItemAnalysis(quizID:\"%s\") { quizID version numResponses numExcluded groupSize kr20 cronbachAlpha items { question description type difficulty discrimination omitted options { option correct count top bottom } } }}"
+}`,
+		"my_scores": `{
+    "query": "query { myScores(pageSize: %d, cursor: \"%s\") { records { quizID title score maxScore attempts version submittedAt } metadata { numRecords } nextPage { pageSize cursor } }}"
+}`,
+		"my_scores_all": `{
+    "query": "query { myScores { records { quizID title score maxScore attempts version submittedAt } metadata { numRecords } nextPage { pageSize cursor } }}"
+}`,
+		"regrade": `{
+    "query": "mutation { regradeScores(quizID:\"%s\") { version pages processed changed unchanged failed netChange largestIncrease largestDecrease }}"
+}`,
+	}
+}
+
+// getGroupsQuery is a map of test group queries.
+func getGroupsQuery() map[string]string {
+	return map[string]string{
+		"create_valid": `{
+    "query": "mutation { createGroup(input: { name: \"Algebra 101\" description: \"Fall cohort\" }) { groupID name description creator joinCode }}"
+}`,
+		"create_invalid": `{
+    "query": "mutation { createGroup(input: { name: \"\" }) { groupID }}"
+}`,
+		"view": `{
+    "query": "query { viewGroup(groupID: \"%s\") { groupID name description creator joinCode }}"
+}`,
+		"delete": `{
+    "query": "mutation { deleteGroup(groupID: \"%s\") }"
+}`,
+		"remove_member": `{
+    "query": "mutation { removeGroupMember(groupID: \"%s\", username: \"%s\") }"
+}`,
+		"invite": `{
+    "query": "mutation { inviteToGroup(groupID: \"%s\", input: { username: \"student\" isOwner: false }) }"
+}`,
+		"accept": `{
+    "query": "mutation { acceptGroupInvitation(groupID: \"%s\") }"
+}`,
+		"join": `{
+    "query": "mutation { joinGroup(joinCode: \"%s\") }"
+}`,
+		"assign": `{
+    "query": "mutation { assignQuiz(groupID: \"%s\", quizID: \"%s\") }"
+}`,
+		"quizzes": `{
+    "query": "query { groupQuizzes(groupID: \"%s\") { quizID title assignedBy assignedAt }}"
+}`,
+		"scores": `{
+    "query": "query { groupScores(groupID: \"%s\") { groupID numMembers quizzes { quizID title numResponses numOutstanding mean meanPercentage min max } members { username numResponses score maxScore percentage } }}"
 }`,
 	}
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/surahman/mcq-platform/pkg/cassandra"
 	"github.com/surahman/mcq-platform/pkg/constants"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
 	graphql_generated "github.com/surahman/mcq-platform/pkg/http/graph/generated"
 	model_cassandra "github.com/surahman/mcq-platform/pkg/model/cassandra"
 	model_http "github.com/surahman/mcq-platform/pkg/model/http"
@@ -32,11 +33,12 @@ func (r *mutationResolver) RegisterUser(ctx context.Context, input *model_cassan
 		return authToken, err
 	}
 
-	if _, err = r.DB.Execute(cassandra.CreateUserQuery, &model_cassandra.User{UserAccount: input}); err != nil {
+	if _, err = r.DB.Execute(cassandra.CreateUserQuery,
+		&model_cassandra.User{UserAccount: input, Role: model_cassandra.RoleStudent}); err != nil {
 		return authToken, err
 	}
 
-	if authToken, err = r.Auth.GenerateJWT(input.Username); err != nil {
+	if authToken, err = r.Auth.GenerateJWT(input.Username, model_cassandra.RoleStudent); err != nil {
 		r.Logger.Error("failure generating JWT during account creation", zap.Error(err))
 		return authToken, err
 	}
@@ -54,7 +56,7 @@ func (r *mutationResolver) DeleteUser(ctx context.Context, input model_http.Dele
 		return "", errors.New(err.Error())
 	}
 
-	if username, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+	if username, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
 		return "", err
 	}
 
@@ -112,7 +114,7 @@ func (r *mutationResolver) LoginUser(ctx context.Context, input model_cassandra.
 		return nil, err
 	}
 
-	if authToken, err = r.Auth.GenerateJWT(input.Username); err != nil {
+	if authToken, err = r.Auth.GenerateJWT(input.Username, truth.Role); err != nil {
 		r.Logger.Error("failure generating JWT during login", zap.Error(err))
 		return nil, err
 	}
@@ -128,7 +130,7 @@ func (r *mutationResolver) RefreshToken(ctx context.Context) (*model_http.JWTAut
 	var dbResponse any
 	var expiresAt int64
 
-	if username, expiresAt, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
+	if username, expiresAt, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx); err != nil {
 		return nil, err
 	}
 
@@ -137,7 +139,8 @@ func (r *mutationResolver) RefreshToken(ctx context.Context) (*model_http.JWTAut
 		return nil, errors.New("please retry your request later")
 	}
 
-	if dbResponse.(*model_cassandra.User).IsDeleted {
+	truth := dbResponse.(*model_cassandra.User)
+	if truth.IsDeleted {
 		r.Logger.Warn("attempt to refresh a JWT for a deleted user", zap.String("username", username))
 		return nil, errors.New("invalid token")
 	}
@@ -147,13 +150,55 @@ func (r *mutationResolver) RefreshToken(ctx context.Context) (*model_http.JWTAut
 		return nil, errors.New("JWT is still valid for more than 60 seconds")
 	}
 
-	if freshToken, err = r.Auth.GenerateJWT(username); err != nil {
+	// The fresh token carries the user's current role.
+	if freshToken, err = r.Auth.GenerateJWT(username, truth.Role); err != nil {
 		r.Logger.Error("failure generating JWT during token refresh", zap.Error(err))
 		return nil, errors.New(err.Error())
 	}
 	return freshToken, nil
 }
 
+// SetUserRole is the resolver for the setUserRole field.
+func (r *mutationResolver) SetUserRole(ctx context.Context, input model_cassandra.UserRoleRequest) (string, error) {
+	var err error
+
+	if err = validator.ValidateStruct(&input); err != nil {
+		return "", err
+	}
+
+	if _, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx, http_common.PermissionManageUsers); err != nil {
+		return "", err
+	}
+
+	if _, err = r.DB.Execute(cassandra.UpdateUserRoleQuery, &input); err != nil {
+		r.Logger.Warn("failed to change the role of a user", zap.String("username", input.Username), zap.Error(err))
+		return "", err
+	}
+
+	return fmt.Sprintf("role of %s changed to %s", input.Username, input.Role), nil
+}
+
+// DeleteAnyUser is the resolver for the deleteAnyUser field.
+func (r *mutationResolver) DeleteAnyUser(ctx context.Context, username string) (string, error) {
+	var err error
+	var requester string
+
+	if requester, _, _, err = AuthorizationCheck(r.Auth, r.Logger, r.AuthHeaderKey, ctx, http_common.PermissionManageUsers); err != nil {
+		return "", err
+	}
+
+	if err = http_common.CheckDeletable(requester, username, r.Auth); err != nil {
+		return "", err
+	}
+
+	if _, err = r.DB.Execute(cassandra.DeleteUserQuery, username); err != nil {
+		r.Logger.Warn("failed to mark a user record as deleted", zap.String("username", username), zap.Error(err))
+		return "", err
+	}
+
+	return "account successfully deleted", nil
+}
+
 // Mutation returns graphql_generated.MutationResolver implementation.
 func (r *Resolver) Mutation() graphql_generated.MutationResolver { return &mutationResolver{r} }
 
@@ -125,8 +125,9 @@ func TestMutationResolver_RegisterUser(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)     // Not called.
-			mockGrading := mocks.NewMockGrading(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
 
 			mockAuth.EXPECT().HashPassword(gomock.Any()).Return(
 				testCase.authHashData.OutputParam1,
@@ -138,13 +139,13 @@ func TestMutationResolver_RegisterUser(t *testing.T) {
 				testCase.cassandraCreateData.OutputErr,
 			).Times(testCase.cassandraCreateData.Times)
 
-			mockAuth.EXPECT().GenerateJWT(gomock.Any()).Return(
+			mockAuth.EXPECT().GenerateJWT(gomock.Any(), gomock.Any()).Return(
 				testCase.authGenJWTData.OutputParam1,
 				testCase.authGenJWTData.OutputErr,
 			).Times(testCase.authGenJWTData.Times)
 
 			// Endpoint setup for test.
-			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCassandra, mockGrading, zapLogger))
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
 
 			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.user))
 			req.Header.Set("Content-Type", "application/json")
@@ -294,8 +295,9 @@ func TestMutationResolver_LoginUser(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)     // Not called.
-			mockGrading := mocks.NewMockGrading(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
 
 			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
 				testCase.cassandraReadData.OutputParam,
@@ -306,13 +308,13 @@ func TestMutationResolver_LoginUser(t *testing.T) {
 				testCase.authCheckPwdData.OutputErr,
 			).Times(testCase.authCheckPwdData.Times)
 
-			mockAuth.EXPECT().GenerateJWT(gomock.Any()).Return(
+			mockAuth.EXPECT().GenerateJWT(gomock.Any(), gomock.Any()).Return(
 				testCase.authGenJWTData.OutputParam1,
 				testCase.authGenJWTData.OutputErr,
 			).Times(testCase.authGenJWTData.Times)
 
 			// Endpoint setup for test.
-			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCassandra, mockGrading, zapLogger))
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
 
 			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.user))
 			req.Header.Set("Content-Type", "application/json")
@@ -508,14 +510,16 @@ func TestMutationResolver_RefreshToken(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)     // Not called.
-			mockGrading := mocks.NewMockGrading(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				// JWT check.
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 					testCase.authValidateJWTData.OutputParam1,
 					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
 					testCase.authValidateJWTData.OutputErr,
 				).Times(testCase.authValidateJWTData.Times),
 
@@ -531,14 +535,14 @@ func TestMutationResolver_RefreshToken(t *testing.T) {
 				).Times(testCase.authRefThresholdData.Times),
 
 				// Generate fresh JWT.
-				mockAuth.EXPECT().GenerateJWT(gomock.Any()).Return(
+				mockAuth.EXPECT().GenerateJWT(gomock.Any(), gomock.Any()).Return(
 					testCase.authGenJWTData.OutputParam1,
 					testCase.authGenJWTData.OutputErr,
 				).Times(testCase.authGenJWTData.Times),
 			)
 
 			// Endpoint setup for test.
-			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCassandra, mockGrading, zapLogger))
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
 
 			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testUserQuery["refresh"]))
 			req.Header.Set("Content-Type", "application/json")
@@ -763,8 +767,9 @@ func TestMutationResolver_DeleteUser(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)     // Not called.
-			mockGrading := mocks.NewMockGrading(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
 
 			authToken := xid.New().String()
 
@@ -773,6 +778,7 @@ func TestMutationResolver_DeleteUser(t *testing.T) {
 				mockAuth.EXPECT().ValidateJWT(authToken).Return(
 					testCase.authValidateJWTData.OutputParam1,
 					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
 					testCase.authValidateJWTData.OutputErr,
 				).Times(testCase.authValidateJWTData.Times),
 				// DB read call.
@@ -792,7 +798,7 @@ func TestMutationResolver_DeleteUser(t *testing.T) {
 			)
 
 			// Endpoint setup for test.
-			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCassandra, mockGrading, zapLogger))
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
 
 			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.query))
 			req.Header.Set("Content-Type", "application/json")
@@ -819,3 +825,277 @@ func TestMutationResolver_DeleteUser(t *testing.T) {
 		})
 	}
 }
+
+func TestMutationResolver_SetUserRole(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	testCases := []struct {
+		name                string
+		path                string
+		query               string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		cassandraUpdateData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "invalid role",
+			path:      "/set-role/invalid-role",
+			query:     fmt.Sprintf(testUserQuery["set_role"], "username1", "superuser"),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "admin",
+				OutputParam3: model_cassandra.RoleAdmin,
+				Times:        0,
+			},
+			cassandraUpdateData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:      "permission denied",
+			path:      "/set-role/permission-denied",
+			query:     fmt.Sprintf(testUserQuery["set_role"], "username1", model_cassandra.RoleInstructor),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "instructor",
+				OutputParam3: model_cassandra.RoleInstructor,
+				Times:        1,
+			},
+			cassandraUpdateData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:      "user not found",
+			path:      "/set-role/user-not-found",
+			query:     fmt.Sprintf(testUserQuery["set_role"], "username1", model_cassandra.RoleInstructor),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "admin",
+				OutputParam3: model_cassandra.RoleAdmin,
+				Times:        1,
+			},
+			cassandraUpdateData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "user not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+		}, {
+			name:      "valid request",
+			path:      "/set-role/valid-request",
+			query:     fmt.Sprintf(testUserQuery["set_role"], "username1", model_cassandra.RoleInstructor),
+			expectErr: false,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "admin",
+				OutputParam3: model_cassandra.RoleAdmin,
+				Times:        1,
+			},
+			cassandraUpdateData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Authorization check.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+				// DB update call.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraUpdateData.OutputParam,
+					testCase.cassandraUpdateData.OutputErr,
+				).Times(testCase.cassandraUpdateData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", xid.New().String())
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				confirmation := data.(map[string]any)["setUserRole"].(string)
+				require.Equal(t, "role of username1 changed to instructor", confirmation, "confirmation message does not match expected")
+			}
+		})
+	}
+}
+
+func TestMutationResolver_DeleteAnyUser(t *testing.T) {
+	// Configure router and middleware that loads the Gin context for the resolvers.
+	router := http_common.GetTestRouter()
+	router.Use(GinContextToContextMiddleware())
+
+	bootstrapAdmin := &model_cassandra.UserAccount{
+		UserLoginCredentials: model_cassandra.UserLoginCredentials{Username: "username1"},
+	}
+
+	testCases := []struct {
+		name                string
+		path                string
+		query               string
+		expectErr           bool
+		authValidateJWTData *http_common.MockAuthData
+		adminAccount        *model_cassandra.UserAccount
+		adminAccountTimes   int
+		cassandraDeleteData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "permission denied",
+			path:      "/delete-any/permission-denied",
+			query:     fmt.Sprintf(testUserQuery["delete_any"], "username1"),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "student",
+				OutputParam3: model_cassandra.RoleStudent,
+				Times:        1,
+			},
+			cassandraDeleteData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:      "own account",
+			path:      "/delete-any/own-account",
+			query:     fmt.Sprintf(testUserQuery["delete_any"], "username1"),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "username1",
+				OutputParam3: model_cassandra.RoleAdmin,
+				Times:        1,
+			},
+			adminAccountTimes: 0,
+			cassandraDeleteData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:      "bootstrap administrator",
+			path:      "/delete-any/bootstrap-administrator",
+			query:     fmt.Sprintf(testUserQuery["delete_any"], "username1"),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "admin",
+				OutputParam3: model_cassandra.RoleAdmin,
+				Times:        1,
+			},
+			adminAccount:      bootstrapAdmin,
+			adminAccountTimes: 1,
+			cassandraDeleteData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:      "db delete failure",
+			path:      "/delete-any/db-delete-failure",
+			query:     fmt.Sprintf(testUserQuery["delete_any"], "username1"),
+			expectErr: true,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "admin",
+				OutputParam3: model_cassandra.RoleAdmin,
+				Times:        1,
+			},
+			adminAccountTimes: 1,
+			cassandraDeleteData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "user not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+		}, {
+			name:      "valid request",
+			path:      "/delete-any/valid-request",
+			query:     fmt.Sprintf(testUserQuery["delete_any"], "username1"),
+			expectErr: false,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "admin",
+				OutputParam3: model_cassandra.RoleAdmin,
+				Times:        1,
+			},
+			adminAccountTimes: 1,
+			cassandraDeleteData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)         // Not called.
+			mockGrading := mocks.NewMockGrading(mockCtrl)     // Not called.
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				// Authorization check.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+				// Protected account check.
+				mockAuth.EXPECT().AdminAccount().Return(testCase.adminAccount).Times(testCase.adminAccountTimes),
+				// DB delete call.
+				mockCassandra.EXPECT().Execute(gomock.Any(), "username1").Return(
+					testCase.cassandraDeleteData.OutputParam,
+					testCase.cassandraDeleteData.OutputErr,
+				).Times(testCase.cassandraDeleteData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockCatalogue, mockCassandra, mockGrading, zapLogger))
+
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBufferString(testCase.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", xid.New().String())
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if testCase.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set")
+				confirmation := data.(map[string]any)["deleteAnyUser"].(string)
+				require.Equal(t, "account successfully deleted", confirmation, "confirmation message does not match expected")
+			}
+		})
+	}
+}
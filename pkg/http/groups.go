@@ -0,0 +1,195 @@
+package http
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"net/http"
+
+	"github.com/gocql/gocql"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+)
+
+// JoinCodeLength is the number of characters in the code that users join a group with.
+const JoinCodeLength = 8
+
+// joinCodeAlphabet are the characters join codes are generated from. Characters that are easily confused are excluded.
+const joinCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+var (
+	// ErrNotGroupMember is returned when a user is not a member of a group.
+	ErrNotGroupMember = errors.New("requester is not a member of the group")
+
+	// ErrNotGroupOwner is returned when a user is not an owner of a group.
+	ErrNotGroupOwner = errors.New("requester is not an owner of the group")
+
+	// ErrQuizRestricted is returned when a quiz is assigned to groups that a user is not a member of.
+	ErrQuizRestricted = errors.New("quiz is restricted to the members of the groups it is assigned to")
+)
+
+// NewJoinCode will generate a random code that users can join a group with.
+func NewJoinCode() (string, error) {
+	code := make([]byte, JoinCodeLength)
+	for idx := range code {
+		pick, err := rand.Int(rand.Reader, big.NewInt(int64(len(joinCodeAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		code[idx] = joinCodeAlphabet[pick.Int64()]
+	}
+	return string(code), nil
+}
+
+// GroupMembership will retrieve the membership of a user in a group. Administrators are owners of every group that has not
+// been deleted. Users who are not members are returned ErrNotGroupMember.
+func GroupMembership(groupId gocql.UUID, username, role string, db cassandra.Cassandra) (*model_cassandra.GroupMember, error) {
+	if HasPermission(role, PermissionManageGroups) {
+		if _, err := db.Execute(cassandra.ReadGroupQuery, groupId); err != nil {
+			return nil, err
+		}
+		return &model_cassandra.GroupMember{GroupID: groupId, Username: username, IsOwner: true}, nil
+	}
+
+	record, err := db.Execute(cassandra.ReadGroupMemberQuery, &model_cassandra.GroupMemberRequest{GroupID: groupId, Username: username})
+	if err != nil {
+		if cassandraErr, ok := err.(*cassandra.Error); ok && cassandraErr.Status == http.StatusNotFound {
+			return nil, ErrNotGroupMember
+		}
+		return nil, err
+	}
+
+	return record.(*model_cassandra.GroupMember), nil
+}
+
+// CheckGroupOwner will verify that a user owns a group. Users who are not owners are returned ErrNotGroupOwner.
+func CheckGroupOwner(groupId gocql.UUID, username, role string, db cassandra.Cassandra) error {
+	member, err := GroupMembership(groupId, username, role, db)
+	if errors.Is(err, ErrNotGroupMember) || (err == nil && !member.IsOwner) {
+		return ErrNotGroupOwner
+	}
+	return err
+}
+
+// IsGroupAccessError reports whether an error is a denial of access to a group or a quiz assigned to groups.
+func IsGroupAccessError(err error) bool {
+	return errors.Is(err, ErrNotGroupMember) || errors.Is(err, ErrNotGroupOwner) || errors.Is(err, ErrQuizRestricted)
+}
+
+// CheckGroupAccess will verify that a user may view and take a quiz. Quizzes that are not assigned to a group are open to all
+// users and users who manage a quiz may always access it. Otherwise, users who are not a member of any of the groups the
+// quiz is assigned to are returned ErrQuizRestricted.
+func CheckGroupAccess(quiz *model_cassandra.Quiz, username, role string, db cassandra.Cassandra) error {
+	if len(quiz.Groups) == 0 || CanManage(quiz.Author, username, role) {
+		return nil
+	}
+
+	record, err := db.Execute(cassandra.ReadUserGroupsQuery, username)
+	if err != nil {
+		return err
+	}
+
+	for _, membership := range record.([]*model_cassandra.UserGroup) {
+		for _, groupId := range quiz.Groups {
+			if membership.GroupID == groupId {
+				return nil
+			}
+		}
+	}
+
+	return ErrQuizRestricted
+}
+
+// RollupGroup will page through all the responses to the quizzes assigned to a group and compute the aggregate effective
+// scores of the group's members on each quiz, along with each member's total across the quizzes. Responses from users who
+// are not members, and from the owners of the group, are not counted.
+func RollupGroup(groupId gocql.UUID, db cassandra.Cassandra) (*model_http.GroupRollup, error) {
+	membersRecord, err := db.Execute(cassandra.ReadGroupMembersQuery, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	quizzesRecord, err := db.Execute(cassandra.ReadGroupQuizzesQuery, groupId)
+	if err != nil {
+		return nil, err
+	}
+	quizzes := quizzesRecord.([]*model_cassandra.GroupQuiz)
+
+	rollup := &model_http.GroupRollup{
+		GroupID: groupId.String(),
+		Quizzes: make([]*model_http.GroupQuizRollup, 0, len(quizzes)),
+		Members: make([]*model_http.GroupMemberRollup, 0),
+	}
+	totals := make(map[string]*model_http.GroupMemberRollup)
+	for _, member := range membersRecord.([]*model_cassandra.GroupMember) {
+		if member.IsOwner {
+			continue
+		}
+		total := &model_http.GroupMemberRollup{Username: member.Username}
+		totals[member.Username] = total
+		rollup.Members = append(rollup.Members, total)
+	}
+	rollup.NumMembers = len(rollup.Members)
+
+	for _, quiz := range quizzes {
+		var sum, percentages float64
+		var numPercentages int
+		summary := &model_http.GroupQuizRollup{QuizID: quiz.QuizID.String(), Title: quiz.Title}
+		request := &model_cassandra.StatsRequest{QuizID: quiz.QuizID, PageSize: SummaryPageSize}
+
+		for {
+			dbRecord, err := db.Execute(cassandra.ReadResponseStatisticsPageQuery, request)
+			if err != nil {
+				return nil, err
+			}
+			page := dbRecord.(*model_cassandra.StatsResponse)
+
+			for _, response := range page.Records {
+				total, ok := totals[response.Username]
+				if !ok {
+					continue
+				}
+
+				if summary.NumResponses == 0 || response.Score < summary.Min {
+					summary.Min = response.Score
+				}
+				if summary.NumResponses == 0 || response.Score > summary.Max {
+					summary.Max = response.Score
+				}
+				summary.NumResponses++
+				sum += response.Score
+				if response.MaxScore > 0 {
+					percentages += 100 * response.Score / response.MaxScore
+					numPercentages++
+				}
+
+				total.NumResponses++
+				total.Score += response.Score
+				total.MaxScore += response.MaxScore
+			}
+
+			if len(page.PageCursor) == 0 {
+				break
+			}
+			request.PageCursor = page.PageCursor
+		}
+
+		summary.NumOutstanding = rollup.NumMembers - summary.NumResponses
+		if summary.NumResponses > 0 {
+			summary.Mean = sum / float64(summary.NumResponses)
+		}
+		if numPercentages > 0 {
+			summary.MeanPercentage = percentages / float64(numPercentages)
+		}
+		rollup.Quizzes = append(rollup.Quizzes, summary)
+	}
+
+	for _, total := range rollup.Members {
+		if total.MaxScore > 0 {
+			total.Percentage = 100 * total.Score / total.MaxScore
+		}
+	}
+
+	return rollup, nil
+}
@@ -0,0 +1,334 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+)
+
+func TestNewJoinCode(t *testing.T) {
+	codes := make(map[string]bool)
+	for idx := 0; idx < 100; idx++ {
+		code, err := NewJoinCode()
+		require.NoError(t, err, "failed to generate join code")
+		require.Len(t, code, JoinCodeLength, "join code length mismatch")
+		for _, char := range code {
+			require.True(t, strings.ContainsRune(joinCodeAlphabet, char), "join code contains invalid character %c", char)
+		}
+		codes[code] = true
+	}
+	require.Greater(t, len(codes), 95, "join codes should not repeat")
+}
+
+func TestGroupMembership(t *testing.T) {
+	groupId := gocql.TimeUUID()
+
+	testCases := []struct {
+		name          string
+		role          string
+		cassandraData *MockCassandraData
+		expectOwner   bool
+		expectErr     error
+	}{
+		// ----- test cases start ----- //
+		{
+			name:          "member",
+			role:          model_cassandra.RoleStudent,
+			cassandraData: &MockCassandraData{OutputParam: &model_cassandra.GroupMember{GroupID: groupId}, Times: 1},
+		}, {
+			name:          "owner",
+			role:          model_cassandra.RoleInstructor,
+			cassandraData: &MockCassandraData{OutputParam: &model_cassandra.GroupMember{GroupID: groupId, IsOwner: true}, Times: 1},
+			expectOwner:   true,
+		}, {
+			name: "not a member",
+			role: model_cassandra.RoleInstructor,
+			cassandraData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "membership not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			expectErr: ErrNotGroupMember,
+		}, {
+			name: "db failure",
+			role: model_cassandra.RoleStudent,
+			cassandraData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			expectErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+		}, {
+			name:          "admin",
+			role:          model_cassandra.RoleAdmin,
+			cassandraData: &MockCassandraData{OutputParam: &model_cassandra.Group{GroupID: groupId}, Times: 1},
+			expectOwner:   true,
+		}, {
+			name: "admin, group not found",
+			role: model_cassandra.RoleAdmin,
+			cassandraData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "group not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			expectErr: &cassandra.Error{Message: "group not found", Status: http.StatusNotFound},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+				testCase.cassandraData.OutputParam,
+				testCase.cassandraData.OutputErr,
+			).Times(testCase.cassandraData.Times)
+
+			member, err := GroupMembership(groupId, "username", testCase.role, mockCassandra)
+			if testCase.expectErr != nil {
+				require.Equal(t, testCase.expectErr, err, "error mismatch")
+				return
+			}
+			require.NoError(t, err, "failed to retrieve membership")
+			require.Equal(t, testCase.expectOwner, member.IsOwner, "ownership mismatch")
+
+			// Ownership check.
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+				testCase.cassandraData.OutputParam,
+				testCase.cassandraData.OutputErr,
+			).Times(1)
+			err = CheckGroupOwner(groupId, "username", testCase.role, mockCassandra)
+			if testCase.expectOwner {
+				require.NoError(t, err, "owner check failed")
+			} else {
+				require.ErrorIs(t, err, ErrNotGroupOwner, "members who are not owners should be rejected")
+			}
+		})
+	}
+}
+
+func TestCheckGroupOwner_NotMember(t *testing.T) {
+	// Mock configurations.
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+	mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+		nil,
+		&cassandra.Error{Message: "membership not found", Status: http.StatusNotFound},
+	).Times(1)
+
+	err := CheckGroupOwner(gocql.TimeUUID(), "username", model_cassandra.RoleInstructor, mockCassandra)
+	require.ErrorIs(t, err, ErrNotGroupOwner, "users who are not members should be rejected")
+	require.True(t, IsGroupAccessError(err), "error should be an access denial")
+}
+
+func TestCheckGroupAccess(t *testing.T) {
+	groupId := gocql.TimeUUID()
+
+	testCases := []struct {
+		name          string
+		username      string
+		role          string
+		groups        []gocql.UUID
+		cassandraData *MockCassandraData
+		expectErr     error
+	}{
+		// ----- test cases start ----- //
+		{
+			name:          "not assigned",
+			username:      "username",
+			role:          model_cassandra.RoleStudent,
+			cassandraData: &MockCassandraData{Times: 0},
+		}, {
+			name:          "author",
+			username:      "author",
+			role:          model_cassandra.RoleInstructor,
+			groups:        []gocql.UUID{groupId},
+			cassandraData: &MockCassandraData{Times: 0},
+		}, {
+			name:          "admin",
+			username:      "username",
+			role:          model_cassandra.RoleAdmin,
+			groups:        []gocql.UUID{groupId},
+			cassandraData: &MockCassandraData{Times: 0},
+		}, {
+			name:     "member",
+			username: "username",
+			role:     model_cassandra.RoleStudent,
+			groups:   []gocql.UUID{gocql.TimeUUID(), groupId},
+			cassandraData: &MockCassandraData{
+				OutputParam: []*model_cassandra.UserGroup{{GroupID: gocql.TimeUUID()}, {GroupID: groupId}},
+				Times:       1,
+			},
+		}, {
+			name:     "not a member",
+			username: "username",
+			role:     model_cassandra.RoleStudent,
+			groups:   []gocql.UUID{groupId},
+			cassandraData: &MockCassandraData{
+				OutputParam: []*model_cassandra.UserGroup{{GroupID: gocql.TimeUUID()}},
+				Times:       1,
+			},
+			expectErr: ErrQuizRestricted,
+		}, {
+			name:     "db failure",
+			username: "username",
+			role:     model_cassandra.RoleStudent,
+			groups:   []gocql.UUID{groupId},
+			cassandraData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			expectErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), testCase.username).Return(
+				testCase.cassandraData.OutputParam,
+				testCase.cassandraData.OutputErr,
+			).Times(testCase.cassandraData.Times)
+
+			quiz := &model_cassandra.Quiz{Author: "author", Groups: testCase.groups}
+			err := CheckGroupAccess(quiz, testCase.username, testCase.role, mockCassandra)
+			require.Equal(t, testCase.expectErr, err, "error mismatch")
+		})
+	}
+}
+
+func TestRollupGroup(t *testing.T) {
+	groupId := gocql.TimeUUID()
+	quizA := gocql.TimeUUID()
+	quizB := gocql.TimeUUID()
+	members := []*model_cassandra.GroupMember{
+		{GroupID: groupId, Username: "owner", IsOwner: true},
+		{GroupID: groupId, Username: "student-1"},
+		{GroupID: groupId, Username: "student-2"},
+	}
+	quizzes := []*model_cassandra.GroupQuiz{
+		{GroupID: groupId, QuizID: quizA, Title: "quiz a"},
+		{GroupID: groupId, QuizID: quizB, Title: "quiz b"},
+	}
+	newResponse := func(username string, score, maxScore float64) *model_cassandra.Response {
+		return &model_cassandra.Response{Username: username, Score: score, MaxScore: maxScore}
+	}
+
+	testCases := []struct {
+		name           string
+		membersErr     error
+		quizzesErr     error
+		pages          map[gocql.UUID][]*model_cassandra.StatsResponse
+		pageErr        error
+		expectErr      require.ErrorAssertionFunc
+		expectedRollup *model_http.GroupRollup
+	}{
+		// ----- test cases start ----- //
+		{
+			name: "responses",
+			pages: map[gocql.UUID][]*model_cassandra.StatsResponse{
+				quizA: {
+					{Records: []*model_cassandra.Response{newResponse("student-1", 2, 4), newResponse("owner", 4, 4)},
+						PageCursor: []byte("cursor")},
+					{Records: []*model_cassandra.Response{newResponse("outsider", 0, 4), newResponse("student-2", 4, 4)}},
+				},
+				quizB: {
+					{Records: []*model_cassandra.Response{newResponse("student-1", 1, 2)}},
+				},
+			},
+			expectErr: require.NoError,
+			expectedRollup: &model_http.GroupRollup{
+				GroupID:    groupId.String(),
+				NumMembers: 2,
+				Quizzes: []*model_http.GroupQuizRollup{
+					{QuizID: quizA.String(), Title: "quiz a", NumResponses: 2, Mean: 3, MeanPercentage: 75, Min: 2, Max: 4},
+					{QuizID: quizB.String(), Title: "quiz b", NumResponses: 1, NumOutstanding: 1, Mean: 1, MeanPercentage: 50,
+						Min: 1, Max: 1},
+				},
+				Members: []*model_http.GroupMemberRollup{
+					{Username: "student-1", NumResponses: 2, Score: 3, MaxScore: 6, Percentage: 50},
+					{Username: "student-2", NumResponses: 1, Score: 4, MaxScore: 4, Percentage: 100},
+				},
+			},
+		}, {
+			name: "no responses",
+			pages: map[gocql.UUID][]*model_cassandra.StatsResponse{
+				quizA: {{}},
+				quizB: {{}},
+			},
+			expectErr: require.NoError,
+			expectedRollup: &model_http.GroupRollup{
+				GroupID:    groupId.String(),
+				NumMembers: 2,
+				Quizzes: []*model_http.GroupQuizRollup{
+					{QuizID: quizA.String(), Title: "quiz a", NumOutstanding: 2},
+					{QuizID: quizB.String(), Title: "quiz b", NumOutstanding: 2},
+				},
+				Members: []*model_http.GroupMemberRollup{{Username: "student-1"}, {Username: "student-2"}},
+			},
+		}, {
+			name:       "members read failure",
+			membersErr: &cassandra.Error{Message: "members read failure", Status: http.StatusInternalServerError},
+			expectErr:  require.Error,
+		}, {
+			name:       "quizzes read failure",
+			quizzesErr: &cassandra.Error{Message: "quizzes read failure", Status: http.StatusInternalServerError},
+			expectErr:  require.Error,
+		}, {
+			name:      "page read failure",
+			pageErr:   &cassandra.Error{Message: "page read failure", Status: http.StatusInternalServerError},
+			expectErr: require.Error,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			groupReads := 0
+			pageIdx := make(map[gocql.UUID]int)
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+					if request, ok := params.(*model_cassandra.StatsRequest); ok {
+						require.Equal(t, SummaryPageSize, request.PageSize, "page size mismatch")
+						if testCase.pageErr != nil {
+							return nil, testCase.pageErr
+						}
+						pageIdx[request.QuizID]++
+						return testCase.pages[request.QuizID][pageIdx[request.QuizID]-1], nil
+					}
+
+					require.Equal(t, groupId, params, "group id mismatch")
+					groupReads++
+					if groupReads == 1 {
+						return members, testCase.membersErr
+					}
+					return quizzes, testCase.quizzesErr
+				}).AnyTimes()
+
+			rollup, err := RollupGroup(groupId, mockCassandra)
+			testCase.expectErr(t, err, "error expectation failed")
+			if err != nil {
+				return
+			}
+			require.Equal(t, testCase.expectedRollup, rollup, "rollup does not match expected")
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package http
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/grading"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+)
+
+// ItemAnalysisPageSize is the number of responses read from the database in each page of an item analysis.
+const ItemAnalysisPageSize = 100
+
+// groupFraction is the fraction of the responses with the highest and lowest scores placed in the top and bottom groups.
+const groupFraction = 0.27
+
+// ErrItemAnalysisUnavailable is returned when an item analysis is requested for a quiz drawn from question banks, where the
+// questions differ between attempts.
+var ErrItemAnalysisUnavailable = errors.New("item analysis is unavailable for quizzes drawn from question banks")
+
+// itemRecord is the answers to a quiz in a single response along with the points awarded for every question.
+type itemRecord struct {
+	answers *model_cassandra.QuizResponse
+	points  []float64
+	correct []bool
+	total   float64
+}
+
+// AnalyzeItems will page through all the responses to a quiz and grade every question in their effective answers against
+// the current version of the quiz. Responses graded against other versions, or that can no longer be graded, are excluded.
+// Quizzes drawn from question banks return ErrItemAnalysisUnavailable.
+func AnalyzeItems(quiz *model_cassandra.Quiz, db cassandra.Cassandra, grader grading.Grading) (*model_http.ItemAnalysis, error) {
+	if isDrawn(quiz.QuizCore) {
+		return nil, ErrItemAnalysisUnavailable
+	}
+
+	var records []*itemRecord
+	excluded := 0
+	request := &model_cassandra.StatsRequest{QuizID: quiz.QuizID, PageSize: ItemAnalysisPageSize}
+
+	for {
+		dbRecord, err := db.Execute(cassandra.ReadResponseStatisticsPageQuery, request)
+		if err != nil {
+			return nil, err
+		}
+		page := dbRecord.(*model_cassandra.StatsResponse)
+
+		for _, response := range page.Records {
+			if response.Version != quiz.Version {
+				excluded++
+				continue
+			}
+
+			answers := response.QuizResponse
+			if answers == nil {
+				answers = &model_cassandra.QuizResponse{}
+			}
+
+			points, correct, err := grader.GradeQuestions(answers, quiz.QuizCore)
+			if err != nil {
+				excluded++
+				continue
+			}
+
+			record := &itemRecord{answers: answers, points: points, correct: correct}
+			for _, mark := range points {
+				record.total += mark
+			}
+			records = append(records, record)
+		}
+
+		if len(page.PageCursor) == 0 {
+			break
+		}
+		request.PageCursor = page.PageCursor
+	}
+
+	analysis := analyzeItems(quiz.QuizCore, records)
+	analysis.QuizID = quiz.QuizID.String()
+	analysis.Version = quiz.Version
+	analysis.NumExcluded = excluded
+
+	return analysis, nil
+}
+
+// analyzeItems will compute the difficulty, discrimination, and option frequencies of every question as well as the
+// reliability of the quiz from the graded responses.
+func analyzeItems(quiz *model_cassandra.QuizCore, records []*itemRecord) *model_http.ItemAnalysis {
+	analysis := &model_http.ItemAnalysis{NumResponses: len(records), Items: make([]*model_http.ItemStatistics, 0, len(quiz.Questions))}
+
+	// Split the responses into the top and bottom scoring groups.
+	sort.SliceStable(records, func(i, j int) bool { return records[i].total > records[j].total })
+	if len(records) > 0 {
+		analysis.GroupSize = int(math.Max(1, math.Round(groupFraction*float64(len(records)))))
+	}
+	top := records[:analysis.GroupSize]
+	bottom := records[len(records)-analysis.GroupSize:]
+
+	totals := make([]float64, len(records))
+	numCorrect := make([]float64, len(records))
+	for idx, record := range records {
+		totals[idx] = record.total
+		for _, correct := range record.correct {
+			if correct {
+				numCorrect[idx]++
+			}
+		}
+	}
+
+	var sumVarCorrect, sumVarPoints float64
+	for question := range quiz.Questions {
+		correct := make([]float64, len(records))
+		points := make([]float64, len(records))
+		for idx, record := range records {
+			points[idx] = record.points[question]
+			if record.correct[question] {
+				correct[idx] = 1
+			}
+		}
+
+		item := &model_http.ItemStatistics{
+			Question:       question,
+			Description:    quiz.Questions[question].Description,
+			Type:           questionType(quiz.Questions[question]),
+			Difficulty:     mean(correct),
+			Discrimination: correlation(correct, totals),
+			Omitted:        countOmitted(quiz.Questions[question], question, records),
+			Options:        optionFrequencies(quiz.Questions[question], question, records, top, bottom),
+		}
+		analysis.Items = append(analysis.Items, item)
+
+		sumVarCorrect += item.Difficulty * (1 - item.Difficulty)
+		sumVarPoints += variance(points)
+	}
+
+	// Reliability is undefined for a single question or when the totals do not vary.
+	if numQuestions := float64(len(quiz.Questions)); numQuestions > 1 {
+		if varCorrect := variance(numCorrect); varCorrect > 0 {
+			analysis.KR20 = numQuestions / (numQuestions - 1) * (1 - sumVarCorrect/varCorrect)
+		}
+		if varTotals := variance(totals); varTotals > 0 {
+			analysis.CronbachAlpha = numQuestions / (numQuestions - 1) * (1 - sumVarPoints/varTotals)
+		}
+	}
+
+	return analysis
+}
+
+// questionType retrieves the type of question, where questions without a type are multiple choice questions.
+func questionType(question *model_cassandra.Question) string {
+	if len(question.Type) == 0 {
+		return model_cassandra.QuestionMultipleChoice
+	}
+	return question.Type
+}
+
+// hasOptions reports whether the answers to a question are a selection of its options.
+func hasOptions(question *model_cassandra.Question) bool {
+	switch questionType(question) {
+	case model_cassandra.QuestionMultipleChoice, model_cassandra.QuestionTrueFalse:
+		return true
+	default:
+		return false
+	}
+}
+
+// selected retrieves the options selected in the answer to a question.
+func selected(answers *model_cassandra.QuizResponse, question int) []int32 {
+	if question < len(answers.Responses) {
+		return answers.Responses[question]
+	}
+	return nil
+}
+
+// countOmitted counts the responses that did not answer a question.
+func countOmitted(question *model_cassandra.Question, idx int, records []*itemRecord) (omitted int) {
+	for _, record := range records {
+		switch questionType(question) {
+		case model_cassandra.QuestionNumeric, model_cassandra.QuestionShortText, model_cassandra.QuestionRegex:
+			if idx >= len(record.answers.TextResponses) || len(strings.TrimSpace(record.answers.TextResponses[idx])) == 0 {
+				omitted++
+			}
+		default:
+			if len(selected(record.answers, idx)) == 0 {
+				omitted++
+			}
+		}
+	}
+	return
+}
+
+// optionFrequencies counts the responses that selected each option of a multiple choice or true/false question, overall and
+// in the top and bottom scoring groups. Other question types have no options to select.
+func optionFrequencies(question *model_cassandra.Question, idx int, records, top, bottom []*itemRecord) []*model_http.OptionFrequency {
+	if !hasOptions(question) {
+		return nil
+	}
+
+	numOptions := len(question.Options)
+	if questionType(question) == model_cassandra.QuestionTrueFalse && numOptions == 0 {
+		numOptions = 2
+	}
+
+	options := make([]*model_http.OptionFrequency, numOptions)
+	for option := range options {
+		options[option] = &model_http.OptionFrequency{Option: option}
+	}
+	for _, answer := range question.Answers {
+		if int(answer) < numOptions {
+			options[answer].Correct = true
+		}
+	}
+
+	count := func(group []*itemRecord, counter func(*model_http.OptionFrequency)) {
+		for _, record := range group {
+			for _, option := range selected(record.answers, idx) {
+				if option >= 0 && int(option) < numOptions {
+					counter(options[option])
+				}
+			}
+		}
+	}
+	count(records, func(frequency *model_http.OptionFrequency) { frequency.Count++ })
+	count(top, func(frequency *model_http.OptionFrequency) { frequency.Top++ })
+	count(bottom, func(frequency *model_http.OptionFrequency) { frequency.Bottom++ })
+
+	return options
+}
+
+// mean calculates the mean of the values, which is zero if there are no values.
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+	return sum / float64(len(values))
+}
+
+// variance calculates the population variance of the values.
+func variance(values []float64) float64 {
+	average := mean(values)
+
+	var squares float64
+	for _, value := range values {
+		squares += (value - average) * (value - average)
+	}
+	return squares / math.Max(1, float64(len(values)))
+}
+
+// correlation calculates the Pearson correlation coefficient between two sets of values. The correlation is zero if either
+// set of values does not vary.
+func correlation(lhs, rhs []float64) float64 {
+	lhsMean, rhsMean := mean(lhs), mean(rhs)
+
+	var covariance, lhsSquares, rhsSquares float64
+	for idx := range lhs {
+		covariance += (lhs[idx] - lhsMean) * (rhs[idx] - rhsMean)
+		lhsSquares += (lhs[idx] - lhsMean) * (lhs[idx] - lhsMean)
+		rhsSquares += (rhs[idx] - rhsMean) * (rhs[idx] - rhsMean)
+	}
+	if lhsSquares == 0 || rhsSquares == 0 {
+		return 0
+	}
+
+	return covariance / math.Sqrt(lhsSquares*rhsSquares)
+}
@@ -0,0 +1,224 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+)
+
+func TestAnalyzeItems(t *testing.T) {
+	quizId := gocql.TimeUUID()
+	quiz := &model_cassandra.Quiz{QuizID: quizId, Version: 2, QuizCore: &model_cassandra.QuizCore{
+		Questions: []*model_cassandra.Question{{Description: "question", Options: []string{"a", "b"}, Answers: []int32{0}}},
+	}}
+	newResponse := func(version int, option int32) *model_cassandra.Response {
+		return &model_cassandra.Response{QuizID: quizId, Version: version,
+			QuizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{option}}}}
+	}
+
+	testCases := []struct {
+		name             string
+		quiz             *model_cassandra.Quiz
+		pages            []*model_cassandra.StatsResponse
+		pageErr          error
+		gradeErrOption   int32
+		expectErr        require.ErrorAssertionFunc
+		expectedResponse int
+		expectedExcluded int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "drawn quiz",
+			quiz:      &model_cassandra.Quiz{QuizID: quizId, QuizCore: &model_cassandra.QuizCore{Draw: &model_cassandra.QuestionDraw{}}},
+			expectErr: require.Error,
+		}, {
+			name:      "page read failure",
+			quiz:      quiz,
+			pageErr:   &cassandra.Error{Message: "page read failure", Status: http.StatusInternalServerError},
+			expectErr: require.Error,
+		}, {
+			name:      "no responses",
+			quiz:      quiz,
+			pages:     []*model_cassandra.StatsResponse{{}},
+			expectErr: require.NoError,
+		}, {
+			name: "multiple pages with excluded responses",
+			quiz: quiz,
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse(2, 0), newResponse(1, 0)}, PageCursor: []byte("cursor")},
+				{Records: []*model_cassandra.Response{newResponse(2, 1), newResponse(2, 4)}},
+			},
+			gradeErrOption:   4,
+			expectErr:        require.NoError,
+			expectedResponse: 2,
+			expectedExcluded: 2,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockGrader := mocks.NewMockGrading(mockCtrl)
+
+			pageIdx := 0
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+					require.Equal(t, ItemAnalysisPageSize, params.(*model_cassandra.StatsRequest).PageSize, "page size mismatch")
+					if testCase.pageErr != nil {
+						return nil, testCase.pageErr
+					}
+					pageIdx++
+					return testCase.pages[pageIdx-1], nil
+				}).AnyTimes()
+
+			mockGrader.EXPECT().GradeQuestions(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(response *model_cassandra.QuizResponse, _ *model_cassandra.QuizCore) ([]float64, []bool, error) {
+					if option := response.Responses[0][0]; option == testCase.gradeErrOption && option != 0 {
+						return nil, nil, errors.New("grading failure")
+					} else if option == 0 {
+						return []float64{1}, []bool{true}, nil
+					}
+					return []float64{0}, []bool{false}, nil
+				}).AnyTimes()
+
+			analysis, err := AnalyzeItems(testCase.quiz, mockCassandra, mockGrader)
+			testCase.expectErr(t, err, "error expectation failed")
+			if err != nil {
+				return
+			}
+
+			require.Equal(t, quizId.String(), analysis.QuizID, "quiz id mismatch")
+			require.Equal(t, quiz.Version, analysis.Version, "version mismatch")
+			require.Equal(t, testCase.expectedResponse, analysis.NumResponses, "number of responses mismatch")
+			require.Equal(t, testCase.expectedExcluded, analysis.NumExcluded, "number of excluded responses mismatch")
+			require.Equal(t, len(quiz.Questions), len(analysis.Items), "number of items mismatch")
+		})
+	}
+}
+
+func TestAnalyzeItemsStatistics(t *testing.T) {
+	multipleChoice := &model_cassandra.QuizCore{Questions: []*model_cassandra.Question{
+		{Description: "first", Options: []string{"a", "b", "c"}, Answers: []int32{0}},
+		{Description: "second", Options: []string{"a", "b", "c"}, Answers: []int32{1}},
+	}}
+	newRecord := func(responses [][]int32, text []string, points ...float64) *itemRecord {
+		record := &itemRecord{answers: &model_cassandra.QuizResponse{Responses: responses, TextResponses: text}, points: points}
+		for _, mark := range points {
+			record.correct = append(record.correct, mark > 0)
+			record.total += mark
+		}
+		return record
+	}
+
+	testCases := []struct {
+		name     string
+		quiz     *model_cassandra.QuizCore
+		records  []*itemRecord
+		expected *model_http.ItemAnalysis
+	}{
+		// ----- test cases start ----- //
+		{
+			name:    "no responses",
+			quiz:    multipleChoice,
+			records: nil,
+			expected: &model_http.ItemAnalysis{Items: []*model_http.ItemStatistics{
+				{Question: 0, Description: "first", Type: model_cassandra.QuestionMultipleChoice, Options: []*model_http.OptionFrequency{
+					{Option: 0, Correct: true}, {Option: 1}, {Option: 2}}},
+				{Question: 1, Description: "second", Type: model_cassandra.QuestionMultipleChoice, Options: []*model_http.OptionFrequency{
+					{Option: 0}, {Option: 1, Correct: true}, {Option: 2}}},
+			}},
+		}, {
+			name: "multiple choice",
+			quiz: multipleChoice,
+			records: []*itemRecord{
+				newRecord([][]int32{{2}, {}}, nil, 0, 0),
+				newRecord([][]int32{{0}, {1}}, nil, 1, 1),
+				newRecord([][]int32{{0}, {2}}, nil, 1, 0),
+				newRecord([][]int32{{1}, {1}}, nil, 0, 1),
+			},
+			expected: &model_http.ItemAnalysis{NumResponses: 4, GroupSize: 1, Items: []*model_http.ItemStatistics{
+				{Question: 0, Description: "first", Type: model_cassandra.QuestionMultipleChoice, Difficulty: 0.5,
+					Discrimination: 0.7071067811865475, Options: []*model_http.OptionFrequency{
+						{Option: 0, Correct: true, Count: 2, Top: 1}, {Option: 1, Count: 1}, {Option: 2, Count: 1, Bottom: 1}}},
+				{Question: 1, Description: "second", Type: model_cassandra.QuestionMultipleChoice, Difficulty: 0.5,
+					Discrimination: 0.7071067811865475, Omitted: 1, Options: []*model_http.OptionFrequency{
+						{Option: 0}, {Option: 1, Correct: true, Count: 2, Top: 1}, {Option: 2, Count: 1}}},
+			}},
+		}, {
+			name: "consistent responses with weighted points",
+			quiz: multipleChoice,
+			records: []*itemRecord{
+				newRecord([][]int32{{0}, {1}}, nil, 1, 2),
+				newRecord([][]int32{{2}, {0}}, nil, 0, 0),
+				newRecord([][]int32{{0}, {1}}, nil, 1, 2),
+			},
+			expected: &model_http.ItemAnalysis{NumResponses: 3, GroupSize: 1, KR20: 1, CronbachAlpha: 8.0 / 9, Items: []*model_http.ItemStatistics{
+				{Question: 0, Description: "first", Type: model_cassandra.QuestionMultipleChoice, Difficulty: 2.0 / 3,
+					Discrimination: 1, Options: []*model_http.OptionFrequency{
+						{Option: 0, Correct: true, Count: 2, Top: 1}, {Option: 1}, {Option: 2, Count: 1, Bottom: 1}}},
+				{Question: 1, Description: "second", Type: model_cassandra.QuestionMultipleChoice, Difficulty: 2.0 / 3,
+					Discrimination: 1, Options: []*model_http.OptionFrequency{
+						{Option: 0, Count: 1, Bottom: 1}, {Option: 1, Correct: true, Count: 2, Top: 1}, {Option: 2}}},
+			}},
+		}, {
+			name: "true or false and numeric",
+			quiz: &model_cassandra.QuizCore{Questions: []*model_cassandra.Question{
+				{Description: "true or false", Type: model_cassandra.QuestionTrueFalse, Answers: []int32{1}},
+				{Description: "numeric", Type: model_cassandra.QuestionNumeric},
+			}},
+			records: []*itemRecord{
+				newRecord([][]int32{{1}}, []string{"", "3.14"}, 1, 1),
+				newRecord([][]int32{{0}}, []string{"", " "}, 0, 0),
+			},
+			expected: &model_http.ItemAnalysis{NumResponses: 2, GroupSize: 1, KR20: 1, CronbachAlpha: 1, Items: []*model_http.ItemStatistics{
+				{Question: 0, Description: "true or false", Type: model_cassandra.QuestionTrueFalse, Difficulty: 0.5,
+					Discrimination: 1, Options: []*model_http.OptionFrequency{
+						{Option: 0, Count: 1, Bottom: 1}, {Option: 1, Correct: true, Count: 1, Top: 1}}},
+				{Question: 1, Description: "numeric", Type: model_cassandra.QuestionNumeric, Difficulty: 0.5,
+					Discrimination: 1, Omitted: 1},
+			}},
+		}, {
+			name: "single question",
+			quiz: &model_cassandra.QuizCore{Questions: multipleChoice.Questions[:1]},
+			records: []*itemRecord{
+				newRecord([][]int32{{0}}, nil, 1),
+				newRecord([][]int32{{1}}, nil, 0),
+			},
+			expected: &model_http.ItemAnalysis{NumResponses: 2, GroupSize: 1, Items: []*model_http.ItemStatistics{
+				{Question: 0, Description: "first", Type: model_cassandra.QuestionMultipleChoice, Difficulty: 0.5,
+					Discrimination: 1, Options: []*model_http.OptionFrequency{
+						{Option: 0, Correct: true, Count: 1, Top: 1}, {Option: 1, Count: 1, Bottom: 1}, {Option: 2}}},
+			}},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			analysis := analyzeItems(testCase.quiz, testCase.records)
+
+			require.InDelta(t, testCase.expected.KR20, analysis.KR20, 1e-9, "KR-20 mismatch")
+			require.InDelta(t, testCase.expected.CronbachAlpha, analysis.CronbachAlpha, 1e-9, "Cronbach's alpha mismatch")
+			analysis.KR20, analysis.CronbachAlpha = testCase.expected.KR20, testCase.expected.CronbachAlpha
+
+			for idx, item := range analysis.Items {
+				expected := testCase.expected.Items[idx]
+				require.InDelta(t, expected.Difficulty, item.Difficulty, 1e-9, "difficulty mismatch")
+				require.InDelta(t, expected.Discrimination, item.Discrimination, 1e-9, "discrimination mismatch")
+				item.Difficulty, item.Discrimination = expected.Difficulty, expected.Discrimination
+			}
+
+			require.Equal(t, testCase.expected, analysis, "item analysis does not match expected")
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/surahman/mcq-platform/pkg/grading"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+	"github.com/surahman/mcq-platform/pkg/redis"
+)
+
+// PracticeRateLimit is the number of practice checks a user may make within the practice rate window.
+const PracticeRateLimit = 30
+
+// PracticeRateWindow is the period over which the practice checks of a user are counted.
+const PracticeRateWindow = time.Minute
+
+var (
+	// ErrPracticeRateLimited is returned when a user has made too many practice checks within the practice rate window.
+	ErrPracticeRateLimited = errors.New("too many practice checks")
+
+	// ErrPracticeQuestion is returned when a practice check is for a question that is not in the quiz.
+	ErrPracticeQuestion = errors.New("question is not in the quiz")
+
+	// ErrPracticeDrawn is returned when the author of a quiz, or an administrator, practices a quiz that draws its questions
+	// from question banks. The questions are only drawn for the users that take the quiz.
+	ErrPracticeDrawn = errors.New("quizzes drawn from question banks cannot be practiced by their managers")
+)
+
+// practiceRateKey is the cache key for the number of practice checks a user has made in the current practice rate window.
+func practiceRateKey(username string) string {
+	return "practice-rate:" + username
+}
+
+// CheckPracticeRate will count a practice check against a user's limit for the current practice rate window. Users over the
+// limit are returned an error wrapping ErrPracticeRateLimited. Incr method will log errors, which are not propagated to the
+// user, and practice checks are not limited whilst the cache is unavailable.
+func CheckPracticeRate(username string, cache redis.Redis) error {
+	count, err := cache.Incr(practiceRateKey(username), PracticeRateWindow)
+	if err != nil {
+		return nil
+	}
+
+	if count > PracticeRateLimit {
+		return fmt.Errorf("%w, at most %d checks are allowed every %s", ErrPracticeRateLimited, PracticeRateLimit, PracticeRateWindow)
+	}
+	return nil
+}
+
+// CheckPractice will grade the answer to a single question of a quiz without recording it. The quiz must be in the order it
+// is presented to the user. The answer key and the explanation are only returned if the answers to the quiz are revealed,
+// whereas the correctness, points, and feedback for the selected options are always returned. Quizzes that have not had
+// their questions drawn from question banks are returned an error wrapping ErrPracticeDrawn.
+func CheckPractice(quiz *model_cassandra.QuizCore, check *model_http.PracticeCheck, reveal bool,
+	grader grading.Grading) (*model_http.QuestionBreakdown, error) {
+	if isDrawn(quiz) {
+		return nil, ErrPracticeDrawn
+	}
+	if check.Question < 0 || check.Question >= len(quiz.Questions) {
+		return nil, fmt.Errorf("%w, question %d of %d", ErrPracticeQuestion, check.Question, len(quiz.Questions))
+	}
+
+	single := withQuestions(quiz, quiz.Questions[check.Question:check.Question+1])
+	answers := &model_cassandra.QuizResponse{Responses: [][]int32{check.Response}, TextResponses: []string{check.TextResponse}}
+	breakdown, err := BreakdownAttempt(single, answers, false, grader)
+	if err != nil {
+		return nil, err
+	}
+
+	result := breakdown[0]
+	result.Question = check.Question
+	if !reveal {
+		result.Answers = nil
+		result.NumericAnswer = nil
+		result.TextAnswers = nil
+		result.Explanation = ""
+	}
+
+	return result, nil
+}
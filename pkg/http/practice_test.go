@@ -0,0 +1,181 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/grading"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+	"github.com/surahman/mcq-platform/pkg/redis"
+)
+
+func TestCheckPracticeRate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		count     int64
+		cacheErr  error
+		expectErr require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "first check",
+			count:     1,
+			expectErr: require.NoError,
+		}, {
+			name:      "at the limit",
+			count:     PracticeRateLimit,
+			expectErr: require.NoError,
+		}, {
+			name:      "over the limit",
+			count:     PracticeRateLimit + 1,
+			expectErr: require.Error,
+		}, {
+			name:      "cache failure",
+			cacheErr:  &redis.Error{Message: "cache failure", Code: redis.ErrorCacheIncr},
+			expectErr: require.NoError,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			mockRedis.EXPECT().Incr(practiceRateKey("username"), PracticeRateWindow).Return(
+				testCase.count,
+				testCase.cacheErr,
+			).Times(1)
+
+			err := CheckPracticeRate("username", mockRedis)
+			testCase.expectErr(t, err, "error expectation failed")
+			if err != nil {
+				require.ErrorIs(t, err, ErrPracticeRateLimited, "error should be a rate limit")
+			}
+		})
+	}
+}
+
+func TestCheckPractice(t *testing.T) {
+	quiz := feedbackTestQuiz(model_cassandra.RevealNever).QuizCore
+	grader := grading.NewGrading()
+
+	testCases := []struct {
+		name            string
+		check           *model_http.PracticeCheck
+		reveal          bool
+		expectErr       require.ErrorAssertionFunc
+		expectedCorrect bool
+		expectedPoints  float64
+		expectedAnswers []int32
+		expectedText    []string
+		expectFeedback  []string
+	}{
+		// ----- test cases start ----- //
+		{
+			name:           "incorrect option",
+			check:          &model_http.PracticeCheck{Question: 0, Response: []int32{0}},
+			expectErr:      require.NoError,
+			expectFeedback: []string{"4 is even"},
+		}, {
+			name:            "correct option revealed",
+			check:           &model_http.PracticeCheck{Question: 0, Response: []int32{1}},
+			reveal:          true,
+			expectErr:       require.NoError,
+			expectedCorrect: true,
+			expectedPoints:  2,
+			expectedAnswers: []int32{1},
+			expectFeedback:  []string{"Correct"},
+		}, {
+			name:            "text answer",
+			check:           &model_http.PracticeCheck{Question: 1, TextResponse: " paris "},
+			expectErr:       require.NoError,
+			expectedCorrect: true,
+			expectedPoints:  1,
+		}, {
+			name:         "text answer revealed",
+			check:        &model_http.PracticeCheck{Question: 1, TextResponse: "London"},
+			reveal:       true,
+			expectErr:    require.NoError,
+			expectedText: []string{"Paris"},
+		}, {
+			name:      "unanswered",
+			check:     &model_http.PracticeCheck{Question: 0},
+			expectErr: require.NoError,
+		}, {
+			name:      "question out of range",
+			check:     &model_http.PracticeCheck{Question: 2, Response: []int32{0}},
+			expectErr: require.Error,
+		}, {
+			name:      "ungradable answer",
+			check:     &model_http.PracticeCheck{Question: 0, Response: []int32{0, 1}},
+			expectErr: require.Error,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := CheckPractice(quiz, testCase.check, testCase.reveal, grader)
+			testCase.expectErr(t, err, "error expectation failed")
+			if err != nil {
+				return
+			}
+
+			require.Equal(t, testCase.check.Question, result.Question, "question index mismatch")
+			require.Equal(t, testCase.expectedCorrect, result.Correct, "correctness mismatch")
+			require.Equal(t, testCase.expectedPoints, result.Points, "points mismatch")
+			require.Equal(t, testCase.expectedAnswers, result.Answers, "answer key mismatch")
+			require.Equal(t, testCase.expectedText, result.TextAnswers, "text answers mismatch")
+			require.Equal(t, testCase.expectFeedback, result.Feedback, "feedback mismatch")
+			if testCase.reveal {
+				require.Equal(t, quiz.Questions[testCase.check.Question].Explanation, result.Explanation, "explanation should be revealed")
+			} else {
+				require.Empty(t, result.Explanation, "explanation should not be revealed")
+			}
+		})
+	}
+
+	// The quiz is not modified by checking its questions.
+	require.Len(t, quiz.Questions, 2, "quiz questions should be unchanged")
+	require.Equal(t, []int32{1}, quiz.Questions[0].Answers, "answer key should be unchanged")
+}
+
+func TestCheckPractice_Drawn(t *testing.T) {
+	quiz := &model_cassandra.QuizCore{
+		Title:       "Drawn practice",
+		MarkingType: "binary",
+		Draw:        &model_cassandra.QuestionDraw{Banks: []string{gocql.TimeUUID().String()}, Count: 1},
+	}
+
+	_, err := CheckPractice(quiz, &model_http.PracticeCheck{Question: 0, Response: []int32{0}}, false, grading.NewGrading())
+	require.ErrorIs(t, err, ErrPracticeDrawn, "drawn quizzes should not be practiced before they are drawn")
+}
+
+func TestCheckPractice_Shuffled(t *testing.T) {
+	quiz := &model_cassandra.QuizCore{
+		Title:       "Shuffled practice",
+		MarkingType: "binary",
+		Shuffle:     true,
+		Questions: []*model_cassandra.Question{
+			{Description: "Pick a letter", Options: []string{"a", "b", "c", "d", "e"}, Answers: []int32{0},
+				Feedback: []string{"fa", "fb", "fc", "fd", "fe"}},
+		},
+	}
+	feedback := map[string]string{"a": "fa", "b": "fb", "c": "fc", "d": "fd", "e": "fe"}
+	grader := grading.NewGrading()
+
+	for seed := int64(0); seed < 10; seed++ {
+		presented := PermuteQuiz(quiz, seed)
+		for idx, option := range presented.Questions[0].Options {
+			result, err := CheckPractice(presented, &model_http.PracticeCheck{Question: 0, Response: []int32{int32(idx)}}, false, grader)
+			require.NoError(t, err, "failed to check practice answer")
+			require.Equalf(t, []string{feedback[option]}, result.Feedback, "feedback mismatch for option %s with seed %d", option, seed)
+			require.Equalf(t, option == "a", result.Correct, "correctness mismatch for option %s with seed %d", option, seed)
+		}
+	}
+}
@@ -5,10 +5,12 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/surahman/mcq-platform/pkg/auth"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
 )
 
-// AuthMiddleware is the middleware that checks whether a JWT is valid and can access an endpoint.
-func AuthMiddleware(auth auth.Auth, authHeaderKey string) gin.HandlerFunc {
+// AuthMiddleware is the middleware that checks whether a JWT is valid and can access an endpoint. The role in the JWT must
+// grant all the permissions required by the endpoint.
+func AuthMiddleware(auth auth.Auth, authHeaderKey string, permissions ...http_common.Permission) gin.HandlerFunc {
 	handler := func(context *gin.Context) {
 		tokenString := context.GetHeader(authHeaderKey)
 		if tokenString == "" {
@@ -16,7 +18,13 @@ func AuthMiddleware(auth auth.Auth, authHeaderKey string) gin.HandlerFunc {
 			context.Abort()
 			return
 		}
-		if _, _, err := auth.ValidateJWT(tokenString); err != nil {
+		_, _, role, err := auth.ValidateJWT(tokenString)
+		if err != nil {
+			context.JSON(http.StatusForbidden, err.Error())
+			context.Abort()
+			return
+		}
+		if err = http_common.CheckPermissions(role, permissions...); err != nil {
 			context.JSON(http.StatusForbidden, err.Error())
 			context.Abort()
 			return
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 	http_common "github.com/surahman/mcq-platform/pkg/http"
 	"github.com/surahman/mcq-platform/pkg/mocks"
+	model_cassandra "github.com/surahman/mcq-platform/pkg/model/cassandra"
 )
 
 func TestAuthMiddleware(t *testing.T) {
@@ -29,6 +30,7 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 		path                string
 		token               string
 		expectedStatus      int
+		permissions         []http_common.Permission
 		authValidateJWTData *http_common.MockAuthData
 	}{
 		// ----- test cases start ----- //
@@ -65,6 +67,32 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 				OutputErr:    nil,
 				Times:        1,
 			},
+		}, {
+			name:           "permission denied",
+			path:           "/permission-denied",
+			token:          "valid-token",
+			expectedStatus: http.StatusForbidden,
+			permissions:    []http_common.Permission{http_common.PermissionManageUsers},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputParam2: int64(-1),
+				OutputParam3: model_cassandra.RoleInstructor,
+				OutputErr:    nil,
+				Times:        1,
+			},
+		}, {
+			name:           "permission granted",
+			path:           "/permission-granted",
+			token:          "valid-token",
+			expectedStatus: http.StatusOK,
+			permissions:    []http_common.Permission{http_common.PermissionManageUsers},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputParam2: int64(-1),
+				OutputParam3: model_cassandra.RoleAdmin,
+				OutputErr:    nil,
+				Times:        1,
+			},
 		},
 		// ----- test cases end ----- //
 	}
@@ -78,11 +106,12 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 				testCase.authValidateJWTData.OutputParam1,
 				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
 				testCase.authValidateJWTData.OutputErr,
 			).Times(testCase.authValidateJWTData.Times)
 
 			// Endpoint setup for test.
-			router.POST(testCase.path, AuthMiddleware(mockAuth, "Authorization"))
+			router.POST(testCase.path, AuthMiddleware(mockAuth, "Authorization", testCase.permissions...))
 			req, _ := http.NewRequest("POST", testCase.path, nil)
 			req.Header.Set("Authorization", testCase.token)
 			w := httptest.NewRecorder()
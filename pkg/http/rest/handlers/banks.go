@@ -0,0 +1,233 @@
+package http_handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gocql/gocql"
+	"github.com/surahman/mcq-platform/pkg/auth"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/logger"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+	"github.com/surahman/mcq-platform/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// ViewQuestionBank will retrieve a question bank using a variable in the URL.
+//	@Summary		View a question bank.
+//	@Description	This endpoint will retrieve a question bank with a provided Bank ID if it was created by the requester and is not deleted.
+//	@Tags			view question bank
+//	@Id				viewQuestionBank
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			bank_id	path		string				true	"The Bank ID for the question bank being requested."
+//	@Success		200		{object}	model_http.Success	"The message will contain the Bank ID and the payload will contain the question bank"
+//	@Failure		400		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/bank/view/{bank_id} [get]
+func ViewQuestionBank(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username string
+		var bankId gocql.UUID
+		var response any
+
+		if bankId, err = gocql.ParseUUID(context.Param("bank_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid bank id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in view question bank handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		if response, err = db.Execute(cassandra.ReadQuestionBankQuery, bankId); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving question bank", Payload: cassandraError.Message})
+			return
+		}
+		bank := response.(*model_cassandra.QuestionBank)
+
+		// Question banks contain answer keys and are only visible to their author.
+		if bank.IsDeleted {
+			context.AbortWithStatusJSON(http.StatusNotFound, &model_http.Error{Message: "question bank not found"})
+			return
+		}
+		if bank.Author != username {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "question bank is not available"})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: bankId.String(), Payload: bank.QuestionBankCore})
+	}
+}
+
+// CreateQuestionBank will submit a question bank and write back the Bank ID.
+//	@Summary		Create a question bank.
+//	@Description	This endpoint will create a question bank with a randomly generated Bank ID and associate it with the requester. The requester must be an instructor or an administrator.
+//	@Description	Quizzes by the same author can draw their questions at random from the question bank.
+//	@Tags			create question bank
+//	@Id				createQuestionBank
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			bank	body		model_cassandra.QuestionBankCore	true	"The question bank to be created"
+//	@Success		200		{object}	model_http.Success					"The message will contain the Bank ID of the newly generated question bank"
+//	@Failure		400		{object}	model_http.Error					"Error message with any available details in payload"
+//	@Failure		409		{object}	model_http.Error					"Error message with any available details in payload"
+//	@Failure		500		{object}	model_http.Error					"Error message with any available details in payload"
+//	@Router			/bank/create/ [post]
+func CreateQuestionBank(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username string
+		var request model_cassandra.QuestionBankCore
+
+		// Get username from JWT.
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in create question bank handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get question bank core from request and validate.
+		if err = context.ShouldBindJSON(&request); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: err.Error()})
+			return
+		}
+
+		if err = validator.ValidateStruct(&request); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "validation", Payload: err})
+			return
+		}
+
+		// Prepare question bank by adding username and generating bank id, then insert record.
+		bank := model_cassandra.QuestionBank{
+			QuestionBankCore: &request,
+			BankID:           gocql.TimeUUID(),
+			Author:           username,
+		}
+		if _, err = db.Execute(cassandra.CreateQuestionBankQuery, &bank); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error creating question bank", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "created question bank with id", Payload: bank.BankID.String()})
+	}
+}
+
+// UpdateQuestionBank will update a question bank.
+//	@Summary		Update a question bank.
+//	@Description	This endpoint will update a question bank with the provided Bank ID if it was created by the requester and is not deleted.
+//	@Description	Attempts that have already been viewed keep the questions that were drawn for them.
+//	@Tags			update modify question bank
+//	@Id				updateQuestionBank
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			bank_id	path		string								true	"The Bank ID for the question bank being updated."
+//	@Param			bank	body		model_cassandra.QuestionBankCore	true	"The question bank to replace the one already submitted"
+//	@Success		200		{object}	model_http.Success					"The message will contain a confirmation of the update"
+//	@Failure		400		{object}	model_http.Error					"Error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error					"Error message with any available details in payload"
+//	@Failure		500		{object}	model_http.Error					"Error message with any available details in payload"
+//	@Router			/bank/update/{bank_id} [patch]
+func UpdateQuestionBank(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username string
+		var request model_cassandra.QuestionBankCore
+		var bankId gocql.UUID
+
+		if bankId, err = gocql.ParseUUID(context.Param("bank_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid bank id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in update question bank handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get question bank core from request and validate.
+		if err = context.ShouldBindJSON(&request); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: err.Error()})
+			return
+		}
+
+		if err = validator.ValidateStruct(&request); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "validation", Payload: err})
+			return
+		}
+
+		updateRequest := model_cassandra.QuestionBankMutateRequest{
+			Username: username,
+			BankID:   bankId,
+			Bank: &model_cassandra.QuestionBank{
+				QuestionBankCore: &request,
+			},
+		}
+		if _, err = db.Execute(cassandra.UpdateQuestionBankQuery, &updateRequest); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error updating question bank", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "updated question bank with id", Payload: bankId.String()})
+	}
+}
+
+// DeleteQuestionBank will delete a question bank using a variable in the URL.
+//	@Summary		Delete a question bank.
+//	@Description	This endpoint will mark a question bank as deleted if it was created by the requester. The Bank ID is provided as a path parameter.
+//	@Description	Questions are no longer drawn from deleted question banks. Attempts that have already been viewed keep the questions that were drawn for them.
+//	@Tags			delete remove question bank
+//	@Id				deleteQuestionBank
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			bank_id	path		string				true	"The Bank ID for the question bank being deleted."
+//	@Success		200		{object}	model_http.Success	"The message will contain a confirmation of deletion"
+//	@Failure		400		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/bank/delete/{bank_id} [delete]
+func DeleteQuestionBank(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username string
+		var bankId gocql.UUID
+
+		if bankId, err = gocql.ParseUUID(context.Param("bank_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid bank id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in delete question bank handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		request := model_cassandra.QuestionBankMutateRequest{
+			Username: username,
+			BankID:   bankId,
+		}
+		if _, err = db.Execute(cassandra.DeleteQuestionBankQuery, &request); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error deleting question bank", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "deleted question bank with id", Payload: bankId.String()})
+	}
+}
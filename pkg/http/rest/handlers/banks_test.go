@@ -0,0 +1,405 @@
+package http_handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+)
+
+func TestCreateQuestionBank(t *testing.T) {
+	router := http_common.GetTestRouter()
+
+	testCases := []struct {
+		name                string
+		path                string
+		expectedStatus      int
+		bank                *model_cassandra.QuestionBankCore
+		authValidateJWTData *http_common.MockAuthData
+		cassandraCreateData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "empty token",
+			path:                "/bank/create/empty-token",
+			expectedStatus:      http.StatusInternalServerError,
+			bank:                &model_cassandra.QuestionBankCore{Title: "bank", Questions: testQuizData["myPubQuiz"].Questions},
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", OutputErr: errors.New("invalid token"), Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "no title",
+			path:                "/bank/create/no-title",
+			expectedStatus:      http.StatusBadRequest,
+			bank:                &model_cassandra.QuestionBankCore{Questions: testQuizData["myPubQuiz"].Questions},
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "invalid questions",
+			path:                "/bank/create/invalid-questions",
+			expectedStatus:      http.StatusBadRequest,
+			bank:                &model_cassandra.QuestionBankCore{Title: "bank", Questions: testQuizData["invalidOptionsNoPubQuiz"].Questions},
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "db failure",
+			path:                "/bank/create/db-failure",
+			expectedStatus:      http.StatusConflict,
+			bank:                &model_cassandra.QuestionBankCore{Title: "bank", Questions: testQuizData["myPubQuiz"].Questions},
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusConflict},
+				Times:     1,
+			},
+		}, {
+			name:                "success",
+			path:                "/bank/create/success",
+			expectedStatus:      http.StatusOK,
+			bank:                &model_cassandra.QuestionBankCore{Title: "bank", Questions: testQuizData["myPubQuiz"].Questions},
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+				testCase.authValidateJWTData.OutputParam1,
+				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
+				testCase.authValidateJWTData.OutputErr,
+			).Times(testCase.authValidateJWTData.Times)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+					bank := params.(*model_cassandra.QuestionBank)
+					require.Equal(t, testCase.authValidateJWTData.OutputParam1, bank.Author, "author mismatch")
+					require.Equal(t, testCase.bank.Title, bank.Title, "title mismatch")
+					return testCase.cassandraCreateData.OutputParam, testCase.cassandraCreateData.OutputErr
+				}).Times(testCase.cassandraCreateData.Times)
+
+			bankJson, err := json.Marshal(testCase.bank)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, CreateQuestionBank(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBuffer(bankJson))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check for bank id.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.Success{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body.")
+				_, err = gocql.ParseUUID(response.Payload.(string))
+				require.NoError(t, err, "did not receive a bank id in response")
+			}
+		})
+	}
+}
+
+func TestViewQuestionBank(t *testing.T) {
+	router := http_common.GetTestRouter()
+
+	bank := &model_cassandra.QuestionBank{
+		QuestionBankCore: &model_cassandra.QuestionBankCore{Title: "bank", Questions: testQuizData["myPubQuiz"].Questions},
+		BankID:           gocql.TimeUUID(),
+		Author:           "author",
+	}
+	deleted := *bank
+	deleted.IsDeleted = true
+
+	testCases := []struct {
+		name                string
+		path                string
+		bankId              string
+		expectedStatus      int
+		authValidateJWTData *http_common.MockAuthData
+		cassandraReadData   *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid bank id",
+			path:                "/bank/view/invalid-bank-id/",
+			bankId:              "not a valid uuid",
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "empty token",
+			path:                "/bank/view/empty-token/",
+			bankId:              bank.BankID.String(),
+			expectedStatus:      http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", OutputErr: errors.New("invalid token"), Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "db failure",
+			path:                "/bank/view/db-failure/",
+			bankId:              bank.BankID.String(),
+			expectedStatus:      http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
+			},
+		}, {
+			name:                "deleted",
+			path:                "/bank/view/deleted/",
+			bankId:              bank.BankID.String(),
+			expectedStatus:      http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{OutputParam: &deleted, Times: 1},
+		}, {
+			name:                "not author",
+			path:                "/bank/view/not-author/",
+			bankId:              bank.BankID.String(),
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "not author", Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{OutputParam: bank, Times: 1},
+		}, {
+			name:                "success",
+			path:                "/bank/view/success/",
+			bankId:              bank.BankID.String(),
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{OutputParam: bank, Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+				testCase.authValidateJWTData.OutputParam1,
+				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
+				testCase.authValidateJWTData.OutputErr,
+			).Times(testCase.authValidateJWTData.Times)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+				testCase.cassandraReadData.OutputParam,
+				testCase.cassandraReadData.OutputErr,
+			).Times(testCase.cassandraReadData.Times)
+
+			// Endpoint setup for test.
+			router.GET(testCase.path+":bank_id", ViewQuestionBank(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("GET", testCase.path+testCase.bankId, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check the question bank.
+			if testCase.expectedStatus == http.StatusOK {
+				response := struct {
+					Message string                           `json:"message"`
+					Payload model_cassandra.QuestionBankCore `json:"payload"`
+				}{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body.")
+				require.Equal(t, testCase.bankId, response.Message, "bank id mismatch")
+				require.Equal(t, *bank.QuestionBankCore, response.Payload, "question bank mismatch")
+			}
+		})
+	}
+}
+
+func TestUpdateQuestionBank(t *testing.T) {
+	router := http_common.GetTestRouter()
+	valid := &model_cassandra.QuestionBankCore{Title: "bank", Questions: testQuizData["myPubQuiz"].Questions}
+
+	testCases := []struct {
+		name                string
+		path                string
+		bankId              string
+		expectedStatus      int
+		bank                *model_cassandra.QuestionBankCore
+		authValidateJWTData *http_common.MockAuthData
+		cassandraUpdateData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid bank id",
+			path:                "/bank/update/invalid-bank-id/",
+			bankId:              "not a valid uuid",
+			expectedStatus:      http.StatusBadRequest,
+			bank:                valid,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "empty token",
+			path:                "/bank/update/empty-token/",
+			bankId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusInternalServerError,
+			bank:                valid,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", OutputErr: errors.New("invalid token"), Times: 1},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "request validate failure",
+			path:                "/bank/update/request-validate-failure/",
+			bankId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusBadRequest,
+			bank:                &model_cassandra.QuestionBankCore{Title: "bank"},
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "db unauthorized",
+			path:                "/bank/update/db-unauthorized/",
+			bankId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusForbidden,
+			bank:                valid,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraUpdateData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusForbidden},
+				Times:     1,
+			},
+		}, {
+			name:                "success",
+			path:                "/bank/update/success/",
+			bankId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusOK,
+			bank:                valid,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+				testCase.authValidateJWTData.OutputParam1,
+				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
+				testCase.authValidateJWTData.OutputErr,
+			).Times(testCase.authValidateJWTData.Times)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+					request := params.(*model_cassandra.QuestionBankMutateRequest)
+					require.Equal(t, testCase.bankId, request.BankID.String(), "bank id mismatch")
+					require.Equal(t, testCase.authValidateJWTData.OutputParam1, request.Username, "username mismatch")
+					return testCase.cassandraUpdateData.OutputParam, testCase.cassandraUpdateData.OutputErr
+				}).Times(testCase.cassandraUpdateData.Times)
+
+			bankJson, err := json.Marshal(testCase.bank)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			// Endpoint setup for test.
+			router.PATCH(testCase.path+":bank_id", UpdateQuestionBank(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("PATCH", testCase.path+testCase.bankId, bytes.NewBuffer(bankJson))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestDeleteQuestionBank(t *testing.T) {
+	router := http_common.GetTestRouter()
+
+	testCases := []struct {
+		name                string
+		path                string
+		bankId              string
+		expectedStatus      int
+		authValidateJWTData *http_common.MockAuthData
+		cassandraDeleteData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid bank id",
+			path:                "/bank/delete/invalid-bank-id/",
+			bankId:              "not a valid uuid",
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			cassandraDeleteData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "empty token",
+			path:                "/bank/delete/empty-token/",
+			bankId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", OutputErr: errors.New("invalid token"), Times: 1},
+			cassandraDeleteData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "db unauthorized",
+			path:                "/bank/delete/db-unauthorized/",
+			bankId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraDeleteData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusForbidden},
+				Times:     1,
+			},
+		}, {
+			name:                "success",
+			path:                "/bank/delete/success/",
+			bankId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "author", Times: 1},
+			cassandraDeleteData: &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+				testCase.authValidateJWTData.OutputParam1,
+				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
+				testCase.authValidateJWTData.OutputErr,
+			).Times(testCase.authValidateJWTData.Times)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+				testCase.cassandraDeleteData.OutputParam,
+				testCase.cassandraDeleteData.OutputErr,
+			).Times(testCase.cassandraDeleteData.Times)
+
+			// Endpoint setup for test.
+			router.DELETE(testCase.path+":bank_id", DeleteQuestionBank(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("DELETE", testCase.path+testCase.bankId, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package http_handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"github.com/surahman/mcq-platform/pkg/auth"
+	"github.com/surahman/mcq-platform/pkg/catalogue"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
+	"github.com/surahman/mcq-platform/pkg/logger"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+)
+
+// ListCatalogue will retrieve a page of the published quizzes listed in the catalogue.
+//	@Summary		List the published quizzes in the catalogue.
+//	@Description	Gets a page of the published quizzes that are not deleted, most recently created first.
+//	@Description	The quizzes can be filtered by a tag and searched for by terms that must all appear in their titles or descriptions.
+//	@Description	A query string to be appended to the next request to retrieve the next page of data will be returned in the response.
+//	@Tags			view test quiz list catalogue search tag
+//	@Id				listCatalogue
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			search		query		string							false	"The terms to search for in the titles and descriptions of the quizzes."
+//	@Param			tag			query		string							false	"The tag the quizzes must have."
+//	@Param			pageCursor	query		string							false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int								false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	model_http.CatalogueResponse	"A page of the quizzes in the catalogue"
+//	@Failure		400			{object}	model_http.Error				"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error				"Error message with any available details in payload"
+//	@Router			/quiz/catalogue [get]
+func ListCatalogue(logger *logger.Logger, auth auth.Auth, catalogue catalogue.Catalogue) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var request *model_http.CatalogueRequest
+		var restResponse *model_http.CatalogueResponse
+
+		// Prepare catalogue page request.
+		if request, err = http_common.PrepareCatalogueRequest(auth, context.Query("search"), context.Query("tag"),
+			context.Query("pageCursor"), context.Query("pageSize")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "malformed query request", Payload: err.Error()})
+			return
+		}
+
+		// Prepare REST response.
+		if restResponse, err = prepareCatalogueResponse(auth, catalogue.Search(request), request); err != nil {
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "error preparing catalogue page", Payload: err.Error()})
+			return
+		}
+
+		context.JSON(http.StatusOK, restResponse)
+	}
+}
+
+// prepareCatalogueResponse will prepare a http response from a catalogue page. It will generate a link to the next page of
+// data, with the same search terms and tag, as appropriate.
+func prepareCatalogueResponse(auth auth.Auth, page *model_http.CataloguePage, request *model_http.CatalogueRequest) (response *model_http.CatalogueResponse, err error) {
+	response = &model_http.CatalogueResponse{Records: page.Records}
+	response.Metadata.Search = request.Search
+	response.Metadata.Tag = request.Tag
+	response.Metadata.NumRecords = len(page.Records)
+
+	// There is no next page if the cursor is not set.
+	if len(page.PageCursor) == 0 {
+		return
+	}
+
+	var cursor string
+	if cursor, err = auth.EncryptToString(page.PageCursor); err != nil {
+		return nil, err
+	}
+
+	nextPageLink := fmt.Sprintf("?pageCursor=%s", cursor)
+	if page.PageSize > 0 {
+		nextPageLink += fmt.Sprintf("&pageSize=%d", page.PageSize)
+	}
+	if len(request.Search) != 0 {
+		nextPageLink += fmt.Sprintf("&search=%s", url.QueryEscape(request.Search))
+	}
+	if len(request.Tag) != 0 {
+		nextPageLink += fmt.Sprintf("&tag=%s", url.QueryEscape(request.Tag))
+	}
+	response.Links.NextPage = nextPageLink
+
+	return
+}
@@ -0,0 +1,207 @@
+package http_handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+)
+
+func TestListCatalogue(t *testing.T) {
+	router := http_common.GetTestRouter()
+	testCases := []struct {
+		name            string
+		path            string
+		querySegment    string
+		expectedLen     int
+		expectedStatus  int
+		expectLink      require.BoolAssertionFunc
+		authDecryptData *http_common.MockAuthData
+		catalogueData   *model_http.CataloguePage
+		catalogueTimes  int
+		authEncryptData *http_common.MockAuthData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:            "non-numeric page size",
+			path:            "/catalogue/non-numeric-page-size",
+			querySegment:    "?pageSize=three",
+			expectedStatus:  http.StatusBadRequest,
+			authDecryptData: &http_common.MockAuthData{Times: 0},
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:         "cursor decryption failure",
+			path:         "/catalogue/cursor-decryption-failure",
+			querySegment: "?pageCursor=PaGeCuRs0R==&pageSize=3",
+			authDecryptData: &http_common.MockAuthData{
+				OutputErr: errors.New("decrypting cursor failed"),
+				Times:     1,
+			},
+			expectedStatus:  http.StatusBadRequest,
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:            "cursor encryption failure",
+			path:            "/catalogue/cursor-encryption-failure",
+			querySegment:    "?pageSize=3",
+			expectedStatus:  http.StatusInternalServerError,
+			authDecryptData: &http_common.MockAuthData{Times: 0},
+			catalogueData: &model_http.CataloguePage{
+				PageCursor: []byte("cursor to next page"),
+				Records:    []*model_http.CatalogueQuiz{{}, {}, {}},
+				PageSize:   3,
+			},
+			catalogueTimes: 1,
+			authEncryptData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("encrypting cursor failed"),
+				Times:        1,
+			},
+		}, {
+			name:            "success",
+			path:            "/catalogue/success",
+			querySegment:    "?pageCursor=PaGeCuRs0R==&pageSize=3&search=linear%20algebra&tag=math",
+			expectedLen:     3,
+			expectedStatus:  http.StatusOK,
+			expectLink:      require.True,
+			authDecryptData: &http_common.MockAuthData{Times: 1},
+			catalogueData: &model_http.CataloguePage{
+				PageCursor: []byte("cursor to next page"),
+				Records:    []*model_http.CatalogueQuiz{{}, {}, {}},
+				PageSize:   3,
+			},
+			catalogueTimes:  1,
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "tHisIsAnEnCrYPtEdCUrS0r", Times: 1},
+		}, {
+			name:            "success last page",
+			path:            "/catalogue/success-last-page",
+			querySegment:    "?pageSize=3",
+			expectedLen:     2,
+			expectedStatus:  http.StatusOK,
+			expectLink:      require.False,
+			authDecryptData: &http_common.MockAuthData{Times: 0},
+			catalogueData: &model_http.CataloguePage{
+				Records:  []*model_http.CatalogueQuiz{{}, {}},
+				PageSize: 3,
+			},
+			catalogueTimes:  1,
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl)
+
+			gomock.InOrder(
+				// Decrypt cursor page.
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).Return(
+					testCase.authDecryptData.OutputParam1,
+					testCase.authDecryptData.OutputErr,
+				).Times(testCase.authDecryptData.Times),
+				// Search the catalogue.
+				mockCatalogue.EXPECT().Search(gomock.Any()).Return(
+					testCase.catalogueData,
+				).Times(testCase.catalogueTimes),
+				// Encrypt cursor page.
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).Return(
+					testCase.authEncryptData.OutputParam1,
+					testCase.authEncryptData.OutputErr,
+				).Times(testCase.authEncryptData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.GET(testCase.path, ListCatalogue(zapLogger, mockAuth, mockCatalogue))
+			req, _ := http.NewRequest("GET", testCase.path+testCase.querySegment, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify response code.
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check records and link to the next page.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.CatalogueResponse{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body")
+
+				require.Equal(t, testCase.expectedLen, len(response.Records), "records count does not match expected")
+				require.Equal(t, testCase.expectedLen, response.Metadata.NumRecords, "metadata record count does not match expected")
+				testCase.expectLink(t, len(response.Links.NextPage) != 0, "link to next page expectation failed")
+			}
+		})
+	}
+}
+
+func TestPrepareCatalogueResponse(t *testing.T) {
+	testCases := []struct {
+		name         string
+		request      *model_http.CatalogueRequest
+		page         *model_http.CataloguePage
+		mockAuthData *http_common.MockAuthData
+		expectErr    require.ErrorAssertionFunc
+		expectLink   string
+	}{
+		// ----- test cases start ----- //
+		{
+			name:         "no cursor",
+			request:      &model_http.CatalogueRequest{PageSize: 3},
+			page:         &model_http.CataloguePage{PageSize: 3},
+			mockAuthData: &http_common.MockAuthData{Times: 0, OutputParam1: ""},
+			expectErr:    require.NoError,
+			expectLink:   "",
+		}, {
+			name:         "cursor and page",
+			request:      &model_http.CatalogueRequest{PageSize: 3},
+			page:         &model_http.CataloguePage{PageCursor: []byte("page-cursor"), PageSize: 3},
+			mockAuthData: &http_common.MockAuthData{Times: 1, OutputParam1: "CuRs0R"},
+			expectErr:    require.NoError,
+			expectLink:   "?pageCursor=CuRs0R&pageSize=3",
+		}, {
+			name:         "cursor, page, search, and tag",
+			request:      &model_http.CatalogueRequest{Search: "linear algebra", Tag: "math & logic", PageSize: 3},
+			page:         &model_http.CataloguePage{PageCursor: []byte("page-cursor"), PageSize: 3},
+			mockAuthData: &http_common.MockAuthData{Times: 1, OutputParam1: "CuRs0R"},
+			expectErr:    require.NoError,
+			expectLink:   "?pageCursor=CuRs0R&pageSize=3&search=linear+algebra&tag=math+%26+logic",
+		}, {
+			name:         "encryption failure",
+			request:      &model_http.CatalogueRequest{PageSize: 3},
+			page:         &model_http.CataloguePage{PageCursor: []byte("page-cursor"), PageSize: 3},
+			mockAuthData: &http_common.MockAuthData{Times: 1, OutputParam1: "", OutputErr: errors.New("encryption failure")},
+			expectErr:    require.Error,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+
+			mockAuth.EXPECT().EncryptToString(gomock.Any()).Return(
+				testCase.mockAuthData.OutputParam1,
+				testCase.mockAuthData.OutputErr,
+			).Times(testCase.mockAuthData.Times)
+
+			resp, err := prepareCatalogueResponse(mockAuth, testCase.page, testCase.request)
+			testCase.expectErr(t, err, "error expectation condition failed")
+
+			if err == nil {
+				require.Equal(t, testCase.expectLink, resp.Links.NextPage, "next page link mismatch")
+				require.Equal(t, testCase.request.Search, resp.Metadata.Search, "search terms mismatch")
+				require.Equal(t, testCase.request.Tag, resp.Metadata.Tag, "tag mismatch")
+			}
+		})
+	}
+}
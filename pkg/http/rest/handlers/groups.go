@@ -0,0 +1,976 @@
+package http_handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gocql/gocql"
+	"github.com/surahman/mcq-platform/pkg/auth"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/catalogue"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
+	"github.com/surahman/mcq-platform/pkg/logger"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+	"github.com/surahman/mcq-platform/pkg/redis"
+	"github.com/surahman/mcq-platform/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// abortGroupError will abort a request that failed a group membership check or a database call. Requesters who are denied
+// access are sent a forbidden status and database errors are sent with their own status.
+func abortGroupError(context *gin.Context, message string, err error) {
+	if cassandraError, ok := err.(*cassandra.Error); ok {
+		context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: message, Payload: cassandraError.Message})
+		return
+	}
+
+	status := http.StatusInternalServerError
+	if http_common.IsGroupAccessError(err) {
+		status = http.StatusForbidden
+	}
+	context.AbortWithStatusJSON(status, &model_http.Error{Message: message, Payload: err.Error()})
+}
+
+// CreateGroup will create a class or cohort and write back the group.
+//	@Summary		Create a group.
+//	@Description	This endpoint will create a class or cohort with a randomly generated Group ID and join code. The requester must be an instructor or an administrator.
+//	@Description	The requester is the creator and the first owner of the group.
+//	@Tags			create group class cohort
+//	@Id				createGroup
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			group	body		model_cassandra.GroupCore	true	"The name and description of the group to be created"
+//	@Success		200		{object}	model_http.Success			"The message will contain the Group ID and the payload will contain the group"
+//	@Failure		400		{object}	model_http.Error			"Error message with any available details in payload"
+//	@Failure		409		{object}	model_http.Error			"Error message with any available details in payload"
+//	@Failure		500		{object}	model_http.Error			"Error message with any available details in payload"
+//	@Router			/group/create [post]
+func CreateGroup(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username string
+		var request model_cassandra.GroupCore
+
+		// Get username from JWT.
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in create group handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get group core from request and validate.
+		if err = context.ShouldBindJSON(&request); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: err.Error()})
+			return
+		}
+
+		if err = validator.ValidateStruct(&request); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "validation", Payload: err})
+			return
+		}
+
+		// Prepare group by adding username and generating the group id and join code, then insert record.
+		group := model_cassandra.Group{
+			GroupCore: &request,
+			GroupID:   gocql.TimeUUID(),
+			Creator:   username,
+		}
+		if group.JoinCode, err = http_common.NewJoinCode(); err != nil {
+			logger.Error("failed to generate join code in create group handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to generate join code"})
+			return
+		}
+
+		if _, err = db.Execute(cassandra.CreateGroupQuery, &group); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error creating group", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: group.GroupID.String(), Payload: &group})
+	}
+}
+
+// ViewGroup will retrieve a group using a variable in the URL.
+//	@Summary		View a group.
+//	@Description	This endpoint will retrieve a class or cohort with a provided Group ID if the requester is a member.
+//	@Description	The join code is only included if the requester is an owner of the group.
+//	@Tags			view group class cohort
+//	@Id				viewGroup
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			group_id	path		string				true	"The Group ID for the group being requested."
+//	@Success		200			{object}	model_http.Success	"The message will contain the Group ID and the payload will contain the group"
+//	@Failure		400			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/group/view/{group_id} [get]
+func ViewGroup(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role string
+		var groupId gocql.UUID
+		var member *model_cassandra.GroupMember
+		var response any
+
+		if groupId, err = gocql.ParseUUID(context.Param("group_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid group id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in view group handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		if member, err = http_common.GroupMembership(groupId, username, role, db); err != nil {
+			abortGroupError(context, "group is not available", err)
+			return
+		}
+
+		if response, err = db.Execute(cassandra.ReadGroupQuery, groupId); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving group", Payload: cassandraError.Message})
+			return
+		}
+		group := response.(*model_cassandra.Group)
+
+		// Only owners may share the join code.
+		if !member.IsOwner {
+			group.JoinCode = ""
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: groupId.String(), Payload: group})
+	}
+}
+
+// UpdateGroup will update the name and description of a group.
+//	@Summary		Update a group.
+//	@Description	This endpoint will update the name and description of a class or cohort with the provided Group ID if the requester is an owner.
+//	@Tags			update modify group class cohort
+//	@Id				updateGroup
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			group_id	path		string						true	"The Group ID for the group being updated."
+//	@Param			group		body		model_cassandra.GroupCore	true	"The name and description to replace the existing ones"
+//	@Success		200			{object}	model_http.Success			"The message will contain a confirmation of the update"
+//	@Failure		400			{object}	model_http.Error			"Error message with any available details in payload"
+//	@Failure		403			{object}	model_http.Error			"Error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error			"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error			"Error message with any available details in payload"
+//	@Router			/group/update/{group_id} [patch]
+func UpdateGroup(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role string
+		var groupId gocql.UUID
+		var request model_cassandra.GroupCore
+
+		if groupId, err = gocql.ParseUUID(context.Param("group_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid group id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in update group handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get group core from request and validate.
+		if err = context.ShouldBindJSON(&request); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: err.Error()})
+			return
+		}
+
+		if err = validator.ValidateStruct(&request); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "validation", Payload: err})
+			return
+		}
+
+		if err = http_common.CheckGroupOwner(groupId, username, role, db); err != nil {
+			abortGroupError(context, "unable to update group", err)
+			return
+		}
+
+		if _, err = db.Execute(cassandra.UpdateGroupQuery, &model_cassandra.GroupMutateRequest{GroupID: groupId, Group: &request}); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error updating group", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "updated group with id", Payload: groupId.String()})
+	}
+}
+
+// DeleteGroup will delete a group using a variable in the URL.
+//	@Summary		Delete a group.
+//	@Description	This endpoint will mark a class or cohort with the provided Group ID as deleted and remove its members if the requester is an owner.
+//	@Description	Quizzes must be unassigned from the group before it can be deleted.
+//	@Tags			delete remove group class cohort
+//	@Id				deleteGroup
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			group_id	path		string				true	"The Group ID for the group being deleted."
+//	@Success		200			{object}	model_http.Success	"The message will contain a confirmation of deletion"
+//	@Failure		400			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		409			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/group/delete/{group_id} [delete]
+func DeleteGroup(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role string
+		var groupId gocql.UUID
+		var response any
+
+		if groupId, err = gocql.ParseUUID(context.Param("group_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid group id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in delete group handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		if err = http_common.CheckGroupOwner(groupId, username, role, db); err != nil {
+			abortGroupError(context, "unable to delete group", err)
+			return
+		}
+
+		// Quizzes assigned to the group would otherwise remain restricted to members that have been removed.
+		if response, err = db.Execute(cassandra.ReadGroupQuizzesQuery, groupId); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving group quizzes", Payload: cassandraError.Message})
+			return
+		}
+		if len(response.([]*model_cassandra.GroupQuiz)) > 0 {
+			context.AbortWithStatusJSON(http.StatusConflict, &model_http.Error{Message: "quizzes must be unassigned from the group before it is deleted"})
+			return
+		}
+
+		if _, err = db.Execute(cassandra.DeleteGroupQuery, groupId); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error deleting group", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "deleted group with id", Payload: groupId.String()})
+	}
+}
+
+// ListGroupMembers will retrieve the members of a group using a variable in the URL.
+//	@Summary		List the members of a group.
+//	@Description	This endpoint will retrieve the members and owners of a class or cohort with the provided Group ID if the requester is an owner.
+//	@Tags			list members group class cohort
+//	@Id				listGroupMembers
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			group_id	path		string				true	"The Group ID for the group whose members are being requested."
+//	@Success		200			{object}	model_http.Success	"The message will contain the Group ID and the payload will contain the members"
+//	@Failure		400			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/group/members/{group_id} [get]
+func ListGroupMembers(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role string
+		var groupId gocql.UUID
+		var response any
+
+		if groupId, err = gocql.ParseUUID(context.Param("group_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid group id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in list group members handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		if err = http_common.CheckGroupOwner(groupId, username, role, db); err != nil {
+			abortGroupError(context, "unable to list group members", err)
+			return
+		}
+
+		if response, err = db.Execute(cassandra.ReadGroupMembersQuery, groupId); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving group members", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: groupId.String(), Payload: response})
+	}
+}
+
+// RemoveGroupMember will remove a member from a group using variables in the URL.
+//	@Summary		Remove a member from a group.
+//	@Description	This endpoint will remove a member from a class or cohort with the provided Group ID if the requester is an owner.
+//	@Description	Members may remove themselves to leave the group. The creator of the group cannot be removed.
+//	@Tags			remove delete leave members group class cohort
+//	@Id				removeGroupMember
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			group_id	path		string				true	"The Group ID for the group the member is being removed from."
+//	@Param			username	path		string				true	"The username of the member being removed."
+//	@Success		200			{object}	model_http.Success	"The message will contain a confirmation of removal"
+//	@Failure		400			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/group/members/{group_id}/{username} [delete]
+func RemoveGroupMember(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role string
+		var groupId gocql.UUID
+		var response any
+
+		if groupId, err = gocql.ParseUUID(context.Param("group_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid group id supplied, must be a valid UUID"})
+			return
+		}
+		member := context.Param("username")
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in remove group member handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Members may leave a group, but only owners may remove other members.
+		if member != username {
+			if err = http_common.CheckGroupOwner(groupId, username, role, db); err != nil {
+				abortGroupError(context, "unable to remove group member", err)
+				return
+			}
+		}
+
+		if response, err = db.Execute(cassandra.ReadGroupQuery, groupId); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving group", Payload: cassandraError.Message})
+			return
+		}
+		if response.(*model_cassandra.Group).Creator == member {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "the creator of a group cannot be removed"})
+			return
+		}
+
+		if _, err = db.Execute(cassandra.DeleteGroupMemberQuery, &model_cassandra.GroupMemberRequest{GroupID: groupId, Username: member}); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error removing group member", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "removed group member", Payload: member})
+	}
+}
+
+// InviteToGroup will invite a user to join a group using a variable in the URL.
+//	@Summary		Invite a user to a group.
+//	@Description	This endpoint will invite a user to join a class or cohort with the provided Group ID if the requester is an owner.
+//	@Description	Users may be invited as owners. Inviting a user again will replace their pending invitation.
+//	@Tags			invite members group class cohort
+//	@Id				inviteToGroup
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			group_id	path		string								true	"The Group ID for the group the user is being invited to."
+//	@Param			invitation	body		model_cassandra.GroupInviteRequest	true	"The user being invited"
+//	@Success		200			{object}	model_http.Success					"The message will contain a confirmation of the invitation"
+//	@Failure		400			{object}	model_http.Error					"Error message with any available details in payload"
+//	@Failure		403			{object}	model_http.Error					"Error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error					"Error message with any available details in payload"
+//	@Failure		409			{object}	model_http.Error					"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error					"Error message with any available details in payload"
+//	@Router			/group/invite/{group_id} [post]
+func InviteToGroup(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role string
+		var groupId gocql.UUID
+		var request model_cassandra.GroupInviteRequest
+		var response any
+
+		if groupId, err = gocql.ParseUUID(context.Param("group_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid group id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in invite to group handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get invitation from request and validate.
+		if err = context.ShouldBindJSON(&request); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: err.Error()})
+			return
+		}
+
+		if err = validator.ValidateStruct(&request); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "validation", Payload: err})
+			return
+		}
+
+		if err = http_common.CheckGroupOwner(groupId, username, role, db); err != nil {
+			abortGroupError(context, "unable to invite user", err)
+			return
+		}
+
+		if response, err = db.Execute(cassandra.ReadGroupQuery, groupId); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving group", Payload: cassandraError.Message})
+			return
+		}
+		group := response.(*model_cassandra.Group)
+
+		// Check to see if the invitee is an active user who is not already a member.
+		if response, err = db.Execute(cassandra.ReadUserQuery, request.Username); err != nil || response.(*model_cassandra.User).IsDeleted {
+			context.AbortWithStatusJSON(http.StatusNotFound, &model_http.Error{Message: "unable to invite user", Payload: "user not found"})
+			return
+		}
+
+		if _, err = db.Execute(cassandra.ReadGroupMemberQuery, &model_cassandra.GroupMemberRequest{GroupID: groupId, Username: request.Username}); err == nil {
+			context.AbortWithStatusJSON(http.StatusConflict, &model_http.Error{Message: "unable to invite user", Payload: "user is already a member"})
+			return
+		}
+
+		invitation := model_cassandra.GroupInvitation{
+			Username:  request.Username,
+			GroupID:   groupId,
+			Name:      group.Name,
+			InvitedBy: username,
+			IsOwner:   request.IsOwner,
+			InvitedAt: time.Now(),
+		}
+		if _, err = db.Execute(cassandra.CreateGroupInvitationQuery, &invitation); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error inviting user", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "invited user to group", Payload: request.Username})
+	}
+}
+
+// ListGroupInvitations will retrieve the pending group invitations for the requester.
+//	@Summary		List my group invitations.
+//	@Description	This endpoint will retrieve the pending invitations for the requester to join classes and cohorts.
+//	@Tags			list invitations group class cohort
+//	@Id				listGroupInvitations
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	model_http.Success	"The payload will contain the pending invitations"
+//	@Failure		500	{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/group/invitations [get]
+func ListGroupInvitations(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username string
+		var response any
+
+		// Get username from JWT.
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in list group invitations handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		if response, err = db.Execute(cassandra.ReadGroupInvitationsQuery, username); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving group invitations", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "group invitations", Payload: response})
+	}
+}
+
+// AcceptGroupInvitation will accept the requester's invitation to join a group using a variable in the URL.
+//	@Summary		Accept a group invitation.
+//	@Description	This endpoint will add the requester to a class or cohort with the provided Group ID if they have been invited to join it.
+//	@Tags			accept invitations join group class cohort
+//	@Id				acceptGroupInvitation
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			group_id	path		string				true	"The Group ID for the group the invitation is to."
+//	@Success		200			{object}	model_http.Success	"The message will contain a confirmation of joining"
+//	@Failure		400			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		409			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/group/accept/{group_id} [post]
+func AcceptGroupInvitation(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username string
+		var groupId gocql.UUID
+		var response any
+
+		if groupId, err = gocql.ParseUUID(context.Param("group_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid group id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in accept group invitation handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		request := model_cassandra.GroupMemberRequest{GroupID: groupId, Username: username}
+		if response, err = db.Execute(cassandra.ReadGroupInvitationQuery, &request); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving group invitation", Payload: cassandraError.Message})
+			return
+		}
+		invitation := response.(*model_cassandra.GroupInvitation)
+
+		member := model_cassandra.GroupMember{GroupID: groupId, Username: username, IsOwner: invitation.IsOwner, JoinedAt: time.Now()}
+		if _, err = db.Execute(cassandra.CreateGroupMemberQuery, &member); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error joining group", Payload: cassandraError.Message})
+			return
+		}
+
+		// HTTP OK status should be set here because joining succeeded.
+		// Failing to remove the invitation should be logged but not propagated to the end user.
+		context.JSON(http.StatusOK, &model_http.Success{Message: "joined group with id", Payload: groupId.String()})
+
+		if _, err = db.Execute(cassandra.DeleteGroupInvitationQuery, &request); err != nil {
+			logger.Error("error removing group invitation after joining", zap.Error(err))
+		}
+	}
+}
+
+// DeclineGroupInvitation will decline the requester's invitation to join a group using a variable in the URL.
+//	@Summary		Decline a group invitation.
+//	@Description	This endpoint will remove the requester's invitation to join a class or cohort with the provided Group ID.
+//	@Tags			decline remove invitations group class cohort
+//	@Id				declineGroupInvitation
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			group_id	path		string				true	"The Group ID for the group the invitation is to."
+//	@Success		200			{object}	model_http.Success	"The message will contain a confirmation of declining"
+//	@Failure		400			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/group/decline/{group_id} [delete]
+func DeclineGroupInvitation(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username string
+		var groupId gocql.UUID
+
+		if groupId, err = gocql.ParseUUID(context.Param("group_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid group id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in decline group invitation handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		if _, err = db.Execute(cassandra.DeleteGroupInvitationQuery, &model_cassandra.GroupMemberRequest{GroupID: groupId, Username: username}); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error declining group invitation", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "declined invitation to group with id", Payload: groupId.String()})
+	}
+}
+
+// JoinGroup will add the requester to a group using the join code in the URL.
+//	@Summary		Join a group.
+//	@Description	This endpoint will add the requester as a member of the class or cohort with the provided join code. Join codes are not case-sensitive.
+//	@Tags			join members group class cohort
+//	@Id				joinGroup
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			join_code	path		string				true	"The join code for the group being joined."
+//	@Success		200			{object}	model_http.Success	"The message will contain a confirmation of joining and the payload will contain the Group ID"
+//	@Failure		404			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		409			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/group/join/{join_code} [post]
+func JoinGroup(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username string
+		var response any
+
+		// Get username from JWT.
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in join group handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		if response, err = db.Execute(cassandra.ReadGroupJoinCodeQuery, strings.ToUpper(context.Param("join_code"))); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving group", Payload: cassandraError.Message})
+			return
+		}
+		groupId := response.(gocql.UUID)
+
+		member := model_cassandra.GroupMember{GroupID: groupId, Username: username, JoinedAt: time.Now()}
+		if _, err = db.Execute(cassandra.CreateGroupMemberQuery, &member); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error joining group", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "joined group with id", Payload: groupId.String()})
+	}
+}
+
+// ResetGroupJoinCode will replace the join code of a group using a variable in the URL.
+//	@Summary		Reset the join code of a group.
+//	@Description	This endpoint will replace the join code of a class or cohort with the provided Group ID if the requester is an owner. The previous join code can no longer be used.
+//	@Tags			reset join code group class cohort
+//	@Id				resetGroupJoinCode
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			group_id	path		string				true	"The Group ID for the group whose join code is being replaced."
+//	@Success		200			{object}	model_http.Success	"The message will contain the Group ID and the payload will contain the new join code"
+//	@Failure		400			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		409			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/group/join-code/{group_id} [patch]
+func ResetGroupJoinCode(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role string
+		var groupId gocql.UUID
+		var response any
+
+		if groupId, err = gocql.ParseUUID(context.Param("group_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid group id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in reset group join code handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		if err = http_common.CheckGroupOwner(groupId, username, role, db); err != nil {
+			abortGroupError(context, "unable to reset join code", err)
+			return
+		}
+
+		if response, err = db.Execute(cassandra.ReadGroupQuery, groupId); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving group", Payload: cassandraError.Message})
+			return
+		}
+
+		request := model_cassandra.GroupJoinCodeRequest{GroupID: groupId, PreviousCode: response.(*model_cassandra.Group).JoinCode}
+		if request.JoinCode, err = http_common.NewJoinCode(); err != nil {
+			logger.Error("failed to generate join code in reset group join code handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to generate join code"})
+			return
+		}
+
+		if _, err = db.Execute(cassandra.UpdateGroupJoinCodeQuery, &request); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error resetting join code", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: groupId.String(), Payload: request.JoinCode})
+	}
+}
+
+// ListMyGroups will retrieve the groups the requester is a member of.
+//	@Summary		List my groups.
+//	@Description	This endpoint will retrieve the classes and cohorts the requester is a member or an owner of.
+//	@Tags			list my groups class cohort
+//	@Id				listMyGroups
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	model_http.Success	"The payload will contain the groups"
+//	@Failure		500	{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/group/mine [get]
+func ListMyGroups(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username string
+		var response any
+
+		// Get username from JWT.
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in list my groups handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		if response, err = db.Execute(cassandra.ReadUserGroupsQuery, username); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving groups", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "groups", Payload: response})
+	}
+}
+
+// AssignQuiz will restrict a quiz to the members of a group using variables in the URL.
+//	@Summary		Assign a quiz to a group.
+//	@Description	This endpoint will assign a quiz with the provided Test ID to a class or cohort with the provided Group ID. The requester must be an owner of the group and manage the quiz.
+//	@Description	Quizzes assigned to groups are removed from the catalogue and may only be viewed and taken by members of at least one of the groups they are assigned to.
+//	@Tags			assign test quiz group class cohort
+//	@Id				assignQuiz
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			group_id	path		string				true	"The Group ID for the group the quiz is being assigned to."
+//	@Param			quiz_id		path		string				true	"The Test ID for the quiz being assigned."
+//	@Success		200			{object}	model_http.Success	"The message will contain a confirmation of the assignment"
+//	@Failure		400			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/group/assign/{group_id}/{quiz_id} [patch]
+func AssignQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis, catalogue catalogue.Catalogue) gin.HandlerFunc {
+	return assignQuiz(logger, auth, db, cache, catalogue, cassandra.AssignQuizQuery, "assign")
+}
+
+// UnassignQuiz will remove the restriction of a quiz to the members of a group using variables in the URL.
+//	@Summary		Unassign a quiz from a group.
+//	@Description	This endpoint will unassign a quiz with the provided Test ID from a class or cohort with the provided Group ID. The requester must be an owner of the group and manage the quiz.
+//	@Description	Published quizzes that are no longer assigned to any group are listed in the catalogue and may be viewed and taken by all users.
+//	@Tags			unassign test quiz group class cohort
+//	@Id				unassignQuiz
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			group_id	path		string				true	"The Group ID for the group the quiz is being unassigned from."
+//	@Param			quiz_id		path		string				true	"The Test ID for the quiz being unassigned."
+//	@Success		200			{object}	model_http.Success	"The message will contain a confirmation of the unassignment"
+//	@Failure		400			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/group/assign/{group_id}/{quiz_id} [delete]
+func UnassignQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis, catalogue catalogue.Catalogue) gin.HandlerFunc {
+	return assignQuiz(logger, auth, db, cache, catalogue, cassandra.UnassignQuizQuery, "unassign")
+}
+
+// assignQuiz will build the handler that assigns a quiz to, or unassigns it from, a group with the supplied database query.
+func assignQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis, catalogue catalogue.Catalogue,
+	query func(cassandra.Cassandra, any) (any, error), action string) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role string
+		var groupId, quizId gocql.UUID
+		var quiz *model_cassandra.Quiz
+		var response any
+
+		if groupId, err = gocql.ParseUUID(context.Param("group_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid group id supplied, must be a valid UUID"})
+			return
+		}
+
+		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quiz id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in "+action+" quiz handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		if err = http_common.CheckGroupOwner(groupId, username, role, db); err != nil {
+			abortGroupError(context, "unable to "+action+" quiz", err)
+			return
+		}
+
+		if quiz, err = http_common.GetQuiz(quizId, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+
+		if !http_common.CanManage(quiz.Author, username, role) {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "unable to " + action + " quiz", Payload: "requester does not manage the quiz"})
+			return
+		}
+
+		// Evict from cache, if present.
+		// This step must be executed before the assignment to ensure the end user is able to reattempt the command in the event of failure.
+		// It must not be the case that a quiz with stale group restrictions remains in the cache till LRU eviction or TTL expiration.
+		if err = cache.Del(quizId.String()); err != nil && err.(*redis.Error).Code != redis.ErrorCacheMiss {
+			logger.Error("failed to evict data from cache", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "please retry the command at a later time"})
+			return
+		}
+
+		request := model_cassandra.QuizAssignRequest{
+			Author:     quiz.Author,
+			AssignedBy: username,
+			GroupID:    groupId,
+			QuizID:     quizId,
+			AssignedAt: time.Now(),
+		}
+		if quiz.QuizCore != nil {
+			request.Title = quiz.Title
+		}
+		if _, err = db.Execute(query, &request); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error " + action + "ing quiz", Payload: cassandraError.Message})
+			return
+		}
+
+		// HTTP OK status should be set here because the assignment succeeded.
+		// Any failures below this point are catalogue and cache related and should be logged but not propagated to the end user.
+		context.JSON(http.StatusOK, &model_http.Success{Message: action + "ed quiz with id", Payload: quizId.String()})
+
+		// Update quiz in catalogue and cache.
+		// [1] Retrieve the quiz from Cassandra.
+		// [2] List in or remove from catalogue.
+		// [3] Place into Redis.
+		if response, err = db.Execute(cassandra.ReadQuizQuery, quizId); err != nil {
+			logger.Error("error retrieving quiz from database to be placed in catalogue and cache post "+action+"ment", zap.Error(err))
+			return
+		}
+		quiz = response.(*model_cassandra.Quiz)
+
+		catalogue.Add(quiz)
+
+		if err = cache.Set(quizId.String(), quiz); err != nil {
+			logger.Error("error placing quiz in cache after "+action+"ment", zap.Error(err))
+			return
+		}
+	}
+}
+
+// ListGroupQuizzes will retrieve the quizzes assigned to a group using a variable in the URL.
+//	@Summary		List the quizzes assigned to a group.
+//	@Description	This endpoint will retrieve the quizzes assigned to a class or cohort with the provided Group ID if the requester is a member.
+//	@Tags			list test quiz group class cohort
+//	@Id				listGroupQuizzes
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			group_id	path		string				true	"The Group ID for the group whose quizzes are being requested."
+//	@Success		200			{object}	model_http.Success	"The message will contain the Group ID and the payload will contain the quizzes"
+//	@Failure		400			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/group/quizzes/{group_id} [get]
+func ListGroupQuizzes(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role string
+		var groupId gocql.UUID
+		var response any
+
+		if groupId, err = gocql.ParseUUID(context.Param("group_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid group id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in list group quizzes handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		if _, err = http_common.GroupMembership(groupId, username, role, db); err != nil {
+			abortGroupError(context, "unable to list group quizzes", err)
+			return
+		}
+
+		if response, err = db.Execute(cassandra.ReadGroupQuizzesQuery, groupId); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving group quizzes", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: groupId.String(), Payload: response})
+	}
+}
+
+// GetGroupScores will retrieve the score rollup for a group using a variable in the URL.
+//	@Summary		Get the scores of a group.
+//	@Description	This endpoint will compute the scores of the members of a class or cohort with the provided Group ID on the quizzes assigned to it if the requester is an owner.
+//	@Description	Every assigned quiz is summarized with the number of responses and outstanding members and the mean, minimum, and maximum scores. Every member is totalled across the assigned quizzes.
+//	@Description	Owners of the group are not included in the rollup.
+//	@Tags			score stats statistics group class cohort
+//	@Id				getGroupScores
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			group_id	path		string				true	"The Group ID for the group whose scores are being requested."
+//	@Success		200			{object}	model_http.Success	"The message will contain the Group ID and the payload will contain the rollup"
+//	@Failure		400			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/group/scores/{group_id} [get]
+func GetGroupScores(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role string
+		var groupId gocql.UUID
+		var rollup *model_http.GroupRollup
+
+		if groupId, err = gocql.ParseUUID(context.Param("group_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid group id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in get group scores handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		if err = http_common.CheckGroupOwner(groupId, username, role, db); err != nil {
+			abortGroupError(context, "unable to retrieve group scores", err)
+			return
+		}
+
+		if rollup, err = http_common.RollupGroup(groupId, db); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving group scores", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: groupId.String(), Payload: rollup})
+	}
+}
@@ -0,0 +1,1114 @@
+package http_handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+	"github.com/surahman/mcq-platform/pkg/redis"
+)
+
+// expectGroupCalls will configure the database calls made by a group handler in the order they are expected.
+func expectGroupCalls(mockCassandra *mocks.MockCassandra, cassandraData []*http_common.MockCassandraData) []*gomock.Call {
+	calls := make([]*gomock.Call, 0, len(cassandraData))
+	for _, data := range cassandraData {
+		calls = append(calls, mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+			data.OutputParam,
+			data.OutputErr,
+		).Times(data.Times))
+	}
+	return calls
+}
+
+func TestCreateGroup(t *testing.T) {
+	router := http_common.GetTestRouter()
+
+	testCases := []struct {
+		name                string
+		path                string
+		expectedStatus      int
+		group               *model_cassandra.GroupCore
+		authValidateJWTData *http_common.MockAuthData
+		cassandraCreateData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "empty token",
+			path:                "/group/create/empty-token",
+			expectedStatus:      http.StatusInternalServerError,
+			group:               &model_cassandra.GroupCore{Name: "class"},
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", OutputErr: errors.New("invalid token"), Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "no name",
+			path:                "/group/create/no-name",
+			expectedStatus:      http.StatusBadRequest,
+			group:               &model_cassandra.GroupCore{Description: "class"},
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "db failure",
+			path:                "/group/create/db-failure",
+			expectedStatus:      http.StatusConflict,
+			group:               &model_cassandra.GroupCore{Name: "class"},
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusConflict},
+				Times:     1,
+			},
+		}, {
+			name:                "success",
+			path:                "/group/create/success",
+			expectedStatus:      http.StatusOK,
+			group:               &model_cassandra.GroupCore{Name: "class", Description: "first year"},
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+				testCase.authValidateJWTData.OutputParam1,
+				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
+				testCase.authValidateJWTData.OutputErr,
+			).Times(testCase.authValidateJWTData.Times)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+					group := params.(*model_cassandra.Group)
+					require.Equal(t, testCase.authValidateJWTData.OutputParam1, group.Creator, "creator mismatch")
+					require.Equal(t, testCase.group.Name, group.Name, "name mismatch")
+					require.Len(t, group.JoinCode, http_common.JoinCodeLength, "join code not generated")
+					return testCase.cassandraCreateData.OutputParam, testCase.cassandraCreateData.OutputErr
+				}).Times(testCase.cassandraCreateData.Times)
+
+			groupJson, err := json.Marshal(testCase.group)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path, CreateGroup(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("POST", testCase.path, bytes.NewBuffer(groupJson))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check for group id.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.Success{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body.")
+				_, err = gocql.ParseUUID(response.Message)
+				require.NoError(t, err, "did not receive a group id in response")
+			}
+		})
+	}
+}
+
+func TestViewGroup(t *testing.T) {
+	router := http_common.GetTestRouter()
+	group := func() *model_cassandra.Group {
+		return &model_cassandra.Group{GroupCore: &model_cassandra.GroupCore{Name: "class"}, Creator: "owner", JoinCode: "ABCD2345"}
+	}
+
+	testCases := []struct {
+		name                string
+		path                string
+		groupId             string
+		expectedStatus      int
+		expectJoinCode      bool
+		authValidateJWTData *http_common.MockAuthData
+		cassandraData       []*http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid group id",
+			path:                "/group/view/invalid-group-id/",
+			groupId:             "not a valid uuid",
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:                "empty token",
+			path:                "/group/view/empty-token/",
+			groupId:             gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", OutputErr: errors.New("invalid token"), Times: 1},
+		}, {
+			name:                "not a member",
+			path:                "/group/view/not-a-member/",
+			groupId:             gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputErr: &cassandra.Error{Message: "group member not found", Status: http.StatusNotFound}, Times: 1},
+			},
+		}, {
+			name:                "member",
+			path:                "/group/view/member/",
+			groupId:             gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "username"}, Times: 1},
+				{OutputParam: group(), Times: 1},
+			},
+		}, {
+			name:                "owner",
+			path:                "/group/view/owner/",
+			groupId:             gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusOK,
+			expectJoinCode:      true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "owner", IsOwner: true}, Times: 1},
+				{OutputParam: group(), Times: 1},
+			},
+		}, {
+			name:           "admin, deleted group",
+			path:           "/group/view/admin-deleted-group/",
+			groupId:        gocql.TimeUUID().String(),
+			expectedStatus: http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "admin",
+				OutputParam3: model_cassandra.RoleAdmin,
+				Times:        1,
+			},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputErr: &cassandra.Error{Message: "group not found", Status: http.StatusNotFound}, Times: 1},
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			gomock.InOrder(append([]*gomock.Call{
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+			}, expectGroupCalls(mockCassandra, testCase.cassandraData)...)...)
+
+			// Endpoint setup for test.
+			router.GET(testCase.path+":group_id", ViewGroup(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("GET", testCase.path+testCase.groupId, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check join code is only shared with owners.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.Success{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body.")
+				joinCode, found := response.Payload.(map[string]any)["join_code"]
+				require.Equal(t, testCase.expectJoinCode, found, "join code presence mismatch")
+				if found {
+					require.Equal(t, "ABCD2345", joinCode, "join code mismatch")
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteGroup(t *testing.T) {
+	router := http_common.GetTestRouter()
+
+	testCases := []struct {
+		name                string
+		path                string
+		expectedStatus      int
+		authValidateJWTData *http_common.MockAuthData
+		cassandraData       []*http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "not an owner",
+			path:                "/group/delete/not-an-owner/",
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "username"}, Times: 1},
+			},
+		}, {
+			name:                "assigned quizzes",
+			path:                "/group/delete/assigned-quizzes/",
+			expectedStatus:      http.StatusConflict,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "owner", IsOwner: true}, Times: 1},
+				{OutputParam: []*model_cassandra.GroupQuiz{{QuizID: gocql.TimeUUID()}}, Times: 1},
+			},
+		}, {
+			name:                "db failure",
+			path:                "/group/delete/db-failure/",
+			expectedStatus:      http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "owner", IsOwner: true}, Times: 1},
+				{OutputParam: []*model_cassandra.GroupQuiz{}, Times: 1},
+				{OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError}, Times: 1},
+			},
+		}, {
+			name:                "success",
+			path:                "/group/delete/success/",
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "owner", IsOwner: true}, Times: 1},
+				{OutputParam: []*model_cassandra.GroupQuiz{}, Times: 1},
+				{Times: 1},
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			gomock.InOrder(append([]*gomock.Call{
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+			}, expectGroupCalls(mockCassandra, testCase.cassandraData)...)...)
+
+			// Endpoint setup for test.
+			router.DELETE(testCase.path+":group_id", DeleteGroup(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("DELETE", testCase.path+gocql.TimeUUID().String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestRemoveGroupMember(t *testing.T) {
+	router := http_common.GetTestRouter()
+	group := &model_cassandra.Group{GroupCore: &model_cassandra.GroupCore{Name: "class"}, Creator: "creator"}
+
+	testCases := []struct {
+		name                string
+		path                string
+		member              string
+		expectedStatus      int
+		authValidateJWTData *http_common.MockAuthData
+		cassandraData       []*http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "leave",
+			path:                "/group/members/leave/",
+			member:              "username",
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: group, Times: 1},
+				{Times: 1},
+			},
+		}, {
+			name:                "leave, not a member",
+			path:                "/group/members/leave-not-a-member/",
+			member:              "username",
+			expectedStatus:      http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: group, Times: 1},
+				{OutputErr: &cassandra.Error{Message: "group member not found", Status: http.StatusNotFound}, Times: 1},
+			},
+		}, {
+			name:                "remove other, not an owner",
+			path:                "/group/members/remove-other-not-an-owner/",
+			member:              "other",
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "username"}, Times: 1},
+			},
+		}, {
+			name:                "remove other, owner",
+			path:                "/group/members/remove-other-owner/",
+			member:              "other",
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "owner", IsOwner: true}, Times: 1},
+				{OutputParam: group, Times: 1},
+				{Times: 1},
+			},
+		}, {
+			name:                "remove creator",
+			path:                "/group/members/remove-creator/",
+			member:              "creator",
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "owner", IsOwner: true}, Times: 1},
+				{OutputParam: group, Times: 1},
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			gomock.InOrder(append([]*gomock.Call{
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+			}, expectGroupCalls(mockCassandra, testCase.cassandraData)...)...)
+
+			// Endpoint setup for test.
+			router.DELETE(testCase.path+":group_id/:username", RemoveGroupMember(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("DELETE", testCase.path+gocql.TimeUUID().String()+"/"+testCase.member, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestInviteToGroup(t *testing.T) {
+	router := http_common.GetTestRouter()
+	ownerData := &http_common.MockCassandraData{OutputParam: &model_cassandra.GroupMember{Username: "owner", IsOwner: true}, Times: 1}
+	groupData := &http_common.MockCassandraData{
+		OutputParam: &model_cassandra.Group{GroupCore: &model_cassandra.GroupCore{Name: "class"}, Creator: "owner"},
+		Times:       1,
+	}
+	userData := &http_common.MockCassandraData{OutputParam: &model_cassandra.User{UserAccount: &model_cassandra.UserAccount{}}, Times: 1}
+	notMemberData := &http_common.MockCassandraData{
+		OutputErr: &cassandra.Error{Message: "group member not found", Status: http.StatusNotFound},
+		Times:     1,
+	}
+
+	testCases := []struct {
+		name                string
+		path                string
+		invitation          *model_cassandra.GroupInviteRequest
+		expectedStatus      int
+		authValidateJWTData *http_common.MockAuthData
+		cassandraData       []*http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "no username",
+			path:                "/group/invite/no-username/",
+			invitation:          &model_cassandra.GroupInviteRequest{},
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+		}, {
+			name:                "not an owner",
+			path:                "/group/invite/not-an-owner/",
+			invitation:          &model_cassandra.GroupInviteRequest{Username: "student"},
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "username"}, Times: 1},
+			},
+		}, {
+			name:                "user not found",
+			path:                "/group/invite/user-not-found/",
+			invitation:          &model_cassandra.GroupInviteRequest{Username: "student"},
+			expectedStatus:      http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				ownerData,
+				groupData,
+				{OutputErr: &cassandra.Error{Message: "user not found", Status: http.StatusNotFound}, Times: 1},
+			},
+		}, {
+			name:                "already a member",
+			path:                "/group/invite/already-a-member/",
+			invitation:          &model_cassandra.GroupInviteRequest{Username: "student"},
+			expectedStatus:      http.StatusConflict,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				ownerData,
+				groupData,
+				userData,
+				{OutputParam: &model_cassandra.GroupMember{Username: "student"}, Times: 1},
+			},
+		}, {
+			name:                "success",
+			path:                "/group/invite/success/",
+			invitation:          &model_cassandra.GroupInviteRequest{Username: "student", IsOwner: true},
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				ownerData,
+				groupData,
+				userData,
+				notMemberData,
+				{Times: 1},
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			gomock.InOrder(append([]*gomock.Call{
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+			}, expectGroupCalls(mockCassandra, testCase.cassandraData)...)...)
+
+			invitationJson, err := json.Marshal(testCase.invitation)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path+":group_id", InviteToGroup(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("POST", testCase.path+gocql.TimeUUID().String(), bytes.NewBuffer(invitationJson))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestAcceptGroupInvitation(t *testing.T) {
+	router := http_common.GetTestRouter()
+	groupId := gocql.TimeUUID()
+
+	testCases := []struct {
+		name                string
+		path                string
+		expectedStatus      int
+		authValidateJWTData *http_common.MockAuthData
+		invitationData      *http_common.MockCassandraData
+		createData          *http_common.MockCassandraData
+		deleteData          *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "not invited",
+			path:                "/group/accept/not-invited/",
+			expectedStatus:      http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			invitationData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "group invitation not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			createData: &http_common.MockCassandraData{Times: 0},
+			deleteData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "group deleted",
+			path:                "/group/accept/group-deleted/",
+			expectedStatus:      http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			invitationData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.GroupInvitation{GroupID: groupId, Username: "username"},
+				Times:       1,
+			},
+			createData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "group not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			deleteData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "invitation removal failure",
+			path:                "/group/accept/invitation-removal-failure/",
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			invitationData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.GroupInvitation{GroupID: groupId, Username: "username", IsOwner: true},
+				Times:       1,
+			},
+			createData: &http_common.MockCassandraData{Times: 1},
+			deleteData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+		}, {
+			name:                "success",
+			path:                "/group/accept/success/",
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			invitationData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.GroupInvitation{GroupID: groupId, Username: "username", IsOwner: true},
+				Times:       1,
+			},
+			createData: &http_common.MockCassandraData{Times: 1},
+			deleteData: &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.invitationData.OutputParam,
+					testCase.invitationData.OutputErr,
+				).Times(testCase.invitationData.Times),
+
+				// Members join with the ownership they were invited with.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+						member := params.(*model_cassandra.GroupMember)
+						invitation := testCase.invitationData.OutputParam.(*model_cassandra.GroupInvitation)
+						require.Equal(t, groupId, member.GroupID, "group id mismatch")
+						require.Equal(t, "username", member.Username, "username mismatch")
+						require.Equal(t, invitation.IsOwner, member.IsOwner, "ownership mismatch")
+						return testCase.createData.OutputParam, testCase.createData.OutputErr
+					}).Times(testCase.createData.Times),
+
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.deleteData.OutputParam,
+					testCase.deleteData.OutputErr,
+				).Times(testCase.deleteData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path+":group_id", AcceptGroupInvitation(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("POST", testCase.path+groupId.String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestJoinGroup(t *testing.T) {
+	router := http_common.GetTestRouter()
+	groupId := gocql.TimeUUID()
+
+	testCases := []struct {
+		name                string
+		path                string
+		expectedStatus      int
+		authValidateJWTData *http_common.MockAuthData
+		joinCodeData        *http_common.MockCassandraData
+		createData          *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "empty token",
+			path:                "/group/join/empty-token/",
+			expectedStatus:      http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", OutputErr: errors.New("invalid token"), Times: 1},
+			joinCodeData:        &http_common.MockCassandraData{Times: 0},
+			createData:          &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "invalid join code",
+			path:                "/group/join/invalid-join-code/",
+			expectedStatus:      http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			joinCodeData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "invalid join code", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			createData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "already a member",
+			path:                "/group/join/already-a-member/",
+			expectedStatus:      http.StatusConflict,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			joinCodeData:        &http_common.MockCassandraData{OutputParam: groupId, Times: 1},
+			createData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "already a member", Status: http.StatusConflict},
+				Times:     1,
+			},
+		}, {
+			name:                "success",
+			path:                "/group/join/success/",
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "username", Times: 1},
+			joinCodeData:        &http_common.MockCassandraData{OutputParam: groupId, Times: 1},
+			createData:          &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Join codes are not case-sensitive.
+				mockCassandra.EXPECT().Execute(gomock.Any(), "ABCD2345").Return(
+					testCase.joinCodeData.OutputParam,
+					testCase.joinCodeData.OutputErr,
+				).Times(testCase.joinCodeData.Times),
+
+				// Members who join with a code are not owners.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+						member := params.(*model_cassandra.GroupMember)
+						require.Equal(t, groupId, member.GroupID, "group id mismatch")
+						require.False(t, member.IsOwner, "member should not be an owner")
+						return testCase.createData.OutputParam, testCase.createData.OutputErr
+					}).Times(testCase.createData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path+":join_code", JoinGroup(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("POST", testCase.path+"abcd2345", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestAssignQuiz(t *testing.T) {
+	router := http_common.GetTestRouter()
+	ownerData := &http_common.MockCassandraData{OutputParam: &model_cassandra.GroupMember{Username: "user-2", IsOwner: true}, Times: 1}
+	assigned := cassandra.GetTestQuizzes()["myPubQuiz"]
+	assigned.Groups = []gocql.UUID{gocql.TimeUUID()}
+
+	testCases := []struct {
+		name                string
+		path                string
+		expectedStatus      int
+		authValidateJWTData *http_common.MockAuthData
+		ownerData           *http_common.MockCassandraData
+		redisGetData        *http_common.MockRedisData
+		redisDelData        *http_common.MockRedisData
+		assignData          *http_common.MockCassandraData
+		quizReadData        *http_common.MockCassandraData
+		catalogueAddTimes   int
+		redisSetData        *http_common.MockRedisData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "not an owner",
+			path:                "/group/assign/not-an-owner/",
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-2", Times: 1},
+			ownerData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "group member not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			redisGetData: &http_common.MockRedisData{Times: 0},
+			redisDelData: &http_common.MockRedisData{Times: 0},
+			assignData:   &http_common.MockCassandraData{Times: 0},
+			quizReadData: &http_common.MockCassandraData{Times: 0},
+			redisSetData: &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "not quiz author",
+			path:                "/group/assign/not-quiz-author/",
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			ownerData:           &http_common.MockCassandraData{OutputParam: &model_cassandra.GroupMember{Username: "owner", IsOwner: true}, Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *testQuizData["myPubQuiz"], Times: 1},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+			assignData:          &http_common.MockCassandraData{Times: 0},
+			quizReadData:        &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "cache eviction failure",
+			path:                "/group/assign/cache-eviction-failure/",
+			expectedStatus:      http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-2", Times: 1},
+			ownerData:           ownerData,
+			redisGetData:        &http_common.MockRedisData{Param2: *testQuizData["myPubQuiz"], Times: 1},
+			redisDelData: &http_common.MockRedisData{
+				Err:   &redis.Error{Message: "cache delete error", Code: redis.ErrorCacheDel},
+				Times: 1,
+			},
+			assignData:   &http_common.MockCassandraData{Times: 0},
+			quizReadData: &http_common.MockCassandraData{Times: 0},
+			redisSetData: &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "db failure",
+			path:                "/group/assign/db-failure/",
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-2", Times: 1},
+			ownerData:           ownerData,
+			redisGetData:        &http_common.MockRedisData{Param2: *testQuizData["myPubQuiz"], Times: 1},
+			redisDelData:        &http_common.MockRedisData{Times: 1},
+			assignData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "not the author", Status: http.StatusForbidden},
+				Times:     1,
+			},
+			quizReadData: &http_common.MockCassandraData{Times: 0},
+			redisSetData: &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "success",
+			path:                "/group/assign/success/",
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-2", Times: 1},
+			ownerData:           ownerData,
+			redisGetData:        &http_common.MockRedisData{Param2: *testQuizData["myPubQuiz"], Times: 1},
+			redisDelData: &http_common.MockRedisData{
+				Err:   &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss},
+				Times: 1,
+			},
+			assignData:        &http_common.MockCassandraData{Times: 1},
+			quizReadData:      &http_common.MockCassandraData{OutputParam: assigned, Times: 1},
+			catalogueAddTimes: 1,
+			redisSetData:      &http_common.MockRedisData{Times: 1},
+		}, {
+			name: "admin, success",
+			path: "/group/assign/admin-success/",
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "admin",
+				OutputParam3: model_cassandra.RoleAdmin,
+				Times:        1,
+			},
+			expectedStatus:    http.StatusOK,
+			ownerData:         &http_common.MockCassandraData{OutputParam: &model_cassandra.Group{}, Times: 1},
+			redisGetData:      &http_common.MockRedisData{Param2: *testQuizData["myPubQuiz"], Times: 1},
+			redisDelData:      &http_common.MockRedisData{Times: 1},
+			assignData:        &http_common.MockCassandraData{Times: 1},
+			quizReadData:      &http_common.MockCassandraData{OutputParam: assigned, Times: 1},
+			catalogueAddTimes: 1,
+			redisSetData:      &http_common.MockRedisData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Check group ownership.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.ownerData.OutputParam,
+					testCase.ownerData.OutputErr,
+				).Times(testCase.ownerData.Times),
+
+				// Get quiz from Redis.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+					testCase.redisGetData.Err,
+				).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Times(testCase.redisGetData.Times),
+
+				// Evict quiz from Redis.
+				mockRedis.EXPECT().Del(gomock.Any()).Return(
+					testCase.redisDelData.Err,
+				).Times(testCase.redisDelData.Times),
+
+				// Assign quiz on behalf of its author.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+						request := params.(*model_cassandra.QuizAssignRequest)
+						require.Equal(t, "user-2", request.Author, "author mismatch")
+						require.Equal(t, testCase.authValidateJWTData.OutputParam1, request.AssignedBy, "assigned by mismatch")
+						require.Equal(t, testQuizData["myPubQuiz"].Title, request.Title, "title mismatch")
+						return testCase.assignData.OutputParam, testCase.assignData.OutputErr
+					}).Times(testCase.assignData.Times),
+
+				// Read the assigned quiz.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.quizReadData.OutputParam,
+					testCase.quizReadData.OutputErr,
+				).Times(testCase.quizReadData.Times),
+
+				// Relist the quiz in the catalogue.
+				mockCatalogue.EXPECT().Add(gomock.Any()).Times(testCase.catalogueAddTimes),
+
+				// Place quiz into Redis.
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any()).Return(
+					testCase.redisSetData.Err,
+				).Times(testCase.redisSetData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.PATCH(testCase.path+":group_id/:quiz_id", AssignQuiz(zapLogger, mockAuth, mockCassandra, mockRedis, mockCatalogue))
+			req, _ := http.NewRequest("PATCH", testCase.path+gocql.TimeUUID().String()+"/"+gocql.TimeUUID().String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestGetGroupScores(t *testing.T) {
+	router := http_common.GetTestRouter()
+	groupId := gocql.TimeUUID()
+	quizId := gocql.TimeUUID()
+
+	testCases := []struct {
+		name                string
+		path                string
+		expectedStatus      int
+		authValidateJWTData *http_common.MockAuthData
+		cassandraData       []*http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "member",
+			path:                "/group/scores/member/",
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "student", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "student"}, Times: 1},
+			},
+		}, {
+			name:                "db failure",
+			path:                "/group/scores/db-failure/",
+			expectedStatus:      http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "owner", IsOwner: true}, Times: 1},
+				{OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError}, Times: 1},
+			},
+		}, {
+			name:                "success",
+			path:                "/group/scores/success/",
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "owner", Times: 1},
+			cassandraData: []*http_common.MockCassandraData{
+				{OutputParam: &model_cassandra.GroupMember{Username: "owner", IsOwner: true}, Times: 1},
+				{OutputParam: []*model_cassandra.GroupMember{{Username: "owner", IsOwner: true}, {Username: "student"}}, Times: 1},
+				{OutputParam: []*model_cassandra.GroupQuiz{{GroupID: groupId, QuizID: quizId, Title: "quiz"}}, Times: 1},
+				{
+					OutputParam: &model_cassandra.StatsResponse{Records: []*model_cassandra.Response{
+						{Username: "student", Score: 3, MaxScore: 4},
+					}},
+					Times: 1,
+				},
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			gomock.InOrder(append([]*gomock.Call{
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+			}, expectGroupCalls(mockCassandra, testCase.cassandraData)...)...)
+
+			// Endpoint setup for test.
+			router.GET(testCase.path+":group_id", GetGroupScores(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("GET", testCase.path+groupId.String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check the rollup.
+			if testCase.expectedStatus == http.StatusOK {
+				response := struct {
+					Message string                  `json:"message"`
+					Payload *model_http.GroupRollup `json:"payload"`
+				}{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body.")
+				require.Equal(t, 1, response.Payload.NumMembers, "owners should not be counted")
+				require.Equal(t, 75.0, response.Payload.Quizzes[0].MeanPercentage, "mean percentage mismatch")
+			}
+		})
+	}
+}
+
+func TestGroupRestrictedQuiz(t *testing.T) {
+	router := http_common.GetTestRouter()
+	groupId := gocql.TimeUUID()
+	restricted := cassandra.GetTestQuizzes()["myPubQuiz"]
+	restricted.Groups = []gocql.UUID{groupId}
+
+	testCases := []struct {
+		name           string
+		path           string
+		method         string
+		body           any
+		username       string
+		expectedStatus int
+		userGroupsData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:           "view, not a member",
+			path:           "/quiz/view/not-a-member/",
+			method:         "GET",
+			username:       "student",
+			expectedStatus: http.StatusForbidden,
+			userGroupsData: &http_common.MockCassandraData{
+				OutputParam: []*model_cassandra.UserGroup{{GroupID: gocql.TimeUUID()}},
+				Times:       1,
+			},
+		}, {
+			name:           "view, member",
+			path:           "/quiz/view/member/",
+			method:         "GET",
+			username:       "student",
+			expectedStatus: http.StatusOK,
+			userGroupsData: &http_common.MockCassandraData{
+				OutputParam: []*model_cassandra.UserGroup{{GroupID: groupId}},
+				Times:       1,
+			},
+		}, {
+			name:           "view, author",
+			path:           "/quiz/view/author/",
+			method:         "GET",
+			username:       "user-2",
+			expectedStatus: http.StatusOK,
+			userGroupsData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:           "view, db failure",
+			path:           "/quiz/view/db-failure/",
+			method:         "GET",
+			username:       "student",
+			expectedStatus: http.StatusInternalServerError,
+			userGroupsData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+		}, {
+			name:           "take, not a member",
+			path:           "/quiz/take/not-a-member/",
+			method:         "POST",
+			body:           &model_cassandra.QuizResponse{Responses: [][]int32{{0}, {1}}},
+			username:       "student",
+			expectedStatus: http.StatusForbidden,
+			userGroupsData: &http_common.MockCassandraData{
+				OutputParam: []*model_cassandra.UserGroup{},
+				Times:       1,
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrading := mocks.NewMockGrading(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.username, int64(0), model_cassandra.RoleStudent, nil,
+				).Times(1),
+
+				// Get quiz from Redis.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil).SetArg(1, *restricted).Times(1),
+
+				// Get the requester's groups from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), testCase.username).Return(
+					testCase.userGroupsData.OutputParam,
+					testCase.userGroupsData.OutputErr,
+				).Times(testCase.userGroupsData.Times),
+			)
+
+			body, err := json.Marshal(testCase.body)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			// Endpoint setup for test.
+			if testCase.method == "GET" {
+				router.GET(testCase.path+":quiz_id", ViewQuiz(zapLogger, mockAuth, mockCassandra, mockRedis))
+			} else {
+				router.POST(testCase.path+":quiz_id", TakeQuiz(zapLogger, mockAuth, mockCassandra, mockRedis, mockGrading))
+			}
+			req, _ := http.NewRequest(testCase.method, testCase.path+restricted.QuizID.String(), bytes.NewBuffer(body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
@@ -1,14 +1,20 @@
 package http_handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gocql/gocql"
 	"github.com/surahman/mcq-platform/pkg/auth"
 	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/catalogue"
 	"github.com/surahman/mcq-platform/pkg/grading"
 	http_common "github.com/surahman/mcq-platform/pkg/http"
+	"github.com/surahman/mcq-platform/pkg/interchange"
 	"github.com/surahman/mcq-platform/pkg/logger"
 	"github.com/surahman/mcq-platform/pkg/model/cassandra"
 	"github.com/surahman/mcq-platform/pkg/model/http"
@@ -20,6 +26,8 @@ import (
 // ViewQuiz will retrieve a test using a variable in the URL.
 //	@Summary		View a quiz.
 //	@Description	This endpoint will retrieve a quiz with a provided quiz ID if it is published.
+//	@Description	Shuffled quizzes are presented in the order fixed for the requester's next attempt.
+//	@Description	Quizzes drawn from question banks present the questions drawn for the requester's next attempt.
 //	@Tags			view test quiz
 //	@Id				viewQuiz
 //	@Produce		json
@@ -28,13 +36,14 @@ import (
 //	@Success		200		{object}	model_http.Success	"The message will contain the quiz ID and the payload will contain the quiz"
 //	@Failure		403		{object}	model_http.Error	"Error message with any available details in payload"
 //	@Failure		404		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		409		{object}	model_http.Error	"Error message with any available details in payload"
 //	@Failure		500		{object}	model_http.Error	"Error message with any available details in payload"
 //	@Router			/quiz/view/{quiz_id} [get]
 func ViewQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var err error
 		var quiz *model_cassandra.Quiz
-		var username string
+		var username, role string
 		var quizId gocql.UUID
 
 		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
@@ -43,7 +52,7 @@ func ViewQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cac
 		}
 
 		// Get username from JWT.
-		if username, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
 			logger.Error("failed to validate JWT in create quiz handler", zap.Error(err))
 			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
 			return
@@ -59,19 +68,32 @@ func ViewQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cac
 		}
 
 		// Check to see if quiz can be set to requester.
-		// [1] Requested quiz is NOT published OR IS deleted
-		// [2] Requester is not the author
+		// [1] Requested quiz is NOT published OR IS deleted. Closed quizzes remain available for review.
+		// [2] Requester does not manage the quiz
 		// FAIL
-		if (!quiz.IsPublished || quiz.IsDeleted) && username != quiz.Author {
+		if errors.Is(http_common.CheckAvailability(quiz, time.Now()), http_common.ErrQuizUnavailable) && !http_common.CanManage(quiz.Author, username, role) {
 			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "quiz is not available"})
 			return
 		}
 
-		// If the requester is not the author remove the answer key.
-		if username != quiz.Author {
-			for idx := range quiz.Questions {
-				quiz.Questions[idx].Answers = nil
+		// Check to see if the quiz is restricted to groups the requester is not a member of.
+		if err = http_common.CheckGroupAccess(quiz, username, role, db); err != nil {
+			abortGroupError(context, "quiz is not available", err)
+			return
+		}
+
+		// If the requester does not manage the quiz present the quiz as drawn and ordered for their next attempt and remove the answer key.
+		if !http_common.CanManage(quiz.Author, username, role) {
+			if quiz.QuizCore, err = http_common.PresentQuiz(quiz, username, db); err != nil {
+				if errors.Is(err, http_common.ErrInsufficientQuestions) {
+					context.AbortWithStatusJSON(http.StatusConflict, &model_http.Error{Message: "unable to draw quiz questions", Payload: err.Error()})
+					return
+				}
+				cassandraError := err.(*cassandra.Error)
+				context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error presenting quiz", Payload: cassandraError.Message})
+				return
 			}
+			http_common.RemoveAnswerKeys(quiz.QuizCore)
 		}
 
 		context.JSON(http.StatusOK, &model_http.Success{Message: quiz.QuizID.String(), Payload: &quiz.QuizCore})
@@ -80,7 +102,7 @@ func ViewQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cac
 
 // CreateQuiz will submit a quiz and write back the GetScore ID.
 //	@Summary		Create a quiz.
-//	@Description	This endpoint will create a quiz with randomly generated Test ID and associate it with the requester.
+//	@Description	This endpoint will create a quiz with randomly generated Test ID and associate it with the requester. The requester must be an instructor or an administrator.
 //	@Description	The username will be extracted from the JWT and associated with the Test ID.
 //	@Tags			create test quiz
 //	@Id				createQuiz
@@ -100,7 +122,7 @@ func CreateQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) g
 		var request model_cassandra.QuizCore
 
 		// Get username from JWT.
-		if username, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
 			logger.Error("failed to validate JWT in create quiz handler", zap.Error(err))
 			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
 			return
@@ -135,9 +157,173 @@ func CreateQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) g
 	}
 }
 
+// ImportQuiz will create a quiz from a file in a quiz interchange format.
+//	@Summary		Import a quiz.
+//	@Description	This endpoint will create an unpublished quiz from a file in the GIFT, Aiken, Moodle XML, or QTI 2.1 content package format and associate it with the requester. The requester must be an instructor or an administrator.
+//	@Description	The file is supplied as the request body. The title query parameter is required for formats without titles, such as Aiken.
+//	@Description	The marking type is not part of any of the formats and must be supplied as a query parameter.
+//	@Description	Questions that cannot be read or fail validation are reported with their index in the file.
+//	@Tags			create import test quiz
+//	@Id				importQuiz
+//	@Accept			plain
+//	@Accept			xml
+//	@Accept			application/zip
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			format		path		string				true	"The format of the file: gift, aiken, moodle-xml, or qti."
+//	@Param			title		query		string				false	"The title of the quiz, which replaces any title in the file."
+//	@Param			markingType	query		string				true	"The marking type of the quiz."
+//	@Param			file		body		string				true	"The quiz file"
+//	@Success		200			{object}	model_http.Success	"The message will contain the Quiz ID of the newly generated quiz"
+//	@Failure		400			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		409			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		413			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/quiz/import/{format} [post]
+func ImportQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username string
+		var data []byte
+		var request *model_cassandra.QuizCore
+
+		if _, _, err = interchange.ContentType(context.Param("format")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "unsupported quiz format", Payload: err.Error()})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in import quiz handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Read quiz core from the file and validate.
+		context.Request.Body = http.MaxBytesReader(context.Writer, context.Request.Body, interchange.MaxFileSize)
+		if data, err = context.GetRawData(); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				context.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, &model_http.Error{Message: "quiz file is too large", Payload: err.Error()})
+				return
+			}
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "unable to read quiz file", Payload: err.Error()})
+			return
+		}
+
+		if request, err = interchange.Import(context.Param("format"), data); err != nil {
+			var validationErr *validator.ErrorValidation
+			if errors.As(err, &validationErr) {
+				context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "validation", Payload: validationErr})
+				return
+			}
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "unable to read quiz file", Payload: err.Error()})
+			return
+		}
+
+		if title := context.Query("title"); len(title) != 0 {
+			request.Title = title
+		}
+		request.MarkingType = context.Query("markingType")
+
+		if err = validator.ValidateStruct(request); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "validation", Payload: err})
+			return
+		}
+
+		// Prepare quiz by adding username and generating quiz id, then insert record.
+		quiz := model_cassandra.Quiz{
+			QuizCore:    request,
+			QuizID:      gocql.TimeUUID(),
+			Author:      username,
+			IsPublished: false,
+			IsDeleted:   false,
+		}
+		if _, err = db.Execute(cassandra.CreateQuizQuery, &quiz); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error creating quiz", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "created quiz with id", Payload: quiz.QuizID.String()})
+	}
+}
+
+// ExportQuiz will download a quiz in a quiz interchange format.
+//	@Summary		Export a quiz.
+//	@Description	This endpoint will download a quiz created by the requester, or any quiz if the requester is an administrator, as a file in the GIFT, Aiken, Moodle XML, or QTI 2.1 content package format.
+//	@Description	Quizzes drawn from question banks cannot be exported.
+//	@Description	Questions that cannot be represented in the format are reported with their index in the quiz.
+//	@Tags			export test quiz
+//	@Id				exportQuiz
+//	@Produce		plain
+//	@Produce		xml
+//	@Produce		application/zip
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			quiz_id	path		string				true	"The Test ID for the quiz being exported."
+//	@Param			format	path		string				true	"The format of the file: gift, aiken, moodle-xml, or qti."
+//	@Success		200		{string}	string				"The quiz file"
+//	@Failure		400		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/quiz/export/{quiz_id}/{format} [get]
+func ExportQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var quiz *model_cassandra.Quiz
+		var username, role string
+		var quizId gocql.UUID
+		var contentType, extension string
+		var data []byte
+
+		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quiz id supplied, must be a valid UUID"})
+			return
+		}
+
+		if contentType, extension, err = interchange.ContentType(context.Param("format")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "unsupported quiz format", Payload: err.Error()})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in export quiz handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get the quiz and verify authorization.
+		if quiz, err = http_common.GetQuiz(quizId, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+		if !http_common.CanManage(quiz.Author, username, role) {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "error verifying quiz author"})
+			return
+		}
+
+		if data, err = interchange.Export(context.Param("format"), quiz.QuizCore); err != nil {
+			var validationErr *validator.ErrorValidation
+			if errors.As(err, &validationErr) {
+				context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "validation", Payload: validationErr})
+				return
+			}
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "unable to export quiz", Payload: err.Error()})
+			return
+		}
+
+		context.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("quiz-%s.%s", quizId, extension)))
+		context.Data(http.StatusOK, contentType, data)
+	}
+}
+
 // UpdateQuiz will update a quiz.
 //	@Summary		Update a quiz.
-//	@Description	This endpoint will update a quiz with the provided Test ID if it was created by the requester and is not published.
+//	@Description	This endpoint will update a quiz with the provided Test ID if it was created by the requester, or the requester is an administrator, and is not published.
 //	@Tags			update modify test quiz
 //	@Id				updateQuiz
 //	@Accept			json
@@ -153,7 +339,7 @@ func CreateQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) g
 func UpdateQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var err error
-		var username string
+		var username, role, owner string
 		var request model_cassandra.QuizCore
 		var quizId gocql.UUID
 
@@ -163,12 +349,19 @@ func UpdateQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) g
 		}
 
 		// Get username from JWT.
-		if username, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
 			logger.Error("failed to validate JWT in update quiz handler", zap.Error(err))
 			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
 			return
 		}
 
+		// Administrators manage the quiz on behalf of its author.
+		if owner, err = http_common.QuizOwner(quizId, username, role, db); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+
 		// Get quiz core from request and validate.
 		if err = context.ShouldBindJSON(&request); err != nil {
 			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: err.Error()})
@@ -182,7 +375,7 @@ func UpdateQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) g
 
 		// Prepare quiz by adding username and generating quiz id, then insert record.
 		updateRequest := model_cassandra.QuizMutateRequest{
-			Username: username,
+			Username: owner,
 			QuizID:   quizId,
 			Quiz: &model_cassandra.Quiz{
 				QuizCore: &request,
@@ -200,7 +393,7 @@ func UpdateQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) g
 
 // DeleteQuiz will delete a quiz using a variable in the URL.
 //	@Summary		Delete a quiz.
-//	@Description	This endpoint will mark a quiz as delete if it was created by the requester. The provided Test ID is provided is a path parameter.
+//	@Description	This endpoint will mark a quiz as delete if it was created by the requester or the requester is an administrator. The provided Test ID is provided is a path parameter.
 //	@Tags			delete remove test quiz
 //	@Id				deleteQuiz
 //	@Produce		json
@@ -210,10 +403,10 @@ func UpdateQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) g
 //	@Failure		403		{object}	model_http.Error	"Error message with any available details in payload"
 //	@Failure		500		{object}	model_http.Error	"Error message with any available details in payload"
 //	@Router			/quiz/delete/{quiz_id} [delete]
-func DeleteQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis) gin.HandlerFunc {
+func DeleteQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis, catalogue catalogue.Catalogue) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var err error
-		var username string
+		var username, role, owner string
 		var quizId gocql.UUID
 		var cachedQuiz model_cassandra.Quiz
 
@@ -223,18 +416,25 @@ func DeleteQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, c
 		}
 
 		// Get username from JWT.
-		if username, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
 			logger.Error("failed to validate JWT in create quiz handler", zap.Error(err))
 			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
 			return
 		}
 
+		// Administrators manage the quiz on behalf of its author.
+		if owner, err = http_common.QuizOwner(quizId, username, role, db); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+
 		// Evict from cache, if present.
 		// This step must be executed before deletion to ensure the end user is able to reattempt the command in the event of failure.
 		// It must not be the case that data marked as deleted remains in the cache till LRU eviction or TTL expiration.
 		// [1] If quiz is in cache.
 		// [2] If error is not a cache miss raise an error.
-		// [3] If individual requesting deletion is the author.
+		// [3] If individual requesting deletion is the author or an administrator.
 		// [4] Then evict from cache.
 		err = cache.Get(quizId.String(), &cachedQuiz)
 		if err != nil && err.(*redis.Error).Code != redis.ErrorCacheMiss {
@@ -244,7 +444,7 @@ func DeleteQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, c
 		}
 		if err == nil {
 			// Check authorization.
-			if username != cachedQuiz.Author {
+			if owner != cachedQuiz.Author {
 				context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "unauthorized"})
 				return
 			}
@@ -258,7 +458,7 @@ func DeleteQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, c
 
 		// Delete quiz record from database.
 		request := model_cassandra.QuizMutateRequest{
-			Username: username,
+			Username: owner,
 			QuizID:   quizId,
 		}
 		if _, err = db.Execute(cassandra.DeleteQuizQuery, &request); err != nil {
@@ -267,6 +467,8 @@ func DeleteQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, c
 			return
 		}
 
+		catalogue.Remove(quizId)
+
 		context.JSON(http.StatusOK, &model_http.Success{Message: "deleted quiz with id", Payload: quizId.String()})
 	}
 }
@@ -274,7 +476,7 @@ func DeleteQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, c
 // PublishQuiz will publish a quiz using a variable in the URL.
 //	@Summary		Publish a quiz.
 //	@Description	When a quiz is submitted it is not published by default and is thus unavailable to be taken.
-//	@Description	This endpoint will publish a quiz with the provided Test ID if it was created by the requester.
+//	@Description	This endpoint will publish a quiz with the provided Test ID if it was created by the requester or the requester is an administrator.
 //	@Tags			publish test quiz create
 //	@Id				publishQuiz
 //	@Produce		json
@@ -284,10 +486,10 @@ func DeleteQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, c
 //	@Failure		403		{object}	model_http.Error	"Error message with any available details in payload"
 //	@Failure		500		{object}	model_http.Error	"Error message with any available details in payload"
 //	@Router			/quiz/publish/{quiz_id} [patch]
-func PublishQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis) gin.HandlerFunc {
+func PublishQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis, catalogue catalogue.Catalogue) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var err error
-		var username string
+		var username, role, owner string
 		var quizId gocql.UUID
 		var response any
 		var quiz *model_cassandra.Quiz
@@ -298,15 +500,22 @@ func PublishQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra,
 		}
 
 		// Get username from JWT.
-		if username, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
 			logger.Error("failed to validate JWT in create quiz handler", zap.Error(err))
 			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
 			return
 		}
 
+		// Administrators manage the quiz on behalf of its author.
+		if owner, err = http_common.QuizOwner(quizId, username, role, db); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+
 		// Publish quiz record in database.
 		request := model_cassandra.QuizMutateRequest{
-			Username: username,
+			Username: owner,
 			QuizID:   quizId,
 		}
 		if _, err = db.Execute(cassandra.PublishQuizQuery, &request); err != nil {
@@ -316,20 +525,23 @@ func PublishQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra,
 		}
 
 		// HTTP OK status should be set here because publishing succeeded.
-		// Any failures below this point are cache related and should be logged but not propagated to the end user.
+		// Any failures below this point are catalogue and cache related and should be logged but not propagated to the end user.
 		context.JSON(http.StatusOK, &model_http.Success{Message: "published quiz with id", Payload: quizId.String()})
 
-		// Place quiz in cache.
+		// Place quiz in catalogue and cache.
 		// [1] Retrieve the quiz from Cassandra.
-		// [2] Place into Redis.
+		// [2] List in catalogue.
+		// [3] Place into Redis.
 
 		// Get quiz record from database.
 		if response, err = db.Execute(cassandra.ReadQuizQuery, quizId); err != nil {
-			logger.Error("error retrieving quiz from database to be placed in cache post publishing", zap.Error(err))
+			logger.Error("error retrieving quiz from database to be placed in catalogue and cache post publishing", zap.Error(err))
 			return
 		}
 		quiz = response.(*model_cassandra.Quiz)
 
+		catalogue.Add(quiz)
+
 		if err = cache.Set(quizId.String(), quiz); err != nil {
 			logger.Error("error placing quiz in cache after publishing", zap.Error(err))
 			return
@@ -337,87 +549,986 @@ func PublishQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra,
 	}
 }
 
-// TakeQuiz will submit the answers to a quiz using a variable in the URL.
-//	@Summary		Take a quiz.
-//	@Description	Take a quiz by submitting an answer sheet. The username will be extracted from the JWT and associated with the scorecard.
-//	@Tags			take test quiz submit answer
-//	@Id				takeQuiz
+// ReviseQuiz will publish a new version of a published quiz.
+//	@Summary		Revise a published quiz.
+//	@Description	This endpoint will publish a new version of a quiz with the provided Test ID if it was created by the requester, or the requester is an administrator, and is published.
+//	@Description	Earlier versions are retained and responses will continue to be graded against the version that was taken.
+//	@Tags			revise update modify test quiz version
+//	@Id				reviseQuiz
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
-//	@Param			quiz_id	path		string							true	"The Test ID for the answers being submitted."
-//	@Param			answers	body		model_cassandra.QuizResponse	true	"The answer card to be submitted."
-//	@Success		200		{object}	model_http.Success				"Score will be in the payload"
-//	@Failure		400		{object}	model_http.Error				"Error message with any available details in payload"
-//	@Failure		403		{object}	model_http.Error				"Error message with any available details in payload"
-//	@Failure		500		{object}	model_http.Error				"Error message with any available details in payload"
-//	@Router			/quiz/take/{quiz_id} [post]
-func TakeQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis, grader grading.Grading) gin.HandlerFunc {
+//	@Param			quiz_id	path		string						true	"The Test ID for the quiz being revised."
+//	@Param			quiz	body		model_cassandra.QuizCore	true	"The Quiz to be published as the next version"
+//	@Success		200		{object}	model_http.Success			"The message will contain the new version number"
+//	@Failure		400		{object}	model_http.Error			"Error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error			"Error message with any available details in payload"
+//	@Failure		404		{object}	model_http.Error			"Error message with any available details in payload"
+//	@Failure		409		{object}	model_http.Error			"Error message with any available details in payload"
+//	@Failure		500		{object}	model_http.Error			"Error message with any available details in payload"
+//	@Router			/quiz/revise/{quiz_id} [patch]
+func ReviseQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis, catalogue catalogue.Catalogue) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var err error
-		var username string
-		var quizResponse model_cassandra.QuizResponse
-		var quiz *model_cassandra.Quiz
+		var username, role, owner string
+		var request model_cassandra.QuizCore
 		var quizId gocql.UUID
-		var score float64
+		var response any
 
 		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
-			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quizResponse id supplied, must be a valid UUID"})
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quiz id supplied, must be a valid UUID"})
 			return
 		}
 
 		// Get username from JWT.
-		if username, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
-			logger.Error("failed to validate JWT in update quizResponse handler", zap.Error(err))
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in revise quiz handler", zap.Error(err))
 			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
 			return
 		}
 
-		// Get quizResponse response from quizResponse and validate.
-		if err = context.ShouldBindJSON(&quizResponse); err != nil {
+		// Administrators manage the quiz on behalf of its author.
+		if owner, err = http_common.QuizOwner(quizId, username, role, db); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+
+		// Get quiz core from request and validate.
+		if err = context.ShouldBindJSON(&request); err != nil {
 			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: err.Error()})
 			return
 		}
 
-		if err = validator.ValidateStruct(&quizResponse); err != nil {
+		if err = validator.ValidateStruct(&request); err != nil {
 			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "validation", Payload: err})
 			return
 		}
 
-		// Get quiz:
-		// [1] Cache call.
-		// [2] Cache miss: read from the database and store it in the cache.
-		if quiz, err = http_common.GetQuiz(quizId, db, cache); err != nil {
+		// Publish the revision in the database.
+		reviseRequest := model_cassandra.QuizMutateRequest{
+			Username: owner,
+			QuizID:   quizId,
+			Quiz: &model_cassandra.Quiz{
+				QuizCore: &request,
+			},
+		}
+		if response, err = db.Execute(cassandra.ReviseQuizQuery, &reviseRequest); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error revising quiz", Payload: cassandraError.Message})
+			return
+		}
+
+		// HTTP OK status should be set here because the revision succeeded.
+		// Any failures below this point are cache related and should be logged but not propagated to the end user.
+		context.JSON(http.StatusOK, &model_http.Success{Message: fmt.Sprintf("published version %d of quiz", response.(int)), Payload: quizId.String()})
+
+		// Replace the catalogue listing with the revision. The quiz record is retrieved from the database because the groups
+		// the quiz is restricted to are not part of the revision.
+		if response, err = db.Execute(cassandra.ReadQuizQuery, quizId); err != nil {
+			logger.Error("error retrieving quiz from database to be placed in catalogue after revision", zap.Error(err))
+		} else {
+			catalogue.Add(response.(*model_cassandra.Quiz))
+		}
+
+		// Evict the previous version from the cache. It will be reloaded on the next cache miss.
+		if err = cache.Del(quizId.String()); err != nil && err.(*redis.Error).Code != redis.ErrorCacheMiss {
+			logger.Error("failed to evict previous quiz version from cache after revision", zap.Error(err))
+		}
+	}
+}
+
+// ScheduleQuiz will set the availability window of a quiz using a variable in the URL.
+//	@Summary		Schedule a quiz.
+//	@Description	This endpoint will set the opening and closing times of a quiz with the provided Test ID if it was created by the requester or the requester is an administrator.
+//	@Description	An unpublished quiz is published at its opening time and a quiz can no longer be taken after its closing time. Closed quizzes remain viewable.
+//	@Description	Only unpublished quizzes can be given an opening time. Scheduling a closed quiz with a later closing time will reopen it.
+//	@Tags			schedule publish close test quiz
+//	@Id				scheduleQuiz
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			quiz_id		path		string							true	"The Test ID for the quiz being scheduled."
+//	@Param			schedule	body		model_cassandra.QuizSchedule	true	"The opening and closing times of the quiz"
+//	@Success		200			{object}	model_http.Success				"The message will contain a confirmation of scheduling"
+//	@Failure		400			{object}	model_http.Error				"Error message with any available details in payload"
+//	@Failure		403			{object}	model_http.Error				"Error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error				"Error message with any available details in payload"
+//	@Failure		409			{object}	model_http.Error				"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error				"Error message with any available details in payload"
+//	@Router			/quiz/schedule/{quiz_id} [patch]
+func ScheduleQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role, owner string
+		var request model_cassandra.QuizSchedule
+		var quizId gocql.UUID
+
+		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quiz id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in schedule quiz handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Administrators manage the quiz on behalf of its author.
+		if owner, err = http_common.QuizOwner(quizId, username, role, db); err != nil {
 			cassandraError := err.(*cassandra.Error)
 			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
 			return
 		}
 
-		// Check to see if the quiz is deleted or unpublished.
-		if !quiz.IsPublished || quiz.IsDeleted {
-			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "quiz is unavailable"})
+		// Get schedule from request and validate.
+		if err = context.ShouldBindJSON(&request); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: err.Error()})
 			return
 		}
 
-		// Grade the quizResponse.
-		if score, err = grader.Grade(&quizResponse, quiz.QuizCore); err != nil {
-			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "error marking response", Payload: err.Error()})
+		if err = validator.ValidateStruct(&request); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "validation", Payload: err})
 			return
 		}
 
-		// Insert updated record.
-		response := model_cassandra.Response{
-			Username:     username,
-			Score:        score,
-			QuizResponse: &quizResponse,
+		// Schedule quiz record in database.
+		scheduleRequest := model_cassandra.QuizScheduleRequest{
+			Username:     owner,
 			QuizID:       quizId,
+			QuizSchedule: &request,
+		}
+		if _, err = db.Execute(cassandra.ScheduleQuizQuery, &scheduleRequest); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error scheduling quiz", Payload: cassandraError.Message})
+			return
+		}
+
+		// HTTP OK status should be set here because scheduling succeeded.
+		// Any failures below this point are cache related and should be logged but not propagated to the end user.
+		context.JSON(http.StatusOK, &model_http.Success{Message: "scheduled quiz with id", Payload: quizId.String()})
+
+		// Evict the quiz from the cache so that the new window is used. It will be reloaded on the next cache miss.
+		if err = cache.Del(quizId.String()); err != nil && err.(*redis.Error).Code != redis.ErrorCacheMiss {
+			logger.Error("failed to evict quiz from cache after scheduling", zap.Error(err))
+		}
+	}
+}
+
+// ListQuizVersions will retrieve all the published versions of a quiz using a variable in the URL.
+//	@Summary		List the versions of a quiz.
+//	@Description	This endpoint will retrieve all the published versions of a quiz with a provided quiz ID, oldest first.
+//	@Description	Answer keys are only included if the requester is the author or an administrator.
+//	@Tags			view test quiz version
+//	@Id				listQuizVersions
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			quiz_id	path		string				true	"The quiz ID for the quiz being requested."
+//	@Success		200		{object}	model_http.Success	"The message will contain the quiz ID and the payload will contain the versions"
+//	@Failure		400		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/quiz/versions/{quiz_id} [get]
+func ListQuizVersions(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var quiz *model_cassandra.Quiz
+		var username, role string
+		var quizId gocql.UUID
+		var response any
+
+		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quiz id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in list quiz versions handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get quiz to check if the versions can be sent to the requester.
+		if quiz, err = http_common.GetQuiz(quizId, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+
+		if (!quiz.IsPublished || quiz.IsDeleted) && !http_common.CanManage(quiz.Author, username, role) {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "quiz is not available"})
+			return
 		}
-		if _, err = db.Execute(cassandra.CreateResponseQuery, &response); err != nil {
+
+		// Check to see if the quiz is restricted to groups the requester is not a member of.
+		if err = http_common.CheckGroupAccess(quiz, username, role, db); err != nil {
+			abortGroupError(context, "quiz is not available", err)
+			return
+		}
+
+		if response, err = db.Execute(cassandra.ReadQuizVersionsQuery, quizId); err != nil {
 			cassandraError := err.(*cassandra.Error)
-			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error submitting response", Payload: cassandraError.Message})
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz versions", Payload: cassandraError.Message})
 			return
 		}
+		versions := response.([]*model_cassandra.QuizVersion)
+
+		if len(versions) == 0 {
+			context.AbortWithStatusJSON(http.StatusNotFound, &model_http.Error{Message: "quiz has no published versions"})
+			return
+		}
+
+		// If the requester does not manage the quiz remove the answer keys.
+		if !http_common.CanManage(quiz.Author, username, role) {
+			for _, version := range versions {
+				http_common.RemoveAnswerKeys(version.QuizCore)
+			}
+		}
 
-		context.JSON(http.StatusOK, &model_http.Success{Message: "submitted quiz response", Payload: &response})
+		context.JSON(http.StatusOK, &model_http.Success{Message: quizId.String(), Payload: versions})
 	}
 }
+
+// ViewQuizVersion will retrieve a specific published version of a quiz using variables in the URL.
+//	@Summary		View a version of a quiz.
+//	@Description	This endpoint will retrieve a published version of a quiz with a provided quiz ID and version number.
+//	@Description	Answer keys are only included if the requester is the author or an administrator.
+//	@Tags			view test quiz version
+//	@Id				viewQuizVersion
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			quiz_id	path		string				true	"The quiz ID for the quiz being requested."
+//	@Param			version	path		int					true	"The version of the quiz being requested."
+//	@Success		200		{object}	model_http.Success	"The message will contain the quiz ID and the payload will contain the version"
+//	@Failure		400		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/quiz/versions/{quiz_id}/{version} [get]
+func ViewQuizVersion(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var quiz *model_cassandra.Quiz
+		var username, role string
+		var quizId gocql.UUID
+		var version int
+		var response any
+
+		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quiz id supplied, must be a valid UUID"})
+			return
+		}
+
+		if version, err = strconv.Atoi(context.Param("version")); err != nil || version < 0 {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid version supplied, must be a non-negative integer"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in view quiz version handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get quiz to check if the version can be sent to the requester.
+		if quiz, err = http_common.GetQuiz(quizId, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+
+		if (!quiz.IsPublished || quiz.IsDeleted) && !http_common.CanManage(quiz.Author, username, role) {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "quiz is not available"})
+			return
+		}
+
+		// Check to see if the quiz is restricted to groups the requester is not a member of.
+		if err = http_common.CheckGroupAccess(quiz, username, role, db); err != nil {
+			abortGroupError(context, "quiz is not available", err)
+			return
+		}
+
+		request := model_cassandra.QuizVersionRequest{QuizID: quizId, Version: version}
+		if response, err = db.Execute(cassandra.ReadQuizVersionQuery, &request); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz version", Payload: cassandraError.Message})
+			return
+		}
+		quizVersion := response.(*model_cassandra.QuizVersion)
+
+		// If the requester does not manage the quiz remove the answer keys.
+		if !http_common.CanManage(quiz.Author, username, role) {
+			http_common.RemoveAnswerKeys(quizVersion.QuizCore)
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: quizId.String(), Payload: quizVersion})
+	}
+}
+
+// StartQuiz will start a timed attempt at a quiz using a variable in the URL.
+//	@Summary		Start a timed quiz.
+//	@Description	Start the next attempt at a quiz with a time limit. The server records the deadline for submitting the attempt and the time remaining is returned.
+//	@Description	Starting an attempt that is already in progress will return the existing session without extending its deadline.
+//	@Tags			start take test quiz timed
+//	@Id				startQuiz
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			quiz_id	path		string				true	"The Test ID for the quiz being started."
+//	@Success		200		{object}	model_http.Success	"The attempt session and the seconds remaining will be in the payload"
+//	@Failure		400		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		429		{object}	model_http.Error	"Error message with the time until the next attempt in payload"
+//	@Failure		500		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/quiz/start/{quiz_id} [post]
+func StartQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role string
+		var quiz *model_cassandra.Quiz
+		var previous *model_cassandra.Response
+		var session *model_cassandra.AttemptSession
+		var quizId gocql.UUID
+
+		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quiz id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in start quiz handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get quiz:
+		// [1] Cache call.
+		// [2] Cache miss: read from the database and store it in the cache.
+		if quiz, err = http_common.GetQuiz(quizId, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+
+		// Check to see if the quiz is deleted, unpublished, or closed.
+		now := time.Now()
+		if err = http_common.CheckAvailability(quiz, now); err != nil {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "quiz is unavailable", Payload: err.Error()})
+			return
+		}
+
+		// Check to see if the quiz is restricted to groups the requester is not a member of.
+		if err = http_common.CheckGroupAccess(quiz, username, role, db); err != nil {
+			abortGroupError(context, "quiz is unavailable", err)
+			return
+		}
+
+		// Check the previous attempts against the attempt limit and cooldown.
+		if previous, err = http_common.GetResponse(username, quizId, db); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving previous attempts", Payload: cassandraError.Message})
+			return
+		}
+
+		if session, err = http_common.StartAttempt(quiz, username, previous, now, db); err != nil {
+			if cassandraError, ok := err.(*cassandra.Error); ok {
+				context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error starting attempt", Payload: cassandraError.Message})
+				return
+			}
+			status := http.StatusForbidden
+			if errors.Is(err, http_common.ErrQuizNotTimed) {
+				status = http.StatusBadRequest
+			} else if errors.Is(err, http_common.ErrAttemptCooldown) {
+				status = http.StatusTooManyRequests
+			}
+			context.AbortWithStatusJSON(status, &model_http.Error{Message: "unable to start quiz", Payload: err.Error()})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{
+			Message: fmt.Sprintf("started attempt %d", session.Attempt),
+			Payload: &model_http.AttemptSession{AttemptSession: session, RemainingTime: http_common.RemainingTime(session, now)},
+		})
+	}
+}
+
+// TakeQuiz will submit the answers to a quiz using a variable in the URL.
+//	@Summary		Take a quiz.
+//	@Description	Take a quiz by submitting an answer sheet. The username will be extracted from the JWT and associated with the scorecard.
+//	@Description	Each submission is an attempt and is limited by the attempt limit and cooldown of the quiz. The effective score is set by the attempt policy of the quiz.
+//	@Description	Attempts at timed quizzes must be started first. Submissions after the deadline and grace period are recorded as late and scored zero.
+//	@Description	Attempts at shuffled quizzes must be viewed first and are answered in the order they were presented in.
+//	@Description	Attempts at quizzes drawn from question banks must be viewed first and are graded against the questions drawn for them.
+//	@Description	Quizzes that reveal their answers immediately include a breakdown of the answer key, points, explanation, and feedback for every question.
+//	@Tags			take test quiz submit answer
+//	@Id				takeQuiz
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			quiz_id	path		string							true	"The Test ID for the answers being submitted."
+//	@Param			answers	body		model_cassandra.QuizResponse	true	"The answer card to be submitted."
+//	@Success		200		{object}	model_http.Success				"Effective score, attempt history, and any breakdown will be in the payload"
+//	@Failure		400		{object}	model_http.Error				"Error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error				"Error message with any available details in payload"
+//	@Failure		409		{object}	model_http.Error				"Error message with any available details in payload"
+//	@Failure		429		{object}	model_http.Error				"Error message with the time until the next attempt in payload"
+//	@Failure		500		{object}	model_http.Error				"Error message with any available details in payload"
+//	@Router			/quiz/take/{quiz_id} [post]
+func TakeQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis, grader grading.Grading) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role string
+		var quizResponse model_cassandra.QuizResponse
+		var scoreCard *model_http.ScoreCard
+		var quizId gocql.UUID
+
+		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quizResponse id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in update quizResponse handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get quizResponse response from quizResponse and validate.
+		if err = context.ShouldBindJSON(&quizResponse); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: err.Error()})
+			return
+		}
+
+		if err = validator.ValidateStruct(&quizResponse); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "validation", Payload: err})
+			return
+		}
+
+		if scoreCard = submitAttempt(context, username, role, quizId, &quizResponse, db, cache, grader); scoreCard == nil {
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "submitted quiz response", Payload: scoreCard})
+	}
+}
+
+// PracticeQuiz will check the answer to a single question of a quiz using a variable in the URL without recording it.
+//	@Summary		Practice a quiz.
+//	@Description	Check the answer to a single question of a published quiz. Practice checks are graded immediately and are never recorded as responses or counted in the quiz statistics.
+//	@Description	Questions and options are indexed in the order the quiz is presented to the requester for their next attempt.
+//	@Description	Authors and administrators practice the quiz in its original order and cannot practice quizzes drawn from question banks.
+//	@Description	The correctness, points, and feedback for the selected options are returned. The answer key and explanation are only returned if the quiz reveals its answers.
+//	@Description	Practice checks are limited per user and checks over the limit will be told to retry later.
+//	@Tags			practice test quiz check answer
+//	@Id				practiceQuiz
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			quiz_id	path		string						true	"The Test ID for the quiz being practiced."
+//	@Param			check	body		model_http.PracticeCheck	true	"The answer to the question being checked."
+//	@Success		200		{object}	model_http.Success			"The result of the check will be in the payload"
+//	@Failure		400		{object}	model_http.Error			"Error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error			"Error message with any available details in payload"
+//	@Failure		409		{object}	model_http.Error			"Error message with any available details in payload"
+//	@Failure		429		{object}	model_http.Error			"Error message with the practice limit in payload"
+//	@Failure		500		{object}	model_http.Error			"Error message with any available details in payload"
+//	@Router			/quiz/practice/{quiz_id} [post]
+func PracticeQuiz(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis, grader grading.Grading) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role string
+		var check model_http.PracticeCheck
+		var quiz *model_cassandra.Quiz
+		var result *model_http.QuestionBreakdown
+		var quizId gocql.UUID
+
+		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quiz id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in practice quiz handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get practice check from request and validate.
+		if err = context.ShouldBindJSON(&check); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: err.Error()})
+			return
+		}
+
+		if err = validator.ValidateStruct(&check); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "validation", Payload: err})
+			return
+		}
+
+		// Count the check against the requester's practice limit.
+		if err = http_common.CheckPracticeRate(username, cache); err != nil {
+			context.AbortWithStatusJSON(http.StatusTooManyRequests, &model_http.Error{Message: "unable to practice quiz", Payload: err.Error()})
+			return
+		}
+
+		// Get quiz:
+		// [1] Cache call.
+		// [2] Cache miss: read from the database and store it in the cache.
+		if quiz, err = http_common.GetQuiz(quizId, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+
+		// Check to see if the quiz is deleted or unpublished. Closed quizzes remain available for practice.
+		now := time.Now()
+		if err = http_common.CheckAvailability(quiz, now); errors.Is(err, http_common.ErrQuizUnavailable) {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "quiz is unavailable", Payload: err.Error()})
+			return
+		}
+
+		// Check to see if the quiz is restricted to groups the requester is not a member of.
+		if err = http_common.CheckGroupAccess(quiz, username, role, db); err != nil {
+			abortGroupError(context, "quiz is unavailable", err)
+			return
+		}
+
+		// Authors and administrators practice the quiz in its original order, whereas all other users check the quiz as drawn
+		// and ordered for their next attempt.
+		if !http_common.CanManage(quiz.Author, username, role) {
+			if quiz.QuizCore, err = http_common.PresentQuiz(quiz, username, db); err != nil {
+				if errors.Is(err, http_common.ErrInsufficientQuestions) {
+					context.AbortWithStatusJSON(http.StatusConflict, &model_http.Error{Message: "unable to draw quiz questions", Payload: err.Error()})
+					return
+				}
+				cassandraError := err.(*cassandra.Error)
+				context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error presenting quiz", Payload: cassandraError.Message})
+				return
+			}
+		}
+
+		if result, err = http_common.CheckPractice(quiz.QuizCore, &check, http_common.RevealAnswers(quiz, now), grader); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "error checking answer", Payload: err.Error()})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "checked practice answer", Payload: result})
+	}
+}
+
+// SaveDraft will save the partially completed answers to a quiz using a variable in the URL.
+//	@Summary		Save a draft of the answers to a quiz.
+//	@Description	Save the partially completed answer sheet for the requester's next attempt at a quiz without submitting it. Saving a draft again replaces the answers.
+//	@Description	Drafts are answered in the order the quiz was presented in and are only saved whilst the quiz is available and the requester has attempts remaining.
+//	@Tags			draft save autosave test quiz answer
+//	@Id				saveDraft
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			quiz_id	path		string							true	"The Test ID for the answers being saved."
+//	@Param			answers	body		model_cassandra.QuizResponse	true	"The partially completed answer card to be saved."
+//	@Success		200		{object}	model_http.Success				"The saved draft will be in the payload"
+//	@Failure		400		{object}	model_http.Error				"Error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error				"Error message with any available details in payload"
+//	@Failure		429		{object}	model_http.Error				"Error message with the time until the next attempt in payload"
+//	@Failure		500		{object}	model_http.Error				"Error message with any available details in payload"
+//	@Router			/quiz/draft/{quiz_id} [put]
+func SaveDraft(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role string
+		var quizResponse model_cassandra.QuizResponse
+		var quiz *model_cassandra.Quiz
+		var previous *model_cassandra.Response
+		var draft *model_cassandra.AnswerDraft
+		var quizId gocql.UUID
+
+		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quiz id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in save draft handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get draft answers from request and validate.
+		if err = context.ShouldBindJSON(&quizResponse); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: err.Error()})
+			return
+		}
+
+		if err = validator.ValidateStruct(&quizResponse); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "validation", Payload: err})
+			return
+		}
+
+		// Get quiz:
+		// [1] Cache call.
+		// [2] Cache miss: read from the database and store it in the cache.
+		if quiz, err = http_common.GetQuiz(quizId, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+
+		// Check to see if the quiz is deleted, unpublished, or closed.
+		now := time.Now()
+		if err = http_common.CheckAvailability(quiz, now); err != nil {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "quiz is unavailable", Payload: err.Error()})
+			return
+		}
+
+		// Check to see if the quiz is restricted to groups the requester is not a member of.
+		if err = http_common.CheckGroupAccess(quiz, username, role, db); err != nil {
+			abortGroupError(context, "quiz is unavailable", err)
+			return
+		}
+
+		// Check the previous attempts against the attempt limit and cooldown.
+		if previous, err = http_common.GetResponse(username, quizId, db); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving previous attempts", Payload: cassandraError.Message})
+			return
+		}
+
+		if err = http_common.CheckAttempt(quiz, previous, now); err != nil {
+			status := http.StatusForbidden
+			if errors.Is(err, http_common.ErrAttemptCooldown) {
+				status = http.StatusTooManyRequests
+			}
+			context.AbortWithStatusJSON(status, &model_http.Error{Message: "unable to save draft", Payload: err.Error()})
+			return
+		}
+
+		if draft, err = http_common.SaveDraft(username, quizId, previous, &quizResponse, now, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error saving draft", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: fmt.Sprintf("saved draft of attempt %d", draft.Attempt), Payload: draft})
+	}
+}
+
+// ResumeDraft will retrieve the saved draft of the answers to a quiz using a variable in the URL.
+//	@Summary		Resume a draft of the answers to a quiz.
+//	@Description	Retrieve the partially completed answer sheet that was last saved for the requester's next attempt at a quiz.
+//	@Description	Drafts saved for attempts that have since been submitted are not resumed.
+//	@Tags			draft resume test quiz answer
+//	@Id				resumeDraft
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			quiz_id	path		string				true	"The Test ID for the draft being resumed."
+//	@Success		200		{object}	model_http.Success	"The saved draft will be in the payload"
+//	@Failure		400		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/quiz/draft/{quiz_id} [get]
+func ResumeDraft(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username string
+		var previous *model_cassandra.Response
+		var draft *model_cassandra.AnswerDraft
+		var quizId gocql.UUID
+
+		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quiz id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in resume draft handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get the previous attempts to locate the draft of the next attempt.
+		if previous, err = http_common.GetResponse(username, quizId, db); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving previous attempts", Payload: cassandraError.Message})
+			return
+		}
+
+		if draft, err = http_common.GetDraft(username, quizId, previous, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving draft", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: fmt.Sprintf("resumed draft of attempt %d", draft.Attempt), Payload: draft})
+	}
+}
+
+// SubmitDraft will submit the saved draft of the answers to a quiz using a variable in the URL.
+//	@Summary		Submit a draft of the answers to a quiz.
+//	@Description	Submit the answer sheet that was last saved for the requester's next attempt at a quiz. The draft is graded and recorded in the same way as an answer sheet that is submitted to take the quiz.
+//	@Tags			draft submit take test quiz answer
+//	@Id				submitDraft
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			quiz_id	path		string				true	"The Test ID for the draft being submitted."
+//	@Success		200		{object}	model_http.Success	"Effective score, attempt history, and any breakdown will be in the payload"
+//	@Failure		400		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		409		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		429		{object}	model_http.Error	"Error message with the time until the next attempt in payload"
+//	@Failure		500		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/quiz/submit/{quiz_id} [post]
+func SubmitDraft(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis, grader grading.Grading) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var username, role string
+		var scoreCard *model_http.ScoreCard
+		var quizId gocql.UUID
+
+		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quiz id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in submit draft handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		if scoreCard = submitAttempt(context, username, role, quizId, nil, db, cache, grader); scoreCard == nil {
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "submitted quiz draft", Payload: scoreCard})
+	}
+}
+
+// ListMarkingSchemes will retrieve the names of all the marking types that can be assigned to a quiz.
+//	@Summary		List the marking schemes.
+//	@Description	This endpoint will retrieve the names of all the registered marking types that can be assigned to a quiz.
+//	@Tags			marking schemes grading test quiz
+//	@Id				listMarkingSchemes
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	model_http.Success	"The payload will contain the list of marking scheme names"
+//	@Router			/quiz/marking-schemes [get]
+func ListMarkingSchemes() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		context.JSON(http.StatusOK, &model_http.Success{Message: "marking schemes", Payload: grading.MarkingSchemes()})
+	}
+}
+
+// ListMyQuizzes will retrieve a page of the quizzes created by the requester.
+//	@Summary		List the quizzes created by the requester.
+//	@Description	Gets a page of the quizzes created by the requester, most recently created first. Extracts username from the JWT.
+//	@Description	The quizzes can be filtered by a status of draft, published, or deleted.
+//	@Description	A query string to be appended to the next request to retrieve the next page of data will be returned in the response.
+//	@Tags			view test quiz list mine
+//	@Id				listMyQuizzes
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			status		query		string								false	"The status of the quizzes to list: draft, published, or deleted."
+//	@Param			pageCursor	query		string								false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int									false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	model_http.AuthorQuizzesResponse	"A page of the requester's quizzes"
+//	@Failure		400			{object}	model_http.Error					"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error					"Error message with any available details in payload"
+//	@Router			/quiz/mine [get]
+func ListMyQuizzes(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var dbRecord any
+		var request *model_cassandra.AuthorQuizzesRequest
+		var restResponse *model_http.AuthorQuizzesResponse
+		var username string
+
+		// Get username from JWT.
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in list my quizzes handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Prepare author quizzes page request for database.
+		if request, err = http_common.PrepareAuthorQuizzesRequest(auth, username, context.Query("status"),
+			context.Query("pageCursor"), context.Query("pageSize")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "malformed query request", Payload: err.Error()})
+			return
+		}
+
+		// Get author quiz record page from database.
+		if dbRecord, err = db.Execute(cassandra.ReadAuthorQuizzesPageQuery, request); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quizzes page", Payload: cassandraError.Message})
+			return
+		}
+
+		// Prepare REST response.
+		if restResponse, err = prepareAuthorQuizzesResponse(auth, dbRecord.(*model_cassandra.AuthorQuizzesResponse), request.Status); err != nil {
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "error preparing quizzes page", Payload: err.Error()})
+			return
+		}
+
+		context.JSON(http.StatusOK, restResponse)
+	}
+}
+
+// prepareAuthorQuizzesResponse will prepare a http response from a database author quizzes response. It will generate a
+// link to the next page of data, with the same status filter, as appropriate.
+func prepareAuthorQuizzesResponse(auth auth.Auth, dbResponse *model_cassandra.AuthorQuizzesResponse, status string) (response *model_http.AuthorQuizzesResponse, err error) {
+	response = &model_http.AuthorQuizzesResponse{Records: dbResponse.Records}
+	response.Metadata.Status = status
+	response.Metadata.NumRecords = len(dbResponse.Records)
+
+	// There is no next page if the cursor is not set.
+	if len(dbResponse.PageCursor) == 0 {
+		return
+	}
+
+	var cursor string
+	if cursor, err = auth.EncryptToString(dbResponse.PageCursor); err != nil {
+		return nil, err
+	}
+
+	nextPageLink := fmt.Sprintf("?pageCursor=%s", cursor)
+	if dbResponse.PageSize > 0 {
+		nextPageLink += fmt.Sprintf("&pageSize=%d", dbResponse.PageSize)
+	}
+	if len(status) != 0 {
+		nextPageLink += fmt.Sprintf("&status=%s", status)
+	}
+	response.Links.NextPage = nextPageLink
+
+	return
+}
+
+// submitAttempt will grade and record an attempt at a quiz. A nil answer card submits the draft saved for the attempt. The
+// request is aborted and a nil scorecard is returned if the attempt cannot be submitted.
+func submitAttempt(context *gin.Context, username, role string, quizId gocql.UUID, quizResponse *model_cassandra.QuizResponse,
+	db cassandra.Cassandra, cache redis.Redis, grader grading.Grading) *model_http.ScoreCard {
+	var err error
+	var canonical *model_cassandra.QuizResponse
+	var quiz *model_cassandra.Quiz
+	var previous *model_cassandra.Response
+	var score, maxScore float64
+	var late bool
+	var breakdown []*model_http.QuestionBreakdown
+
+	// Get quiz:
+	// [1] Cache call.
+	// [2] Cache miss: read from the database and store it in the cache.
+	if quiz, err = http_common.GetQuiz(quizId, db, cache); err != nil {
+		cassandraError := err.(*cassandra.Error)
+		context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+		return nil
+	}
+
+	// Check to see if the quiz is deleted, unpublished, or closed.
+	now := time.Now()
+	if err = http_common.CheckAvailability(quiz, now); err != nil {
+		context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "quiz is unavailable", Payload: err.Error()})
+		return nil
+	}
+
+	// Check to see if the quiz is restricted to groups the requester is not a member of.
+	if err = http_common.CheckGroupAccess(quiz, username, role, db); err != nil {
+		abortGroupError(context, "quiz is unavailable", err)
+		return nil
+	}
+
+	// Check the previous attempts against the attempt limit and cooldown.
+	if previous, err = http_common.GetResponse(username, quizId, db); err != nil {
+		cassandraError := err.(*cassandra.Error)
+		context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving previous attempts", Payload: cassandraError.Message})
+		return nil
+	}
+
+	if err = http_common.CheckAttempt(quiz, previous, now); err != nil {
+		status := http.StatusForbidden
+		if errors.Is(err, http_common.ErrAttemptCooldown) {
+			status = http.StatusTooManyRequests
+		}
+		context.AbortWithStatusJSON(status, &model_http.Error{Message: "unable to attempt quiz", Payload: err.Error()})
+		return nil
+	}
+
+	// Load the answers saved in the draft of the attempt when submitting a draft.
+	if quizResponse == nil {
+		var draft *model_cassandra.AnswerDraft
+		if draft, err = http_common.GetDraft(username, quizId, previous, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving draft", Payload: cassandraError.Message})
+			return nil
+		}
+		quizResponse = draft.QuizResponse
+	}
+
+	// Check that attempts at timed quizzes were started and whether they are submitted after the deadline.
+	if late, err = http_common.CheckDeadline(quiz, username, previous, now, db); err != nil {
+		if errors.Is(err, http_common.ErrAttemptNotStarted) {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "unable to attempt quiz", Payload: err.Error()})
+			return nil
+		}
+		cassandraError := err.(*cassandra.Error)
+		context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving attempt session", Payload: cassandraError.Message})
+		return nil
+	}
+
+	// Retrieve the questions drawn for attempts at quizzes drawn from question banks.
+	if quiz, err = http_common.DrawnQuiz(quiz, username, previous, db); err != nil {
+		if errors.Is(err, http_common.ErrAttemptNotViewed) {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "unable to attempt quiz", Payload: err.Error()})
+			return nil
+		}
+		cassandraError := err.(*cassandra.Error)
+		context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving attempt questions", Payload: cassandraError.Message})
+		return nil
+	}
+
+	// Translate responses to shuffled quizzes to the canonical order of the questions and options.
+	if canonical, err = http_common.UnshuffleResponse(quiz, username, previous, quizResponse, db); err != nil {
+		if errors.Is(err, http_common.ErrAttemptNotViewed) {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "unable to attempt quiz", Payload: err.Error()})
+			return nil
+		}
+		cassandraError := err.(*cassandra.Error)
+		context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving attempt order", Payload: cassandraError.Message})
+		return nil
+	}
+
+	// Grade the quizResponse.
+	if score, maxScore, err = grader.Grade(canonical, quiz.QuizCore); err != nil {
+		context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "error marking response", Payload: err.Error()})
+		return nil
+	}
+
+	// Break down every question in the attempt if the quiz reveals its answers.
+	if http_common.RevealAnswers(quiz, now) {
+		if breakdown, err = http_common.BreakdownAttempt(quiz.QuizCore, canonical, late, grader); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "error marking response", Payload: err.Error()})
+			return nil
+		}
+	}
+
+	// Insert or update the record with the new attempt.
+	response := http_common.RecordAttempt(quiz, username, previous, canonical, score, maxScore, late, now)
+	if err = http_common.StoreAttempt(response, previous, db); err != nil {
+		cassandraError := err.(*cassandra.Error)
+		context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error submitting response", Payload: cassandraError.Message})
+		return nil
+	}
+	http_common.InvalidateStatsSummary(quizId, cache)
+
+	return &model_http.ScoreCard{Response: response, Breakdown: breakdown}
+}
@@ -6,13 +6,17 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/grading"
 	http_common "github.com/surahman/mcq-platform/pkg/http"
+	"github.com/surahman/mcq-platform/pkg/interchange"
 	"github.com/surahman/mcq-platform/pkg/mocks"
 	"github.com/surahman/mcq-platform/pkg/model/cassandra"
 	"github.com/surahman/mcq-platform/pkg/model/http"
@@ -135,6 +139,7 @@ func TestCreateQuiz(t *testing.T) {
 			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 				testCase.authValidateJWTData.OutputParam1,
 				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
 				testCase.authValidateJWTData.OutputErr,
 			).Times(testCase.authValidateJWTData.Times)
 
@@ -168,6 +173,10 @@ func TestCreateQuiz(t *testing.T) {
 
 func TestViewQuiz(t *testing.T) {
 	router := http_common.GetTestRouter()
+	closedQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	closedQuiz.IsClosed = true
+	shuffledQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	shuffledQuiz.Shuffle = true
 
 	testCases := []struct {
 		name                string
@@ -179,6 +188,8 @@ func TestViewQuiz(t *testing.T) {
 		cassandraReadData   *http_common.MockCassandraData
 		redisGetData        *http_common.MockRedisData
 		redisSetData        *http_common.MockRedisData
+		responseReadData    *http_common.MockCassandraData
+		shuffleData         *http_common.MockCassandraData
 	}{
 		// ----- test cases start ----- //
 		{
@@ -200,6 +211,12 @@ func TestViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:           "invalid quiz id",
 			path:           "/view/invalid-quiz-id",
@@ -218,6 +235,12 @@ func TestViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:           "db failure",
 			path:           "/view/db-failure/",
@@ -242,6 +265,12 @@ func TestViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:           "unpublished not owner",
 			path:           "/view/unpublished-not-owner/",
@@ -267,6 +296,12 @@ func TestViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:           "published but deleted not owner",
 			path:           "/view/published-but-deleted-not-owner/",
@@ -292,6 +327,12 @@ func TestViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:           "published not owner",
 			path:           "/view/published-not-owner/",
@@ -318,6 +359,44 @@ func TestViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 1,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "closed not owner",
+			path:           "/view/closed-not-owner/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			expectAnswers:  require.False,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss error",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: closedQuiz,
+				OutputErr:   nil,
+				Times:       1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 1,
+			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:           "published owner",
 			path:           "/view/published-owner/",
@@ -344,6 +423,12 @@ func TestViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 1,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:           "unpublished owner",
 			path:           "/view/unpublished-owner/",
@@ -370,6 +455,12 @@ func TestViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:           "published deleted owner",
 			path:           "/view/published-deleted-owner/",
@@ -396,6 +487,12 @@ func TestViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:           "cache set failure",
 			path:           "/view/cache-set-failure/",
@@ -426,6 +523,12 @@ func TestViewQuiz(t *testing.T) {
 				},
 				Times: 1,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:           "cache hit",
 			path:           "/view/cache-hit/",
@@ -446,6 +549,93 @@ func TestViewQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "shuffled not owner",
+			path:           "/view/shuffled-not-owner/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			expectAnswers:  require.False,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *shuffledQuiz,
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			responseReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AttemptShuffle{Username: "not owner", Attempt: 1, Seed: 42},
+				Times:       1,
+			},
+		}, {
+			name:           "shuffled not owner failure",
+			path:           "/view/shuffled-not-owner-failure/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *shuffledQuiz,
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			responseReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+		}, {
+			name:           "shuffled owner",
+			path:           "/view/shuffled-owner/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			expectAnswers:  require.True,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "user-2",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *shuffledQuiz,
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			responseReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		},
 		// ----- test cases end ----- //
 	}
@@ -463,6 +653,7 @@ func TestViewQuiz(t *testing.T) {
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 					testCase.authValidateJWTData.OutputParam1,
 					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
 					testCase.authValidateJWTData.OutputErr,
 				).Times(testCase.authValidateJWTData.Times),
 
@@ -484,6 +675,18 @@ func TestViewQuiz(t *testing.T) {
 				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any()).Return(
 					testCase.redisSetData.Err,
 				).Times(testCase.redisSetData.Times),
+
+				// Get previous attempts from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.responseReadData.OutputParam,
+					testCase.responseReadData.OutputErr,
+				).Times(testCase.responseReadData.Times),
+
+				// Create attempt order in Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.shuffleData.OutputParam,
+					testCase.shuffleData.OutputErr,
+				).Times(testCase.shuffleData.Times),
 			)
 
 			// Endpoint setup for test.
@@ -741,12 +944,14 @@ func TestDeleteQuiz(t *testing.T) {
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
 			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl)
 
 			gomock.InOrder(
 				// Check authorization.
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 					testCase.authValidateJWTData.OutputParam1,
 					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
 					testCase.authValidateJWTData.OutputErr,
 				).Times(testCase.authValidateJWTData.Times),
 
@@ -770,8 +975,15 @@ func TestDeleteQuiz(t *testing.T) {
 				).Times(testCase.cassandraDeleteData.Times),
 			)
 
+			// Quizzes are removed from the catalogue once they are deleted.
+			catalogueRemoveTimes := 0
+			if testCase.expectedStatus == http.StatusOK {
+				catalogueRemoveTimes = 1
+			}
+			mockCatalogue.EXPECT().Remove(gomock.Any()).Times(catalogueRemoveTimes)
+
 			// Endpoint setup for test.
-			router.DELETE(testCase.path+":quiz_id", DeleteQuiz(zapLogger, mockAuth, mockCassandra, mockRedis))
+			router.DELETE(testCase.path+":quiz_id", DeleteQuiz(zapLogger, mockAuth, mockCassandra, mockRedis, mockCatalogue))
 			req, _ := http.NewRequest("DELETE", testCase.path+testCase.quizId, nil)
 			w := httptest.NewRecorder()
 			router.ServeHTTP(w, req)
@@ -943,10 +1155,12 @@ func TestPublishQuiz(t *testing.T) {
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
 			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl)
 
 			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 				testCase.authValidateJWTData.OutputParam1,
 				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
 				testCase.authValidateJWTData.OutputErr,
 			).Times(testCase.authValidateJWTData.Times)
 
@@ -963,12 +1177,15 @@ func TestPublishQuiz(t *testing.T) {
 				).Times(testCase.cassandraGetData.Times),
 			)
 
+			// Quizzes are listed in the catalogue whenever they are placed in the cache.
+			mockCatalogue.EXPECT().Add(gomock.Any()).Times(testCase.redisSetData.Times)
+
 			mockRedis.EXPECT().Set(gomock.Any(), gomock.Any()).Return(
 				testCase.redisSetData.Err,
 			).Times(testCase.redisSetData.Times)
 
 			// Endpoint setup for test.
-			router.PATCH(testCase.path+":quiz_id", PublishQuiz(zapLogger, mockAuth, mockCassandra, mockRedis))
+			router.PATCH(testCase.path+":quiz_id", PublishQuiz(zapLogger, mockAuth, mockCassandra, mockRedis, mockCatalogue))
 			req, _ := http.NewRequest("PATCH", testCase.path+testCase.quizId, nil)
 			w := httptest.NewRecorder()
 			router.ServeHTTP(w, req)
@@ -998,6 +1215,7 @@ func TestUpdateQuiz(t *testing.T) {
 		expectedStatus      int
 		quiz                *model_cassandra.QuizCore
 		authValidateJWTData *http_common.MockAuthData
+		cassandraReadData   *http_common.MockCassandraData
 		cassandraUpdateData *http_common.MockCassandraData
 	}{
 		// ----- test cases start ----- //
@@ -1011,6 +1229,9 @@ func TestUpdateQuiz(t *testing.T) {
 				OutputErr:    errors.New("invalid token"),
 				Times:        1,
 			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 			cassandraUpdateData: &http_common.MockCassandraData{
 				OutputErr: nil,
 				Times:     0,
@@ -1024,6 +1245,9 @@ func TestUpdateQuiz(t *testing.T) {
 				OutputParam1: "",
 				Times:        0,
 			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 			cassandraUpdateData: &http_common.MockCassandraData{
 				Times: 0,
 			},
@@ -1037,6 +1261,9 @@ func TestUpdateQuiz(t *testing.T) {
 				OutputParam1: "",
 				Times:        1,
 			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 			cassandraUpdateData: &http_common.MockCassandraData{
 				Times: 0,
 			},
@@ -1050,6 +1277,9 @@ func TestUpdateQuiz(t *testing.T) {
 				OutputParam1: "",
 				Times:        1,
 			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 			cassandraUpdateData: &http_common.MockCassandraData{
 				OutputErr: &cassandra.Error{
 					Message: "",
@@ -1067,6 +1297,9 @@ func TestUpdateQuiz(t *testing.T) {
 				OutputParam1: "",
 				Times:        1,
 			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 			cassandraUpdateData: &http_common.MockCassandraData{
 				OutputErr: &cassandra.Error{
 					Message: "",
@@ -1084,6 +1317,49 @@ func TestUpdateQuiz(t *testing.T) {
 				OutputParam1: "",
 				Times:        1,
 			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraUpdateData: &http_common.MockCassandraData{
+				OutputErr: nil,
+				Times:     1,
+			},
+		}, {
+			name:           "admin, quiz not found",
+			path:           "/update/admin-quiz-not-found/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusNotFound,
+			quiz:           testQuizData["myNoPubQuiz"].QuizCore,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "admin",
+				OutputParam3: model_cassandra.RoleAdmin,
+				Times:        1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{
+					Message: "quiz not found",
+					Status:  http.StatusNotFound,
+				},
+				Times: 1,
+			},
+			cassandraUpdateData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "admin, success",
+			path:           "/update/admin-success/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			quiz:           testQuizData["myNoPubQuiz"].QuizCore,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "admin",
+				OutputParam3: model_cassandra.RoleAdmin,
+				Times:        1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Quiz{Author: "author"},
+				Times:       1,
+			},
 			cassandraUpdateData: &http_common.MockCassandraData{
 				OutputErr: nil,
 				Times:     1,
@@ -1099,14 +1375,28 @@ func TestUpdateQuiz(t *testing.T) {
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
 
-			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
-				testCase.cassandraUpdateData.OutputParam,
-				testCase.cassandraUpdateData.OutputErr,
-			).Times(testCase.cassandraUpdateData.Times)
+			gomock.InOrder(
+				// Quiz author read for administrators.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+				// Quiz update.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ any, params any) (any, error) {
+						if testCase.cassandraReadData.OutputParam != nil {
+							require.Equal(t, "author", params.(*model_cassandra.QuizMutateRequest).Username,
+								"administrators should update the quiz on behalf of its author")
+						}
+						return testCase.cassandraUpdateData.OutputParam, testCase.cassandraUpdateData.OutputErr
+					},
+				).Times(testCase.cassandraUpdateData.Times),
+			)
 
 			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 				testCase.authValidateJWTData.OutputParam1,
 				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
 				testCase.authValidateJWTData.OutputErr,
 			).Times(testCase.authValidateJWTData.Times)
 
@@ -1135,26 +1425,26 @@ func TestUpdateQuiz(t *testing.T) {
 	}
 }
 
-func TestTakeQuiz(t *testing.T) {
+func TestStartQuiz(t *testing.T) {
 	router := http_common.GetTestRouter()
+	closesAt := time.Now().Add(-time.Hour)
 
 	testCases := []struct {
-		name                string
-		path                string
-		quizId              string
-		expectedStatus      int
-		quizResponse        *model_cassandra.QuizResponse
-		authValidateJWTData *http_common.MockAuthData
-		redisGetData        *http_common.MockRedisData
-		cassandraReadData   *http_common.MockCassandraData
-		redisSetData        *http_common.MockRedisData
-		cassandraTakeData   *http_common.MockCassandraData
-		graderData          *http_common.MockGraderData
+		name                  string
+		path                  string
+		quizId                string
+		expectedStatus        int
+		authValidateJWTData   *http_common.MockAuthData
+		redisGetData          *http_common.MockRedisData
+		cassandraReadData     *http_common.MockCassandraData
+		redisSetData          *http_common.MockRedisData
+		cassandraPreviousData *http_common.MockCassandraData
+		cassandraSessionData  *http_common.MockCassandraData
 	}{
 		// ----- test cases start ----- //
 		{
 			name:           "empty token",
-			path:           "/take/empty-token/",
+			path:           "/start/empty-token/",
 			quizId:         gocql.TimeUUID().String(),
 			expectedStatus: http.StatusInternalServerError,
 			authValidateJWTData: &http_common.MockAuthData{
@@ -1171,15 +1461,15 @@ func TestTakeQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
+			cassandraPreviousData: &http_common.MockCassandraData{
 				Times: 0,
 			},
-			graderData: &http_common.MockGraderData{
+			cassandraSessionData: &http_common.MockCassandraData{
 				Times: 0,
 			},
 		}, {
 			name:           "invalid quiz id",
-			path:           "/take/invalid-quiz-id",
+			path:           "/start/invalid-quiz-id/",
 			quizId:         "not a valid uuid",
 			expectedStatus: http.StatusBadRequest,
 			authValidateJWTData: &http_common.MockAuthData{
@@ -1195,375 +1485,252 @@ func TestTakeQuiz(t *testing.T) {
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
+			cassandraPreviousData: &http_common.MockCassandraData{
 				Times: 0,
 			},
-			graderData: &http_common.MockGraderData{
+			cassandraSessionData: &http_common.MockCassandraData{
 				Times: 0,
 			},
 		}, {
-			name:           "request validate failure",
-			path:           "/take/request-validate-failure/",
+			name:           "db read failure",
+			path:           "/start/db-read-failure/",
 			quizId:         gocql.TimeUUID().String(),
-			expectedStatus: http.StatusBadRequest,
-			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{-1}, {1, 2, 3, 4}}},
+			expectedStatus: http.StatusInternalServerError,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Times: 0,
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				Times: 0,
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
 			},
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
+			cassandraPreviousData: &http_common.MockCassandraData{
 				Times: 0,
 			},
-			graderData: &http_common.MockGraderData{
+			cassandraSessionData: &http_common.MockCassandraData{
 				Times: 0,
 			},
 		}, {
-			name:           "db read unauthorized",
-			path:           "/take/db-read-unauthorized/",
+			name:           "quiz unpublished",
+			path:           "/start/quiz-unpublished/",
 			quizId:         gocql.TimeUUID().String(),
 			expectedStatus: http.StatusForbidden,
-			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
+				Param2: model_cassandra.Quiz{QuizCore: &model_cassandra.QuizCore{TimeLimit: 600}},
+				Times:  1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputErr: &cassandra.Error{
-					Message: "",
-					Status:  http.StatusForbidden,
-				},
-				Times: 1,
+				Times: 0,
 			},
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
+			cassandraPreviousData: &http_common.MockCassandraData{
 				Times: 0,
 			},
-			graderData: &http_common.MockGraderData{
+			cassandraSessionData: &http_common.MockCassandraData{
 				Times: 0,
 			},
 		}, {
-			name:           "db read failure",
-			path:           "/take/db-read-failure/",
+			name:           "quiz closed",
+			path:           "/start/quiz-closed/",
 			quizId:         gocql.TimeUUID().String(),
-			expectedStatus: http.StatusInternalServerError,
-			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			expectedStatus: http.StatusForbidden,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
+				Param2: model_cassandra.Quiz{IsPublished: true, ClosesAt: &closesAt, QuizCore: &model_cassandra.QuizCore{TimeLimit: 600}},
+				Times:  1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputErr: &cassandra.Error{
-					Message: "",
-					Status:  http.StatusInternalServerError,
-				},
-				Times: 1,
-			},
-			redisSetData: &http_common.MockRedisData{
-				Times: 0,
-			},
-			cassandraTakeData: &http_common.MockCassandraData{
-				Times: 0,
-			},
-			graderData: &http_common.MockGraderData{
 				Times: 0,
 			},
-		}, {
-			name:           "quiz unpublished",
-			path:           "/take/quiz-unpublished/",
-			quizId:         gocql.TimeUUID().String(),
-			expectedStatus: http.StatusForbidden,
-			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
-			authValidateJWTData: &http_common.MockAuthData{
-				OutputParam1: "",
-				Times:        1,
-			},
-			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
-			},
-			cassandraReadData: &http_common.MockCassandraData{
-				OutputParam: &model_cassandra.Quiz{
-					IsPublished: false,
-				},
-				Times: 1,
-			},
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
+			cassandraPreviousData: &http_common.MockCassandraData{
 				Times: 0,
 			},
-			graderData: &http_common.MockGraderData{
+			cassandraSessionData: &http_common.MockCassandraData{
 				Times: 0,
 			},
 		}, {
-			name:           "quiz deleted",
-			path:           "/take/quiz-deleted/",
+			name:           "previous attempts read failure",
+			path:           "/start/previous-attempts-read-failure/",
 			quizId:         gocql.TimeUUID().String(),
-			expectedStatus: http.StatusForbidden,
-			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			expectedStatus: http.StatusInternalServerError,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{MaxAttempts: 2, AttemptCooldown: 3600, TimeLimit: 600}},
+				Times:  1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputParam: &model_cassandra.Quiz{
-					IsPublished: true,
-					IsDeleted:   true,
-				},
-				Times: 1,
+				Times: 0,
 			},
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
-				Times: 0,
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
 			},
-			graderData: &http_common.MockGraderData{
+			cassandraSessionData: &http_common.MockCassandraData{
 				Times: 0,
 			},
 		}, {
-			name:           "grader failure",
-			path:           "/take/grader-failure/",
+			name:           "quiz not timed",
+			path:           "/start/quiz-not-timed/",
 			quizId:         gocql.TimeUUID().String(),
 			expectedStatus: http.StatusBadRequest,
-			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{}},
+				Times:  1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputParam: &model_cassandra.Quiz{IsPublished: true},
-				OutputErr:   nil,
-				Times:       1,
+				Times: 0,
 			},
 			redisSetData: &http_common.MockRedisData{
-				Times: 1,
-			},
-			cassandraTakeData: &http_common.MockCassandraData{
 				Times: 0,
 			},
-			graderData: &http_common.MockGraderData{
-				OutputErr: errors.New("grader failure"),
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
 				Times:     1,
 			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
-			name:           "db take unauthorized",
-			path:           "/take/db-take-unauthorized/",
+			name:           "attempts exhausted",
+			path:           "/start/attempts-exhausted/",
 			quizId:         gocql.TimeUUID().String(),
 			expectedStatus: http.StatusForbidden,
-			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{TimeLimit: 600}},
+				Times:  1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputParam: &model_cassandra.Quiz{IsPublished: true},
-				OutputErr:   nil,
-				Times:       1,
+				Times: 0,
 			},
 			redisSetData: &http_common.MockRedisData{
-				Times: 1,
+				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
-				OutputErr: &cassandra.Error{
-					Message: "db take auth failure",
-					Status:  http.StatusForbidden,
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Response{
+					Attempts: []*model_cassandra.Attempt{{Number: 1, SubmittedAt: time.Now().Add(-time.Minute)}},
 				},
 				Times: 1,
 			},
-			graderData: &http_common.MockGraderData{
-				OutputParam: 1.333,
-				Times:       1,
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
 			},
 		}, {
-			name:           "db take failure",
-			path:           "/take/db-take-failure/",
+			name:           "attempt cooldown",
+			path:           "/start/attempt-cooldown/",
 			quizId:         gocql.TimeUUID().String(),
-			expectedStatus: http.StatusInternalServerError,
-			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			expectedStatus: http.StatusTooManyRequests,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{MaxAttempts: 2, AttemptCooldown: 3600, TimeLimit: 600}},
+				Times:  1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputParam: &model_cassandra.Quiz{IsPublished: true},
-				OutputErr:   nil,
-				Times:       1,
+				Times: 0,
 			},
 			redisSetData: &http_common.MockRedisData{
-				Times: 1,
-			},
-			cassandraTakeData: &http_common.MockCassandraData{
-				OutputErr: &cassandra.Error{
-					Message: "db take auth failure",
-					Status:  http.StatusInternalServerError,
-				},
-				Times: 1,
-			},
-			graderData: &http_common.MockGraderData{
-				OutputParam: 1.333,
-				Times:       1,
-			},
-		}, {
-			name:           "success",
-			path:           "/take/success/",
-			quizId:         gocql.TimeUUID().String(),
-			expectedStatus: http.StatusOK,
-			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
-			authValidateJWTData: &http_common.MockAuthData{
-				OutputParam1: "",
-				Times:        1,
+				Times: 0,
 			},
-			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Response{
+					Attempts: []*model_cassandra.Attempt{{Number: 1, SubmittedAt: time.Now().Add(-time.Minute)}},
 				},
 				Times: 1,
 			},
-			cassandraReadData: &http_common.MockCassandraData{
-				OutputParam: &model_cassandra.Quiz{IsPublished: true},
-				OutputErr:   nil,
-				Times:       1,
-			},
-			redisSetData: &http_common.MockRedisData{
-				Times: 1,
-			},
-			cassandraTakeData: &http_common.MockCassandraData{
-				Times: 1,
-			},
-			graderData: &http_common.MockGraderData{
-				OutputParam: 1.333,
-				Times:       1,
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
 			},
 		}, {
-			name:           "success - cache set failure",
-			path:           "/take/success-cache-set-failure/",
+			name:           "db start failure",
+			path:           "/start/db-start-failure/",
 			quizId:         gocql.TimeUUID().String(),
-			expectedStatus: http.StatusOK,
-			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			expectedStatus: http.StatusInternalServerError,
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{},
-				Err: &redis.Error{
-					Message: "cache miss",
-					Code:    redis.ErrorCacheMiss,
-				},
-				Times: 1,
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{MaxAttempts: 2, AttemptCooldown: 3600, TimeLimit: 600}},
+				Times:  1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputParam: &model_cassandra.Quiz{IsPublished: true},
-				OutputErr:   nil,
-				Times:       1,
+				Times: 0,
 			},
 			redisSetData: &http_common.MockRedisData{
-				Err: &redis.Error{
-					Message: "internal error",
-					Code:    redis.ErrorUnknown,
-				},
-				Times: 1,
+				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
-				Times: 1,
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
 			},
-			graderData: &http_common.MockGraderData{
-				OutputParam: 1.333,
-				Times:       1,
+			cassandraSessionData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
 			},
 		}, {
-			name:           "success - cache hit",
-			path:           "/take/success-cache-hit/",
+			name:           "success",
+			path:           "/start/success/",
 			quizId:         gocql.TimeUUID().String(),
 			expectedStatus: http.StatusOK,
-			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
 			authValidateJWTData: &http_common.MockAuthData{
 				OutputParam1: "",
 				Times:        1,
 			},
 			redisGetData: &http_common.MockRedisData{
-				Param2: model_cassandra.Quiz{IsPublished: true},
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{MaxAttempts: 2, AttemptCooldown: 3600, TimeLimit: 600}},
 				Times:  1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputErr: nil,
-				Times:     0,
+				Times: 0,
 			},
 			redisSetData: &http_common.MockRedisData{
 				Times: 0,
 			},
-			cassandraTakeData: &http_common.MockCassandraData{
-				Times: 1,
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
 			},
-			graderData: &http_common.MockGraderData{
-				OutputParam: 1.333,
+			cassandraSessionData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AttemptSession{Attempt: 1, Deadline: time.Now().Add(10 * time.Minute)},
 				Times:       1,
 			},
 		},
@@ -1577,13 +1744,13 @@ func TestTakeQuiz(t *testing.T) {
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
 			mockRedis := mocks.NewMockRedis(mockCtrl)
-			mockGrader := mocks.NewMockGrading(mockCtrl)
 
 			gomock.InOrder(
 				// Validate JWT.
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 					testCase.authValidateJWTData.OutputParam1,
 					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
 					testCase.authValidateJWTData.OutputErr,
 				).Times(testCase.authValidateJWTData.Times),
 
@@ -1595,7 +1762,7 @@ func TestTakeQuiz(t *testing.T) {
 					testCase.redisGetData.Err,
 				).Times(testCase.redisGetData.Times),
 
-				// Read quizResponse.
+				// Read quiz.
 				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
 					testCase.cassandraReadData.OutputParam,
 					testCase.cassandraReadData.OutputErr,
@@ -1606,41 +1773,3737 @@ func TestTakeQuiz(t *testing.T) {
 					testCase.redisSetData.Err,
 				).Times(testCase.redisSetData.Times),
 
-				// Grade quiz.
-				mockGrader.EXPECT().Grade(gomock.Any(), gomock.Any()).Return(
-					testCase.graderData.OutputParam,
-					testCase.graderData.OutputErr,
-				).Times(testCase.graderData.Times),
+				// Read previous attempts.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraPreviousData.OutputParam,
+					testCase.cassandraPreviousData.OutputErr,
+				).Times(testCase.cassandraPreviousData.Times),
 
-				// Submit response.
+				// Start attempt session.
 				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
-					testCase.cassandraTakeData.OutputParam,
-					testCase.cassandraTakeData.OutputErr,
-				).Times(testCase.cassandraTakeData.Times),
+					testCase.cassandraSessionData.OutputParam,
+					testCase.cassandraSessionData.OutputErr,
+				).Times(testCase.cassandraSessionData.Times),
 			)
 
-			responseJson, err := json.Marshal(&testCase.quizResponse)
-			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
-
 			// Endpoint setup for test.
-			router.POST(testCase.path+":quiz_id", TakeQuiz(zapLogger, mockAuth, mockCassandra, mockRedis, mockGrader))
-			req, _ := http.NewRequest("POST", testCase.path+testCase.quizId, bytes.NewBuffer(responseJson))
+			router.POST(testCase.path+":quiz_id", StartQuiz(zapLogger, mockAuth, mockCassandra, mockRedis))
+			req, _ := http.NewRequest("POST", testCase.path+testCase.quizId, nil)
 			w := httptest.NewRecorder()
 			router.ServeHTTP(w, req)
 
 			// Verify responses
 			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
 
-			// Check message and quizResponse id.
+			// Check the attempt and time remaining.
 			if testCase.expectedStatus == http.StatusOK {
 				response := model_http.Success{}
 				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body")
 
-				require.True(t, len(response.Message) != 0, "did not receive quiz response message")
-
 				responseMap, ok := response.Payload.(map[string]any)
 				require.True(t, ok, "failed to convert payload to an index-able map")
-				require.NotEqual(t, 0, responseMap["score"], "failed to get score from payload")
+				require.Equal(t, float64(1), responseMap["attempt"], "attempt number mismatch")
+				require.InDelta(t, 600, responseMap["remaining_time"], 1, "remaining time mismatch")
+			}
+		})
+	}
+}
+
+func TestTakeQuiz(t *testing.T) {
+	router := http_common.GetTestRouter()
+	closesAt := time.Now().Add(-time.Hour)
+	shuffledQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	shuffledQuiz.Shuffle = true
+	shuffledResponse := &model_cassandra.QuizResponse{Responses: [][]int32{{0}, {1}}}
+	revealedQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	revealedQuiz.RevealPolicy = model_cassandra.RevealImmediately
+
+	testCases := []struct {
+		name                  string
+		path                  string
+		quizId                string
+		expectedStatus        int
+		quizResponse          *model_cassandra.QuizResponse
+		authValidateJWTData   *http_common.MockAuthData
+		redisGetData          *http_common.MockRedisData
+		cassandraReadData     *http_common.MockCassandraData
+		redisSetData          *http_common.MockRedisData
+		cassandraPreviousData *http_common.MockCassandraData
+		cassandraSessionData  *http_common.MockCassandraData
+		cassandraShuffleData  *http_common.MockCassandraData
+		cassandraTakeData     *http_common.MockCassandraData
+		graderData            *http_common.MockGraderData
+		breakdownTimes        int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:           "empty token",
+			path:           "/take/empty-token/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "invalid quiz id",
+			path:           "/take/invalid-quiz-id",
+			quizId:         "not a valid uuid",
+			expectedStatus: http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        0,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "request validate failure",
+			path:           "/take/request-validate-failure/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusBadRequest,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{-1}, {1, 2, 3, 4}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "db read unauthorized",
+			path:           "/take/db-read-unauthorized/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusForbidden,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{
+					Message: "",
+					Status:  http.StatusForbidden,
+				},
+				Times: 1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "db read failure",
+			path:           "/take/db-read-failure/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{
+					Message: "",
+					Status:  http.StatusInternalServerError,
+				},
+				Times: 1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "quiz unpublished",
+			path:           "/take/quiz-unpublished/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusForbidden,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Quiz{
+					IsPublished: false,
+				},
+				Times: 1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "quiz deleted",
+			path:           "/take/quiz-deleted/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusForbidden,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Quiz{
+					IsPublished: true,
+					IsDeleted:   true,
+				},
+				Times: 1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "quiz closed",
+			path:           "/take/quiz-closed/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusForbidden,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true, ClosesAt: &closesAt},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "grader failure",
+			path:           "/take/grader-failure/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusBadRequest,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Quiz{IsPublished: true},
+				OutputErr:   nil,
+				Times:       1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputErr: errors.New("grader failure"),
+				Times:     1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "db take unauthorized",
+			path:           "/take/db-take-unauthorized/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusForbidden,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Quiz{IsPublished: true},
+				OutputErr:   nil,
+				Times:       1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{
+					Message: "db take auth failure",
+					Status:  http.StatusForbidden,
+				},
+				Times: 1,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1.333,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "db take failure",
+			path:           "/take/db-take-failure/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Quiz{IsPublished: true},
+				OutputErr:   nil,
+				Times:       1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{
+					Message: "db take auth failure",
+					Status:  http.StatusInternalServerError,
+				},
+				Times: 1,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1.333,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "success",
+			path:           "/take/success/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Quiz{IsPublished: true},
+				OutputErr:   nil,
+				Times:       1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1.333,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "success - cache set failure",
+			path:           "/take/success-cache-set-failure/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err: &redis.Error{
+					Message: "cache miss",
+					Code:    redis.ErrorCacheMiss,
+				},
+				Times: 1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Quiz{IsPublished: true},
+				OutputErr:   nil,
+				Times:       1,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Err: &redis.Error{
+					Message: "internal error",
+					Code:    redis.ErrorUnknown,
+				},
+				Times: 1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1.333,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "success - cache hit",
+			path:           "/take/success-cache-hit/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: nil,
+				Times:     0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1.333,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "previous attempts read failure",
+			path:           "/take/previous-attempts-read-failure/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "attempts exhausted",
+			path:           "/take/attempts-exhausted/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusForbidden,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{MaxAttempts: 2}},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Response{Attempts: []*model_cassandra.Attempt{{Number: 1}, {Number: 2}}},
+				Times:       1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "attempt cooldown",
+			path:           "/take/attempt-cooldown/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusTooManyRequests,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{MaxAttempts: 2, AttemptCooldown: 3600}},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Response{Attempts: []*model_cassandra.Attempt{{Number: 1, SubmittedAt: time.Now()}}},
+				Times:       1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "concurrent attempt",
+			path:           "/take/concurrent-attempt/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusConflict,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{MaxAttempts: 2}},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Response{Attempts: []*model_cassandra.Attempt{{Number: 1}}},
+				Times:       1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "conflict", Status: http.StatusConflict},
+				Times:     1,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "success - second attempt",
+			path:           "/take/success-second-attempt/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{MaxAttempts: 2, AttemptCooldown: 60}},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.Response{
+					Attempts: []*model_cassandra.Attempt{{Number: 1, Score: 0.5, MaxScore: 2, SubmittedAt: time.Now().Add(-time.Hour)}},
+				},
+				Times: 1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1.333,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "timed attempt not started",
+			path:           "/take/timed-attempt-not-started/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusForbidden,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{TimeLimit: 600, GracePeriod: 30}},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "timed attempt session read failure",
+			path:           "/take/timed-attempt-session-read-failure/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{TimeLimit: 600, GracePeriod: 30}},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "success - timed attempt within grace period",
+			path:           "/take/success-timed-attempt-grace/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{TimeLimit: 600, GracePeriod: 30}},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AttemptSession{Attempt: 1, Deadline: time.Now().Add(-10 * time.Second)},
+				Times:       1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1.333,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "success - timed attempt late",
+			path:           "/take/success-timed-attempt-late/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{IsPublished: true, QuizCore: &model_cassandra.QuizCore{TimeLimit: 600, GracePeriod: 30}},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AttemptSession{Attempt: 1, Deadline: time.Now().Add(-time.Minute)},
+				Times:       1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1.333,
+				OutputMax:   2,
+				Times:       1,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "shuffled attempt not viewed",
+			path:           "/take/shuffled-attempt-not-viewed/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusForbidden,
+			quizResponse:   shuffledResponse,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *shuffledQuiz,
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "attempt has not been viewed", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+		}, {
+			name:           "shuffled attempt read failure",
+			path:           "/take/shuffled-attempt-read-failure/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			quizResponse:   shuffledResponse,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *shuffledQuiz,
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			graderData: &http_common.MockGraderData{
+				Times: 0,
+			},
+		}, {
+			name:           "success - shuffled attempt",
+			path:           "/take/success-shuffled-attempt/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			quizResponse:   shuffledResponse,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *shuffledQuiz,
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AttemptShuffle{Attempt: 1, Seed: 42},
+				Times:       1,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+			graderData: &http_common.MockGraderData{
+				InputQuizResp: http_common.CanonicalResponse(shuffledQuiz.QuizCore, shuffledResponse, 42),
+				OutputParam:   1,
+				OutputMax:     2,
+				Times:         1,
+			},
+		}, {
+			name:           "success - answers revealed",
+			path:           "/take/success-answers-revealed/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			quizResponse:   &model_cassandra.QuizResponse{Responses: [][]int32{{0}, {1}}},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *revealedQuiz,
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			redisSetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraPreviousData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraSessionData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraShuffleData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+			cassandraTakeData: &http_common.MockCassandraData{
+				Times: 1,
+			},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 1,
+				OutputMax:   2,
+				Times:       1,
+			},
+			breakdownTimes: 1,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrader := mocks.NewMockGrading(mockCtrl)
+
+			summaryEvictions := 0
+			if testCase.expectedStatus == http.StatusOK {
+				summaryEvictions = 1
+			}
+
+			// Shuffled responses must be graded in the canonical order.
+			var gradedResponse gomock.Matcher = gomock.Any()
+			if testCase.graderData.InputQuizResp != nil {
+				gradedResponse = gomock.Eq(testCase.graderData.InputQuizResp)
+			}
+
+			gomock.InOrder(
+				// Validate JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
+
+				// Read quizResponse.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+
+				// Cache set.
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any()).Return(
+					testCase.redisSetData.Err,
+				).Times(testCase.redisSetData.Times),
+
+				// Read previous attempts.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraPreviousData.OutputParam,
+					testCase.cassandraPreviousData.OutputErr,
+				).Times(testCase.cassandraPreviousData.Times),
+
+				// Read attempt session.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraSessionData.OutputParam,
+					testCase.cassandraSessionData.OutputErr,
+				).Times(testCase.cassandraSessionData.Times),
+
+				// Read attempt order.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraShuffleData.OutputParam,
+					testCase.cassandraShuffleData.OutputErr,
+				).Times(testCase.cassandraShuffleData.Times),
+
+				// Grade quiz.
+				mockGrader.EXPECT().Grade(gradedResponse, gomock.Any()).Return(
+					testCase.graderData.OutputParam,
+					testCase.graderData.OutputMax,
+					testCase.graderData.OutputErr,
+				).Times(testCase.graderData.Times),
+
+				// Break down the questions of quizzes that reveal their answers.
+				mockGrader.EXPECT().GradeQuestions(gomock.Any(), gomock.Any()).Return(
+					[]float64{1, 0},
+					[]bool{true, false},
+					nil,
+				).Times(testCase.breakdownTimes),
+
+				// Submit response.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraTakeData.OutputParam,
+					testCase.cassandraTakeData.OutputErr,
+				).Times(testCase.cassandraTakeData.Times),
+
+				// Evict the statistics summary once the response is recorded.
+				mockRedis.EXPECT().Del(gomock.Any()).Return(nil).Times(summaryEvictions),
+			)
+
+			responseJson, err := json.Marshal(&testCase.quizResponse)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path+":quiz_id", TakeQuiz(zapLogger, mockAuth, mockCassandra, mockRedis, mockGrader))
+			req, _ := http.NewRequest("POST", testCase.path+testCase.quizId, bytes.NewBuffer(responseJson))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check message and quizResponse id.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.Success{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body")
+
+				require.True(t, len(response.Message) != 0, "did not receive quiz response message")
+
+				responseMap, ok := response.Payload.(map[string]any)
+				require.True(t, ok, "failed to convert payload to an index-able map")
+				require.NotEqual(t, 0, responseMap["score"], "failed to get score from payload")
+				require.Equal(t, testCase.graderData.OutputMax, responseMap["max_score"], "failed to get maximum score from payload")
+				require.NotEmpty(t, responseMap["attempts"], "failed to get attempt history from payload")
+
+				if testCase.breakdownTimes > 0 {
+					breakdown, ok := responseMap["breakdown"].([]any)
+					require.True(t, ok, "failed to get breakdown from payload")
+					require.Len(t, breakdown, len(revealedQuiz.Questions), "breakdown should have every question")
+				} else {
+					require.Nil(t, responseMap["breakdown"], "answers should not be revealed")
+				}
+			}
+		})
+	}
+}
+
+func TestPracticeQuiz(t *testing.T) {
+	router := http_common.GetTestRouter()
+	closedQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	closedQuiz.IsClosed = true
+	revealedQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	revealedQuiz.RevealPolicy = model_cassandra.RevealImmediately
+	shuffledQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	shuffledQuiz.Shuffle = true
+	drawnQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	drawnQuiz.Questions = nil
+	drawnQuiz.Draw = &model_cassandra.QuestionDraw{Banks: []string{gocql.TimeUUID().String()}, Count: 1}
+
+	testCases := []struct {
+		name                string
+		path                string
+		quizId              string
+		data                string
+		expectedStatus      int
+		expectAnswers       bool
+		authValidateJWTData *http_common.MockAuthData
+		redisIncrData       *http_common.MockRedisData
+		redisGetData        *http_common.MockRedisData
+		cassandraReadData   *http_common.MockCassandraData
+		responseReadData    *http_common.MockCassandraData
+		shuffleData         *http_common.MockCassandraData
+		graderData          *http_common.MockGraderData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:           "empty token",
+			path:           "/practice/empty-token/",
+			quizId:         gocql.TimeUUID().String(),
+			data:           `{"question": 0, "response": [2]}`,
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisIncrData:     &http_common.MockRedisData{Times: 0},
+			redisGetData:      &http_common.MockRedisData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			responseReadData:  &http_common.MockCassandraData{Times: 0},
+			shuffleData:       &http_common.MockCassandraData{Times: 0},
+			graderData:        &http_common.MockGraderData{Times: 0},
+		}, {
+			name:                "invalid quiz id",
+			path:                "/practice/invalid-quiz-id/",
+			quizId:              "not a valid uuid",
+			data:                `{"question": 0, "response": [2]}`,
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisIncrData:       &http_common.MockRedisData{Times: 0},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			shuffleData:         &http_common.MockCassandraData{Times: 0},
+			graderData:          &http_common.MockGraderData{Times: 0},
+		}, {
+			name:                "empty request",
+			path:                "/practice/empty-request/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                ``,
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisIncrData:       &http_common.MockRedisData{Times: 0},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			shuffleData:         &http_common.MockCassandraData{Times: 0},
+			graderData:          &http_common.MockGraderData{Times: 0},
+		}, {
+			name:                "validation failure",
+			path:                "/practice/validation-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"question": -1, "response": [2]}`,
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisIncrData:       &http_common.MockRedisData{Times: 0},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			shuffleData:         &http_common.MockCassandraData{Times: 0},
+			graderData:          &http_common.MockGraderData{Times: 0},
+		}, {
+			name:                "rate limited",
+			path:                "/practice/rate-limited/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"question": 0, "response": [2]}`,
+			expectedStatus:      http.StatusTooManyRequests,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisIncrData:       &http_common.MockRedisData{Param2: int64(http_common.PracticeRateLimit + 1), Times: 1},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			shuffleData:         &http_common.MockCassandraData{Times: 0},
+			graderData:          &http_common.MockGraderData{Times: 0},
+		}, {
+			name:                "quiz read failure",
+			path:                "/practice/quiz-read-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"question": 0, "response": [2]}`,
+			expectedStatus:      http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisIncrData:       &http_common.MockRedisData{Param2: int64(1), Times: 1},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err:    &redis.Error{Message: "cache miss error", Code: redis.ErrorCacheMiss},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			responseReadData: &http_common.MockCassandraData{Times: 0},
+			shuffleData:      &http_common.MockCassandraData{Times: 0},
+			graderData:       &http_common.MockGraderData{Times: 0},
+		}, {
+			name:                "unpublished quiz",
+			path:                "/practice/unpublished-quiz/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"question": 0, "response": [2]}`,
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisIncrData:       &http_common.MockRedisData{Param2: int64(1), Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *cassandra.GetTestQuizzes()["myNoPubQuiz"], Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			shuffleData:         &http_common.MockCassandraData{Times: 0},
+			graderData:          &http_common.MockGraderData{Times: 0},
+		}, {
+			name:                "question out of range",
+			path:                "/practice/question-out-of-range/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"question": 2, "response": [0]}`,
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisIncrData:       &http_common.MockRedisData{Param2: int64(1), Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *cassandra.GetTestQuizzes()["myPubQuiz"], Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			shuffleData:         &http_common.MockCassandraData{Times: 0},
+			graderData:          &http_common.MockGraderData{Times: 0},
+		}, {
+			name:                "grading failure",
+			path:                "/practice/grading-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"question": 0, "response": [0, 1]}`,
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisIncrData:       &http_common.MockRedisData{Param2: int64(1), Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *cassandra.GetTestQuizzes()["myPubQuiz"], Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			shuffleData:         &http_common.MockCassandraData{Times: 0},
+			graderData:          &http_common.MockGraderData{OutputErr: errors.New("grading failure"), Times: 1},
+		}, {
+			name:                "success",
+			path:                "/practice/success/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"question": 0, "response": [2]}`,
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisIncrData:       &http_common.MockRedisData{Param2: int64(1), Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *cassandra.GetTestQuizzes()["myPubQuiz"], Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			shuffleData:         &http_common.MockCassandraData{Times: 0},
+			graderData:          &http_common.MockGraderData{Times: 1},
+		}, {
+			name:                "success - cache failure",
+			path:                "/practice/success-cache-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"question": 0, "response": [2]}`,
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisIncrData: &http_common.MockRedisData{
+				Param2: int64(0),
+				Err:    &redis.Error{Message: "cache failure", Code: redis.ErrorCacheIncr},
+				Times:  1,
+			},
+			redisGetData:      &http_common.MockRedisData{Param2: *cassandra.GetTestQuizzes()["myPubQuiz"], Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			responseReadData:  &http_common.MockCassandraData{Times: 0},
+			shuffleData:       &http_common.MockCassandraData{Times: 0},
+			graderData:        &http_common.MockGraderData{Times: 1},
+		}, {
+			name:                "success - closed quiz",
+			path:                "/practice/success-closed-quiz/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"question": 0, "response": [2]}`,
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisIncrData:       &http_common.MockRedisData{Param2: int64(1), Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *closedQuiz, Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			shuffleData:         &http_common.MockCassandraData{Times: 0},
+			graderData:          &http_common.MockGraderData{Times: 1},
+		}, {
+			name:                "success - answers revealed",
+			path:                "/practice/success-answers-revealed/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"question": 0, "response": [2]}`,
+			expectedStatus:      http.StatusOK,
+			expectAnswers:       true,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisIncrData:       &http_common.MockRedisData{Param2: int64(1), Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *revealedQuiz, Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			shuffleData:         &http_common.MockCassandraData{Times: 0},
+			graderData:          &http_common.MockGraderData{Times: 1},
+		}, {
+			name:                "success - shuffled quiz",
+			path:                "/practice/success-shuffled-quiz/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"question": 1, "response": [0]}`,
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisIncrData:       &http_common.MockRedisData{Param2: int64(1), Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *shuffledQuiz, Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			responseReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			shuffleData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AttemptShuffle{Attempt: 1, Seed: 42},
+				Times:       1,
+			},
+			graderData: &http_common.MockGraderData{Times: 1},
+		}, {
+			name:           "success - administrator shuffled quiz",
+			path:           "/practice/success-administrator-shuffled-quiz/",
+			quizId:         gocql.TimeUUID().String(),
+			data:           `{"question": 0, "response": [2]}`,
+			expectedStatus: http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "admin",
+				OutputParam3: model_cassandra.RoleAdmin,
+				Times:        1,
+			},
+			redisIncrData:     &http_common.MockRedisData{Param2: int64(1), Times: 1},
+			redisGetData:      &http_common.MockRedisData{Param2: *shuffledQuiz, Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			responseReadData:  &http_common.MockCassandraData{Times: 0},
+			shuffleData:       &http_common.MockCassandraData{Times: 0},
+			graderData:        &http_common.MockGraderData{Times: 1},
+		}, {
+			name:           "administrator drawn quiz",
+			path:           "/practice/administrator-drawn-quiz/",
+			quizId:         gocql.TimeUUID().String(),
+			data:           `{"question": 0, "response": [2]}`,
+			expectedStatus: http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "admin",
+				OutputParam3: model_cassandra.RoleAdmin,
+				Times:        1,
+			},
+			redisIncrData:     &http_common.MockRedisData{Param2: int64(1), Times: 1},
+			redisGetData:      &http_common.MockRedisData{Param2: *drawnQuiz, Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			responseReadData:  &http_common.MockCassandraData{Times: 0},
+			shuffleData:       &http_common.MockCassandraData{Times: 0},
+			graderData:        &http_common.MockGraderData{Times: 0},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrader := mocks.NewMockGrading(mockCtrl)
+
+			var practiceCount int64
+			if testCase.redisIncrData.Param2 != nil {
+				practiceCount = testCase.redisIncrData.Param2.(int64)
+			}
+
+			gomock.InOrder(
+				// Check JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Count the practice check.
+				mockRedis.EXPECT().Incr(gomock.Any(), http_common.PracticeRateWindow).Return(
+					practiceCount,
+					testCase.redisIncrData.Err,
+				).Times(testCase.redisIncrData.Times),
+
+				// Get quiz from Redis.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
+
+				// Get quiz from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+
+				// Get previous attempts from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.responseReadData.OutputParam,
+					testCase.responseReadData.OutputErr,
+				).Times(testCase.responseReadData.Times),
+
+				// Create attempt order in Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.shuffleData.OutputParam,
+					testCase.shuffleData.OutputErr,
+				).Times(testCase.shuffleData.Times),
+
+				// Grade the question.
+				mockGrader.EXPECT().GradeQuestions(gomock.Any(), gomock.Any()).Return(
+					[]float64{1},
+					[]bool{true},
+					testCase.graderData.OutputErr,
+				).Times(testCase.graderData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path+":quiz_id", PracticeQuiz(zapLogger, mockAuth, mockCassandra, mockRedis, mockGrader))
+			req, _ := http.NewRequest("POST", testCase.path+testCase.quizId, bytes.NewBufferString(testCase.data))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check the result of the practice check.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.Success{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body")
+
+				result, ok := response.Payload.(map[string]any)
+				require.True(t, ok, "failed to convert payload to an index-able map")
+				require.Equal(t, true, result["correct"], "correctness mismatch")
+				require.InDelta(t, 1, result["points"], 0.01, "points mismatch")
+
+				_, found := result["answers"]
+				require.Equal(t, testCase.expectAnswers, found, "answer key condition failed")
+			}
+		})
+	}
+}
+
+func TestSaveDraft(t *testing.T) {
+	router := http_common.GetTestRouter()
+	pubQuiz := *cassandra.GetTestQuizzes()["myPubQuiz"]
+	exhausted := &model_cassandra.Response{Attempts: []*model_cassandra.Attempt{{Number: 1, SubmittedAt: time.Now()}}}
+	cacheMiss := &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss}
+
+	testCases := []struct {
+		name                string
+		path                string
+		quizId              string
+		data                string
+		expectedStatus      int
+		authValidateJWTData *http_common.MockAuthData
+		redisGetData        *http_common.MockRedisData
+		cassandraReadData   *http_common.MockCassandraData
+		redisSetData        *http_common.MockRedisData
+		responseReadData    *http_common.MockCassandraData
+		draftSaveData       *http_common.MockCassandraData
+		redisSetTTLData     *http_common.MockRedisData
+		redisDelData        *http_common.MockRedisData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:           "empty token",
+			path:           "/draft-save/empty-token/",
+			quizId:         gocql.TimeUUID().String(),
+			data:           `{"responses": [[2], []]}`,
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisGetData:      &http_common.MockRedisData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			redisSetData:      &http_common.MockRedisData{Times: 0},
+			responseReadData:  &http_common.MockCassandraData{Times: 0},
+			draftSaveData:     &http_common.MockCassandraData{Times: 0},
+			redisSetTTLData:   &http_common.MockRedisData{Times: 0},
+			redisDelData:      &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "invalid quiz id",
+			path:                "/draft-save/invalid-quiz-id/",
+			quizId:              "not a valid uuid",
+			data:                `{"responses": [[2], []]}`,
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			draftSaveData:       &http_common.MockCassandraData{Times: 0},
+			redisSetTTLData:     &http_common.MockRedisData{Times: 0},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "empty request",
+			path:                "/draft-save/empty-request/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                ``,
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			draftSaveData:       &http_common.MockCassandraData{Times: 0},
+			redisSetTTLData:     &http_common.MockRedisData{Times: 0},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "validation failure",
+			path:                "/draft-save/validation-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{}`,
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			draftSaveData:       &http_common.MockCassandraData{Times: 0},
+			redisSetTTLData:     &http_common.MockRedisData{Times: 0},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "quiz read failure",
+			path:                "/draft-save/quiz-read-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"responses": [[2], []]}`,
+			expectedStatus:      http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Err: cacheMiss, Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			redisSetData:     &http_common.MockRedisData{Times: 0},
+			responseReadData: &http_common.MockCassandraData{Times: 0},
+			draftSaveData:    &http_common.MockCassandraData{Times: 0},
+			redisSetTTLData:  &http_common.MockRedisData{Times: 0},
+			redisDelData:     &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "quiz unpublished",
+			path:                "/draft-save/quiz-unpublished/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"responses": [[2], []]}`,
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *cassandra.GetTestQuizzes()["myNoPubQuiz"], Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			draftSaveData:       &http_common.MockCassandraData{Times: 0},
+			redisSetTTLData:     &http_common.MockRedisData{Times: 0},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "attempts exhausted",
+			path:                "/draft-save/attempts-exhausted/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"responses": [[2], []]}`,
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: pubQuiz, Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{OutputParam: exhausted, Times: 1},
+			draftSaveData:       &http_common.MockCassandraData{Times: 0},
+			redisSetTTLData:     &http_common.MockRedisData{Times: 0},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "draft save failure",
+			path:                "/draft-save/draft-save-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"responses": [[2], []]}`,
+			expectedStatus:      http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: pubQuiz, Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+			responseReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			draftSaveData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "failed to save draft", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			redisSetTTLData: &http_common.MockRedisData{Times: 0},
+			redisDelData:    &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "success",
+			path:                "/draft-save/success/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"responses": [[2], []]}`,
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: pubQuiz, Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+			responseReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			draftSaveData:   &http_common.MockCassandraData{Times: 1},
+			redisSetTTLData: &http_common.MockRedisData{Times: 1},
+			redisDelData:    &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "success with cache failure",
+			path:                "/draft-save/success-cache-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			data:                `{"responses": [[2], []]}`,
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: pubQuiz, Times: 1},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+			responseReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			draftSaveData: &http_common.MockCassandraData{Times: 1},
+			redisSetTTLData: &http_common.MockRedisData{
+				Err:   &redis.Error{Message: "cache failure", Code: redis.ErrorCacheSet},
+				Times: 1,
+			},
+			redisDelData: &http_common.MockRedisData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				// Check JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Get quiz from Redis.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
+
+				// Get quiz from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+
+				// Set quiz in Redis.
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any()).Return(
+					testCase.redisSetData.Err,
+				).Times(testCase.redisSetData.Times),
+
+				// Get previous attempts from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.responseReadData.OutputParam,
+					testCase.responseReadData.OutputErr,
+				).Times(testCase.responseReadData.Times),
+
+				// Save draft in Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.draftSaveData.OutputParam,
+					testCase.draftSaveData.OutputErr,
+				).Times(testCase.draftSaveData.Times),
+
+				// Set draft in Redis.
+				mockRedis.EXPECT().SetTTL(gomock.Any(), gomock.Any(), http_common.DraftTTL).Return(
+					testCase.redisSetTTLData.Err,
+				).Times(testCase.redisSetTTLData.Times),
+
+				// Evict draft from Redis.
+				mockRedis.EXPECT().Del(gomock.Any()).Return(
+					testCase.redisDelData.Err,
+				).Times(testCase.redisDelData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.PUT(testCase.path+":quiz_id", SaveDraft(zapLogger, mockAuth, mockCassandra, mockRedis))
+			req, _ := http.NewRequest("PUT", testCase.path+testCase.quizId, bytes.NewBufferString(testCase.data))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check the saved draft.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.Success{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body")
+
+				draft, ok := response.Payload.(map[string]any)
+				require.True(t, ok, "failed to convert payload to an index-able map")
+				require.Equal(t, float64(1), draft["attempt"], "attempt number mismatch")
+				require.Len(t, draft["responses"], 2, "draft responses mismatch")
+			}
+		})
+	}
+}
+
+func TestResumeDraft(t *testing.T) {
+	router := http_common.GetTestRouter()
+	cacheMiss := &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss}
+	draft := &model_cassandra.AnswerDraft{Attempt: 1, QuizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{2}, {}}}}
+
+	testCases := []struct {
+		name                string
+		path                string
+		quizId              string
+		expectedStatus      int
+		authValidateJWTData *http_common.MockAuthData
+		responseReadData    *http_common.MockCassandraData
+		redisGetData        *http_common.MockRedisData
+		draftReadData       *http_common.MockCassandraData
+		redisSetTTLData     *http_common.MockRedisData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:           "empty token",
+			path:           "/draft-resume/empty-token/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			responseReadData: &http_common.MockCassandraData{Times: 0},
+			redisGetData:     &http_common.MockRedisData{Times: 0},
+			draftReadData:    &http_common.MockCassandraData{Times: 0},
+			redisSetTTLData:  &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "invalid quiz id",
+			path:                "/draft-resume/invalid-quiz-id/",
+			quizId:              "not a valid uuid",
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			draftReadData:       &http_common.MockCassandraData{Times: 0},
+			redisSetTTLData:     &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "previous attempts read failure",
+			path:                "/draft-resume/previous-attempts-read-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			responseReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			redisGetData:    &http_common.MockRedisData{Times: 0},
+			draftReadData:   &http_common.MockCassandraData{Times: 0},
+			redisSetTTLData: &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "draft not saved",
+			path:                "/draft-resume/draft-not-saved/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			responseReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			redisGetData: &http_common.MockRedisData{Param2: model_cassandra.AnswerDraft{}, Err: cacheMiss, Times: 1},
+			draftReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "draft has not been saved", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			redisSetTTLData: &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "success from cache",
+			path:                "/draft-resume/success-cache/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			responseReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			redisGetData:    &http_common.MockRedisData{Param2: *draft, Times: 1},
+			draftReadData:   &http_common.MockCassandraData{Times: 0},
+			redisSetTTLData: &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "success from database",
+			path:                "/draft-resume/success-database/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			responseReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			redisGetData:    &http_common.MockRedisData{Param2: model_cassandra.AnswerDraft{}, Err: cacheMiss, Times: 1},
+			draftReadData:   &http_common.MockCassandraData{OutputParam: draft, Times: 1},
+			redisSetTTLData: &http_common.MockRedisData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				// Check JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Get previous attempts from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.responseReadData.OutputParam,
+					testCase.responseReadData.OutputErr,
+				).Times(testCase.responseReadData.Times),
+
+				// Get draft from Redis.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
+
+				// Get draft from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.draftReadData.OutputParam,
+					testCase.draftReadData.OutputErr,
+				).Times(testCase.draftReadData.Times),
+
+				// Set draft in Redis.
+				mockRedis.EXPECT().SetTTL(gomock.Any(), gomock.Any(), http_common.DraftTTL).Return(
+					testCase.redisSetTTLData.Err,
+				).Times(testCase.redisSetTTLData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.GET(testCase.path+":quiz_id", ResumeDraft(zapLogger, mockAuth, mockCassandra, mockRedis))
+			req, _ := http.NewRequest("GET", testCase.path+testCase.quizId, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check the resumed draft.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.Success{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body")
+
+				resumed, ok := response.Payload.(map[string]any)
+				require.True(t, ok, "failed to convert payload to an index-able map")
+				require.Equal(t, float64(1), resumed["attempt"], "attempt number mismatch")
+				require.Len(t, resumed["responses"], 2, "draft responses mismatch")
+			}
+		})
+	}
+}
+
+func TestSubmitDraft(t *testing.T) {
+	router := http_common.GetTestRouter()
+	pubQuiz := *cassandra.GetTestQuizzes()["myPubQuiz"]
+	exhausted := &model_cassandra.Response{Attempts: []*model_cassandra.Attempt{{Number: 1, SubmittedAt: time.Now()}}}
+	cacheMiss := &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss}
+	notFound := &cassandra.Error{Message: "score card not found", Status: http.StatusNotFound}
+	draft := model_cassandra.AnswerDraft{Attempt: 1, QuizResponse: &model_cassandra.QuizResponse{Responses: [][]int32{{2}, {0}}}}
+
+	testCases := []struct {
+		name                string
+		path                string
+		quizId              string
+		expectedStatus      int
+		authValidateJWTData *http_common.MockAuthData
+		redisGetData        *http_common.MockRedisData
+		responseReadData    *http_common.MockCassandraData
+		redisDraftData      *http_common.MockRedisData
+		draftReadData       *http_common.MockCassandraData
+		graderData          *http_common.MockGraderData
+		responseStoreData   *http_common.MockCassandraData
+		redisDelData        *http_common.MockRedisData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:           "empty token",
+			path:           "/draft-submit/empty-token/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisGetData:      &http_common.MockRedisData{Times: 0},
+			responseReadData:  &http_common.MockCassandraData{Times: 0},
+			redisDraftData:    &http_common.MockRedisData{Times: 0},
+			draftReadData:     &http_common.MockCassandraData{Times: 0},
+			graderData:        &http_common.MockGraderData{Times: 0},
+			responseStoreData: &http_common.MockCassandraData{Times: 0},
+			redisDelData:      &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "invalid quiz id",
+			path:                "/draft-submit/invalid-quiz-id/",
+			quizId:              "not a valid uuid",
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			responseReadData:    &http_common.MockCassandraData{Times: 0},
+			redisDraftData:      &http_common.MockRedisData{Times: 0},
+			draftReadData:       &http_common.MockCassandraData{Times: 0},
+			graderData:          &http_common.MockGraderData{Times: 0},
+			responseStoreData:   &http_common.MockCassandraData{Times: 0},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "attempts exhausted",
+			path:                "/draft-submit/attempts-exhausted/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: pubQuiz, Times: 1},
+			responseReadData:    &http_common.MockCassandraData{OutputParam: exhausted, Times: 1},
+			redisDraftData:      &http_common.MockRedisData{Times: 0},
+			draftReadData:       &http_common.MockCassandraData{Times: 0},
+			graderData:          &http_common.MockGraderData{Times: 0},
+			responseStoreData:   &http_common.MockCassandraData{Times: 0},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "draft not saved",
+			path:                "/draft-submit/draft-not-saved/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: pubQuiz, Times: 1},
+			responseReadData:    &http_common.MockCassandraData{OutputErr: notFound, Times: 1},
+			redisDraftData:      &http_common.MockRedisData{Param2: model_cassandra.AnswerDraft{}, Err: cacheMiss, Times: 1},
+			draftReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "draft has not been saved", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			graderData:        &http_common.MockGraderData{Times: 0},
+			responseStoreData: &http_common.MockCassandraData{Times: 0},
+			redisDelData:      &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "grading failure",
+			path:                "/draft-submit/grading-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: pubQuiz, Times: 1},
+			responseReadData:    &http_common.MockCassandraData{OutputErr: notFound, Times: 1},
+			redisDraftData:      &http_common.MockRedisData{Param2: draft, Times: 1},
+			draftReadData:       &http_common.MockCassandraData{Times: 0},
+			graderData: &http_common.MockGraderData{
+				OutputErr: errors.New("grading failure"),
+				Times:     1,
+			},
+			responseStoreData: &http_common.MockCassandraData{Times: 0},
+			redisDelData:      &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "success",
+			path:                "/draft-submit/success/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: pubQuiz, Times: 1},
+			responseReadData:    &http_common.MockCassandraData{OutputErr: notFound, Times: 1},
+			redisDraftData:      &http_common.MockRedisData{Param2: draft, Times: 1},
+			draftReadData:       &http_common.MockCassandraData{Times: 0},
+			graderData: &http_common.MockGraderData{
+				OutputParam: 2,
+				OutputMax:   3,
+				Times:       1,
+			},
+			responseStoreData: &http_common.MockCassandraData{Times: 1},
+			redisDelData:      &http_common.MockRedisData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrader := mocks.NewMockGrading(mockCtrl)
+
+			gomock.InOrder(
+				// Check JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Get quiz from Redis.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
+
+				// Get previous attempts from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.responseReadData.OutputParam,
+					testCase.responseReadData.OutputErr,
+				).Times(testCase.responseReadData.Times),
+
+				// Get draft from Redis.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisDraftData.Param2,
+				).Return(
+					testCase.redisDraftData.Err,
+				).Times(testCase.redisDraftData.Times),
+
+				// Get draft from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.draftReadData.OutputParam,
+					testCase.draftReadData.OutputErr,
+				).Times(testCase.draftReadData.Times),
+
+				// Grade the draft.
+				mockGrader.EXPECT().Grade(draft.QuizResponse, gomock.Any()).Return(
+					testCase.graderData.OutputParam,
+					testCase.graderData.OutputMax,
+					testCase.graderData.OutputErr,
+				).Times(testCase.graderData.Times),
+
+				// Store the attempt in Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.responseStoreData.OutputParam,
+					testCase.responseStoreData.OutputErr,
+				).Times(testCase.responseStoreData.Times),
+
+				// Evict statistics summary from Redis.
+				mockRedis.EXPECT().Del(gomock.Any()).Return(
+					testCase.redisDelData.Err,
+				).Times(testCase.redisDelData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path+":quiz_id", SubmitDraft(zapLogger, mockAuth, mockCassandra, mockRedis, mockGrader))
+			req, _ := http.NewRequest("POST", testCase.path+testCase.quizId, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check the recorded attempt.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.Success{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body")
+
+				scoreCard, ok := response.Payload.(map[string]any)
+				require.True(t, ok, "failed to convert payload to an index-able map")
+				require.InDelta(t, testCase.graderData.OutputParam, scoreCard["score"], 0.01, "score mismatch")
+				require.Len(t, scoreCard["attempts"], 1, "attempt history mismatch")
+			}
+		})
+	}
+}
+
+func TestListMarkingSchemes(t *testing.T) {
+	router := http_common.GetTestRouter()
+	router.GET("/marking-schemes", ListMarkingSchemes())
+	req, _ := http.NewRequest("GET", "/marking-schemes", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
+
+	response := model_http.Success{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body")
+
+	schemes, ok := response.Payload.([]any)
+	require.True(t, ok, "failed to convert payload to a list")
+	require.Equal(t, len(grading.MarkingSchemes()), len(schemes), "incorrect number of marking schemes")
+	require.Contains(t, schemes, "binary", "built-in marking scheme missing")
+}
+
+func TestReviseQuiz(t *testing.T) {
+	router := http_common.GetTestRouter()
+
+	// The revised quiz record carries the groups the quiz is restricted to.
+	revisedQuiz := &model_cassandra.Quiz{
+		QuizCore:    testQuizData["myPubQuiz"].QuizCore,
+		QuizID:      gocql.TimeUUID(),
+		Author:      "username1",
+		Version:     2,
+		IsPublished: true,
+		Groups:      []gocql.UUID{gocql.TimeUUID()},
+	}
+
+	testCases := []struct {
+		name                string
+		path                string
+		quizId              string
+		expectedStatus      int
+		quiz                *model_cassandra.QuizCore
+		authValidateJWTData *http_common.MockAuthData
+		cassandraReviseData *http_common.MockCassandraData
+		cassandraReadData   *http_common.MockCassandraData
+		catalogueAddTimes   int
+		redisDelData        *http_common.MockRedisData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:           "empty token",
+			path:           "/revise/empty-token/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:           "invalid quiz id",
+			path:           "/revise/invalid-quiz-id",
+			quizId:         "not a valid uuid",
+			expectedStatus: http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        0,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:           "request validate failure",
+			path:           "/revise/request-validate-failure/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusBadRequest,
+			quiz:           testQuizData["invalidOptionsNoPubQuiz"].QuizCore,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+			redisDelData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:           "db unauthorized",
+			path:           "/revise/db-unauthorized/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusForbidden,
+			quiz:           testQuizData["myPubQuiz"].QuizCore,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "", Status: http.StatusForbidden},
+				Times:     1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			redisDelData:      &http_common.MockRedisData{Times: 0},
+		}, {
+			name:           "db concurrent revision",
+			path:           "/revise/db-concurrent-revision/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusConflict,
+			quiz:           testQuizData["myPubQuiz"].QuizCore,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "", Status: http.StatusConflict},
+				Times:     1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			redisDelData:      &http_common.MockRedisData{Times: 0},
+		}, {
+			name:           "success - cache miss",
+			path:           "/revise/success-cache-miss/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			quiz:           testQuizData["myPubQuiz"].QuizCore,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{
+				OutputParam: 2,
+				Times:       1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{OutputParam: revisedQuiz, Times: 1},
+			catalogueAddTimes: 1,
+			redisDelData: &http_common.MockRedisData{
+				Err:   &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss},
+				Times: 1,
+			},
+		}, {
+			name:           "success - cache evict failure",
+			path:           "/revise/success-cache-evict-failure/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			quiz:           testQuizData["myPubQuiz"].QuizCore,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{
+				OutputParam: 2,
+				Times:       1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{OutputParam: revisedQuiz, Times: 1},
+			catalogueAddTimes: 1,
+			redisDelData: &http_common.MockRedisData{
+				Err:   &redis.Error{Message: "cache failure", Code: redis.ErrorCacheDel},
+				Times: 1,
+			},
+		}, {
+			name:           "success",
+			path:           "/revise/success/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			quiz:           testQuizData["myPubQuiz"].QuizCore,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{
+				OutputParam: 2,
+				Times:       1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{OutputParam: revisedQuiz, Times: 1},
+			catalogueAddTimes: 1,
+			redisDelData:      &http_common.MockRedisData{Times: 1},
+		}, {
+			name:           "success - db read failure",
+			path:           "/revise/success-db-read-failure/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			quiz:           testQuizData["myPubQuiz"].QuizCore,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReviseData: &http_common.MockCassandraData{
+				OutputParam: 2,
+				Times:       1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			catalogueAddTimes: 0,
+			redisDelData:      &http_common.MockRedisData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl)
+
+			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+				testCase.authValidateJWTData.OutputParam1,
+				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
+				testCase.authValidateJWTData.OutputErr,
+			).Times(testCase.authValidateJWTData.Times)
+
+			gomock.InOrder(
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReviseData.OutputParam,
+					testCase.cassandraReviseData.OutputErr,
+				).Times(testCase.cassandraReviseData.Times),
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+			)
+
+			// The catalogue listing is replaced with the quiz record, including the groups it is restricted to.
+			mockCatalogue.EXPECT().Add(gomock.Any()).Do(func(quiz *model_cassandra.Quiz) {
+				require.Equal(t, revisedQuiz.Groups, quiz.Groups, "catalogue listing does not carry the quiz groups")
+			}).Times(testCase.catalogueAddTimes)
+
+			mockRedis.EXPECT().Del(gomock.Any()).Return(
+				testCase.redisDelData.Err,
+			).Times(testCase.redisDelData.Times)
+
+			quizJson, err := json.Marshal(testCase.quiz)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			// Endpoint setup for test.
+			router.PATCH(testCase.path+":quiz_id", ReviseQuiz(zapLogger, mockAuth, mockCassandra, mockRedis, mockCatalogue))
+			req, _ := http.NewRequest("PATCH", testCase.path+testCase.quizId, bytes.NewBuffer(quizJson))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check message and quiz id.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.Success{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body.")
+
+				require.Contains(t, response.Message, "version 2", "did not receive version in message response")
+				require.Equal(t, testCase.quizId, response.Payload.(string), "did not receive quiz id in response")
+			}
+		})
+	}
+}
+
+func TestScheduleQuiz(t *testing.T) {
+	router := http_common.GetTestRouter()
+	opensAt := time.Now().Add(time.Hour)
+	closesAt := opensAt.Add(time.Hour)
+
+	testCases := []struct {
+		name                  string
+		path                  string
+		quizId                string
+		expectedStatus        int
+		schedule              *model_cassandra.QuizSchedule
+		authValidateJWTData   *http_common.MockAuthData
+		cassandraScheduleData *http_common.MockCassandraData
+		redisDelData          *http_common.MockRedisData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:           "empty token",
+			path:           "/schedule/empty-token/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			schedule:       &model_cassandra.QuizSchedule{OpensAt: &opensAt, ClosesAt: &closesAt},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			cassandraScheduleData: &http_common.MockCassandraData{Times: 0},
+			redisDelData:          &http_common.MockRedisData{Times: 0},
+		}, {
+			name:           "invalid quiz id",
+			path:           "/schedule/invalid-quiz-id",
+			quizId:         "not a valid uuid",
+			expectedStatus: http.StatusBadRequest,
+			schedule:       &model_cassandra.QuizSchedule{OpensAt: &opensAt, ClosesAt: &closesAt},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        0,
+			},
+			cassandraScheduleData: &http_common.MockCassandraData{Times: 0},
+			redisDelData:          &http_common.MockRedisData{Times: 0},
+		}, {
+			name:           "closes before opening",
+			path:           "/schedule/closes-before-opening/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusBadRequest,
+			schedule:       &model_cassandra.QuizSchedule{OpensAt: &closesAt, ClosesAt: &opensAt},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraScheduleData: &http_common.MockCassandraData{Times: 0},
+			redisDelData:          &http_common.MockRedisData{Times: 0},
+		}, {
+			name:           "db unauthorized",
+			path:           "/schedule/db-unauthorized/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusForbidden,
+			schedule:       &model_cassandra.QuizSchedule{OpensAt: &opensAt, ClosesAt: &closesAt},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraScheduleData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "", Status: http.StatusForbidden},
+				Times:     1,
+			},
+			redisDelData: &http_common.MockRedisData{Times: 0},
+		}, {
+			name:           "db concurrent modification",
+			path:           "/schedule/db-concurrent-modification/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusConflict,
+			schedule:       &model_cassandra.QuizSchedule{ClosesAt: &closesAt},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraScheduleData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "", Status: http.StatusConflict},
+				Times:     1,
+			},
+			redisDelData: &http_common.MockRedisData{Times: 0},
+		}, {
+			name:           "success - cache miss",
+			path:           "/schedule/success-cache-miss/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			schedule:       &model_cassandra.QuizSchedule{OpensAt: &opensAt, ClosesAt: &closesAt},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraScheduleData: &http_common.MockCassandraData{Times: 1},
+			redisDelData: &http_common.MockRedisData{
+				Err:   &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss},
+				Times: 1,
+			},
+		}, {
+			name:           "success - cache evict failure",
+			path:           "/schedule/success-cache-evict-failure/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			schedule:       &model_cassandra.QuizSchedule{ClosesAt: &closesAt},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraScheduleData: &http_common.MockCassandraData{Times: 1},
+			redisDelData: &http_common.MockRedisData{
+				Err:   &redis.Error{Message: "cache failure", Code: redis.ErrorCacheDel},
+				Times: 1,
+			},
+		}, {
+			name:           "success - clear schedule",
+			path:           "/schedule/success-clear-schedule/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			schedule:       &model_cassandra.QuizSchedule{},
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraScheduleData: &http_common.MockCassandraData{Times: 1},
+			redisDelData:          &http_common.MockRedisData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+				testCase.authValidateJWTData.OutputParam1,
+				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
+				testCase.authValidateJWTData.OutputErr,
+			).Times(testCase.authValidateJWTData.Times)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+				testCase.cassandraScheduleData.OutputParam,
+				testCase.cassandraScheduleData.OutputErr,
+			).Times(testCase.cassandraScheduleData.Times)
+
+			mockRedis.EXPECT().Del(gomock.Any()).Return(
+				testCase.redisDelData.Err,
+			).Times(testCase.redisDelData.Times)
+
+			scheduleJson, err := json.Marshal(testCase.schedule)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			// Endpoint setup for test.
+			router.PATCH(testCase.path+":quiz_id", ScheduleQuiz(zapLogger, mockAuth, mockCassandra, mockRedis))
+			req, _ := http.NewRequest("PATCH", testCase.path+testCase.quizId, bytes.NewBuffer(scheduleJson))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check message and quiz id.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.Success{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body.")
+
+				require.Equal(t, testCase.quizId, response.Payload.(string), "did not receive quiz id in response")
+			}
+		})
+	}
+}
+
+func TestListQuizVersions(t *testing.T) {
+	router := http_common.GetTestRouter()
+
+	// getVersions will generate the versions of a quiz with the answer keys present.
+	getVersions := func(key string) []*model_cassandra.QuizVersion {
+		quiz := cassandra.GetTestQuizzes()[key]
+		return []*model_cassandra.QuizVersion{
+			{QuizCore: quiz.QuizCore, QuizID: quiz.QuizID, Version: 1, Author: quiz.Author},
+			{QuizCore: cassandra.GetTestQuizzes()[key].QuizCore, QuizID: quiz.QuizID, Version: 2, Author: quiz.Author},
+		}
+	}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		quizId               string
+		expectedStatus       int
+		expectAnswers        require.BoolAssertionFunc
+		authValidateJWTData  *http_common.MockAuthData
+		redisGetData         *http_common.MockRedisData
+		cassandraVersionData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:           "empty token",
+			path:           "/versions/empty-token/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisGetData:         &http_common.MockRedisData{Times: 0},
+			cassandraVersionData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:           "invalid quiz id",
+			path:           "/versions/invalid-quiz-id/",
+			quizId:         "not a valid uuid",
+			expectedStatus: http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        0,
+			},
+			redisGetData:         &http_common.MockRedisData{Times: 0},
+			cassandraVersionData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:           "unpublished not owner",
+			path:           "/versions/unpublished-not-owner/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myNoPubQuiz"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:           "db failure",
+			path:           "/versions/db-failure/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+		}, {
+			name:           "no versions",
+			path:           "/versions/no-versions/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "user-3",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myNoPubQuiz"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{
+				OutputParam: []*model_cassandra.QuizVersion(nil),
+				Times:       1,
+			},
+		}, {
+			name:           "published not owner",
+			path:           "/versions/published-not-owner/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			expectAnswers:  require.False,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{
+				OutputParam: getVersions("myPubQuiz"),
+				Times:       1,
+			},
+		}, {
+			name:           "published owner",
+			path:           "/versions/published-owner/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			expectAnswers:  require.True,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "user-2",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{
+				OutputParam: getVersions("myPubQuiz"),
+				Times:       1,
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				// Check JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Get quiz from Redis.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+					testCase.redisGetData.Err,
+				).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Times(testCase.redisGetData.Times),
+
+				// Get versions from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraVersionData.OutputParam,
+					testCase.cassandraVersionData.OutputErr,
+				).Times(testCase.cassandraVersionData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.GET(testCase.path+":quiz_id", ListQuizVersions(zapLogger, mockAuth, mockCassandra, mockRedis))
+			req, _ := http.NewRequest("GET", testCase.path+testCase.quizId, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check versions and answer keys.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.Success{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body.")
+
+				versions := response.Payload.([]any)
+				require.Equal(t, 2, len(versions), "expected two versions")
+
+				for _, version := range versions {
+					questions := version.(map[string]any)["quiz_core"].(map[string]any)["questions"]
+					for _, question := range questions.([]any) {
+						_, found := question.(map[string]any)["answers"]
+						testCase.expectAnswers(t, found, "answer condition failed")
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestViewQuizVersion(t *testing.T) {
+	router := http_common.GetTestRouter()
+
+	// getVersion will generate a version of a quiz with the answer keys present.
+	getVersion := func(key string) *model_cassandra.QuizVersion {
+		quiz := cassandra.GetTestQuizzes()[key]
+		return &model_cassandra.QuizVersion{QuizCore: quiz.QuizCore, QuizID: quiz.QuizID, Version: 1, Author: quiz.Author}
+	}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		quizId               string
+		version              string
+		expectedStatus       int
+		expectAnswers        require.BoolAssertionFunc
+		authValidateJWTData  *http_common.MockAuthData
+		redisGetData         *http_common.MockRedisData
+		cassandraVersionData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:           "empty token",
+			path:           "/version/empty-token/",
+			quizId:         gocql.TimeUUID().String(),
+			version:        "1",
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisGetData:         &http_common.MockRedisData{Times: 0},
+			cassandraVersionData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:           "invalid quiz id",
+			path:           "/version/invalid-quiz-id/",
+			quizId:         "not a valid uuid",
+			version:        "1",
+			expectedStatus: http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        0,
+			},
+			redisGetData:         &http_common.MockRedisData{Times: 0},
+			cassandraVersionData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:           "invalid version",
+			path:           "/version/invalid-version/",
+			quizId:         gocql.TimeUUID().String(),
+			version:        "one",
+			expectedStatus: http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        0,
+			},
+			redisGetData:         &http_common.MockRedisData{Times: 0},
+			cassandraVersionData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:           "negative version",
+			path:           "/version/negative-version/",
+			quizId:         gocql.TimeUUID().String(),
+			version:        "-1",
+			expectedStatus: http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        0,
+			},
+			redisGetData:         &http_common.MockRedisData{Times: 0},
+			cassandraVersionData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:           "published but deleted not owner",
+			path:           "/version/published-but-deleted-not-owner/",
+			quizId:         gocql.TimeUUID().String(),
+			version:        "1",
+			expectedStatus: http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuizDeleted"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:           "version not found",
+			path:           "/version/version-not-found/",
+			quizId:         gocql.TimeUUID().String(),
+			version:        "7",
+			expectedStatus: http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "quiz version not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+		}, {
+			name:           "published not owner",
+			path:           "/version/published-not-owner/",
+			quizId:         gocql.TimeUUID().String(),
+			version:        "1",
+			expectedStatus: http.StatusOK,
+			expectAnswers:  require.False,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "not owner",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{
+				OutputParam: getVersion("myPubQuiz"),
+				Times:       1,
+			},
+		}, {
+			name:           "published owner",
+			path:           "/version/published-owner/",
+			quizId:         gocql.TimeUUID().String(),
+			version:        "1",
+			expectedStatus: http.StatusOK,
+			expectAnswers:  require.True,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "user-2",
+				Times:        1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraVersionData: &http_common.MockCassandraData{
+				OutputParam: getVersion("myPubQuiz"),
+				Times:       1,
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				// Check JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Get quiz from Redis.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+					testCase.redisGetData.Err,
+				).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Times(testCase.redisGetData.Times),
+
+				// Get version from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraVersionData.OutputParam,
+					testCase.cassandraVersionData.OutputErr,
+				).Times(testCase.cassandraVersionData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.GET(testCase.path+":quiz_id/:version", ViewQuizVersion(zapLogger, mockAuth, mockCassandra, mockRedis))
+			req, _ := http.NewRequest("GET", testCase.path+testCase.quizId+"/"+testCase.version, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check version and answer keys.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.Success{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body.")
+
+				version := response.Payload.(map[string]any)
+				require.Equal(t, float64(1), version["version"], "version mismatch")
+
+				for _, question := range version["quiz_core"].(map[string]any)["questions"].([]any) {
+					_, found := question.(map[string]any)["answers"]
+					testCase.expectAnswers(t, found, "answer condition failed")
+				}
+			}
+		})
+	}
+}
+
+func TestListMyQuizzes(t *testing.T) {
+	router := http_common.GetTestRouter()
+	testCases := []struct {
+		name                string
+		path                string
+		querySegment        string
+		expectedLen         int
+		expectedStatus      int
+		expectLink          require.BoolAssertionFunc
+		authValidateJWTData *http_common.MockAuthData
+		authDecryptData     *http_common.MockAuthData
+		cassandraReadData   *http_common.MockCassandraData
+		authEncryptData     *http_common.MockAuthData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:           "empty token",
+			path:           "/mine/empty-token",
+			querySegment:   "?pageCursor=PaGeCuRs0R==&pageSize=3",
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			authDecryptData:   &http_common.MockAuthData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			authEncryptData:   &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:           "invalid status",
+			path:           "/mine/invalid-status",
+			querySegment:   "?pageSize=3&status=archived",
+			expectedStatus: http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData:   &http_common.MockAuthData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			authEncryptData:   &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:           "cursor decryption failure",
+			path:           "/mine/cursor-decryption-failure",
+			querySegment:   "?pageCursor=PaGeCuRs0R==&pageSize=3",
+			expectedStatus: http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{
+				OutputErr: errors.New("decrypting cursor failed"),
+				Times:     1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			authEncryptData:   &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:           "db read failure",
+			path:           "/mine/db-read-failure",
+			querySegment:   "?pageCursor=PaGeCuRs0R==&pageSize=3&status=draft",
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:           "cursor encryption failure",
+			path:           "/mine/cursor-encryption-failure",
+			querySegment:   "?pageSize=3",
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AuthorQuizzesResponse{
+					PageCursor: []byte("cursor to next page"),
+					Records:    []*model_cassandra.AuthorQuiz{{}, {}, {}},
+					PageSize:   3,
+				},
+				Times: 1,
+			},
+			authEncryptData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("encrypting cursor failed"),
+				Times:        1,
+			},
+		}, {
+			name:           "success",
+			path:           "/mine/success",
+			querySegment:   "?pageCursor=PaGeCuRs0R==&pageSize=3&status=published",
+			expectedLen:    3,
+			expectedStatus: http.StatusOK,
+			expectLink:     require.True,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AuthorQuizzesResponse{
+					PageCursor: []byte("cursor to next page"),
+					Records:    []*model_cassandra.AuthorQuiz{{}, {}, {}},
+					PageSize:   3,
+				},
+				Times: 1,
+			},
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "tHisIsAnEnCrYPtEdCUrS0r", Times: 1},
+		}, {
+			name:           "success last page",
+			path:           "/mine/success-last-page",
+			querySegment:   "?pageSize=3",
+			expectedLen:    2,
+			expectedStatus: http.StatusOK,
+			expectLink:     require.False,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.AuthorQuizzesResponse{
+					Records:  []*model_cassandra.AuthorQuiz{{}, {}},
+					PageSize: 3,
+				},
+				Times: 1,
+			},
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			gomock.InOrder(
+				// Validate JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+				// Decrypt cursor page.
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).Return(
+					testCase.authDecryptData.OutputParam1,
+					testCase.authDecryptData.OutputErr,
+				).Times(testCase.authDecryptData.Times),
+				// Get quizzes page.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+				// Encrypt cursor page.
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).Return(
+					testCase.authEncryptData.OutputParam1,
+					testCase.authEncryptData.OutputErr,
+				).Times(testCase.authEncryptData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.GET(testCase.path, ListMyQuizzes(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("GET", testCase.path+testCase.querySegment, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify response code.
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check records and link to the next page.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.AuthorQuizzesResponse{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body")
+
+				require.Equal(t, testCase.expectedLen, len(response.Records), "records count does not match expected")
+				require.Equal(t, testCase.expectedLen, response.Metadata.NumRecords, "metadata record count does not match expected")
+				testCase.expectLink(t, len(response.Links.NextPage) != 0, "link to next page expectation failed")
+			}
+		})
+	}
+}
+
+func TestPrepareAuthorQuizzesResponse(t *testing.T) {
+	testCases := []struct {
+		name         string
+		status       string
+		dbResponse   *model_cassandra.AuthorQuizzesResponse
+		mockAuthData *http_common.MockAuthData
+		expectErr    require.ErrorAssertionFunc
+		expectLink   string
+	}{
+		// ----- test cases start ----- //
+		{
+			name:         "no cursor",
+			dbResponse:   &model_cassandra.AuthorQuizzesResponse{PageSize: 3},
+			mockAuthData: &http_common.MockAuthData{Times: 0, OutputParam1: ""},
+			expectErr:    require.NoError,
+			expectLink:   "",
+		}, {
+			name:         "cursor and page",
+			dbResponse:   &model_cassandra.AuthorQuizzesResponse{PageCursor: []byte("page-cursor"), PageSize: 3},
+			mockAuthData: &http_common.MockAuthData{Times: 1, OutputParam1: "CuRs0R"},
+			expectErr:    require.NoError,
+			expectLink:   "?pageCursor=CuRs0R&pageSize=3",
+		}, {
+			name:         "cursor, page, and status",
+			status:       model_cassandra.QuizStatusDeleted,
+			dbResponse:   &model_cassandra.AuthorQuizzesResponse{PageCursor: []byte("page-cursor"), PageSize: 3},
+			mockAuthData: &http_common.MockAuthData{Times: 1, OutputParam1: "CuRs0R"},
+			expectErr:    require.NoError,
+			expectLink:   "?pageCursor=CuRs0R&pageSize=3&status=deleted",
+		}, {
+			name:         "encryption failure",
+			dbResponse:   &model_cassandra.AuthorQuizzesResponse{PageCursor: []byte("page-cursor"), PageSize: 3},
+			mockAuthData: &http_common.MockAuthData{Times: 1, OutputParam1: "", OutputErr: errors.New("encryption failure")},
+			expectErr:    require.Error,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+
+			mockAuth.EXPECT().EncryptToString(gomock.Any()).Return(
+				testCase.mockAuthData.OutputParam1,
+				testCase.mockAuthData.OutputErr,
+			).Times(testCase.mockAuthData.Times)
+
+			resp, err := prepareAuthorQuizzesResponse(mockAuth, testCase.dbResponse, testCase.status)
+			testCase.expectErr(t, err, "error expectation condition failed")
+
+			if err == nil {
+				require.Equal(t, testCase.expectLink, resp.Links.NextPage, "next page link mismatch")
+				require.Equal(t, testCase.status, resp.Metadata.Status, "status mismatch")
+			}
+		})
+	}
+}
+
+func TestImportQuiz(t *testing.T) {
+	router := http_common.GetTestRouter()
+
+	giftQuiz := "$CATEGORY: $course$/Imported quiz\n\nMoon is a star {F}\n\nWeight can be measured in {=Gram ~Kelvin ~Liters}"
+	qtiQuiz, err := interchange.Export(interchange.FormatQTI, testQuizData["myPubQuiz"].QuizCore)
+	require.NoError(t, err, "failed to export QTI content package")
+
+	testCases := []struct {
+		name                string
+		path                string
+		format              string
+		query               string
+		data                string
+		expectedStatus      int
+		expectedMessage     string
+		authValidateJWTData *http_common.MockAuthData
+		cassandraCreateData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "unsupported format",
+			path:                "/import/unsupported-format/",
+			format:              "docx",
+			query:               "?markingType=negative",
+			data:                giftQuiz,
+			expectedStatus:      http.StatusBadRequest,
+			expectedMessage:     "unsupported quiz format",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:            "empty token",
+			path:            "/import/empty-token/",
+			format:          "gift",
+			query:           "?markingType=negative",
+			data:            giftQuiz,
+			expectedStatus:  http.StatusInternalServerError,
+			expectedMessage: "unable to verify username",
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "file too large",
+			path:                "/import/file-too-large/",
+			format:              "gift",
+			query:               "?markingType=negative",
+			data:                giftQuiz + "\n\n" + strings.Repeat("x", interchange.MaxFileSize),
+			expectedStatus:      http.StatusRequestEntityTooLarge,
+			expectedMessage:     "quiz file is too large",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-1", Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "unreadable file",
+			path:                "/import/unreadable-file/",
+			format:              "moodle-xml",
+			query:               "?markingType=negative",
+			data:                "<quiz><question>",
+			expectedStatus:      http.StatusBadRequest,
+			expectedMessage:     "unable to read quiz file",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-1", Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "invalid questions",
+			path:                "/import/invalid-questions/",
+			format:              "gift",
+			query:               "?markingType=negative",
+			data:                "Write an essay {}\n\nOnly one option {=Yes}",
+			expectedStatus:      http.StatusBadRequest,
+			expectedMessage:     "validation",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-1", Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "missing title",
+			path:                "/import/missing-title/",
+			format:              "aiken",
+			query:               "?markingType=negative",
+			data:                "Moon is a star\nA. Yes\nB. No\nANSWER: B",
+			expectedStatus:      http.StatusBadRequest,
+			expectedMessage:     "validation",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-1", Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "missing marking type",
+			path:                "/import/missing-marking-type/",
+			format:              "gift",
+			data:                giftQuiz,
+			expectedStatus:      http.StatusBadRequest,
+			expectedMessage:     "validation",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-1", Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:            "db failure",
+			path:            "/import/db-failure/",
+			format:          "gift",
+			query:           "?markingType=negative",
+			data:            giftQuiz,
+			expectedStatus:  http.StatusConflict,
+			expectedMessage: "error creating quiz",
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "user-1",
+				Times:        1,
+			},
+			cassandraCreateData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "conflict", Status: http.StatusConflict},
+				Times:     1,
+			},
+		}, {
+			name:                "valid gift quiz",
+			path:                "/import/valid-gift-quiz/",
+			format:              "GIFT",
+			query:               "?markingType=negative",
+			data:                giftQuiz,
+			expectedStatus:      http.StatusOK,
+			expectedMessage:     "created quiz with id",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-1", Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 1},
+		}, {
+			name:                "valid aiken quiz with title",
+			path:                "/import/valid-aiken-quiz/",
+			format:              "aiken",
+			query:               "?markingType=negative&title=Astronomy",
+			data:                "Moon is a star\nA. Yes\nB. No\nANSWER: B",
+			expectedStatus:      http.StatusOK,
+			expectedMessage:     "created quiz with id",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-1", Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 1},
+		}, {
+			name:                "valid qti content package",
+			path:                "/import/valid-qti-package/",
+			format:              "qti",
+			query:               "?markingType=negative",
+			data:                string(qtiQuiz),
+			expectedStatus:      http.StatusOK,
+			expectedMessage:     "created quiz with id",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-1", Times: 1},
+			cassandraCreateData: &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			gomock.InOrder(
+				// Check JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Create quiz in Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ any, params any) (any, error) {
+						quiz := params.(*model_cassandra.Quiz)
+						require.Equal(t, "user-1", quiz.Author, "author mismatch")
+						require.False(t, quiz.IsPublished, "imported quiz should be a draft")
+						return testCase.cassandraCreateData.OutputParam, testCase.cassandraCreateData.OutputErr
+					},
+				).Times(testCase.cassandraCreateData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.POST(testCase.path+":format", ImportQuiz(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("POST", testCase.path+testCase.format+testCase.query, bytes.NewBufferString(testCase.data))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body.")
+			require.Equal(t, testCase.expectedMessage, response["message"], "expected message does not match")
+		})
+	}
+}
+
+func TestExportQuiz(t *testing.T) {
+	router := http_common.GetTestRouter()
+
+	drawnQuiz := *testQuizData["myPubQuiz"]
+	drawnQuiz.QuizCore = &model_cassandra.QuizCore{Title: "Drawn quiz", MarkingType: "Negative",
+		Draw: &model_cassandra.QuestionDraw{Banks: []string{"units"}, Count: 1}}
+
+	textQuiz := *testQuizData["myPubQuiz"]
+	textQuiz.QuizCore = &model_cassandra.QuizCore{Title: "Text quiz", MarkingType: "Negative", Questions: []*model_cassandra.Question{
+		{Description: "Capital of France?", Type: model_cassandra.QuestionShortText, TextAnswers: []string{"Paris"}},
+	}}
+
+	testCases := []struct {
+		name                string
+		path                string
+		quizId              string
+		format              string
+		expectedStatus      int
+		expectedType        string
+		authValidateJWTData *http_common.MockAuthData
+		redisGetData        *http_common.MockRedisData
+		cassandraReadData   *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid quiz id",
+			path:                "/export/invalid-quiz-id/",
+			quizId:              "not a valid uuid",
+			format:              "gift",
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "unsupported format",
+			path:                "/export/unsupported-format/",
+			quizId:              gocql.TimeUUID().String(),
+			format:              "docx",
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisGetData:        &http_common.MockRedisData{Times: 0},
+			cassandraReadData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:           "empty token",
+			path:           "/export/empty-token/",
+			quizId:         gocql.TimeUUID().String(),
+			format:         "gift",
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisGetData:      &http_common.MockRedisData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "quiz not found",
+			path:                "/export/quiz-not-found/",
+			quizId:              gocql.TimeUUID().String(),
+			format:              "gift",
+			expectedStatus:      http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-2", Times: 1},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err:    &redis.Error{Message: "cache miss error", Code: redis.ErrorCacheMiss},
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusNotFound},
+				Times:     1,
+			},
+		}, {
+			name:                "not author",
+			path:                "/export/not-author/",
+			quizId:              gocql.TimeUUID().String(),
+			format:              "gift",
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "not author", Times: 1},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "drawn quiz",
+			path:                "/export/drawn-quiz/",
+			quizId:              gocql.TimeUUID().String(),
+			format:              "gift",
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-2", Times: 1},
+			redisGetData: &http_common.MockRedisData{
+				Param2: drawnQuiz,
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "unsupported questions",
+			path:                "/export/unsupported-questions/",
+			quizId:              gocql.TimeUUID().String(),
+			format:              "aiken",
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-2", Times: 1},
+			redisGetData: &http_common.MockRedisData{
+				Param2: textQuiz,
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "gift",
+			path:                "/export/gift/",
+			quizId:              gocql.TimeUUID().String(),
+			format:              "gift",
+			expectedStatus:      http.StatusOK,
+			expectedType:        "text/plain; charset=utf-8",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-2", Times: 1},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "moodle xml",
+			path:                "/export/moodle-xml/",
+			quizId:              gocql.TimeUUID().String(),
+			format:              "moodle-xml",
+			expectedStatus:      http.StatusOK,
+			expectedType:        "application/xml; charset=utf-8",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-2", Times: 1},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "qti",
+			path:                "/export/qti/",
+			quizId:              gocql.TimeUUID().String(),
+			format:              "qti",
+			expectedStatus:      http.StatusOK,
+			expectedType:        "application/zip",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "user-2", Times: 1},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *testQuizData["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				// Check JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Get quiz from Redis.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+					testCase.redisGetData.Err,
+				).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Times(testCase.redisGetData.Times),
+
+				// Get quiz from Cassandra.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.GET(testCase.path+":quiz_id/:format", ExportQuiz(zapLogger, mockAuth, mockCassandra, mockRedis))
+			req, _ := http.NewRequest("GET", testCase.path+testCase.quizId+"/"+testCase.format, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check the file.
+			if testCase.expectedStatus == http.StatusOK {
+				require.Equal(t, testCase.expectedType, w.Header().Get("Content-Type"), "content type mismatch")
+				require.Contains(t, w.Header().Get("Content-Disposition"), "attachment", "file should be downloaded")
+
+				quiz, err := interchange.Import(testCase.format, w.Body.Bytes())
+				require.NoError(t, err, "exported file should be imported")
+				require.Len(t, quiz.Questions, len(testQuizData["myPubQuiz"].Questions), "questions should be exported")
+				require.Equal(t, "Weight can be measured in", quiz.Questions[0].Description, "question mismatch")
 			}
 		})
 	}
@@ -2,38 +2,47 @@ package http_handlers
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gocql/gocql"
 	"github.com/surahman/mcq-platform/pkg/auth"
 	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/grading"
 	http_common "github.com/surahman/mcq-platform/pkg/http"
 	"github.com/surahman/mcq-platform/pkg/logger"
 	"github.com/surahman/mcq-platform/pkg/model/cassandra"
 	"github.com/surahman/mcq-platform/pkg/model/http"
+	"github.com/surahman/mcq-platform/pkg/redis"
 	"go.uber.org/zap"
 )
 
 // GetScore will retrieve a test score with the provided test id and the username from the JWT payload.
 //	@Summary		Get a user's score.
 //	@Description	Gets a scorecard for a user. Extracts username from the JWT and Test ID is provided as a path parameter.
+//	@Description	The scorecard contains the effective score set by the attempt policy of the quiz along with the history of every attempt.
+//	@Description	Once the answers to the quiz are revealed by its reveal policy, the scorecard includes a breakdown of the answer key, points, explanation, and feedback for every question in the effective attempt.
 //	@Tags			score scores
 //	@Id				getScore
 //	@Produce		json
 //	@Security		ApiKeyAuth
 //	@Param			quiz_id	path		string				true	"The Test ID for the requested scorecard."
-//	@Success		200		{object}	model_http.Success	"Score will be in the payload"
+//	@Success		200		{object}	model_http.Success	"Effective score, attempt history, and any breakdown will be in the payload"
 //	@Failure		400		{object}	model_http.Error	"Error message with any available details in payload"
 //	@Failure		404		{object}	model_http.Error	"Error message with any available details in payload"
 //	@Failure		500		{object}	model_http.Error	"Error message with any available details in payload"
 //	@Router			/score/test/{quiz_id} [get]
-func GetScore(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+func GetScore(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis, grader grading.Grading) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var err error
 		var dbRecord any
 		var response *model_cassandra.Response
+		var quiz *model_cassandra.Quiz
+		var breakdown []*model_http.QuestionBreakdown
 		var username string
 		var quizId gocql.UUID
 
@@ -43,7 +52,7 @@ func GetScore(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin
 		}
 
 		// Get username from JWT.
-		if username, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
 			logger.Error("failed to validate JWT in create quiz handler", zap.Error(err))
 			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
 			return
@@ -60,8 +69,22 @@ func GetScore(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin
 			return
 		}
 		response = dbRecord.(*model_cassandra.Response)
+		response.Attempts = http_common.AttemptHistory(response)
 
-		context.JSON(http.StatusOK, &model_http.Success{Message: "score card", Payload: response})
+		// Break down the effective attempt if the answers to the quiz have been revealed.
+		if quiz, err = http_common.GetQuiz(quizId, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+
+		if breakdown, err = http_common.ScoreBreakdown(quiz, response, db, grader, time.Now()); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving score breakdown", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "score card", Payload: &model_http.ScoreCard{Response: response, Breakdown: breakdown}})
 	}
 }
 
@@ -85,7 +108,7 @@ func GetStats(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin
 		var err error
 		var dbRecord any
 		var response []*model_cassandra.Response
-		var username string
+		var username, role string
 		var quizId gocql.UUID
 
 		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
@@ -94,7 +117,7 @@ func GetStats(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin
 		}
 
 		// Get username from JWT.
-		if username, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
 			logger.Error("failed to validate JWT in create quiz handler", zap.Error(err))
 			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
 			return
@@ -113,7 +136,7 @@ func GetStats(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin
 			context.AbortWithStatusJSON(http.StatusNotFound, &model_http.Error{Message: "could not locate results"})
 			return
 		}
-		if username != response[0].Author {
+		if !http_common.CanManage(response[0].Author, username, role) {
 			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "error verifying quiz author"})
 			return
 		}
@@ -146,7 +169,7 @@ func GetStatsPage(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra)
 		var dbRecord any
 		var statRequest *model_cassandra.StatsRequest
 		var restResponse *model_http.StatsResponse
-		var username string
+		var username, role string
 		var quizId gocql.UUID
 
 		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
@@ -155,7 +178,7 @@ func GetStatsPage(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra)
 		}
 
 		// Get username from JWT.
-		if username, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
 			logger.Error("failed to validate JWT in create quiz handler", zap.Error(err))
 			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
 			return
@@ -180,7 +203,7 @@ func GetStatsPage(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra)
 			context.AbortWithStatusJSON(http.StatusNotFound, &model_http.Error{Message: "could not locate results"})
 			return
 		}
-		if username != statsResponse.Records[0].Author {
+		if !http_common.CanManage(statsResponse.Records[0].Author, username, role) {
 			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "error verifying quiz author"})
 			return
 		}
@@ -194,6 +217,347 @@ func GetStatsPage(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra)
 	}
 }
 
+// GetStatsSummary will retrieve the aggregate statistics of the scores for a test with the provided test id and the username
+// from the JWT payload.
+//	@Summary		Get the aggregate statistics of the scores for a specific test.
+//	@Description	Gets the mean, median, standard deviation, minimum, and maximum of the scores for a specific test if the user created the test.
+//	@Description	The histogram counts the scores as a percentage of the maximum score in ten equal width bins.
+//	@Description	Extracts username from the JWT and the Test ID is provided as a path parameter.
+//	@Tags			score scores stats statistics summary
+//	@Id				getStatsSummary
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			quiz_id	path		string				true	"The Test ID for the requested statistics summary."
+//	@Success		200		{object}	model_http.Success	"The statistics summary will be in the payload"
+//	@Failure		400		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/score/summary/{quiz_id} [get]
+func GetStatsSummary(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var quiz *model_cassandra.Quiz
+		var summary *model_http.StatsSummary
+		var username, role string
+		var quizId gocql.UUID
+
+		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quiz id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in stats summary handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get the quiz and verify authorization.
+		if quiz, err = http_common.GetQuiz(quizId, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+		if !http_common.CanManage(quiz.Author, username, role) {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "error verifying quiz author"})
+			return
+		}
+
+		// Get the statistics summary from the cache or summarize the responses in the database.
+		if summary, err = http_common.GetStatsSummary(quizId, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error summarizing score cards", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "statistics summary", Payload: summary})
+	}
+}
+
+// GetItemAnalysis will retrieve the item analysis for a test with the provided test id and the username from the JWT payload.
+//	@Summary		Get the item analysis for a specific test.
+//	@Description	Gets the difficulty and discrimination of every question in a specific test if the user created the test.
+//	@Description	The selection frequencies of the options are counted overall and in the top and bottom 27% of the responses by score.
+//	@Description	The reliability of the test is reported as KR-20 and Cronbach's alpha. Responses graded against other versions are excluded.
+//	@Description	Extracts username from the JWT and the Test ID is provided as a path parameter.
+//	@Tags			score scores stats statistics item analysis
+//	@Id				getItemAnalysis
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			quiz_id	path		string				true	"The Test ID for the requested item analysis."
+//	@Success		200		{object}	model_http.Success	"The item analysis will be in the payload"
+//	@Failure		400		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/score/items/{quiz_id} [get]
+func GetItemAnalysis(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis, grader grading.Grading) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var quiz *model_cassandra.Quiz
+		var analysis *model_http.ItemAnalysis
+		var username, role string
+		var quizId gocql.UUID
+
+		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quiz id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in item analysis handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get the quiz and verify authorization.
+		if quiz, err = http_common.GetQuiz(quizId, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+		if !http_common.CanManage(quiz.Author, username, role) {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "error verifying quiz author"})
+			return
+		}
+
+		// Grade every question in the responses and analyze the items.
+		if analysis, err = http_common.AnalyzeItems(quiz, db, grader); err != nil {
+			if errors.Is(err, http_common.ErrItemAnalysisUnavailable) {
+				context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "unable to analyze items", Payload: err.Error()})
+				return
+			}
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error analyzing score cards", Payload: cassandraError.Message})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "item analysis", Payload: analysis})
+	}
+}
+
+// ExportResults will stream the results of every response to a test with the provided test id in the format requested in
+// the Accept header, if the user from the JWT payload created the test.
+//	@Summary		Export the results of a specific test.
+//	@Description	Streams the username, effective score, and selected options for every question of every response to a specific test if the user created the test.
+//	@Description	The format is negotiated with the Accept header and is either CSV (text/csv) or NDJSON (application/x-ndjson). CSV is the default.
+//	@Description	The correctness of every answer is included when requested and is graded against the version of the test the response was graded against.
+//	@Description	Extracts username from the JWT and the Test ID is provided as a path parameter.
+//	@Tags			score scores results export csv ndjson
+//	@Id				exportResults
+//	@Produce		text/csv
+//	@Produce		application/x-ndjson
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			quiz_id		path		string				true	"The Test ID for the requested results."
+//	@Param			correctness	query		bool				false	"Include the correctness of every answer."
+//	@Success		200			{string}	string				"The results of every response to the test"
+//	@Failure		400			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		406			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error	"Error message with any available details in payload"
+//	@Router			/score/export/{quiz_id} [get]
+func ExportResults(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis, grader grading.Grading) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var quiz *model_cassandra.Quiz
+		var username, role string
+		var quizId gocql.UUID
+		var correctness bool
+
+		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quiz id supplied, must be a valid UUID"})
+			return
+		}
+
+		if correctnessParam := context.Query("correctness"); len(correctnessParam) != 0 {
+			if correctness, err = strconv.ParseBool(correctnessParam); err != nil {
+				context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid correctness supplied, must be a boolean"})
+				return
+			}
+		}
+
+		format := context.NegotiateFormat(http_common.ExportFormatCSV, http_common.ExportFormatNDJSON)
+		if len(format) == 0 {
+			context.AbortWithStatusJSON(http.StatusNotAcceptable, &model_http.Error{Message: "unsupported export format",
+				Payload: fmt.Sprintf("accepted formats are %s and %s", http_common.ExportFormatCSV, http_common.ExportFormatNDJSON)})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in export results handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get the quiz and verify authorization.
+		if quiz, err = http_common.GetQuiz(quizId, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+		if !http_common.CanManage(quiz.Author, username, role) {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "error verifying quiz author"})
+			return
+		}
+
+		// Stream the results. Failures after the first page has been written can only truncate the export.
+		context.Header("Content-Type", format)
+		context.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", exportFilename(quizId, format)))
+		if err = http_common.ExportResults(quiz, db, grader, format, correctness, context.Writer); err != nil {
+			if context.Writer.Written() {
+				logger.Error("failed to export all results", zap.String("quiz_id", quizId.String()), zap.Error(err))
+				context.Abort()
+				return
+			}
+
+			context.Writer.Header().Del("Content-Type")
+			context.Writer.Header().Del("Content-Disposition")
+			if errors.Is(err, http_common.ErrExportCorrectness) {
+				context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "unable to export correctness", Payload: err.Error()})
+				return
+			}
+			if cassandraError, ok := err.(*cassandra.Error); ok {
+				context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error exporting score cards", Payload: cassandraError.Message})
+				return
+			}
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "error exporting score cards", Payload: err.Error()})
+			return
+		}
+	}
+}
+
+// exportFilename is the name of the file the results of a quiz are downloaded as.
+func exportFilename(quizId gocql.UUID, format string) string {
+	if format == http_common.ExportFormatNDJSON {
+		return fmt.Sprintf("results-%s.ndjson", quizId)
+	}
+	return fmt.Sprintf("results-%s.csv", quizId)
+}
+
+// ListMyScores will retrieve a page of the scores in the requester's score history.
+//	@Summary		List the requester's scores across all quizzes.
+//	@Description	Gets a page of the requester's scores on every quiz they have taken, most recently created quiz first.
+//	@Description	Extracts username from the JWT. Every score contains the quiz title, the number of attempts, and the time of the latest attempt.
+//	@Description	A query string to be appended to the next request to retrieve the next page of data will be returned in the response.
+//	@Tags			score scores list mine
+//	@Id				listMyScores
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			pageCursor	query		string							false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int								false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	model_http.UserScoresResponse	"A page of the requester's scores"
+//	@Failure		400			{object}	model_http.Error				"Error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error				"Error message with any available details in payload"
+//	@Router			/score/mine [get]
+func ListMyScores(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var dbRecord any
+		var request *model_cassandra.UserScoresRequest
+		var restResponse *model_http.UserScoresResponse
+		var username string
+
+		// Get username from JWT.
+		if username, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in list my scores handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Prepare user scores page request for database.
+		if request, err = http_common.PrepareUserScoresRequest(auth, username, context.Query("pageCursor"), context.Query("pageSize")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "malformed query request", Payload: err.Error()})
+			return
+		}
+
+		// Get user score record page from database.
+		if dbRecord, err = db.Execute(cassandra.ReadUserScoresPageQuery, request); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving scores page", Payload: cassandraError.Message})
+			return
+		}
+
+		// Prepare REST response.
+		if restResponse, err = prepareUserScoresResponse(auth, dbRecord.(*model_cassandra.UserScoresResponse)); err != nil {
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "error preparing scores page", Payload: err.Error()})
+			return
+		}
+
+		context.JSON(http.StatusOK, restResponse)
+	}
+}
+
+// RegradeScores will regrade all the responses to a quiz against its current version with the provided test id.
+//	@Summary		Regrade all the responses to a quiz.
+//	@Description	Regrades every response to a quiz against its current version and writes back the updated scores if the user created the test.
+//	@Description	Responses that cannot be graded against the current version are left unchanged and reported as failed.
+//	@Description	Attempts are only regraded if the version taken has the same number of questions, and each question the same type and number of options and matches, as the current version.
+//	@Description	Extracts username from the JWT and the Test ID is provided as a path parameter.
+//	@Tags			score scores regrade
+//	@Id				regradeScores
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			quiz_id	path		string				true	"The Test ID for the responses to be regraded."
+//	@Success		200		{object}	model_http.Success	"A summary of the regrade will be in the payload"
+//	@Failure		400		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		404		{object}	model_http.Error	"Error message with any available details in payload"
+//	@Failure		500		{object}	model_http.Error	"A summary of the progress made before the failure will be in the payload"
+//	@Router			/score/regrade/{quiz_id} [patch]
+func RegradeScores(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, cache redis.Redis, grader grading.Grading) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var quiz *model_cassandra.Quiz
+		var summary *model_http.RegradeSummary
+		var username, role string
+		var quizId gocql.UUID
+
+		if quizId, err = gocql.ParseUUID(context.Param("quiz_id")); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "invalid quiz id supplied, must be a valid UUID"})
+			return
+		}
+
+		// Get username from JWT.
+		if username, _, role, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in regrade scores handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		// Get the current version of the quiz and verify authorization.
+		if quiz, err = http_common.GetQuiz(quizId, db, cache); err != nil {
+			cassandraError := err.(*cassandra.Error)
+			context.AbortWithStatusJSON(cassandraError.Status, &model_http.Error{Message: "error retrieving quiz", Payload: cassandraError.Message})
+			return
+		}
+		if !http_common.CanManage(quiz.Author, username, role) {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "error verifying quiz author"})
+			return
+		}
+
+		// Regrade all responses, logging the progress after every page.
+		progress := func(summary *model_http.RegradeSummary) {
+			logger.Info("regrading quiz responses", zap.String("quiz_id", quizId.String()),
+				zap.Int("pages", summary.Pages), zap.Int("processed", summary.Processed), zap.Int("changed", summary.Changed))
+		}
+		summary, err = http_common.RegradeResponses(quiz, db, grader, progress)
+		http_common.InvalidateStatsSummary(quizId, cache)
+		if err != nil {
+			logger.Error("failed to regrade quiz responses", zap.String("quiz_id", quizId.String()), zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "error regrading responses, please retry", Payload: summary})
+			return
+		}
+
+		context.JSON(http.StatusOK, &model_http.Success{Message: "regraded responses", Payload: summary})
+	}
+}
+
 // prepareStatsResponse will prepare a http response from a database stats response. It will generate a link to the next
 // page of data as appropriate.
 func prepareStatsResponse(auth auth.Auth, dbResponse *model_cassandra.StatsResponse, quizId gocql.UUID) (response *model_http.StatsResponse, err error) {
@@ -225,3 +589,28 @@ func prepareStatsResponse(auth auth.Auth, dbResponse *model_cassandra.StatsRespo
 
 	return
 }
+
+// prepareUserScoresResponse will prepare a http response from a database user scores response. It will generate a link to
+// the next page of data as appropriate.
+func prepareUserScoresResponse(auth auth.Auth, dbResponse *model_cassandra.UserScoresResponse) (response *model_http.UserScoresResponse, err error) {
+	response = &model_http.UserScoresResponse{Records: dbResponse.Records}
+	response.Metadata.NumRecords = len(dbResponse.Records)
+
+	// There is no next page if the cursor is not set.
+	if len(dbResponse.PageCursor) == 0 {
+		return
+	}
+
+	var cursor string
+	if cursor, err = auth.EncryptToString(dbResponse.PageCursor); err != nil {
+		return nil, err
+	}
+
+	nextPageLink := fmt.Sprintf("?pageCursor=%s", cursor)
+	if dbResponse.PageSize > 0 {
+		nextPageLink += fmt.Sprintf("&pageSize=%d", dbResponse.PageSize)
+	}
+	response.Links.NextPage = nextPageLink
+
+	return
+}
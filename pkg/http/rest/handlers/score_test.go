@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gocql/gocql"
@@ -15,10 +16,21 @@ import (
 	"github.com/surahman/mcq-platform/pkg/mocks"
 	"github.com/surahman/mcq-platform/pkg/model/cassandra"
 	"github.com/surahman/mcq-platform/pkg/model/http"
+	"github.com/surahman/mcq-platform/pkg/redis"
 )
 
 func TestGetScore(t *testing.T) {
 	router := http_common.GetTestRouter()
+	revealedQuiz := cassandra.GetTestQuizzes()["myPubQuiz"]
+	revealedQuiz.RevealPolicy = model_cassandra.RevealImmediately
+	scoreCard := func() *model_cassandra.Response {
+		return &model_cassandra.Response{
+			Username:     "mock response card",
+			Score:        99.99,
+			QuizResponse: nil,
+			QuizID:       gocql.TimeUUID(),
+		}
+	}
 
 	testCases := []struct {
 		name                string
@@ -27,6 +39,9 @@ func TestGetScore(t *testing.T) {
 		expectedStatus      int
 		authValidateJWTData *http_common.MockAuthData
 		cassandraReadData   *http_common.MockCassandraData
+		redisGetData        *http_common.MockRedisData
+		cassandraQuizData   *http_common.MockCassandraData
+		breakdownTimes      int
 	}{
 		// ----- test cases start ----- //
 		{
@@ -42,6 +57,12 @@ func TestGetScore(t *testing.T) {
 			cassandraReadData: &http_common.MockCassandraData{
 				Times: 0,
 			},
+			redisGetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraQuizData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:           "invalid quiz id",
 			path:           "/score/invalid-quiz-id",
@@ -54,6 +75,12 @@ func TestGetScore(t *testing.T) {
 			cassandraReadData: &http_common.MockCassandraData{
 				Times: 0,
 			},
+			redisGetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraQuizData: &http_common.MockCassandraData{
+				Times: 0,
+			},
 		}, {
 			name:           "db read not found",
 			path:           "/score/db-read-not-found/",
@@ -70,6 +97,34 @@ func TestGetScore(t *testing.T) {
 				},
 				Times: 1,
 			},
+			redisGetData: &http_common.MockRedisData{
+				Times: 0,
+			},
+			cassandraQuizData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "quiz read failure",
+			path:           "/score/quiz-read-failure/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: scoreCard(),
+				Times:       1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err:    &redis.Error{Message: "cache miss error", Code: redis.ErrorCacheMiss},
+				Times:  1,
+			},
+			cassandraQuizData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
 		}, {
 			name:           "success",
 			path:           "/score/success/",
@@ -80,14 +135,37 @@ func TestGetScore(t *testing.T) {
 				Times:        1,
 			},
 			cassandraReadData: &http_common.MockCassandraData{
-				OutputParam: &model_cassandra.Response{
-					Username:     "mock response card",
-					Score:        99.99,
-					QuizResponse: nil,
-					QuizID:       gocql.TimeUUID(),
-				},
-				Times: 1,
+				OutputParam: scoreCard(),
+				Times:       1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *cassandra.GetTestQuizzes()["myPubQuiz"],
+				Times:  1,
+			},
+			cassandraQuizData: &http_common.MockCassandraData{
+				Times: 0,
+			},
+		}, {
+			name:           "success - answers revealed",
+			path:           "/score/success-answers-revealed/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				Times:        1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: scoreCard(),
+				Times:       1,
+			},
+			redisGetData: &http_common.MockRedisData{
+				Param2: *revealedQuiz,
+				Times:  1,
+			},
+			cassandraQuizData: &http_common.MockCassandraData{
+				Times: 0,
 			},
+			breakdownTimes: 1,
 		},
 		// ----- test cases end ----- //
 	}
@@ -98,20 +176,47 @@ func TestGetScore(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrading := mocks.NewMockGrading(mockCtrl)
 
-			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
-				testCase.cassandraReadData.OutputParam,
-				testCase.cassandraReadData.OutputErr,
-			).Times(testCase.cassandraReadData.Times)
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
 
-			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
-				testCase.authValidateJWTData.OutputParam1,
-				testCase.authValidateJWTData.OutputParam2,
-				testCase.authValidateJWTData.OutputErr,
-			).Times(testCase.authValidateJWTData.Times)
+				// Score card read.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+
+				// Quiz cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
+
+				// Quiz read on cache miss.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraQuizData.OutputParam,
+					testCase.cassandraQuizData.OutputErr,
+				).Times(testCase.cassandraQuizData.Times),
+
+				// Break down the effective attempt if the answers are revealed.
+				mockGrading.EXPECT().GradeQuestions(gomock.Any(), gomock.Any()).Return(
+					[]float64{1, 0},
+					[]bool{true, false},
+					nil,
+				).Times(testCase.breakdownTimes),
+			)
 
 			// Endpoint setup for test.
-			router.GET(testCase.path+":quiz_id", GetScore(zapLogger, mockAuth, mockCassandra))
+			router.GET(testCase.path+":quiz_id", GetScore(zapLogger, mockAuth, mockCassandra, mockRedis, mockGrading))
 			req, _ := http.NewRequest("GET", testCase.path+testCase.quizId, nil)
 			w := httptest.NewRecorder()
 			router.ServeHTTP(w, req)
@@ -129,6 +234,14 @@ func TestGetScore(t *testing.T) {
 				responseMap, ok := response.Payload.(map[string]any)
 				require.True(t, ok, "failed to convert payload to an index-able map")
 				require.NotEqual(t, 0, responseMap["score"], "failed to get score from payload")
+
+				if testCase.breakdownTimes > 0 {
+					breakdown, ok := responseMap["breakdown"].([]any)
+					require.True(t, ok, "failed to get breakdown from payload")
+					require.Len(t, breakdown, len(revealedQuiz.Questions), "breakdown should have every question")
+				} else {
+					require.Nil(t, responseMap["breakdown"], "answers should not be revealed")
+				}
 			}
 		})
 	}
@@ -283,6 +396,7 @@ func TestGetStats(t *testing.T) {
 			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 				testCase.authValidateJWTData.OutputParam1,
 				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
 				testCase.authValidateJWTData.OutputErr,
 			).Times(testCase.authValidateJWTData.Times)
 
@@ -663,6 +777,7 @@ func TestGetStatsPage(t *testing.T) {
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 					testCase.authValidateJWTData.OutputParam1,
 					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
 					testCase.authValidateJWTData.OutputErr,
 				).Times(testCase.authValidateJWTData.Times),
 				// Decrypt cursor page.
@@ -704,3 +819,989 @@ func TestGetStatsPage(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStatsSummary(t *testing.T) {
+	router := http_common.GetTestRouter()
+	quiz := testQuizData["myPubQuiz"]
+	cacheMiss := &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss}
+
+	testCases := []struct {
+		name                string
+		path                string
+		quizId              string
+		expectedStatus      int
+		expectedResponses   float64
+		authValidateJWTData *http_common.MockAuthData
+		redisQuizData       *http_common.MockRedisData
+		cassandraQuizData   *http_common.MockCassandraData
+		redisSummaryData    *http_common.MockRedisData
+		cassandraPageData   *http_common.MockCassandraData
+		redisSetData        *http_common.MockRedisData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid quiz id",
+			path:                "/summary/invalid-quiz-id/",
+			quizId:              "not a valid uuid",
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisQuizData:       &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			redisSummaryData:    &http_common.MockRedisData{Param2: model_http.StatsSummary{}, Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:           "empty token",
+			path:           "/summary/empty-token/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisQuizData:     &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			cassandraQuizData: &http_common.MockCassandraData{Times: 0},
+			redisSummaryData:  &http_common.MockRedisData{Param2: model_http.StatsSummary{}, Times: 0},
+			cassandraPageData: &http_common.MockCassandraData{Times: 0},
+			redisSetData:      &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "quiz not found",
+			path:                "/summary/quiz-not-found/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Err: cacheMiss, Times: 1},
+			cassandraQuizData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "quiz not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			redisSummaryData:  &http_common.MockRedisData{Param2: model_http.StatsSummary{}, Times: 0},
+			cassandraPageData: &http_common.MockCassandraData{Times: 0},
+			redisSetData:      &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "not author",
+			path:                "/summary/not-author/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "not the author", Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			redisSummaryData:    &http_common.MockRedisData{Param2: model_http.StatsSummary{}, Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "page read failure",
+			path:                "/summary/page-read-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			redisSummaryData:    &http_common.MockRedisData{Param2: model_http.StatsSummary{}, Err: cacheMiss, Times: 1},
+			cassandraPageData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "page read failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			redisSetData: &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "success cache hit",
+			path:                "/summary/success-cache-hit/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusOK,
+			expectedResponses:   3,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			redisSummaryData:    &http_common.MockRedisData{Param2: model_http.StatsSummary{NumResponses: 3}, Times: 1},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+			redisSetData:        &http_common.MockRedisData{Times: 0},
+		}, {
+			name:                "success cache miss",
+			path:                "/summary/success-cache-miss/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusOK,
+			expectedResponses:   2,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			redisSummaryData:    &http_common.MockRedisData{Param2: model_http.StatsSummary{}, Err: cacheMiss, Times: 1},
+			cassandraPageData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.StatsResponse{
+					Records: []*model_cassandra.Response{{Score: 1, MaxScore: 2}, {Score: 2, MaxScore: 2}},
+				},
+				Times: 1,
+			},
+			redisSetData: &http_common.MockRedisData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Quiz cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisQuizData.Param2,
+				).Return(
+					testCase.redisQuizData.Err,
+				).Times(testCase.redisQuizData.Times),
+
+				// Quiz read on cache miss.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraQuizData.OutputParam,
+					testCase.cassandraQuizData.OutputErr,
+				).Times(testCase.cassandraQuizData.Times),
+
+				// Summary cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisSummaryData.Param2,
+				).Return(
+					testCase.redisSummaryData.Err,
+				).Times(testCase.redisSummaryData.Times),
+
+				// Response page read on cache miss.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraPageData.OutputParam,
+					testCase.cassandraPageData.OutputErr,
+				).Times(testCase.cassandraPageData.Times),
+
+				// Summary cache set.
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any()).Return(
+					testCase.redisSetData.Err,
+				).Times(testCase.redisSetData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.GET(testCase.path+":quiz_id", GetStatsSummary(zapLogger, mockAuth, mockCassandra, mockRedis))
+			req, _ := http.NewRequest("GET", testCase.path+testCase.quizId, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check the statistics summary.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.Success{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body")
+
+				summary, ok := response.Payload.(map[string]any)
+				require.True(t, ok, "failed to convert payload to an index-able map")
+				require.Equal(t, testCase.expectedResponses, summary["num_responses"], "incorrect number of responses")
+			}
+		})
+	}
+}
+
+func TestGetItemAnalysis(t *testing.T) {
+	router := http_common.GetTestRouter()
+	quiz := testQuizData["myPubQuiz"]
+	drawnQuiz := *quiz
+	drawnQuiz.QuizCore = &model_cassandra.QuizCore{Title: "drawn", Draw: &model_cassandra.QuestionDraw{}}
+	cacheMiss := &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss}
+
+	testCases := []struct {
+		name                string
+		path                string
+		quizId              string
+		expectedStatus      int
+		expectedResponses   float64
+		authValidateJWTData *http_common.MockAuthData
+		redisQuizData       *http_common.MockRedisData
+		cassandraQuizData   *http_common.MockCassandraData
+		cassandraPageData   *http_common.MockCassandraData
+		gradeTimes          int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid quiz id",
+			path:                "/items/invalid-quiz-id/",
+			quizId:              "not a valid uuid",
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisQuizData:       &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:           "empty token",
+			path:           "/items/empty-token/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisQuizData:     &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			cassandraQuizData: &http_common.MockCassandraData{Times: 0},
+			cassandraPageData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "quiz not found",
+			path:                "/items/quiz-not-found/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Err: cacheMiss, Times: 1},
+			cassandraQuizData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "quiz not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraPageData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "not author",
+			path:                "/items/not-author/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "not the author", Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "drawn quiz",
+			path:                "/items/drawn-quiz/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: drawnQuiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "page read failure",
+			path:                "/items/page-read-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "page read failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+		}, {
+			name:                "success",
+			path:                "/items/success/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusOK,
+			expectedResponses:   2,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.StatsResponse{
+					Records: []*model_cassandra.Response{
+						{Version: quiz.Version, QuizResponse: &model_cassandra.QuizResponse{}},
+						{Version: quiz.Version, QuizResponse: &model_cassandra.QuizResponse{}},
+					},
+				},
+				Times: 1,
+			},
+			gradeTimes: 2,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrading := mocks.NewMockGrading(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Quiz cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisQuizData.Param2,
+				).Return(
+					testCase.redisQuizData.Err,
+				).Times(testCase.redisQuizData.Times),
+
+				// Quiz read on cache miss.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraQuizData.OutputParam,
+					testCase.cassandraQuizData.OutputErr,
+				).Times(testCase.cassandraQuizData.Times),
+
+				// Response page read.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraPageData.OutputParam,
+					testCase.cassandraPageData.OutputErr,
+				).Times(testCase.cassandraPageData.Times),
+			)
+
+			// Grade every question in the responses.
+			mockGrading.EXPECT().GradeQuestions(gomock.Any(), gomock.Any()).Return(
+				make([]float64, len(quiz.Questions)),
+				make([]bool, len(quiz.Questions)),
+				nil,
+			).Times(testCase.gradeTimes)
+
+			// Endpoint setup for test.
+			router.GET(testCase.path+":quiz_id", GetItemAnalysis(zapLogger, mockAuth, mockCassandra, mockRedis, mockGrading))
+			req, _ := http.NewRequest("GET", testCase.path+testCase.quizId, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check the item analysis.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.Success{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body")
+
+				analysis, ok := response.Payload.(map[string]any)
+				require.True(t, ok, "failed to convert payload to an index-able map")
+				require.Equal(t, testCase.expectedResponses, analysis["num_responses"], "incorrect number of responses")
+				require.Equal(t, len(quiz.Questions), len(analysis["items"].([]any)), "incorrect number of items")
+			}
+		})
+	}
+}
+
+func TestExportResults(t *testing.T) {
+	router := http_common.GetTestRouter()
+	quiz := testQuizData["myPubQuiz"]
+	drawnQuiz := *quiz
+	drawnQuiz.QuizCore = &model_cassandra.QuizCore{Title: "drawn", Draw: &model_cassandra.QuestionDraw{Count: 1}}
+	cacheMiss := &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss}
+	page := &model_cassandra.StatsResponse{Records: []*model_cassandra.Response{
+		{Username: "user1", Score: 1, MaxScore: 2, Version: quiz.Version, QuizResponse: &model_cassandra.QuizResponse{}},
+	}}
+
+	testCases := []struct {
+		name                string
+		path                string
+		quizId              string
+		querySegment        string
+		accept              string
+		expectedStatus      int
+		expectedType        string
+		expectedPrefix      string
+		authValidateJWTData *http_common.MockAuthData
+		redisQuizData       *http_common.MockRedisData
+		cassandraQuizData   *http_common.MockCassandraData
+		cassandraPageData   *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid quiz id",
+			path:                "/export/invalid-quiz-id/",
+			quizId:              "not a valid uuid",
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisQuizData:       &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "invalid correctness",
+			path:                "/export/invalid-correctness/",
+			quizId:              gocql.TimeUUID().String(),
+			querySegment:        "?correctness=maybe",
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisQuizData:       &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "unsupported format",
+			path:                "/export/unsupported-format/",
+			quizId:              gocql.TimeUUID().String(),
+			accept:              "application/xml",
+			expectedStatus:      http.StatusNotAcceptable,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisQuizData:       &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:           "empty token",
+			path:           "/export/empty-token/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisQuizData:     &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			cassandraQuizData: &http_common.MockCassandraData{Times: 0},
+			cassandraPageData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "quiz not found",
+			path:                "/export/quiz-not-found/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Err: cacheMiss, Times: 1},
+			cassandraQuizData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "quiz not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraPageData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "not author",
+			path:                "/export/not-author/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "not the author", Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "correctness of drawn quiz",
+			path:                "/export/correctness-of-drawn-quiz/",
+			quizId:              gocql.TimeUUID().String(),
+			querySegment:        "?correctness=true",
+			expectedStatus:      http.StatusBadRequest,
+			expectedType:        "application/json",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: drawnQuiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "page read failure",
+			path:                "/export/page-read-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusInternalServerError,
+			expectedType:        "application/json",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "page read failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+		}, {
+			name:                "success csv",
+			path:                "/export/success-csv/",
+			quizId:              gocql.TimeUUID().String(),
+			accept:              "text/csv",
+			expectedStatus:      http.StatusOK,
+			expectedType:        http_common.ExportFormatCSV,
+			expectedPrefix:      "username,score,max_score,version,attempts,q1,",
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{OutputParam: page, Times: 1},
+		}, {
+			name:                "success ndjson",
+			path:                "/export/success-ndjson/",
+			quizId:              gocql.TimeUUID().String(),
+			accept:              "application/x-ndjson",
+			expectedStatus:      http.StatusOK,
+			expectedType:        http_common.ExportFormatNDJSON,
+			expectedPrefix:      `{"username":"user1","score":1,"max_score":2,`,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisQuizData:       &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{OutputParam: page, Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrading := mocks.NewMockGrading(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Quiz cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisQuizData.Param2,
+				).Return(
+					testCase.redisQuizData.Err,
+				).Times(testCase.redisQuizData.Times),
+
+				// Quiz read on cache miss.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraQuizData.OutputParam,
+					testCase.cassandraQuizData.OutputErr,
+				).Times(testCase.cassandraQuizData.Times),
+
+				// Response page read.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraPageData.OutputParam,
+					testCase.cassandraPageData.OutputErr,
+				).Times(testCase.cassandraPageData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.GET(testCase.path+":quiz_id", ExportResults(zapLogger, mockAuth, mockCassandra, mockRedis, mockGrading))
+			req, _ := http.NewRequest("GET", testCase.path+testCase.quizId+testCase.querySegment, nil)
+			if len(testCase.accept) != 0 {
+				req.Header.Set("Accept", testCase.accept)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+			if len(testCase.expectedType) != 0 {
+				require.Contains(t, w.Header().Get("Content-Type"), testCase.expectedType, "content type mismatch")
+			}
+
+			// Check the exported results.
+			if testCase.expectedStatus == http.StatusOK {
+				require.NotEmpty(t, w.Header().Get("Content-Disposition"), "content disposition expected but not set")
+				require.True(t, strings.HasPrefix(w.Body.String(), testCase.expectedPrefix), "export does not start with expected prefix")
+			}
+		})
+	}
+}
+
+func TestRegradeScores(t *testing.T) {
+	router := http_common.GetTestRouter()
+	quiz := testQuizData["myPubQuiz"]
+
+	testCases := []struct {
+		name                string
+		path                string
+		quizId              string
+		expectedStatus      int
+		authValidateJWTData *http_common.MockAuthData
+		redisGetData        *http_common.MockRedisData
+		cassandraQuizData   *http_common.MockCassandraData
+		cassandraPageData   *http_common.MockCassandraData
+		gradingData         *http_common.MockGraderData
+		cassandraUpdateData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "invalid quiz id",
+			path:                "/regrade/invalid-quiz-id/",
+			quizId:              "not a valid uuid",
+			expectedStatus:      http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+			redisGetData:        &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+			gradingData:         &http_common.MockGraderData{Times: 0},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:           "empty token",
+			path:           "/regrade/empty-token/",
+			quizId:         gocql.TimeUUID().String(),
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			redisGetData:        &http_common.MockRedisData{Param2: model_cassandra.Quiz{}, Times: 0},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+			gradingData:         &http_common.MockGraderData{Times: 0},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "quiz not found",
+			path:                "/regrade/quiz-not-found/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisGetData: &http_common.MockRedisData{
+				Param2: model_cassandra.Quiz{},
+				Err:    &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss},
+				Times:  1,
+			},
+			cassandraQuizData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "quiz not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+			gradingData:         &http_common.MockGraderData{Times: 0},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "not author",
+			path:                "/regrade/not-author/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "not the author", Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData:   &http_common.MockCassandraData{Times: 0},
+			gradingData:         &http_common.MockGraderData{Times: 0},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "page read failure",
+			path:                "/regrade/page-read-failure/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "page read failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			gradingData:         &http_common.MockGraderData{Times: 0},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "success",
+			path:                "/regrade/success/",
+			quizId:              gocql.TimeUUID().String(),
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: quiz.Author, Times: 1},
+			redisGetData:        &http_common.MockRedisData{Param2: *quiz, Times: 1},
+			cassandraQuizData:   &http_common.MockCassandraData{Times: 0},
+			cassandraPageData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.StatsResponse{
+					Records: []*model_cassandra.Response{{Username: "username 1", Author: quiz.Author, Score: 1, MaxScore: 2}},
+				},
+				Times: 1,
+			},
+			gradingData:         &http_common.MockGraderData{OutputParam: 2, OutputMax: 2, Times: 1},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockGrading := mocks.NewMockGrading(mockCtrl)
+
+			summaryEvictions := 0
+			if testCase.cassandraPageData.Times > 0 {
+				summaryEvictions = 1
+			}
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+
+				// Cache call.
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).SetArg(
+					1,
+					testCase.redisGetData.Param2,
+				).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
+
+				// Quiz read on cache miss.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraQuizData.OutputParam,
+					testCase.cassandraQuizData.OutputErr,
+				).Times(testCase.cassandraQuizData.Times),
+
+				// Response page read.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraPageData.OutputParam,
+					testCase.cassandraPageData.OutputErr,
+				).Times(testCase.cassandraPageData.Times),
+
+				mockGrading.EXPECT().Grade(gomock.Any(), gomock.Any()).Return(
+					testCase.gradingData.OutputParam,
+					testCase.gradingData.OutputMax,
+					testCase.gradingData.OutputErr,
+				).Times(testCase.gradingData.Times),
+
+				// Score update.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraUpdateData.OutputParam,
+					testCase.cassandraUpdateData.OutputErr,
+				).Times(testCase.cassandraUpdateData.Times),
+
+				// Evict the statistics summary once the responses are regraded, even partially.
+				mockRedis.EXPECT().Del(gomock.Any()).Return(nil).Times(summaryEvictions),
+			)
+
+			// Endpoint setup for test.
+			router.PATCH(testCase.path+":quiz_id", RegradeScores(zapLogger, mockAuth, mockCassandra, mockRedis, mockGrading))
+			req, _ := http.NewRequest("PATCH", testCase.path+testCase.quizId, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check the regrade summary.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.Success{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body")
+
+				summary, ok := response.Payload.(map[string]any)
+				require.True(t, ok, "failed to convert payload to an index-able map")
+				require.Equal(t, float64(1), summary["processed"], "incorrect number of processed responses")
+				require.Equal(t, float64(1), summary["changed"], "incorrect number of changed responses")
+				require.Equal(t, float64(1), summary["net_change"], "incorrect net change in scores")
+			}
+		})
+	}
+}
+
+func TestListMyScores(t *testing.T) {
+	router := http_common.GetTestRouter()
+	testCases := []struct {
+		name                string
+		path                string
+		querySegment        string
+		expectedLen         int
+		expectedStatus      int
+		expectLink          require.BoolAssertionFunc
+		authValidateJWTData *http_common.MockAuthData
+		authDecryptData     *http_common.MockAuthData
+		cassandraReadData   *http_common.MockCassandraData
+		authEncryptData     *http_common.MockAuthData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:           "empty token",
+			path:           "/my-scores/empty-token",
+			querySegment:   "?pageCursor=PaGeCuRs0R==&pageSize=3",
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			authDecryptData:   &http_common.MockAuthData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			authEncryptData:   &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:           "cursor decryption failure",
+			path:           "/my-scores/cursor-decryption-failure",
+			querySegment:   "?pageCursor=PaGeCuRs0R==&pageSize=3",
+			expectedStatus: http.StatusBadRequest,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{
+				OutputErr: errors.New("decrypting cursor failed"),
+				Times:     1,
+			},
+			cassandraReadData: &http_common.MockCassandraData{Times: 0},
+			authEncryptData:   &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:           "db read failure",
+			path:           "/my-scores/db-read-failure",
+			querySegment:   "?pageCursor=PaGeCuRs0R==&pageSize=3",
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		}, {
+			name:           "cursor encryption failure",
+			path:           "/my-scores/cursor-encryption-failure",
+			querySegment:   "?pageSize=3",
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.UserScoresResponse{
+					PageCursor: []byte("cursor to next page"),
+					Records:    []*model_cassandra.UserScore{{}, {}, {}},
+					PageSize:   3,
+				},
+				Times: 1,
+			},
+			authEncryptData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("encrypting cursor failed"),
+				Times:        1,
+			},
+		}, {
+			name:           "success",
+			path:           "/my-scores/success",
+			querySegment:   "?pageCursor=PaGeCuRs0R==&pageSize=3",
+			expectedLen:    3,
+			expectedStatus: http.StatusOK,
+			expectLink:     require.True,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 1},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.UserScoresResponse{
+					PageCursor: []byte("cursor to next page"),
+					Records:    []*model_cassandra.UserScore{{}, {}, {}},
+					PageSize:   3,
+				},
+				Times: 1,
+			},
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "tHisIsAnEnCrYPtEdCUrS0r", Times: 1},
+		}, {
+			name:           "success last page",
+			path:           "/my-scores/success-last-page",
+			querySegment:   "?pageSize=3",
+			expectedLen:    2,
+			expectedStatus: http.StatusOK,
+			expectLink:     require.False,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "expected-username",
+				Times:        1,
+			},
+			authDecryptData: &http_common.MockAuthData{Times: 0},
+			cassandraReadData: &http_common.MockCassandraData{
+				OutputParam: &model_cassandra.UserScoresResponse{
+					Records:  []*model_cassandra.UserScore{{}, {}},
+					PageSize: 3,
+				},
+				Times: 1,
+			},
+			authEncryptData: &http_common.MockAuthData{OutputParam1: "", Times: 0},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			gomock.InOrder(
+				// Validate JWT.
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+				// Decrypt cursor page.
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).Return(
+					testCase.authDecryptData.OutputParam1,
+					testCase.authDecryptData.OutputErr,
+				).Times(testCase.authDecryptData.Times),
+				// Get scores page.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+				// Encrypt cursor page.
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).Return(
+					testCase.authEncryptData.OutputParam1,
+					testCase.authEncryptData.OutputErr,
+				).Times(testCase.authEncryptData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.GET(testCase.path, ListMyScores(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("GET", testCase.path+testCase.querySegment, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify response code.
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+
+			// Check records and link to the next page.
+			if testCase.expectedStatus == http.StatusOK {
+				response := model_http.UserScoresResponse{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response), "failed to unmarshall response body")
+
+				require.Equal(t, testCase.expectedLen, len(response.Records), "records count does not match expected")
+				require.Equal(t, testCase.expectedLen, response.Metadata.NumRecords, "metadata record count does not match expected")
+				testCase.expectLink(t, len(response.Links.NextPage) != 0, "link to next page expectation failed")
+			}
+		})
+	}
+}
+
+func TestPrepareUserScoresResponse(t *testing.T) {
+	testCases := []struct {
+		name         string
+		dbResponse   *model_cassandra.UserScoresResponse
+		mockAuthData *http_common.MockAuthData
+		expectErr    require.ErrorAssertionFunc
+		expectLink   string
+	}{
+		// ----- test cases start ----- //
+		{
+			name:         "no cursor",
+			dbResponse:   &model_cassandra.UserScoresResponse{PageSize: 3},
+			mockAuthData: &http_common.MockAuthData{Times: 0, OutputParam1: ""},
+			expectErr:    require.NoError,
+			expectLink:   "",
+		}, {
+			name:         "cursor and page",
+			dbResponse:   &model_cassandra.UserScoresResponse{PageCursor: []byte("page-cursor"), PageSize: 3},
+			mockAuthData: &http_common.MockAuthData{Times: 1, OutputParam1: "CuRs0R"},
+			expectErr:    require.NoError,
+			expectLink:   "?pageCursor=CuRs0R&pageSize=3",
+		}, {
+			name:         "encryption failure",
+			dbResponse:   &model_cassandra.UserScoresResponse{PageCursor: []byte("page-cursor"), PageSize: 3},
+			mockAuthData: &http_common.MockAuthData{Times: 1, OutputParam1: "", OutputErr: errors.New("encryption failure")},
+			expectErr:    require.Error,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+
+			mockAuth.EXPECT().EncryptToString(gomock.Any()).Return(
+				testCase.mockAuthData.OutputParam1,
+				testCase.mockAuthData.OutputErr,
+			).Times(testCase.mockAuthData.Times)
+
+			resp, err := prepareUserScoresResponse(mockAuth, testCase.dbResponse)
+			testCase.expectErr(t, err, "error expectation condition failed")
+
+			if err == nil {
+				require.Equal(t, testCase.expectLink, resp.Links.NextPage, "next page link mismatch")
+				require.Equal(t, len(testCase.dbResponse.Records), resp.Metadata.NumRecords, "number of records mismatch")
+			}
+		})
+	}
+}
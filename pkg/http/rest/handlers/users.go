@@ -9,6 +9,7 @@ import (
 	"github.com/surahman/mcq-platform/pkg/auth"
 	"github.com/surahman/mcq-platform/pkg/cassandra"
 	"github.com/surahman/mcq-platform/pkg/constants"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
 	"github.com/surahman/mcq-platform/pkg/logger"
 	"github.com/surahman/mcq-platform/pkg/model/cassandra"
 	"github.com/surahman/mcq-platform/pkg/model/http"
@@ -51,12 +52,12 @@ func RegisterUser(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra)
 			return
 		}
 
-		if _, err = db.Execute(cassandra.CreateUserQuery, &model_cassandra.User{UserAccount: &user}); err != nil {
+		if _, err = db.Execute(cassandra.CreateUserQuery, &model_cassandra.User{UserAccount: &user, Role: model_cassandra.RoleStudent}); err != nil {
 			context.AbortWithStatusJSON(err.(*cassandra.Error).Status, &model_http.Error{Message: err.Error()})
 			return
 		}
 
-		if authToken, err = auth.GenerateJWT(user.Username); err != nil {
+		if authToken, err = auth.GenerateJWT(user.Username, model_cassandra.RoleStudent); err != nil {
 			logger.Error("failure generating JWT during account creation", zap.Error(err))
 			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: err.Error()})
 			return
@@ -107,7 +108,7 @@ func LoginUser(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gi
 			return
 		}
 
-		if authToken, err = auth.GenerateJWT(loginRequest.Username); err != nil {
+		if authToken, err = auth.GenerateJWT(loginRequest.Username, truth.Role); err != nil {
 			logger.Error("failure generating JWT during login", zap.Error(err))
 			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: err.Error()})
 			return
@@ -117,7 +118,7 @@ func LoginUser(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gi
 	}
 }
 
-// LoginRefresh validates a JWT token and issues a fresh token.
+// LoginRefresh validates a JWT token and issues a fresh token with the user's current role.
 //	@Summary		Refresh a user's JWT by extending its expiration time.
 //	@Description	Refreshes a user's JWT by validating it and then issuing a fresh JWT with an extended validity time. JWT must be expiring in under 60 seconds.
 //	@Tags			user users login refresh security
@@ -139,7 +140,7 @@ func LoginRefresh(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra,
 		var expiresAt int64
 		originalToken := context.GetHeader(authHeaderKey)
 
-		if username, expiresAt, err = auth.ValidateJWT(originalToken); err != nil {
+		if username, expiresAt, _, err = auth.ValidateJWT(originalToken); err != nil {
 			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: err.Error()})
 			return
 		}
@@ -150,7 +151,8 @@ func LoginRefresh(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra,
 			return
 		}
 
-		if dbResponse.(*model_cassandra.User).IsDeleted {
+		truth := dbResponse.(*model_cassandra.User)
+		if truth.IsDeleted {
 			logger.Warn("attempt to refresh a JWT for a deleted user", zap.String("username", username))
 			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: "invalid token"})
 			return
@@ -162,7 +164,8 @@ func LoginRefresh(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra,
 			return
 		}
 
-		if freshToken, err = auth.GenerateJWT(username); err != nil {
+		// The fresh token carries the user's current role.
+		if freshToken, err = auth.GenerateJWT(username, truth.Role); err != nil {
 			logger.Error("failure generating JWT during token refresh", zap.Error(err))
 			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: err.Error()})
 			return
@@ -206,7 +209,7 @@ func DeleteUser(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, a
 		}
 
 		// Validate the JWT and extract the username, compare the username against the deletion request login credentials.
-		if username, _, err = auth.ValidateJWT(jwt); err != nil {
+		if username, _, _, err = auth.ValidateJWT(jwt); err != nil {
 			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: err.Error()})
 			return
 		}
@@ -252,3 +255,85 @@ func DeleteUser(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra, a
 		context.JSON(http.StatusOK, model_http.Success{Message: "account successfully deleted"})
 	}
 }
+
+// SetUserRole will change the role of a user in the database.
+//	@Summary		Changes the role of a user. The requester must be an administrator.
+//	@Description	Changes the role of a user to admin, instructor, or student. The new role is carried by the user's JWT once they next login or refresh their JWT.
+//	@Tags			user users admin role security
+//	@Id				setUserRole
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		model_cassandra.UserRoleRequest	true	"The username and the role to assign to the user"
+//	@Success		200		{object}	model_http.Success				"message with a confirmation of the changed role"
+//	@Failure		400		{object}	model_http.Error				"error message with any available details in payload"
+//	@Failure		403		{object}	model_http.Error				"error message with any available details in payload"
+//	@Failure		404		{object}	model_http.Error				"error message with any available details in payload"
+//	@Failure		500		{object}	model_http.Error				"error message with any available details in payload"
+//	@Router			/admin/role [patch]
+func SetUserRole(logger *logger.Logger, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var roleRequest model_cassandra.UserRoleRequest
+
+		if err = context.ShouldBindJSON(&roleRequest); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: err.Error()})
+			return
+		}
+
+		if err = validator.ValidateStruct(&roleRequest); err != nil {
+			context.AbortWithStatusJSON(http.StatusBadRequest, &model_http.Error{Message: "validation", Payload: err})
+			return
+		}
+
+		if _, err = db.Execute(cassandra.UpdateUserRoleQuery, &roleRequest); err != nil {
+			logger.Warn("failed to change the role of a user", zap.String("username", roleRequest.Username), zap.Error(err))
+			context.AbortWithStatusJSON(err.(*cassandra.Error).Status, &model_http.Error{Message: err.Error()})
+			return
+		}
+
+		context.JSON(http.StatusOK, model_http.Success{Message: fmt.Sprintf("role of %s changed to %s", roleRequest.Username, roleRequest.Role)})
+	}
+}
+
+// DeleteAnyUser will mark any user as deleted in the database.
+//	@Summary		Deletes any user. The requester must be an administrator.
+//	@Description	Deletes any user stored in the database by marking it as deleted.
+//	@Description	Administrators cannot delete their own account or the configured administrator's account.
+//	@Tags			user users admin delete security
+//	@Id				deleteAnyUser
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			username	path		string				true	"The username of the account to delete."
+//	@Success		200			{object}	model_http.Success	"message with a confirmation of a deleted user account"
+//	@Failure		403			{object}	model_http.Error	"error message with any available details in payload"
+//	@Failure		404			{object}	model_http.Error	"error message with any available details in payload"
+//	@Failure		500			{object}	model_http.Error	"error message with any available details in payload"
+//	@Router			/admin/user/{username} [delete]
+func DeleteAnyUser(logger *logger.Logger, auth auth.Auth, db cassandra.Cassandra) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var err error
+		var requester string
+		username := context.Param("username")
+
+		// Get requester's username from JWT.
+		if requester, _, _, err = auth.ValidateJWT(context.GetHeader("Authorization")); err != nil {
+			logger.Error("failed to validate JWT in delete any user handler", zap.Error(err))
+			context.AbortWithStatusJSON(http.StatusInternalServerError, &model_http.Error{Message: "unable to verify username"})
+			return
+		}
+
+		if err = http_common.CheckDeletable(requester, username, auth); err != nil {
+			context.AbortWithStatusJSON(http.StatusForbidden, &model_http.Error{Message: err.Error()})
+			return
+		}
+
+		if _, err = db.Execute(cassandra.DeleteUserQuery, username); err != nil {
+			logger.Warn("failed to mark a user record as deleted", zap.String("username", username), zap.Error(err))
+			context.AbortWithStatusJSON(err.(*cassandra.Error).Status, &model_http.Error{Message: err.Error()})
+			return
+		}
+
+		context.JSON(http.StatusOK, model_http.Success{Message: "account successfully deleted"})
+	}
+}
@@ -137,7 +137,7 @@ func TestRegisterUser(t *testing.T) {
 				testCase.cassandraCreateData.OutputErr,
 			).Times(testCase.cassandraCreateData.Times)
 
-			mockAuth.EXPECT().GenerateJWT(gomock.Any()).Return(
+			mockAuth.EXPECT().GenerateJWT(gomock.Any(), gomock.Any()).Return(
 				testCase.authGenJWTData.OutputParam1,
 				testCase.authGenJWTData.OutputErr,
 			).Times(testCase.authGenJWTData.Times)
@@ -289,7 +289,7 @@ func TestLoginUser(t *testing.T) {
 				testCase.authCheckPwdData.OutputErr,
 			).Times(testCase.authCheckPwdData.Times)
 
-			mockAuth.EXPECT().GenerateJWT(gomock.Any()).Return(
+			mockAuth.EXPECT().GenerateJWT(gomock.Any(), gomock.Any()).Return(
 				testCase.authGenJWTData.OutputParam1,
 				testCase.authGenJWTData.OutputErr,
 			).Times(testCase.authGenJWTData.Times)
@@ -480,10 +480,11 @@ func TestLoginRefresh(t *testing.T) {
 			mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
 				testCase.authValidateJWTData.OutputParam1,
 				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
 				testCase.authValidateJWTData.OutputErr,
 			).Times(testCase.authValidateJWTData.Times)
 
-			mockAuth.EXPECT().GenerateJWT(gomock.Any()).Return(
+			mockAuth.EXPECT().GenerateJWT(gomock.Any(), gomock.Any()).Return(
 				testCase.authGenJWTData.OutputParam1,
 				testCase.authGenJWTData.OutputErr,
 			).Times(testCase.authGenJWTData.Times)
@@ -746,6 +747,7 @@ func TestDeleteUser(t *testing.T) {
 			mockAuth.EXPECT().ValidateJWT(authToken).Return(
 				testCase.authValidateJWTData.OutputParam1,
 				testCase.authValidateJWTData.OutputParam2,
+				testCase.authValidateJWTData.OutputParam3,
 				testCase.authValidateJWTData.OutputErr,
 			).Times(testCase.authValidateJWTData.Times)
 
@@ -765,3 +767,172 @@ func TestDeleteUser(t *testing.T) {
 		})
 	}
 }
+
+func TestSetUserRole(t *testing.T) {
+	router := http_common.GetTestRouter()
+
+	testCases := []struct {
+		name                string
+		path                string
+		expectedStatus      int
+		roleRequest         *model_cassandra.UserRoleRequest
+		cassandraUpdateData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:                "empty request",
+			path:                "/role/empty-request",
+			expectedStatus:      http.StatusBadRequest,
+			roleRequest:         &model_cassandra.UserRoleRequest{},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "invalid role",
+			path:                "/role/invalid-role",
+			expectedStatus:      http.StatusBadRequest,
+			roleRequest:         &model_cassandra.UserRoleRequest{Username: "username1", Role: "superuser"},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:           "user not found",
+			path:           "/role/user-not-found",
+			expectedStatus: http.StatusNotFound,
+			roleRequest:    &model_cassandra.UserRoleRequest{Username: "username1", Role: model_cassandra.RoleInstructor},
+			cassandraUpdateData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "user not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+		}, {
+			name:                "valid request",
+			path:                "/role/valid-request",
+			expectedStatus:      http.StatusOK,
+			roleRequest:         &model_cassandra.UserRoleRequest{Username: "username1", Role: model_cassandra.RoleInstructor},
+			cassandraUpdateData: &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			requestJson, err := json.Marshal(&testCase.roleRequest)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), testCase.roleRequest).Return(
+				testCase.cassandraUpdateData.OutputParam,
+				testCase.cassandraUpdateData.OutputErr,
+			).Times(testCase.cassandraUpdateData.Times)
+
+			// Endpoint setup for test.
+			router.PATCH(testCase.path, SetUserRole(zapLogger, mockCassandra))
+			req, _ := http.NewRequest("PATCH", testCase.path, bytes.NewBuffer(requestJson))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestDeleteAnyUser(t *testing.T) {
+	router := http_common.GetTestRouter()
+
+	bootstrapAdmin := &model_cassandra.UserAccount{
+		UserLoginCredentials: model_cassandra.UserLoginCredentials{Username: "administrator"},
+	}
+
+	testCases := []struct {
+		name                string
+		path                string
+		username            string
+		expectedStatus      int
+		authValidateJWTData *http_common.MockAuthData
+		adminAccountTimes   int
+		cassandraDeleteData *http_common.MockCassandraData
+	}{
+		// ----- test cases start ----- //
+		{
+			name:           "invalid jwt",
+			path:           "/user/invalid-jwt/",
+			username:       "username1",
+			expectedStatus: http.StatusInternalServerError,
+			authValidateJWTData: &http_common.MockAuthData{
+				OutputParam1: "",
+				OutputErr:    errors.New("invalid token"),
+				Times:        1,
+			},
+			adminAccountTimes:   0,
+			cassandraDeleteData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "own account",
+			path:                "/user/own-account/",
+			username:            "admin",
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "admin", Times: 1},
+			adminAccountTimes:   0,
+			cassandraDeleteData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "bootstrap administrator",
+			path:                "/user/bootstrap-administrator/",
+			username:            "administrator",
+			expectedStatus:      http.StatusForbidden,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "admin", Times: 1},
+			adminAccountTimes:   1,
+			cassandraDeleteData: &http_common.MockCassandraData{Times: 0},
+		}, {
+			name:                "already deleted",
+			path:                "/user/already-deleted/",
+			username:            "username1",
+			expectedStatus:      http.StatusNotFound,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "admin", Times: 1},
+			adminAccountTimes:   1,
+			cassandraDeleteData: &http_common.MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "user not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+		}, {
+			name:                "valid request",
+			path:                "/user/valid-request/",
+			username:            "username1",
+			expectedStatus:      http.StatusOK,
+			authValidateJWTData: &http_common.MockAuthData{OutputParam1: "admin", Times: 1},
+			adminAccountTimes:   1,
+			cassandraDeleteData: &http_common.MockCassandraData{Times: 1},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).Return(
+					testCase.authValidateJWTData.OutputParam1,
+					testCase.authValidateJWTData.OutputParam2,
+					testCase.authValidateJWTData.OutputParam3,
+					testCase.authValidateJWTData.OutputErr,
+				).Times(testCase.authValidateJWTData.Times),
+				mockAuth.EXPECT().AdminAccount().Return(bootstrapAdmin).Times(testCase.adminAccountTimes),
+				mockCassandra.EXPECT().Execute(gomock.Any(), testCase.username).Return(
+					testCase.cassandraDeleteData.OutputParam,
+					testCase.cassandraDeleteData.OutputErr,
+				).Times(testCase.cassandraDeleteData.Times),
+			)
+
+			// Endpoint setup for test.
+			router.DELETE(testCase.path+":username", DeleteAnyUser(zapLogger, mockAuth, mockCassandra))
+			req, _ := http.NewRequest("DELETE", testCase.path+testCase.username, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, testCase.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
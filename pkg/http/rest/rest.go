@@ -15,7 +15,9 @@ import (
 	_ "github.com/surahman/mcq-platform/docs" // Swaggo generated Swagger documentation
 	"github.com/surahman/mcq-platform/pkg/auth"
 	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/catalogue"
 	"github.com/surahman/mcq-platform/pkg/grading"
+	http_common "github.com/surahman/mcq-platform/pkg/http"
 	"github.com/surahman/mcq-platform/pkg/http/rest/handlers"
 	"github.com/surahman/mcq-platform/pkg/logger"
 	"github.com/surahman/mcq-platform/pkg/redis"
@@ -29,18 +31,19 @@ import (
 
 // Server is the HTTP REST server.
 type Server struct {
-	auth    auth.Auth
-	cache   redis.Redis
-	db      cassandra.Cassandra
-	grading grading.Grading
-	conf    *config
-	logger  *logger.Logger
-	router  *gin.Engine
-	wg      *sync.WaitGroup
+	auth      auth.Auth
+	cache     redis.Redis
+	catalogue catalogue.Catalogue
+	db        cassandra.Cassandra
+	grading   grading.Grading
+	conf      *config
+	logger    *logger.Logger
+	router    *gin.Engine
+	wg        *sync.WaitGroup
 }
 
 // NewServer will create a new REST server instance in a non-running state.
-func NewServer(fs *afero.Fs, auth auth.Auth, cassandra cassandra.Cassandra, redis redis.Redis,
+func NewServer(fs *afero.Fs, auth auth.Auth, cassandra cassandra.Cassandra, redis redis.Redis, catalogue catalogue.Catalogue,
 	grading grading.Grading, logger *logger.Logger, wg *sync.WaitGroup) (server *Server, err error) {
 	// Load configurations.
 	conf := newConfig()
@@ -49,13 +52,14 @@ func NewServer(fs *afero.Fs, auth auth.Auth, cassandra cassandra.Cassandra, redi
 	}
 
 	return &Server{
-			conf:    conf,
-			auth:    auth,
-			cache:   redis,
-			db:      cassandra,
-			grading: grading,
-			logger:  logger,
-			wg:      wg,
+			conf:      conf,
+			auth:      auth,
+			cache:     redis,
+			catalogue: catalogue,
+			db:        cassandra,
+			grading:   grading,
+			logger:    logger,
+			wg:        wg,
 		},
 		err
 }
@@ -131,6 +135,9 @@ func (s *Server) initialize() {
 
 	// Endpoint configurations
 	authMiddleware := http_handlers.AuthMiddleware(s.auth, s.conf.Authorization.HeaderKey)
+	authorMiddleware := http_handlers.AuthMiddleware(s.auth, s.conf.Authorization.HeaderKey, http_common.PermissionCreateQuizzes)
+	groupCreatorMiddleware := http_handlers.AuthMiddleware(s.auth, s.conf.Authorization.HeaderKey, http_common.PermissionCreateGroups)
+	adminMiddleware := http_handlers.AuthMiddleware(s.auth, s.conf.Authorization.HeaderKey, http_common.PermissionManageUsers)
 	api := s.router.Group(s.conf.Server.BasePath)
 
 	api.GET("/health", http_handlers.Healthcheck(s.logger, s.db, s.cache))
@@ -142,15 +149,69 @@ func (s *Server) initialize() {
 	userGroup.Use(authMiddleware).DELETE("/delete", http_handlers.DeleteUser(s.logger, s.auth, s.db, s.conf.Authorization.HeaderKey))
 
 	scoreGroup := api.Group("/score").Use(authMiddleware)
-	scoreGroup.GET("/test/:quiz_id", http_handlers.GetScore(s.logger, s.auth, s.db))
+	scoreGroup.GET("/test/:quiz_id", http_handlers.GetScore(s.logger, s.auth, s.db, s.cache, s.grading))
 	scoreGroup.GET("/stats/:quiz_id", http_handlers.GetStats(s.logger, s.auth, s.db))
 	scoreGroup.GET("/stats-paged/:quiz_id", http_handlers.GetStatsPage(s.logger, s.auth, s.db))
+	scoreGroup.GET("/summary/:quiz_id", http_handlers.GetStatsSummary(s.logger, s.auth, s.db, s.cache))
+	scoreGroup.GET("/items/:quiz_id", http_handlers.GetItemAnalysis(s.logger, s.auth, s.db, s.cache, s.grading))
+	scoreGroup.GET("/export/:quiz_id", http_handlers.ExportResults(s.logger, s.auth, s.db, s.cache, s.grading))
+	scoreGroup.GET("/mine", http_handlers.ListMyScores(s.logger, s.auth, s.db))
+	scoreGroup.PATCH("/regrade/:quiz_id", http_handlers.RegradeScores(s.logger, s.auth, s.db, s.cache, s.grading))
 
 	quizGroup := api.Group("/quiz").Use(authMiddleware)
 	quizGroup.GET("/view/:quiz_id", http_handlers.ViewQuiz(s.logger, s.auth, s.db, s.cache))
-	quizGroup.POST("/create", http_handlers.CreateQuiz(s.logger, s.auth, s.db))
+	quizGroup.GET("/export/:quiz_id/:format", http_handlers.ExportQuiz(s.logger, s.auth, s.db, s.cache))
 	quizGroup.PATCH("/update/:quiz_id", http_handlers.UpdateQuiz(s.logger, s.auth, s.db))
-	quizGroup.DELETE("/delete/:quiz_id", http_handlers.DeleteQuiz(s.logger, s.auth, s.db, s.cache))
-	quizGroup.PATCH("/publish/:quiz_id", http_handlers.PublishQuiz(s.logger, s.auth, s.db, s.cache))
+	quizGroup.DELETE("/delete/:quiz_id", http_handlers.DeleteQuiz(s.logger, s.auth, s.db, s.cache, s.catalogue))
+	quizGroup.PATCH("/publish/:quiz_id", http_handlers.PublishQuiz(s.logger, s.auth, s.db, s.cache, s.catalogue))
+	quizGroup.PATCH("/revise/:quiz_id", http_handlers.ReviseQuiz(s.logger, s.auth, s.db, s.cache, s.catalogue))
+	quizGroup.PATCH("/schedule/:quiz_id", http_handlers.ScheduleQuiz(s.logger, s.auth, s.db, s.cache))
+	quizGroup.GET("/versions/:quiz_id", http_handlers.ListQuizVersions(s.logger, s.auth, s.db, s.cache))
+	quizGroup.GET("/versions/:quiz_id/:version", http_handlers.ViewQuizVersion(s.logger, s.auth, s.db, s.cache))
+	quizGroup.POST("/start/:quiz_id", http_handlers.StartQuiz(s.logger, s.auth, s.db, s.cache))
 	quizGroup.POST("/take/:quiz_id", http_handlers.TakeQuiz(s.logger, s.auth, s.db, s.cache, s.grading))
+	quizGroup.POST("/practice/:quiz_id", http_handlers.PracticeQuiz(s.logger, s.auth, s.db, s.cache, s.grading))
+	quizGroup.PUT("/draft/:quiz_id", http_handlers.SaveDraft(s.logger, s.auth, s.db, s.cache))
+	quizGroup.GET("/draft/:quiz_id", http_handlers.ResumeDraft(s.logger, s.auth, s.db, s.cache))
+	quizGroup.POST("/submit/:quiz_id", http_handlers.SubmitDraft(s.logger, s.auth, s.db, s.cache, s.grading))
+	quizGroup.GET("/marking-schemes", http_handlers.ListMarkingSchemes())
+	quizGroup.GET("/mine", http_handlers.ListMyQuizzes(s.logger, s.auth, s.db))
+	quizGroup.GET("/catalogue", http_handlers.ListCatalogue(s.logger, s.auth, s.catalogue))
+
+	quizAuthorGroup := api.Group("/quiz").Use(authorMiddleware)
+	quizAuthorGroup.POST("/create", http_handlers.CreateQuiz(s.logger, s.auth, s.db))
+	quizAuthorGroup.POST("/import/:format", http_handlers.ImportQuiz(s.logger, s.auth, s.db))
+
+	bankGroup := api.Group("/bank").Use(authMiddleware)
+	bankGroup.GET("/view/:bank_id", http_handlers.ViewQuestionBank(s.logger, s.auth, s.db))
+	bankGroup.PATCH("/update/:bank_id", http_handlers.UpdateQuestionBank(s.logger, s.auth, s.db))
+	bankGroup.DELETE("/delete/:bank_id", http_handlers.DeleteQuestionBank(s.logger, s.auth, s.db))
+
+	bankAuthorGroup := api.Group("/bank").Use(authorMiddleware)
+	bankAuthorGroup.POST("/create", http_handlers.CreateQuestionBank(s.logger, s.auth, s.db))
+
+	groupGroup := api.Group("/group").Use(authMiddleware)
+	groupGroup.GET("/view/:group_id", http_handlers.ViewGroup(s.logger, s.auth, s.db))
+	groupGroup.PATCH("/update/:group_id", http_handlers.UpdateGroup(s.logger, s.auth, s.db))
+	groupGroup.DELETE("/delete/:group_id", http_handlers.DeleteGroup(s.logger, s.auth, s.db))
+	groupGroup.GET("/members/:group_id", http_handlers.ListGroupMembers(s.logger, s.auth, s.db))
+	groupGroup.DELETE("/members/:group_id/:username", http_handlers.RemoveGroupMember(s.logger, s.auth, s.db))
+	groupGroup.POST("/invite/:group_id", http_handlers.InviteToGroup(s.logger, s.auth, s.db))
+	groupGroup.GET("/invitations", http_handlers.ListGroupInvitations(s.logger, s.auth, s.db))
+	groupGroup.POST("/accept/:group_id", http_handlers.AcceptGroupInvitation(s.logger, s.auth, s.db))
+	groupGroup.DELETE("/decline/:group_id", http_handlers.DeclineGroupInvitation(s.logger, s.auth, s.db))
+	groupGroup.POST("/join/:join_code", http_handlers.JoinGroup(s.logger, s.auth, s.db))
+	groupGroup.PATCH("/join-code/:group_id", http_handlers.ResetGroupJoinCode(s.logger, s.auth, s.db))
+	groupGroup.GET("/mine", http_handlers.ListMyGroups(s.logger, s.auth, s.db))
+	groupGroup.PATCH("/assign/:group_id/:quiz_id", http_handlers.AssignQuiz(s.logger, s.auth, s.db, s.cache, s.catalogue))
+	groupGroup.DELETE("/assign/:group_id/:quiz_id", http_handlers.UnassignQuiz(s.logger, s.auth, s.db, s.cache, s.catalogue))
+	groupGroup.GET("/quizzes/:group_id", http_handlers.ListGroupQuizzes(s.logger, s.auth, s.db))
+	groupGroup.GET("/scores/:group_id", http_handlers.GetGroupScores(s.logger, s.auth, s.db))
+
+	groupCreatorGroup := api.Group("/group").Use(groupCreatorMiddleware)
+	groupCreatorGroup.POST("/create", http_handlers.CreateGroup(s.logger, s.auth, s.db))
+
+	adminGroup := api.Group("/admin").Use(adminMiddleware)
+	adminGroup.PATCH("/role", http_handlers.SetUserRole(s.logger, s.db))
+	adminGroup.DELETE("/user/:username", http_handlers.DeleteAnyUser(s.logger, s.auth, s.db))
 }
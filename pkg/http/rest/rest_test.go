@@ -17,6 +17,7 @@ func TestNewRESTServer(t *testing.T) {
 
 	mockAuth := mocks.NewMockAuth(mockCtrl)
 	mockCassandra := mocks.NewMockCassandra(mockCtrl)
+	mockCatalogue := mocks.NewMockCatalogue(mockCtrl)
 	mockGrading := mocks.NewMockGrading(mockCtrl)
 	mockRedis := mocks.NewMockRedis(mockCtrl)
 
@@ -25,7 +26,7 @@ func TestNewRESTServer(t *testing.T) {
 	require.NoError(t, afero.WriteFile(fs, constants.GetEtcDir()+constants.GetHTTPRESTFileName(),
 		[]byte(restConfigTestData["valid"]), 0644), "Failed to write in memory file")
 
-	server, err := NewServer(&fs, mockAuth, mockCassandra, mockRedis, mockGrading, zapLogger, &sync.WaitGroup{})
+	server, err := NewServer(&fs, mockAuth, mockCassandra, mockRedis, mockCatalogue, mockGrading, zapLogger, &sync.WaitGroup{})
 	require.NoError(t, err, "error whilst creating mock server")
 	require.NotNil(t, server, "failed to create mock server")
 }
@@ -0,0 +1,115 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gocql/gocql"
+	"github.com/surahman/mcq-platform/pkg/auth"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+// Permission is an operation that a user may only perform if it is granted by their role.
+type Permission string
+
+// Permissions granted to the roles.
+const (
+	PermissionCreateQuizzes Permission = "create-quizzes" // Create and import quizzes and question banks.
+	PermissionCreateGroups  Permission = "create-groups"  // Create classes and cohorts.
+	PermissionManageQuizzes Permission = "manage-quizzes" // Manage the quizzes of any author.
+	PermissionManageGroups  Permission = "manage-groups"  // Manage any class or cohort as an owner.
+	PermissionManageUsers   Permission = "manage-users"   // Change the role of and delete any user.
+)
+
+// rolePermissions are the permissions granted to each role. Students are not granted any permissions.
+var rolePermissions = map[string][]Permission{
+	model_cassandra.RoleAdmin: {PermissionCreateQuizzes, PermissionCreateGroups, PermissionManageQuizzes, PermissionManageGroups,
+		PermissionManageUsers},
+	model_cassandra.RoleInstructor: {PermissionCreateQuizzes, PermissionCreateGroups},
+}
+
+// ErrPermissionDenied is returned when a user's role does not grant a permission required for an operation.
+var ErrPermissionDenied = errors.New("permission denied")
+
+// ErrProtectedAccount is returned when an administrator attempts to delete their own account or the bootstrapped
+// administrator's account.
+var ErrProtectedAccount = errors.New("account cannot be deleted")
+
+// HasPermission reports whether a role grants a permission.
+func HasPermission(role string, permission Permission) bool {
+	for _, granted := range rolePermissions[role] {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckPermissions will verify that a role grants all the permissions. Roles missing a permission are returned an error
+// wrapping ErrPermissionDenied.
+func CheckPermissions(role string, permissions ...Permission) error {
+	for _, permission := range permissions {
+		if !HasPermission(role, permission) {
+			return fmt.Errorf("%w, the %s role does not grant %s", ErrPermissionDenied, role, permission)
+		}
+	}
+	return nil
+}
+
+// CanManage reports whether a user may manage a quiz. Authors manage their own quizzes and administrators manage all quizzes.
+func CanManage(author, username, role string) bool {
+	return username == author || HasPermission(role, PermissionManageQuizzes)
+}
+
+// QuizOwner returns the username that a quiz is mutated on behalf of. The database only permits authors to mutate their
+// quizzes, so administrators mutate a quiz on behalf of its author, whereas all other users mutate it as themselves.
+func QuizOwner(quizId gocql.UUID, username, role string, db cassandra.Cassandra) (string, error) {
+	if !HasPermission(role, PermissionManageQuizzes) {
+		return username, nil
+	}
+
+	record, err := db.Execute(cassandra.ReadQuizQuery, quizId)
+	if err != nil {
+		return "", err
+	}
+
+	return record.(*model_cassandra.Quiz).Author, nil
+}
+
+// CheckDeletable will verify that a requester may delete a user's account. Requesters may not delete their own account or
+// the configured administrator's account, which would leave the deployment without an administrator. Protected accounts
+// are returned an error wrapping ErrProtectedAccount.
+func CheckDeletable(requester, username string, auth auth.Auth) error {
+	if username == requester {
+		return fmt.Errorf("%w, administrators cannot delete their own account", ErrProtectedAccount)
+	}
+	if admin := auth.AdminAccount(); admin != nil && username == admin.Username {
+		return fmt.Errorf("%w, the configured administrator cannot be deleted", ErrProtectedAccount)
+	}
+	return nil
+}
+
+// BootstrapAdmin will create the configured administrator account, if one is configured. An account that already exists
+// is promoted to administrator and keeps its password.
+func BootstrapAdmin(auth auth.Auth, db cassandra.Cassandra) error {
+	var err error
+	account := auth.AdminAccount()
+	if account == nil {
+		return nil
+	}
+
+	if account.Password, err = auth.HashPassword(account.Password); err != nil {
+		return err
+	}
+
+	_, err = db.Execute(cassandra.CreateUserQuery, &model_cassandra.User{UserAccount: account, Role: model_cassandra.RoleAdmin})
+	if cassandraErr, ok := err.(*cassandra.Error); !ok || cassandraErr.Status != http.StatusConflict {
+		return err
+	}
+
+	_, err = db.Execute(cassandra.UpdateUserRoleQuery,
+		&model_cassandra.UserRoleRequest{Username: account.Username, Role: model_cassandra.RoleAdmin})
+	return err
+}
@@ -0,0 +1,315 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+func TestCheckPermissions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		role        string
+		permissions []Permission
+		expectErr   require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "student, no permissions required",
+			role:      model_cassandra.RoleStudent,
+			expectErr: require.NoError,
+		}, {
+			name:        "student, create quizzes",
+			role:        model_cassandra.RoleStudent,
+			permissions: []Permission{PermissionCreateQuizzes},
+			expectErr:   require.Error,
+		}, {
+			name:        "unknown role, create quizzes",
+			role:        "unknown",
+			permissions: []Permission{PermissionCreateQuizzes},
+			expectErr:   require.Error,
+		}, {
+			name:        "instructor, create quizzes",
+			role:        model_cassandra.RoleInstructor,
+			permissions: []Permission{PermissionCreateQuizzes},
+			expectErr:   require.NoError,
+		}, {
+			name:        "instructor, create groups",
+			role:        model_cassandra.RoleInstructor,
+			permissions: []Permission{PermissionCreateGroups},
+			expectErr:   require.NoError,
+		}, {
+			name:        "instructor, manage groups",
+			role:        model_cassandra.RoleInstructor,
+			permissions: []Permission{PermissionManageGroups},
+			expectErr:   require.Error,
+		}, {
+			name:        "student, create groups",
+			role:        model_cassandra.RoleStudent,
+			permissions: []Permission{PermissionCreateGroups},
+			expectErr:   require.Error,
+		}, {
+			name:        "instructor, manage users",
+			role:        model_cassandra.RoleInstructor,
+			permissions: []Permission{PermissionCreateQuizzes, PermissionManageUsers},
+			expectErr:   require.Error,
+		}, {
+			name: "admin, all permissions",
+			role: model_cassandra.RoleAdmin,
+			permissions: []Permission{PermissionCreateQuizzes, PermissionCreateGroups, PermissionManageQuizzes, PermissionManageGroups,
+				PermissionManageUsers},
+			expectErr: require.NoError,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := CheckPermissions(testCase.role, testCase.permissions...)
+			testCase.expectErr(t, err, "error expectation failed")
+			if err != nil {
+				require.ErrorIs(t, err, ErrPermissionDenied, "error should be a permission denial")
+			}
+		})
+	}
+}
+
+func TestCanManage(t *testing.T) {
+	require.True(t, CanManage("author", "author", model_cassandra.RoleStudent), "authors manage their quizzes")
+	require.False(t, CanManage("author", "instructor", model_cassandra.RoleInstructor), "instructors only manage their quizzes")
+	require.True(t, CanManage("author", "admin", model_cassandra.RoleAdmin), "administrators manage all quizzes")
+}
+
+func TestCheckDeletable(t *testing.T) {
+	admin := &model_cassandra.UserAccount{UserLoginCredentials: model_cassandra.UserLoginCredentials{Username: "administrator"}}
+
+	testCases := []struct {
+		name              string
+		requester         string
+		username          string
+		account           *model_cassandra.UserAccount
+		adminAccountTimes int
+		expectErr         require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:              "own account",
+			requester:         "admin",
+			username:          "admin",
+			account:           admin,
+			adminAccountTimes: 0,
+			expectErr:         require.Error,
+		}, {
+			name:              "configured administrator",
+			requester:         "admin",
+			username:          "administrator",
+			account:           admin,
+			adminAccountTimes: 1,
+			expectErr:         require.Error,
+		}, {
+			name:              "administrator not configured",
+			requester:         "admin",
+			username:          "administrator",
+			adminAccountTimes: 1,
+			expectErr:         require.NoError,
+		}, {
+			name:              "other account",
+			requester:         "admin",
+			username:          "username1",
+			account:           admin,
+			adminAccountTimes: 1,
+			expectErr:         require.NoError,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+
+			mockAuth.EXPECT().AdminAccount().Return(testCase.account).Times(testCase.adminAccountTimes)
+
+			err := CheckDeletable(testCase.requester, testCase.username, mockAuth)
+			testCase.expectErr(t, err, "error expectation failed")
+			if err != nil {
+				require.ErrorIs(t, err, ErrProtectedAccount, "error should wrap ErrProtectedAccount")
+			}
+		})
+	}
+}
+
+func TestQuizOwner(t *testing.T) {
+	quizId := gocql.TimeUUID()
+
+	testCases := []struct {
+		name          string
+		role          string
+		expectedOwner string
+		cassandraData *MockCassandraData
+		expectErr     require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:          "instructor",
+			role:          model_cassandra.RoleInstructor,
+			expectedOwner: "username",
+			cassandraData: &MockCassandraData{Times: 0},
+			expectErr:     require.NoError,
+		}, {
+			name:          "admin",
+			role:          model_cassandra.RoleAdmin,
+			expectedOwner: "author",
+			cassandraData: &MockCassandraData{OutputParam: &model_cassandra.Quiz{Author: "author"}, Times: 1},
+			expectErr:     require.NoError,
+		}, {
+			name: "admin, db failure",
+			role: model_cassandra.RoleAdmin,
+			cassandraData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "quiz not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			expectErr: require.Error,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), quizId).Return(
+				testCase.cassandraData.OutputParam,
+				testCase.cassandraData.OutputErr,
+			).Times(testCase.cassandraData.Times)
+
+			owner, err := QuizOwner(quizId, "username", testCase.role, mockCassandra)
+			testCase.expectErr(t, err, "error expectation failed")
+			require.Equal(t, testCase.expectedOwner, owner, "owner mismatch")
+		})
+	}
+}
+
+func TestBootstrapAdmin(t *testing.T) {
+	admin := &model_cassandra.UserAccount{
+		UserLoginCredentials: model_cassandra.UserLoginCredentials{Username: "administrator", Password: "password"},
+		Email:                "admin@email-address.com",
+	}
+
+	testCases := []struct {
+		name        string
+		account     *model_cassandra.UserAccount
+		hashData    *MockAuthData
+		createData  *MockCassandraData
+		promoteData *MockCassandraData
+		expectErr   require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:        "not configured",
+			hashData:    &MockAuthData{OutputParam1: "", Times: 0},
+			createData:  &MockCassandraData{Times: 0},
+			promoteData: &MockCassandraData{Times: 0},
+			expectErr:   require.NoError,
+		}, {
+			name:        "hash failure",
+			account:     admin,
+			hashData:    &MockAuthData{OutputParam1: "", OutputErr: errors.New("hash failure"), Times: 1},
+			createData:  &MockCassandraData{Times: 0},
+			promoteData: &MockCassandraData{Times: 0},
+			expectErr:   require.Error,
+		}, {
+			name:        "created",
+			account:     admin,
+			hashData:    &MockAuthData{OutputParam1: "hashed", Times: 1},
+			createData:  &MockCassandraData{Times: 1},
+			promoteData: &MockCassandraData{Times: 0},
+			expectErr:   require.NoError,
+		}, {
+			name:     "create failure",
+			account:  admin,
+			hashData: &MockAuthData{OutputParam1: "hashed", Times: 1},
+			createData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			promoteData: &MockCassandraData{Times: 0},
+			expectErr:   require.Error,
+		}, {
+			name:     "promoted",
+			account:  admin,
+			hashData: &MockAuthData{OutputParam1: "hashed", Times: 1},
+			createData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "user already exists", Status: http.StatusConflict},
+				Times:     1,
+			},
+			promoteData: &MockCassandraData{Times: 1},
+			expectErr:   require.NoError,
+		}, {
+			name:     "promote failure",
+			account:  admin,
+			hashData: &MockAuthData{OutputParam1: "hashed", Times: 1},
+			createData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "user already exists", Status: http.StatusConflict},
+				Times:     1,
+			},
+			promoteData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "user not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			expectErr: require.Error,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			// A copy of the account is returned because the password is replaced with its hash.
+			var account *model_cassandra.UserAccount
+			if testCase.account != nil {
+				copied := *testCase.account
+				account = &copied
+			}
+
+			gomock.InOrder(
+				mockAuth.EXPECT().AdminAccount().Return(account).Times(1),
+				mockAuth.EXPECT().HashPassword(gomock.Any()).Return(
+					testCase.hashData.OutputParam1,
+					testCase.hashData.OutputErr,
+				).Times(testCase.hashData.Times),
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ any, params any) (any, error) {
+						user := params.(*model_cassandra.User)
+						require.Equal(t, model_cassandra.RoleAdmin, user.Role, "administrator role not set")
+						require.Equal(t, "hashed", user.Password, "password not hashed")
+						return nil, testCase.createData.OutputErr
+					},
+				).Times(testCase.createData.Times),
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ any, params any) (any, error) {
+						request := params.(*model_cassandra.UserRoleRequest)
+						require.Equal(t, model_cassandra.RoleAdmin, request.Role, "administrator role not set")
+						require.Equal(t, admin.Username, request.Username, "username mismatch")
+						return nil, testCase.promoteData.OutputErr
+					},
+				).Times(testCase.promoteData.Times),
+			)
+
+			testCase.expectErr(t, BootstrapAdmin(mockAuth, mockCassandra), "error expectation failed")
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+
+	"github.com/gocql/gocql"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+// ErrAttemptNotViewed is returned when a user submits an attempt at a shuffled quiz without viewing it.
+var ErrAttemptNotViewed = errors.New("attempt has not been viewed")
+
+// permutation is the order in which the questions and options of a quiz are presented. Entries map the position at which
+// a question or option is presented to its canonical index in the quiz.
+type permutation struct {
+	questions []int   // The canonical index of the question presented at each position.
+	options   [][]int // The canonical index of the option or match presented at each position, by canonical question index.
+}
+
+// shuffleAttempt will present a quiz in the order seeded for a user's attempt. The seed is created the first time the
+// attempt is viewed and the same order is presented on later views.
+func shuffleAttempt(quiz *model_cassandra.QuizCore, quizId gocql.UUID, username string, attempt int,
+	db cassandra.Cassandra) (*model_cassandra.QuizCore, error) {
+	shuffle := &model_cassandra.AttemptShuffle{
+		Username: username,
+		QuizID:   quizId,
+		Attempt:  attempt,
+		Seed:     rand.Int63(),
+	}
+	record, err := db.Execute(cassandra.CreateAttemptShuffleQuery, shuffle)
+	if err != nil {
+		return nil, err
+	}
+
+	return PermuteQuiz(quiz, record.(*model_cassandra.AttemptShuffle).Seed), nil
+}
+
+// UnshuffleResponse will translate a user's response to their next attempt at a quiz from the order in which it was
+// presented to the canonical order of the quiz. Responses to quizzes that are not shuffled are unchanged. Attempts at
+// shuffled quizzes that were not viewed return an error wrapping ErrAttemptNotViewed.
+func UnshuffleResponse(quiz *model_cassandra.Quiz, username string, previous *model_cassandra.Response,
+	response *model_cassandra.QuizResponse, db cassandra.Cassandra) (*model_cassandra.QuizResponse, error) {
+	if !isShuffled(quiz.QuizCore) {
+		return response, nil
+	}
+
+	request := &model_cassandra.AttemptShuffleRequest{
+		Username: username,
+		QuizID:   quiz.QuizID,
+		Attempt:  len(AttemptHistory(previous)) + 1,
+	}
+	record, err := db.Execute(cassandra.ReadAttemptShuffleQuery, request)
+	if err != nil {
+		if cassandraErr, ok := err.(*cassandra.Error); ok && cassandraErr.Status == http.StatusNotFound {
+			return nil, fmt.Errorf("%w, view attempt %d before submitting it", ErrAttemptNotViewed, request.Attempt)
+		}
+		return nil, err
+	}
+
+	return CanonicalResponse(quiz.QuizCore, response, record.(*model_cassandra.AttemptShuffle).Seed), nil
+}
+
+// PermuteQuiz will create a copy of a quiz with its questions and options in the order generated from the seed. Answer keys
+// are translated to the presented order. Multiple choice and ordering questions have their options shuffled and matching
+// questions have their matches shuffled. True or false questions keep their options in order.
+func PermuteQuiz(quiz *model_cassandra.QuizCore, seed int64) *model_cassandra.QuizCore {
+	order := newPermutation(quiz, seed)
+	permuted := *quiz
+	permuted.Questions = make([]*model_cassandra.Question, len(quiz.Questions))
+
+	for position, canonical := range order.questions {
+		question := *quiz.Questions[canonical]
+		options := order.options[canonical]
+		if options != nil {
+			if answers := quiz.Questions[canonical].Answers; answers != nil {
+				presented := inverse(options)
+				question.Answers = make([]int32, len(answers))
+				for idx, answer := range answers {
+					question.Answers[idx] = mapIndex(presented, answer)
+				}
+			}
+
+			if question.Type == model_cassandra.QuestionMatching {
+				question.Matches = make([]string, len(options))
+				for idx, match := range options {
+					question.Matches[idx] = quiz.Questions[canonical].Matches[match]
+				}
+			} else {
+				question.Options = make([]string, len(options))
+				for idx, option := range options {
+					question.Options[idx] = quiz.Questions[canonical].Options[option]
+				}
+
+				// Feedback for each option must follow the option to its presented position.
+				if feedback := quiz.Questions[canonical].Feedback; len(feedback) == len(options) {
+					question.Feedback = make([]string, len(options))
+					for idx, option := range options {
+						question.Feedback[idx] = feedback[option]
+					}
+				}
+			}
+		}
+		permuted.Questions[position] = &question
+	}
+
+	return &permuted
+}
+
+// CanonicalResponse will translate a response to a quiz presented in the order generated from the seed to the canonical
+// order of the quiz. Indices that do not refer to a question or option in the quiz are left unchanged to be rejected when
+// the response is graded.
+func CanonicalResponse(quiz *model_cassandra.QuizCore, response *model_cassandra.QuizResponse, seed int64) *model_cassandra.QuizResponse {
+	order := newPermutation(quiz, seed)
+	canonical := &model_cassandra.QuizResponse{}
+
+	if len(response.Responses) > 0 {
+		canonical.Responses = make([][]int32, canonicalLength(order.questions, len(response.Responses)))
+		for position, row := range response.Responses {
+			idx := canonicalIndex(order.questions, position)
+			var options []int
+			if idx < len(order.options) {
+				options = order.options[idx]
+			}
+
+			translated := make([]int32, len(row))
+			for col, val := range row {
+				translated[col] = mapIndex(options, val)
+			}
+			canonical.Responses[idx] = translated
+		}
+		for idx := range canonical.Responses {
+			if canonical.Responses[idx] == nil {
+				canonical.Responses[idx] = []int32{}
+			}
+		}
+	}
+
+	if len(response.TextResponses) > 0 {
+		canonical.TextResponses = make([]string, canonicalLength(order.questions, len(response.TextResponses)))
+		for position, text := range response.TextResponses {
+			canonical.TextResponses[canonicalIndex(order.questions, position)] = text
+		}
+	}
+
+	return canonical
+}
+
+// newPermutation will generate the order of the questions and options in a quiz from a seed. The same seed will always
+// generate the same order for a quiz.
+func newPermutation(quiz *model_cassandra.QuizCore, seed int64) *permutation {
+	generator := rand.New(rand.NewSource(seed))
+	order := &permutation{
+		questions: generator.Perm(len(quiz.Questions)),
+		options:   make([][]int, len(quiz.Questions)),
+	}
+
+	for idx, question := range quiz.Questions {
+		switch question.Type {
+		case "", model_cassandra.QuestionMultipleChoice, model_cassandra.QuestionOrdering:
+			order.options[idx] = generator.Perm(len(question.Options))
+		case model_cassandra.QuestionMatching:
+			order.options[idx] = generator.Perm(len(question.Matches))
+		}
+	}
+
+	return order
+}
+
+// isShuffled reports whether a quiz is presented in a different order to each user and attempt.
+func isShuffled(quiz *model_cassandra.QuizCore) bool {
+	return quiz != nil && quiz.Shuffle
+}
+
+// canonicalIndex is the canonical index of the question presented at a position. Positions beyond the end of the quiz are
+// unchanged.
+func canonicalIndex(questions []int, position int) int {
+	if position < len(questions) {
+		return questions[position]
+	}
+	return position
+}
+
+// canonicalLength is the number of canonical questions needed to hold the answers to the first count presented questions.
+func canonicalLength(questions []int, count int) int {
+	length := count
+	for position := 0; position < count && position < len(questions); position++ {
+		if questions[position] >= length {
+			length = questions[position] + 1
+		}
+	}
+	return length
+}
+
+// mapIndex will translate an index using a mapping. Indices outside the mapping are unchanged.
+func mapIndex(mapping []int, idx int32) int32 {
+	if idx < 0 || int(idx) >= len(mapping) {
+		return idx
+	}
+	return int32(mapping[idx])
+}
+
+// inverse will generate the inverse of a permutation.
+func inverse(mapping []int) []int {
+	inverted := make([]int, len(mapping))
+	for idx, val := range mapping {
+		inverted[val] = idx
+	}
+	return inverted
+}
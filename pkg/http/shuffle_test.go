@@ -0,0 +1,339 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/grading"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+// shuffleTestQuiz will generate a quiz containing every type of question.
+func shuffleTestQuiz() *model_cassandra.QuizCore {
+	numericAnswer := 9.81
+	return &model_cassandra.QuizCore{
+		Title:       "Shuffled quiz",
+		MarkingType: "binary",
+		Shuffle:     true,
+		Questions: []*model_cassandra.Question{
+			{Description: "Temperature can be measured in", Options: []string{"Kelvin", "Fahrenheit", "Gram", "Celsius", "Liters"},
+				Answers: []int32{0, 1, 3}},
+			{Description: "Moon is a star", Type: model_cassandra.QuestionTrueFalse, Options: []string{"True", "False"},
+				Answers: []int32{1}},
+			{Description: "Acceleration due to gravity", Type: model_cassandra.QuestionNumeric, NumericAnswer: &numericAnswer,
+				Tolerance: 0.01},
+			{Description: "Order by size", Type: model_cassandra.QuestionOrdering, Options: []string{"Atom", "Cell", "Planet", "Star"},
+				Answers: []int32{0, 1, 2, 3}},
+			{Description: "Match the capitals", Type: model_cassandra.QuestionMatching, Options: []string{"France", "Japan", "Peru"},
+				Matches: []string{"Tokyo", "Lima", "Paris"}, Answers: []int32{2, 0, 1}},
+		},
+	}
+}
+
+// answerCard will generate a response to a quiz containing its answer keys.
+func answerCard(quiz *model_cassandra.QuizCore) *model_cassandra.QuizResponse {
+	response := &model_cassandra.QuizResponse{
+		Responses:     make([][]int32, len(quiz.Questions)),
+		TextResponses: make([]string, len(quiz.Questions)),
+	}
+	for idx, question := range quiz.Questions {
+		response.Responses[idx] = question.Answers
+		if response.Responses[idx] == nil {
+			response.Responses[idx] = []int32{}
+		}
+		if question.NumericAnswer != nil {
+			response.TextResponses[idx] = "9.81"
+		}
+	}
+	return response
+}
+
+func TestPermuteQuiz(t *testing.T) {
+	quiz := shuffleTestQuiz()
+	canonical := shuffleTestQuiz()
+
+	// The same seed must always generate the same order.
+	require.Equal(t, PermuteQuiz(quiz, 42), PermuteQuiz(quiz, 42), "same seed generated different orders")
+	require.Equal(t, canonical, quiz, "canonical quiz was modified")
+
+	reordered := false
+	for seed := int64(0); seed < 50; seed++ {
+		permuted := PermuteQuiz(quiz, seed)
+		require.Equal(t, len(quiz.Questions), len(permuted.Questions), "number of questions changed")
+		require.Equal(t, quiz.Title, permuted.Title, "quiz details changed")
+
+		for _, question := range permuted.Questions {
+			var original *model_cassandra.Question
+			for _, candidate := range quiz.Questions {
+				if candidate.Description == question.Description {
+					original = candidate
+				}
+			}
+			require.NotNil(t, original, "presented question not found in the quiz")
+			require.ElementsMatch(t, original.Options, question.Options, "options changed")
+			require.ElementsMatch(t, original.Matches, question.Matches, "matches changed")
+
+			// True or false options must keep their order.
+			if question.Type == model_cassandra.QuestionTrueFalse {
+				require.Equal(t, original.Options, question.Options, "true or false options reordered")
+			}
+
+			// The presented answer keys must refer to the same options.
+			if question.Type == "" {
+				var answers []string
+				for _, answer := range question.Answers {
+					answers = append(answers, question.Options[answer])
+				}
+				require.ElementsMatch(t, []string{"Kelvin", "Fahrenheit", "Celsius"}, answers, "answer key mismatch")
+			}
+		}
+
+		reordered = reordered || permuted.Questions[0].Description != quiz.Questions[0].Description
+	}
+	require.True(t, reordered, "questions were never reordered")
+}
+
+func TestPermuteQuiz_Feedback(t *testing.T) {
+	quiz := &model_cassandra.QuizCore{
+		Title:       "Shuffled feedback",
+		MarkingType: "binary",
+		Shuffle:     true,
+		Questions: []*model_cassandra.Question{
+			{Description: "Pick a letter", Options: []string{"a", "b", "c", "d", "e"}, Answers: []int32{0},
+				Feedback: []string{"fa", "fb", "fc", "fd", "fe"}},
+			{Description: "Pick a number", Options: []string{"1", "2", "3"}, Answers: []int32{2}},
+		},
+	}
+	feedback := map[string]string{"a": "fa", "b": "fb", "c": "fc", "d": "fd", "e": "fe"}
+
+	reordered := false
+	for seed := int64(0); seed < 50; seed++ {
+		permuted := PermuteQuiz(quiz, seed)
+		for _, question := range permuted.Questions {
+			if question.Description != "Pick a letter" {
+				require.Nil(t, question.Feedback, "feedback added to a question without feedback")
+				continue
+			}
+			require.Len(t, question.Feedback, len(question.Options), "feedback length mismatch")
+			for idx, option := range question.Options {
+				require.Equalf(t, feedback[option], question.Feedback[idx], "feedback does not belong to option %s", option)
+			}
+			reordered = reordered || question.Options[0] != "a"
+		}
+	}
+	require.True(t, reordered, "options were never reordered")
+	require.Equal(t, []string{"fa", "fb", "fc", "fd", "fe"}, quiz.Questions[0].Feedback, "canonical feedback was modified")
+}
+
+func TestCanonicalResponse(t *testing.T) {
+	grader := grading.NewGrading()
+	quiz := shuffleTestQuiz()
+
+	t.Run("answer keys", func(t *testing.T) {
+		for seed := int64(0); seed < 50; seed++ {
+			presented := PermuteQuiz(quiz, seed)
+			canonical := CanonicalResponse(quiz, answerCard(presented), seed)
+			require.Equal(t, answerCard(quiz), canonical, "canonical answer card mismatch for seed %d", seed)
+
+			score, maxScore, err := grader.Grade(canonical, quiz)
+			require.NoError(t, err, "failed to grade canonical response")
+			require.Equal(t, maxScore, score, "answer keys not awarded full marks for seed %d", seed)
+		}
+	})
+
+	t.Run("partial response", func(t *testing.T) {
+		for seed := int64(0); seed < 50; seed++ {
+			presented := PermuteQuiz(quiz, seed)
+			response := &model_cassandra.QuizResponse{Responses: answerCard(presented).Responses[:1]}
+			canonical := CanonicalResponse(quiz, response, seed)
+
+			for idx, row := range canonical.Responses {
+				require.NotNil(t, row, "unanswered question %d must be an empty row", idx)
+				if quiz.Questions[idx].Description == presented.Questions[0].Description {
+					require.Equal(t, answerCard(quiz).Responses[idx], row, "answered question mismatch")
+				} else {
+					require.Empty(t, row, "unanswered question %d has a response", idx)
+				}
+			}
+			require.Nil(t, canonical.TextResponses, "text responses were added")
+		}
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		response := &model_cassandra.QuizResponse{Responses: make([][]int32, len(quiz.Questions)+1)}
+		response.Responses[len(quiz.Questions)] = []int32{7}
+		canonical := CanonicalResponse(quiz, response, 42)
+		require.Equal(t, []int32{7}, canonical.Responses[len(quiz.Questions)], "extra question was translated")
+
+		_, _, err := grader.Grade(canonical, quiz)
+		require.Error(t, err, "response with extra questions was graded")
+	})
+}
+
+func TestPresentQuiz_Shuffled(t *testing.T) {
+	quiz := &model_cassandra.Quiz{QuizID: gocql.TimeUUID(), QuizCore: shuffleTestQuiz()}
+	unshuffled := &model_cassandra.Quiz{QuizID: gocql.TimeUUID(), QuizCore: testQuizData["myPubQuiz"].QuizCore}
+	stored := &model_cassandra.AttemptShuffle{Username: "username", QuizID: quiz.QuizID, Attempt: 1, Seed: 42}
+
+	testCases := []struct {
+		name            string
+		quiz            *model_cassandra.Quiz
+		responseData    *MockCassandraData
+		shuffleData     *MockCassandraData
+		expectedAttempt int
+		expected        *model_cassandra.QuizCore
+		expectErr       require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:         "not shuffled",
+			quiz:         unshuffled,
+			responseData: &MockCassandraData{Times: 0},
+			shuffleData:  &MockCassandraData{Times: 0},
+			expected:     unshuffled.QuizCore,
+			expectErr:    require.NoError,
+		}, {
+			name: "response db failure",
+			quiz: quiz,
+			responseData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			shuffleData: &MockCassandraData{Times: 0},
+			expectErr:   require.Error,
+		}, {
+			name: "shuffle db failure",
+			quiz: quiz,
+			responseData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			shuffleData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			expectedAttempt: 1,
+			expectErr:       require.Error,
+		}, {
+			name: "first attempt",
+			quiz: quiz,
+			responseData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			shuffleData:     &MockCassandraData{OutputParam: stored, Times: 1},
+			expectedAttempt: 1,
+			expected:        PermuteQuiz(quiz.QuizCore, stored.Seed),
+			expectErr:       require.NoError,
+		}, {
+			name:            "next attempt",
+			quiz:            quiz,
+			responseData:    &MockCassandraData{OutputParam: &model_cassandra.Response{Attempts: []*model_cassandra.Attempt{{Number: 1}}}, Times: 1},
+			shuffleData:     &MockCassandraData{OutputParam: stored, Times: 1},
+			expectedAttempt: 2,
+			expected:        PermuteQuiz(quiz.QuizCore, stored.Seed),
+			expectErr:       require.NoError,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			gomock.InOrder(
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.responseData.OutputParam,
+					testCase.responseData.OutputErr,
+				).Times(testCase.responseData.Times),
+
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+						require.Equal(t, testCase.expectedAttempt, params.(*model_cassandra.AttemptShuffle).Attempt, "attempt mismatch")
+						return testCase.shuffleData.OutputParam, testCase.shuffleData.OutputErr
+					}).Times(testCase.shuffleData.Times),
+			)
+
+			presented, err := PresentQuiz(testCase.quiz, "username", mockCassandra)
+			testCase.expectErr(t, err, "error expectation failed")
+			require.Equal(t, testCase.expected, presented, "presented quiz mismatch")
+		})
+	}
+}
+
+func TestUnshuffleResponse(t *testing.T) {
+	quiz := &model_cassandra.Quiz{QuizID: gocql.TimeUUID(), QuizCore: shuffleTestQuiz()}
+	unshuffled := &model_cassandra.Quiz{QuizID: gocql.TimeUUID(), QuizCore: testQuizData["myPubQuiz"].QuizCore}
+	stored := &model_cassandra.AttemptShuffle{Username: "username", QuizID: quiz.QuizID, Attempt: 1, Seed: 42}
+	response := answerCard(PermuteQuiz(quiz.QuizCore, stored.Seed))
+
+	testCases := []struct {
+		name          string
+		quiz          *model_cassandra.Quiz
+		cassandraData *MockCassandraData
+		expected      *model_cassandra.QuizResponse
+		expectedErr   error
+		expectErr     require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:          "not shuffled",
+			quiz:          unshuffled,
+			cassandraData: &MockCassandraData{Times: 0},
+			expected:      response,
+			expectErr:     require.NoError,
+		}, {
+			name: "not viewed",
+			quiz: quiz,
+			cassandraData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "not found", Status: http.StatusNotFound},
+				Times:     1,
+			},
+			expectedErr: ErrAttemptNotViewed,
+			expectErr:   require.Error,
+		}, {
+			name: "db failure",
+			quiz: quiz,
+			cassandraData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			expectErr: require.Error,
+		}, {
+			name:          "shuffled",
+			quiz:          quiz,
+			cassandraData: &MockCassandraData{OutputParam: stored, Times: 1},
+			expected:      answerCard(quiz.QuizCore),
+			expectErr:     require.NoError,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+				testCase.cassandraData.OutputParam,
+				testCase.cassandraData.OutputErr,
+			).Times(testCase.cassandraData.Times)
+
+			canonical, err := UnshuffleResponse(testCase.quiz, "username", nil, response, mockCassandra)
+			testCase.expectErr(t, err, "error expectation failed")
+			if testCase.expectedErr != nil {
+				require.True(t, errors.Is(err, testCase.expectedErr), "expected %v but got %v", testCase.expectedErr, err)
+			}
+			require.Equal(t, testCase.expected, canonical, "canonical response mismatch")
+		})
+	}
+}
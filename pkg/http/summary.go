@@ -0,0 +1,128 @@
+package http
+
+import (
+	"math"
+	"sort"
+
+	"github.com/gocql/gocql"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+	"github.com/surahman/mcq-platform/pkg/redis"
+)
+
+// SummaryPageSize is the number of responses read from the database in each page when summarizing the statistics of a quiz.
+const SummaryPageSize = 100
+
+// histogramBins is the number of equal width bins the percentage scores are counted in.
+const histogramBins = 10
+
+// statsSummaryKey is the cache key for the statistics summary of a quiz.
+func statsSummaryKey(quizId gocql.UUID) string {
+	return "stats-summary:" + quizId.String()
+}
+
+// GetStatsSummary will make a cache call for the statistics summary of a quiz. Upon a cache miss it will summarize all the
+// responses to the quiz from the database and then load the summary into the cache.
+func GetStatsSummary(quizId gocql.UUID, db cassandra.Cassandra, cache redis.Redis) (*model_http.StatsSummary, error) {
+	var err error
+	var summary *model_http.StatsSummary
+
+	// Cache call.
+	cached := model_http.StatsSummary{}
+	if err = cache.Get(statsSummaryKey(quizId), &cached); err == nil {
+		return &cached, nil
+	}
+
+	// Cache miss. Set method will log errors, which are not propagated to the user.
+	if summary, err = SummarizeResponses(quizId, db); err != nil {
+		return nil, err
+	}
+	_ = cache.Set(statsSummaryKey(quizId), summary)
+
+	return summary, nil
+}
+
+// InvalidateStatsSummary will evict the cached statistics summary of a quiz after a response to it has been recorded or
+// regraded. Del method will log errors, which are not propagated to the user.
+func InvalidateStatsSummary(quizId gocql.UUID, cache redis.Redis) {
+	_ = cache.Del(statsSummaryKey(quizId))
+}
+
+// SummarizeResponses will page through all the responses to a quiz and compute the aggregate statistics of their effective
+// scores. Only the scores are retained between pages. Responses to unmarked quizzes have no score and are not summarized.
+func SummarizeResponses(quizId gocql.UUID, db cassandra.Cassandra) (*model_http.StatsSummary, error) {
+	var scores, percentages []float64
+	request := &model_cassandra.StatsRequest{QuizID: quizId, PageSize: SummaryPageSize}
+
+	for {
+		dbRecord, err := db.Execute(cassandra.ReadResponseStatisticsPageQuery, request)
+		if err != nil {
+			return nil, err
+		}
+		page := dbRecord.(*model_cassandra.StatsResponse)
+
+		for _, response := range page.Records {
+			if math.IsNaN(response.Score) || math.IsInf(response.Score, 0) {
+				continue
+			}
+			scores = append(scores, response.Score)
+			if response.MaxScore > 0 {
+				percentages = append(percentages, 100*response.Score/response.MaxScore)
+			}
+		}
+
+		if len(page.PageCursor) == 0 {
+			break
+		}
+		request.PageCursor = page.PageCursor
+	}
+
+	summary := summarize(scores, percentages)
+	summary.QuizID = quizId.String()
+
+	return summary, nil
+}
+
+// summarize will compute the aggregate statistics of the scores and count the percentage scores in the histogram. Responses
+// recorded without a maximum score have no percentage score and are not counted in the histogram.
+func summarize(scores, percentages []float64) *model_http.StatsSummary {
+	summary := &model_http.StatsSummary{NumResponses: len(scores), Histogram: make([]*model_http.HistogramBin, histogramBins)}
+
+	width := 100.0 / histogramBins
+	for idx := range summary.Histogram {
+		summary.Histogram[idx] = &model_http.HistogramBin{Lower: float64(idx) * width, Upper: float64(idx+1) * width}
+	}
+	for _, percentage := range percentages {
+		bin := int(math.Max(0, math.Min(percentage/width, histogramBins-1)))
+		summary.Histogram[bin].Count++
+	}
+
+	if len(scores) == 0 {
+		return summary
+	}
+
+	sort.Float64s(scores)
+	summary.Min = scores[0]
+	summary.Max = scores[len(scores)-1]
+
+	middle := len(scores) / 2
+	summary.Median = scores[middle]
+	if len(scores)%2 == 0 {
+		summary.Median = (scores[middle-1] + scores[middle]) / 2
+	}
+
+	var sum float64
+	for _, score := range scores {
+		sum += score
+	}
+	summary.Mean = sum / float64(len(scores))
+
+	var squares float64
+	for _, score := range scores {
+		squares += (score - summary.Mean) * (score - summary.Mean)
+	}
+	summary.StandardDeviation = math.Sqrt(squares / float64(len(scores)))
+
+	return summary
+}
@@ -0,0 +1,214 @@
+package http
+
+import (
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/model/http"
+	"github.com/surahman/mcq-platform/pkg/redis"
+)
+
+func TestGetStatsSummary(t *testing.T) {
+	quizId := gocql.TimeUUID()
+
+	testCases := []struct {
+		name              string
+		redisGetData      *MockRedisData
+		cassandraReadData *MockCassandraData
+		redisSetData      *MockRedisData
+		expectErr         require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:              "cache hit",
+			redisGetData:      &MockRedisData{Times: 1},
+			cassandraReadData: &MockCassandraData{Times: 0},
+			redisSetData:      &MockRedisData{Times: 0},
+			expectErr:         require.NoError,
+		}, {
+			name: "cache miss, db read failure",
+			redisGetData: &MockRedisData{
+				Err:   &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss},
+				Times: 1,
+			},
+			cassandraReadData: &MockCassandraData{
+				OutputErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+				Times:     1,
+			},
+			redisSetData: &MockRedisData{Times: 0},
+			expectErr:    require.Error,
+		}, {
+			name: "cache miss, db read success, cache set failure",
+			redisGetData: &MockRedisData{
+				Err:   &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss},
+				Times: 1,
+			},
+			cassandraReadData: &MockCassandraData{
+				OutputParam: &model_cassandra.StatsResponse{Records: []*model_cassandra.Response{{Score: 1, MaxScore: 2}}},
+				Times:       1,
+			},
+			redisSetData: &MockRedisData{
+				Err:   &redis.Error{Message: "cache failure", Code: redis.ErrorCacheSet},
+				Times: 1,
+			},
+			expectErr: require.NoError,
+		}, {
+			name: "cache miss, db read success, cache set success",
+			redisGetData: &MockRedisData{
+				Err:   &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss},
+				Times: 1,
+			},
+			cassandraReadData: &MockCassandraData{
+				OutputParam: &model_cassandra.StatsResponse{Records: []*model_cassandra.Response{{Score: 1, MaxScore: 2}}},
+				Times:       1,
+			},
+			redisSetData: &MockRedisData{Times: 1},
+			expectErr:    require.NoError,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			gomock.InOrder(
+				// Cache call.
+				mockRedis.EXPECT().Get(statsSummaryKey(quizId), gomock.Any()).Return(
+					testCase.redisGetData.Err,
+				).Times(testCase.redisGetData.Times),
+				// Cassandra read.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(
+					testCase.cassandraReadData.OutputParam,
+					testCase.cassandraReadData.OutputErr,
+				).Times(testCase.cassandraReadData.Times),
+				// Cache set.
+				mockRedis.EXPECT().Set(statsSummaryKey(quizId), gomock.Any()).Return(
+					testCase.redisSetData.Err,
+				).Times(testCase.redisSetData.Times),
+			)
+
+			summary, err := GetStatsSummary(quizId, mockCassandra, mockRedis)
+			testCase.expectErr(t, err, "error expectation failed")
+			if err != nil {
+				return
+			}
+			require.NotNil(t, summary, "summary should be returned")
+		})
+	}
+}
+
+func TestInvalidateStatsSummary(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockRedis := mocks.NewMockRedis(mockCtrl)
+	quizId := gocql.TimeUUID()
+
+	mockRedis.EXPECT().Del(statsSummaryKey(quizId)).Return(
+		&redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss}).Times(1)
+
+	InvalidateStatsSummary(quizId, mockRedis)
+}
+
+func TestSummarizeResponses(t *testing.T) {
+	quizId := gocql.TimeUUID()
+	newResponse := func(score, maxScore float64) *model_cassandra.Response {
+		return &model_cassandra.Response{QuizID: quizId, Score: score, MaxScore: maxScore}
+	}
+	histogram := func(counts ...int) []*model_http.HistogramBin {
+		bins := make([]*model_http.HistogramBin, 0, len(counts))
+		for idx, count := range counts {
+			bins = append(bins, &model_http.HistogramBin{Lower: float64(idx) * 10, Upper: float64(idx+1) * 10, Count: count})
+		}
+		return bins
+	}
+
+	testCases := []struct {
+		name            string
+		pages           []*model_cassandra.StatsResponse
+		pageErr         error
+		expectErr       require.ErrorAssertionFunc
+		expectedSummary *model_http.StatsSummary
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "no responses",
+			pages:     []*model_cassandra.StatsResponse{{}},
+			expectErr: require.NoError,
+			expectedSummary: &model_http.StatsSummary{QuizID: quizId.String(),
+				Histogram: histogram(0, 0, 0, 0, 0, 0, 0, 0, 0, 0)},
+		}, {
+			name: "single response",
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse(3, 4)}},
+			},
+			expectErr: require.NoError,
+			expectedSummary: &model_http.StatsSummary{QuizID: quizId.String(), NumResponses: 1, Mean: 3, Median: 3, Min: 3, Max: 3,
+				Histogram: histogram(0, 0, 0, 0, 0, 0, 0, 1, 0, 0)},
+		}, {
+			name: "multiple pages, even number of responses",
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse(2, 4), newResponse(4, 4)}, PageCursor: []byte("cursor")},
+				{Records: []*model_cassandra.Response{newResponse(4, 4), newResponse(0, 4), newResponse(5, 5)}, PageCursor: []byte("cursor")},
+				{Records: []*model_cassandra.Response{newResponse(1, 0)}},
+			},
+			expectErr: require.NoError,
+			expectedSummary: &model_http.StatsSummary{QuizID: quizId.String(), NumResponses: 6, Mean: 16.0 / 6, Median: 3,
+				StandardDeviation: 1.7950549357115013, Min: 0, Max: 5,
+				Histogram: histogram(1, 0, 0, 0, 0, 1, 0, 0, 0, 3)},
+		}, {
+			name: "unmarked responses",
+			pages: []*model_cassandra.StatsResponse{
+				{Records: []*model_cassandra.Response{newResponse(math.NaN(), 0), newResponse(2, 4)}, PageCursor: []byte("cursor")},
+				{Records: []*model_cassandra.Response{newResponse(math.NaN(), 4), newResponse(math.Inf(1), 4)}},
+			},
+			expectErr: require.NoError,
+			expectedSummary: &model_http.StatsSummary{QuizID: quizId.String(), NumResponses: 1, Mean: 2, Median: 2, Min: 2, Max: 2,
+				Histogram: histogram(0, 0, 0, 0, 0, 1, 0, 0, 0, 0)},
+		}, {
+			name:      "page read failure",
+			pageErr:   &cassandra.Error{Message: "page read failure", Status: http.StatusInternalServerError},
+			expectErr: require.Error,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+
+			pageIdx := 0
+			mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ func(cassandra.Cassandra, any) (any, error), params any) (any, error) {
+					require.Equal(t, SummaryPageSize, params.(*model_cassandra.StatsRequest).PageSize, "page size mismatch")
+					if testCase.pageErr != nil {
+						return nil, testCase.pageErr
+					}
+					pageIdx++
+					return testCase.pages[pageIdx-1], nil
+				}).AnyTimes()
+
+			summary, err := SummarizeResponses(quizId, mockCassandra)
+			testCase.expectErr(t, err, "error expectation failed")
+			if err != nil {
+				return
+			}
+
+			require.InDelta(t, testCase.expectedSummary.StandardDeviation, summary.StandardDeviation, 1e-9, "standard deviation mismatch")
+			summary.StandardDeviation = testCase.expectedSummary.StandardDeviation
+			require.Equal(t, testCase.expectedSummary, summary, "summary does not match expected")
+		})
+	}
+}
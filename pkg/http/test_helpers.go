@@ -12,6 +12,7 @@ type MockAuthData struct {
 	InputParam2  string
 	OutputParam1 any
 	OutputParam2 int64
+	OutputParam3 string
 	OutputErr    error
 	Times        int
 }
@@ -30,6 +31,7 @@ type MockGraderData struct {
 	InputQuizResp *model_cassandra.QuizResponse
 	InputQuiz     *model_cassandra.Quiz
 	OutputParam   float64
+	OutputMax     float64
 	OutputErr     error
 	Times         int
 }
@@ -0,0 +1,123 @@
+package interchange
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/validator"
+)
+
+var (
+	// aikenOption matches an option line such as "A. Option" or "B) Option".
+	aikenOption = regexp.MustCompile(`^([A-Z])[.)]\s+(.*)$`)
+
+	// aikenAnswer matches the answer line that ends a question such as "ANSWER: B".
+	aikenAnswer = regexp.MustCompile(`^ANSWER:\s*([A-Z])\s*$`)
+)
+
+// decodeAiken will read single answer multiple choice questions in the Aiken format. Every question is written on a single
+// line followed by its lettered options and the letter of the correct option. The format has no title.
+func decodeAiken(data []byte) (string, []*parsedQuestion, error) {
+	var parsed []*parsedQuestion
+	var question *model_cassandra.Question
+	var letters []string
+
+	// finish will record the question being read, or the reason it is incomplete.
+	finish := func(err error) {
+		if question == nil {
+			return
+		}
+		if err != nil {
+			parsed = append(parsed, &parsedQuestion{err: err})
+		} else {
+			parsed = append(parsed, &parsedQuestion{question: question})
+		}
+		question, letters = nil, nil
+	}
+
+	for _, block := range splitBlocks(data) {
+		for _, line := range block {
+			line = strings.TrimSpace(line)
+
+			if match := aikenAnswer.FindStringSubmatch(line); match != nil {
+				if question == nil {
+					parsed = append(parsed, &parsedQuestion{err: errors.New("answer without a question")})
+					continue
+				}
+				answer := -1
+				for idx, letter := range letters {
+					if letter == match[1] {
+						answer = idx
+					}
+				}
+				if answer < 0 {
+					finish(fmt.Errorf("answer %s is not one of the options", match[1]))
+					continue
+				}
+				question.Answers = []int32{int32(answer)}
+				finish(nil)
+				continue
+			}
+
+			if match := aikenOption.FindStringSubmatch(line); match != nil && question != nil {
+				letters = append(letters, match[1])
+				question.Options = append(question.Options, strings.TrimSpace(match[2]))
+				continue
+			}
+
+			// Lines that are not options or answers start a new question, or continue one without any options.
+			if question != nil && len(question.Options) == 0 {
+				question.Description += " " + line
+				continue
+			}
+			finish(errors.New("question does not have an answer"))
+			question = &model_cassandra.Question{Description: line}
+		}
+	}
+	finish(errors.New("question does not have an answer"))
+
+	return "", parsed, nil
+}
+
+// encodeAiken will write the single answer multiple choice and true or false questions of a quiz in the Aiken format.
+func encodeAiken(quiz *model_cassandra.QuizCore) ([]byte, []*validator.ErrorField) {
+	var builder strings.Builder
+	var issues []*validator.ErrorField
+
+	for idx, question := range quiz.Questions {
+		var options []string
+		switch questionType(question) {
+		case model_cassandra.QuestionMultipleChoice:
+			options = question.Options
+		case model_cassandra.QuestionTrueFalse:
+			options = trueFalseOptions(question)
+		default:
+			issues = append(issues, unsupported(idx, question, FormatAiken))
+			continue
+		}
+		if len(question.Answers) != 1 {
+			issues = append(issues, &validator.ErrorField{Field: questionField(idx), Tag: FormatAiken,
+				Value: "questions with more than one answer are not supported"})
+			continue
+		}
+
+		if builder.Len() != 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString(singleLine(question.Description) + "\n")
+		for option, text := range options {
+			builder.WriteString(fmt.Sprintf("%c. %s\n", 'A'+option, singleLine(text)))
+		}
+		builder.WriteString(fmt.Sprintf("ANSWER: %c\n", 'A'+question.Answers[0]))
+	}
+
+	return []byte(builder.String()), issues
+}
+
+// singleLine will join the lines of text into a single line.
+func singleLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,77 @@
+package interchange
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+func TestDecodeAiken(t *testing.T) {
+	data := `What is the capital
+of France?
+A. Paris
+B) London
+ANSWER: A
+
+Which is even?
+A. One
+B. Two
+ANSWER: C
+
+ANSWER: A
+
+No answer
+A. Yes
+B. No
+Which is odd?
+A. One
+B. Two
+ANSWER: A
+
+Trailing question
+A. Yes`
+
+	expected := []*parsedQuestion{
+		{question: &model_cassandra.Question{Description: "What is the capital of France?", Options: []string{"Paris", "London"},
+			Answers: []int32{0}}},
+		{err: errors.New("answer C is not one of the options")},
+		{err: errors.New("answer without a question")},
+		{err: errors.New("question does not have an answer")},
+		{question: &model_cassandra.Question{Description: "Which is odd?", Options: []string{"One", "Two"}, Answers: []int32{0}}},
+		{err: errors.New("question does not have an answer")},
+	}
+
+	title, parsed, err := decodeAiken([]byte(data))
+	require.NoError(t, err, "decoding should not fail")
+	require.Empty(t, title, "the Aiken format does not have titles")
+	require.Len(t, parsed, len(expected), "question count mismatch")
+
+	for idx, entry := range parsed {
+		if expected[idx].err != nil {
+			require.EqualError(t, entry.err, expected[idx].err.Error(), "error %d mismatch", idx)
+			continue
+		}
+		require.NoError(t, entry.err, "question %d should be parsed", idx)
+		require.Equal(t, expected[idx].question, entry.question, "question %d mismatch", idx)
+	}
+}
+
+func TestEncodeAiken(t *testing.T) {
+	quiz := &model_cassandra.QuizCore{Title: "Round trip", Questions: []*model_cassandra.Question{
+		{Description: "What is the capital\nof France?", Options: []string{"Paris", "London"}, Answers: []int32{0}},
+		{Description: "Grass is green", Type: model_cassandra.QuestionTrueFalse, Answers: []int32{1}},
+	}}
+
+	data, issues := encodeAiken(quiz)
+	require.Empty(t, issues, "all questions should be supported")
+	require.Equal(t, "What is the capital of France?\nA. Paris\nB. London\nANSWER: A\n\n"+
+		"Grass is green\nA. True\nB. False\nANSWER: B\n", string(data), "encoded quiz mismatch")
+
+	_, parsed, err := decodeAiken(data)
+	require.NoError(t, err, "decoding should not fail")
+	require.Len(t, parsed, 2, "question count mismatch")
+	require.Equal(t, []string{"True", "False"}, parsed[1].question.Options, "true or false options should be written")
+	require.Equal(t, []int32{1}, parsed[1].question.Answers, "true or false answer mismatch")
+}
@@ -0,0 +1,384 @@
+package interchange
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/validator"
+)
+
+// giftSpecial are the characters that must be escaped with a backslash in the text of a GIFT question.
+const giftSpecial = `~=#{}:\`
+
+// giftCategory is the command that sets the category of the questions that follow it, which is used as the quiz title.
+const giftCategory = "$CATEGORY:"
+
+// decodeGIFT will read questions in the GIFT format. Questions are separated by blank lines and comment lines start with
+// a double slash. Question names and feedback are discarded. Essay, description, and missing word questions with text
+// after the answers are not supported.
+func decodeGIFT(data []byte) (string, []*parsedQuestion, error) {
+	var title string
+	var parsed []*parsedQuestion
+
+	for _, block := range splitBlocks(data) {
+		var lines []string
+		for _, line := range block {
+			trimmed := strings.TrimSpace(line)
+			switch {
+			case strings.HasPrefix(trimmed, "//"):
+				continue
+			case strings.HasPrefix(trimmed, giftCategory):
+				category := strings.TrimSpace(strings.TrimPrefix(trimmed, giftCategory))
+				title = category[strings.LastIndex(category, "/")+1:]
+				continue
+			}
+			lines = append(lines, line)
+		}
+
+		if len(lines) != 0 {
+			question, err := decodeGIFTQuestion(strings.Join(lines, "\n"))
+			parsed = append(parsed, &parsedQuestion{question: question, err: err})
+		}
+	}
+
+	return title, parsed, nil
+}
+
+// decodeGIFTQuestion will read a single GIFT question.
+func decodeGIFTQuestion(text string) (*model_cassandra.Question, error) {
+	text = strings.TrimSpace(text)
+
+	// Discard the question name.
+	if strings.HasPrefix(text, "::") {
+		end := indexUnescaped(text[2:], "::")
+		if end < 0 {
+			return nil, errors.New("question name is not closed")
+		}
+		text = strings.TrimSpace(text[end+4:])
+	}
+
+	// Discard the text format.
+	for _, format := range []string{"[html]", "[moodle]", "[plain]", "[markdown]"} {
+		text = strings.TrimSpace(strings.TrimPrefix(text, format))
+	}
+
+	open := indexUnescaped(text, "{")
+	if open < 0 {
+		return nil, errors.New("question does not have answers")
+	}
+	closing := indexUnescaped(text[open:], "}")
+	if closing < 0 {
+		return nil, errors.New("answers are not closed")
+	}
+	closing += open
+	if len(strings.TrimSpace(text[closing+1:])) != 0 {
+		return nil, errors.New("missing word questions are not supported")
+	}
+
+	question := &model_cassandra.Question{Description: unescapeGIFT(strings.TrimSpace(text[:open]))}
+	answers := strings.TrimSpace(text[open+1 : closing])
+
+	if strings.HasPrefix(answers, "#") {
+		return decodeGIFTNumeric(question, answers[1:])
+	}
+
+	switch strings.ToUpper(strings.TrimSpace(stripGIFTFeedback(answers))) {
+	case "":
+		return nil, errors.New("essay and description questions are not supported")
+	case "T", "TRUE":
+		question.Type = model_cassandra.QuestionTrueFalse
+		question.Answers = []int32{0}
+		return question, nil
+	case "F", "FALSE":
+		question.Type = model_cassandra.QuestionTrueFalse
+		question.Answers = []int32{1}
+		return question, nil
+	}
+
+	return decodeGIFTChoices(question, answers)
+}
+
+// decodeGIFTNumeric will read the answer to a numeric question written as a value with an optional tolerance or as a
+// range. Only the first answer with full credit is read if there are several.
+func decodeGIFTNumeric(question *model_cassandra.Question, answers string) (*model_cassandra.Question, error) {
+	answer := answers
+	if tokens := splitGIFTAnswers(answers); len(tokens) != 0 {
+		answer = ""
+		for _, token := range tokens {
+			if token[0] == '=' && !strings.HasPrefix(token, "=%") {
+				answer = token[1:]
+				break
+			}
+		}
+	}
+	answer = strings.TrimSpace(stripGIFTFeedback(answer))
+
+	var value, tolerance float64
+	var err error
+	if bounds := strings.Split(answer, ".."); len(bounds) == 2 {
+		var lower, upper float64
+		if lower, err = strconv.ParseFloat(strings.TrimSpace(bounds[0]), 64); err == nil {
+			upper, err = strconv.ParseFloat(strings.TrimSpace(bounds[1]), 64)
+		}
+		value, tolerance = (lower+upper)/2, (upper-lower)/2
+	} else {
+		parts := strings.SplitN(answer, ":", 2)
+		if value, err = strconv.ParseFloat(strings.TrimSpace(parts[0]), 64); err == nil && len(parts) == 2 {
+			tolerance, err = strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid numeric answer %s", answer)
+	}
+
+	question.Type = model_cassandra.QuestionNumeric
+	question.NumericAnswer = &value
+	question.Tolerance = tolerance
+
+	return question, nil
+}
+
+// decodeGIFTChoices will read the answers of multiple choice, short answer, and matching questions. Options with a
+// positive weight are correct answers of multiple choice questions.
+func decodeGIFTChoices(question *model_cassandra.Question, answers string) (*model_cassandra.Question, error) {
+	tokens := splitGIFTAnswers(answers)
+	if len(tokens) == 0 {
+		return nil, errors.New("answers must start with = or ~")
+	}
+
+	allCorrect, matching := true, true
+	for _, token := range tokens {
+		allCorrect = allCorrect && token[0] == '=' && !strings.HasPrefix(token, "=%")
+		matching = matching && token[0] == '=' && indexUnescaped(token, "->") >= 0
+	}
+
+	switch {
+	case matching:
+		question.Type = model_cassandra.QuestionMatching
+		for _, token := range tokens {
+			separator := indexUnescaped(token, "->")
+			option := unescapeGIFT(strings.TrimSpace(token[1:separator]))
+			match := unescapeGIFT(strings.TrimSpace(stripGIFTFeedback(token[separator+2:])))
+
+			matchIdx := -1
+			for idx, existing := range question.Matches {
+				if existing == match {
+					matchIdx = idx
+				}
+			}
+			if matchIdx < 0 {
+				matchIdx = len(question.Matches)
+				question.Matches = append(question.Matches, match)
+			}
+
+			// Options without any text are distractor matches.
+			if len(option) != 0 {
+				question.Options = append(question.Options, option)
+				question.Answers = append(question.Answers, int32(matchIdx))
+			}
+		}
+
+	case allCorrect:
+		question.Type = model_cassandra.QuestionShortText
+		for _, token := range tokens {
+			question.TextAnswers = append(question.TextAnswers, unescapeGIFT(strings.TrimSpace(stripGIFTFeedback(token[1:]))))
+		}
+
+	default:
+		for idx, token := range tokens {
+			correct := token[0] == '='
+			token = token[1:]
+			if strings.HasPrefix(token, "%") {
+				end := strings.Index(token[1:], "%")
+				if end < 0 {
+					return nil, errors.New("answer weight is not closed")
+				}
+				weight, err := strconv.ParseFloat(token[1:end+1], 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid answer weight %s", token[1:end+1])
+				}
+				correct, token = weight > 0, token[end+2:]
+			}
+
+			question.Options = append(question.Options, unescapeGIFT(strings.TrimSpace(stripGIFTFeedback(token))))
+			if correct {
+				question.Answers = append(question.Answers, int32(idx))
+			}
+		}
+	}
+
+	return question, nil
+}
+
+// encodeGIFT will write a quiz in the GIFT format. The title is written as the category of the questions. Question weights
+// are not part of the format and ordering and regular expression questions are not supported.
+func encodeGIFT(quiz *model_cassandra.QuizCore) ([]byte, []*validator.ErrorField) {
+	var builder strings.Builder
+	var issues []*validator.ErrorField
+
+	if len(quiz.Title) != 0 {
+		builder.WriteString(fmt.Sprintf("%s %s\n", giftCategory, singleLine(quiz.Title)))
+	}
+
+	for idx, question := range quiz.Questions {
+		var answers []string
+
+		switch questionType(question) {
+		case model_cassandra.QuestionMultipleChoice:
+			answers = encodeGIFTChoices(question, question.Options)
+
+		case model_cassandra.QuestionTrueFalse:
+			if len(question.Options) != 0 {
+				answers = encodeGIFTChoices(question, question.Options)
+			} else if isAnswer(question, 0) {
+				answers = []string{"TRUE"}
+			} else {
+				answers = []string{"FALSE"}
+			}
+
+		case model_cassandra.QuestionNumeric:
+			if question.NumericAnswer == nil {
+				issues = append(issues, &validator.ErrorField{Field: questionField(idx), Tag: FormatGIFT, Value: "numeric answer is not set"})
+				continue
+			}
+			answer := fmt.Sprintf("#%s", strconv.FormatFloat(*question.NumericAnswer, 'f', -1, 64))
+			if question.Tolerance > 0 {
+				answer += ":" + strconv.FormatFloat(question.Tolerance, 'f', -1, 64)
+			}
+			answers = []string{answer}
+
+		case model_cassandra.QuestionShortText:
+			for _, answer := range question.TextAnswers {
+				answers = append(answers, "="+escapeGIFT(answer))
+			}
+
+		case model_cassandra.QuestionMatching:
+			matched := make(map[int32]struct{}, len(question.Answers))
+			for option, match := range question.Answers {
+				matched[match] = struct{}{}
+				answers = append(answers, fmt.Sprintf("=%s -> %s", escapeGIFT(question.Options[option]), escapeGIFT(question.Matches[match])))
+			}
+			for match, text := range question.Matches {
+				if _, ok := matched[int32(match)]; !ok {
+					answers = append(answers, fmt.Sprintf("= -> %s", escapeGIFT(text)))
+				}
+			}
+
+		default:
+			issues = append(issues, unsupported(idx, question, FormatGIFT))
+			continue
+		}
+
+		builder.WriteString(fmt.Sprintf("\n::Q%d:: %s {\n", idx+1, escapeGIFT(question.Description)))
+		for _, answer := range answers {
+			builder.WriteString("\t" + answer + "\n")
+		}
+		builder.WriteString("}\n")
+	}
+
+	return []byte(builder.String()), issues
+}
+
+// encodeGIFTChoices will write the options of a multiple choice question. Questions with several answers split the full
+// weight between their correct answers.
+func encodeGIFTChoices(question *model_cassandra.Question, options []string) []string {
+	answers := make([]string, 0, len(options))
+	weight := strconv.FormatFloat(100/float64(len(question.Answers)), 'f', -1, 64)
+
+	for option, text := range options {
+		switch {
+		case !isAnswer(question, option):
+			answers = append(answers, "~"+escapeGIFT(text))
+		case len(question.Answers) == 1:
+			answers = append(answers, "="+escapeGIFT(text))
+		default:
+			answers = append(answers, fmt.Sprintf("~%%%s%%%s", weight, escapeGIFT(text)))
+		}
+	}
+
+	return answers
+}
+
+// splitGIFTAnswers will split the answers of a question at every unescaped = and ~ that starts an answer. The marker is
+// kept at the start of every answer.
+func splitGIFTAnswers(answers string) []string {
+	var tokens []string
+	start := -1
+
+	for idx := 0; idx < len(answers); idx++ {
+		switch answers[idx] {
+		case '\\':
+			idx++
+		case '=', '~':
+			if start >= 0 {
+				tokens = append(tokens, strings.TrimSpace(answers[start:idx]))
+			}
+			start = idx
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, strings.TrimSpace(answers[start:]))
+	}
+
+	return tokens
+}
+
+// stripGIFTFeedback will remove the feedback that follows an unescaped # in an answer.
+func stripGIFTFeedback(answer string) string {
+	if idx := indexUnescaped(answer, "#"); idx >= 0 {
+		return answer[:idx]
+	}
+	return answer
+}
+
+// indexUnescaped will find the first occurrence of a substring in text that is not escaped with a backslash.
+func indexUnescaped(text, substr string) int {
+	for idx := 0; idx < len(text); idx++ {
+		if text[idx] == '\\' {
+			idx++
+			continue
+		}
+		if strings.HasPrefix(text[idx:], substr) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// escapeGIFT will escape the special characters in text and write newlines as escape sequences.
+func escapeGIFT(text string) string {
+	var builder strings.Builder
+	for _, char := range text {
+		switch {
+		case char == '\n':
+			builder.WriteString(`\n`)
+		case strings.ContainsRune(giftSpecial, char):
+			builder.WriteRune('\\')
+			builder.WriteRune(char)
+		default:
+			builder.WriteRune(char)
+		}
+	}
+	return builder.String()
+}
+
+// unescapeGIFT will replace the escaped special characters and newline escape sequences in text.
+func unescapeGIFT(text string) string {
+	var builder strings.Builder
+	for idx := 0; idx < len(text); idx++ {
+		if text[idx] == '\\' && idx+1 < len(text) {
+			idx++
+			if text[idx] == 'n' {
+				builder.WriteByte('\n')
+			} else {
+				builder.WriteByte(text[idx])
+			}
+			continue
+		}
+		builder.WriteByte(text[idx])
+	}
+	return builder.String()
+}
@@ -0,0 +1,155 @@
+package interchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+func TestDecodeGIFT(t *testing.T) {
+	pi := 3.14
+	two := 2.0
+
+	testCases := []struct {
+		name     string
+		data     string
+		expected *model_cassandra.Question
+		errMsg   string
+	}{
+		// ----- test cases start ----- //
+		{
+			name: "multiple choice with name, comment, and feedback",
+			data: "// comment\n::Name:: [html]Which is \\{red\\}? {\n=Red#right\n~Blue#wrong\n}",
+			expected: &model_cassandra.Question{Description: "Which is {red}?", Options: []string{"Red", "Blue"},
+				Answers: []int32{0}},
+		}, {
+			name: "multiple answers with weights",
+			data: "Pick two {~%50%one ~%50%two ~%-100%three}",
+			expected: &model_cassandra.Question{Description: "Pick two", Options: []string{"one", "two", "three"},
+				Answers: []int32{0, 1}},
+		}, {
+			name:     "true",
+			data:     "Grass is green {TRUE#correct}",
+			expected: &model_cassandra.Question{Description: "Grass is green", Type: model_cassandra.QuestionTrueFalse, Answers: []int32{0}},
+		}, {
+			name:     "false",
+			data:     "The sky is green {F}",
+			expected: &model_cassandra.Question{Description: "The sky is green", Type: model_cassandra.QuestionTrueFalse, Answers: []int32{1}},
+		}, {
+			name: "short answer",
+			data: "Capital of France? {=Paris =paris\\: France}",
+			expected: &model_cassandra.Question{Description: "Capital of France?", Type: model_cassandra.QuestionShortText,
+				TextAnswers: []string{"Paris", "paris: France"}},
+		}, {
+			name: "numeric with tolerance",
+			data: "Pi? {#3.14:0.01}",
+			expected: &model_cassandra.Question{Description: "Pi?", Type: model_cassandra.QuestionNumeric, NumericAnswer: &pi,
+				Tolerance: 0.01},
+		}, {
+			name: "numeric range",
+			data: "Between one and three? {#1..3}",
+			expected: &model_cassandra.Question{Description: "Between one and three?", Type: model_cassandra.QuestionNumeric,
+				NumericAnswer: &two, Tolerance: 1},
+		}, {
+			name: "numeric with several answers",
+			data: "Pi? {#=%50%3:1 =3.14:0.01}",
+			expected: &model_cassandra.Question{Description: "Pi?", Type: model_cassandra.QuestionNumeric, NumericAnswer: &pi,
+				Tolerance: 0.01},
+		}, {
+			name: "matching with distractor",
+			data: "Match {=cat -> meow =dog -> woof =cow -> moo = -> quack}",
+			expected: &model_cassandra.Question{Description: "Match", Type: model_cassandra.QuestionMatching,
+				Options: []string{"cat", "dog", "cow"}, Matches: []string{"meow", "woof", "moo", "quack"}, Answers: []int32{0, 1, 2}},
+		}, {
+			name:   "essay",
+			data:   "Write an essay {}",
+			errMsg: "essay and description questions are not supported",
+		}, {
+			name:   "missing word",
+			data:   "The {=sky ~sea} is blue",
+			errMsg: "missing word questions are not supported",
+		}, {
+			name:   "no answers",
+			data:   "No answers",
+			errMsg: "question does not have answers",
+		}, {
+			name:   "answers not closed",
+			data:   "Not closed {=yes",
+			errMsg: "answers are not closed",
+		}, {
+			name:   "name not closed",
+			data:   "::Name Not closed {=yes}",
+			errMsg: "question name is not closed",
+		}, {
+			name:   "invalid numeric",
+			data:   "Pi? {#pi}",
+			errMsg: "invalid numeric answer pi",
+		}, {
+			name:   "invalid weight",
+			data:   "Weight {~%half%one =two}",
+			errMsg: "invalid answer weight half",
+		}, {
+			name:   "answers without markers",
+			data:   "Markers {one two}",
+			errMsg: "answers must start with = or ~",
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, parsed, err := decodeGIFT([]byte(testCase.data))
+			require.NoError(t, err, "decoding should not fail")
+			require.Len(t, parsed, 1, "one question expected")
+
+			if len(testCase.errMsg) != 0 {
+				require.EqualError(t, parsed[0].err, testCase.errMsg, "error message mismatch")
+				return
+			}
+			require.NoError(t, parsed[0].err, "question should be parsed")
+			require.Equal(t, testCase.expected, parsed[0].question, "question mismatch")
+		})
+	}
+}
+
+func TestEncodeGIFT(t *testing.T) {
+	pi := 3.14
+	quiz := &model_cassandra.QuizCore{Title: "Round trip", Questions: []*model_cassandra.Question{
+		{Description: "Which is {red}?", Options: []string{"Red", "Blue: dark"}, Answers: []int32{0}},
+		{Description: "Pick two", Options: []string{"one", "two", "three"}, Answers: []int32{0, 2}},
+		{Description: "Grass is green", Type: model_cassandra.QuestionTrueFalse, Answers: []int32{0}},
+		{Description: "Custom options", Type: model_cassandra.QuestionTrueFalse, Options: []string{"Yes", "No"}, Answers: []int32{1}},
+		{Description: "Pi?", Type: model_cassandra.QuestionNumeric, NumericAnswer: &pi, Tolerance: 0.01},
+		{Description: "Capital of France?", Type: model_cassandra.QuestionShortText, TextAnswers: []string{"Paris", "Paris = France"}},
+		{Description: "Match", Type: model_cassandra.QuestionMatching, Options: []string{"cat", "dog"},
+			Matches: []string{"woof", "meow", "quack"}, Answers: []int32{1, 0}},
+	}}
+
+	data, issues := encodeGIFT(quiz)
+	require.Empty(t, issues, "all questions should be supported")
+
+	title, parsed, err := decodeGIFT(data)
+	require.NoError(t, err, "decoding should not fail")
+	require.Equal(t, quiz.Title, title, "title mismatch")
+	require.Len(t, parsed, len(quiz.Questions), "question count mismatch")
+
+	// True or false questions with their own options are written as multiple choice questions.
+	expected := *quiz.Questions[3]
+	expected.Type = ""
+	quiz.Questions[3] = &expected
+
+	// Matches are read in the order they are paired with the options.
+	quiz.Questions[6].Matches = []string{"meow", "woof", "quack"}
+	quiz.Questions[6].Answers = []int32{0, 1}
+
+	for idx, entry := range parsed {
+		require.NoError(t, entry.err, "question %d should be parsed", idx)
+		require.Equal(t, quiz.Questions[idx], entry.question, "question %d mismatch", idx)
+	}
+
+	_, issues = encodeGIFT(&model_cassandra.QuizCore{Questions: []*model_cassandra.Question{
+		{Description: "Order", Type: model_cassandra.QuestionOrdering, Options: []string{"a", "b"}, Answers: []int32{1, 0}},
+		{Description: "Regex", Type: model_cassandra.QuestionRegex, TextAnswers: []string{"a+"}},
+	}})
+	require.Len(t, issues, 2, "ordering and regular expression questions should not be supported")
+}
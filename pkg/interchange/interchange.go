@@ -0,0 +1,202 @@
+package interchange
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/validator"
+)
+
+// Formats are the quiz interchange formats that quizzes can be imported from and exported to.
+const (
+	FormatGIFT      = "gift"       // Moodle's General Import Format Technology plain text format.
+	FormatAiken     = "aiken"      // Plain text format for single answer multiple choice questions.
+	FormatMoodleXML = "moodle-xml" // Moodle's XML question format.
+	FormatQTI       = "qti"        // IMS Question and Test Interoperability 2.1 test in an IMS content package.
+)
+
+// MaxFileSize is the maximum size of a quiz file, or of a file within a content package, that will be read.
+const MaxFileSize = 1 << 20
+
+// ErrUnsupportedFormat is returned when a quiz is imported from or exported to a format that is not supported.
+var ErrUnsupportedFormat = errors.New("unsupported quiz format")
+
+// ErrDrawnQuiz is returned when a quiz that draws its questions from question banks is exported.
+var ErrDrawnQuiz = errors.New("quizzes drawn from question banks cannot be exported")
+
+// parsedQuestion is a question read from a quiz file or the reason it could not be read.
+type parsedQuestion struct {
+	question *model_cassandra.Question
+	err      error
+}
+
+// codec reads and writes quizzes in an interchange format.
+type codec struct {
+	contentType string
+	extension   string
+
+	// decode will read the title and questions from a quiz file. Errors are only returned if the file cannot be read at
+	// all, otherwise they are reported against the questions they occur in.
+	decode func(data []byte) (string, []*parsedQuestion, error)
+
+	// encode will write a quiz to a file. Questions that cannot be represented in the format are reported as issues.
+	encode func(quiz *model_cassandra.QuizCore) ([]byte, []*validator.ErrorField)
+}
+
+// codecs are the supported interchange formats.
+var codecs = map[string]*codec{
+	FormatGIFT:      {contentType: "text/plain; charset=utf-8", extension: "gift.txt", decode: decodeGIFT, encode: encodeGIFT},
+	FormatAiken:     {contentType: "text/plain; charset=utf-8", extension: "aiken.txt", decode: decodeAiken, encode: encodeAiken},
+	FormatMoodleXML: {contentType: "application/xml; charset=utf-8", extension: "xml", decode: decodeMoodleXML, encode: encodeMoodleXML},
+	FormatQTI:       {contentType: "application/zip", extension: "zip", decode: decodeQTI, encode: encodeQTI},
+}
+
+// Formats will retrieve the names of the supported interchange formats in alphabetical order.
+func Formats() []string {
+	formats := make([]string, 0, len(codecs))
+	for format := range codecs {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+
+	return formats
+}
+
+// getCodec will retrieve the codec for a format. Format names are case-insensitive.
+func getCodec(format string) (*codec, error) {
+	if formatCodec, ok := codecs[strings.ToLower(format)]; ok {
+		return formatCodec, nil
+	}
+	return nil, fmt.Errorf("%w %s, supported formats are %s", ErrUnsupportedFormat, format, strings.Join(Formats(), ", "))
+}
+
+// ContentType will retrieve the content type and file extension that quizzes are exported with in a format.
+func ContentType(format string) (contentType string, extension string, err error) {
+	var formatCodec *codec
+	if formatCodec, err = getCodec(format); err != nil {
+		return
+	}
+	return formatCodec.contentType, formatCodec.extension, nil
+}
+
+// Import will read the questions and, if the format supports it, the title of a quiz from a file. Every question is
+// validated and any questions that cannot be read or that fail validation are reported in a validator.ErrorValidation
+// with the index of the question in the field name. The quiz as a whole is not validated.
+func Import(format string, data []byte) (*model_cassandra.QuizCore, error) {
+	formatCodec, err := getCodec(format)
+	if err != nil {
+		return nil, err
+	}
+
+	title, parsed, err := formatCodec.decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	quiz := &model_cassandra.QuizCore{Title: title}
+	var issues []*validator.ErrorField
+	for idx, entry := range parsed {
+		if entry.err != nil {
+			issues = append(issues, &validator.ErrorField{Field: questionField(idx), Tag: strings.ToLower(format), Value: entry.err.Error()})
+			continue
+		}
+
+		if err = validator.ValidateStruct(entry.question); err != nil {
+			for _, issue := range err.(*validator.ErrorValidation).Errors {
+				issue.Field = questionField(idx) + "." + issue.Field
+				issues = append(issues, issue)
+			}
+		}
+		quiz.Questions = append(quiz.Questions, entry.question)
+	}
+
+	if len(issues) != 0 {
+		return nil, &validator.ErrorValidation{Errors: issues}
+	}
+
+	return quiz, nil
+}
+
+// Export will write a quiz to a file in a format. Questions that cannot be represented in the format are reported in a
+// validator.ErrorValidation with the index of the question in the field name.
+func Export(format string, quiz *model_cassandra.QuizCore) ([]byte, error) {
+	formatCodec, err := getCodec(format)
+	if err != nil {
+		return nil, err
+	}
+
+	if quiz.Draw != nil {
+		return nil, ErrDrawnQuiz
+	}
+
+	data, issues := formatCodec.encode(quiz)
+	if len(issues) != 0 {
+		return nil, &validator.ErrorValidation{Errors: issues}
+	}
+
+	return data, nil
+}
+
+// questionField is the field name that issues with a question are reported against.
+func questionField(idx int) string {
+	return fmt.Sprintf("Questions[%d]", idx)
+}
+
+// unsupported will report a question that cannot be represented in a format.
+func unsupported(idx int, question *model_cassandra.Question, format string) *validator.ErrorField {
+	return &validator.ErrorField{Field: questionField(idx), Tag: format,
+		Value: fmt.Sprintf("%s questions are not supported", questionType(question))}
+}
+
+// questionType retrieves the type of question, where questions without a type are multiple choice questions.
+func questionType(question *model_cassandra.Question) string {
+	if len(question.Type) == 0 {
+		return model_cassandra.QuestionMultipleChoice
+	}
+	return question.Type
+}
+
+// trueFalseOptions retrieves the options of a true or false question, which default to true and false.
+func trueFalseOptions(question *model_cassandra.Question) []string {
+	if len(question.Options) == 0 {
+		return []string{"True", "False"}
+	}
+	return question.Options
+}
+
+// isAnswer reports whether an option is in the answer key of a question.
+func isAnswer(question *model_cassandra.Question, option int) bool {
+	for _, answer := range question.Answers {
+		if int(answer) == option {
+			return true
+		}
+	}
+	return false
+}
+
+// splitBlocks will split text into blocks of consecutive lines that are separated by blank lines. Line endings are
+// normalized and lines are trimmed of trailing whitespace.
+func splitBlocks(data []byte) [][]string {
+	var blocks [][]string
+	var block []string
+
+	for _, line := range strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if len(strings.TrimSpace(line)) == 0 {
+			if len(block) != 0 {
+				blocks = append(blocks, block)
+			}
+			block = nil
+			continue
+		}
+		block = append(block, line)
+	}
+	if len(block) != 0 {
+		blocks = append(blocks, block)
+	}
+
+	return blocks
+}
@@ -0,0 +1,142 @@
+package interchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/validator"
+)
+
+func TestFormats(t *testing.T) {
+	require.Equal(t, []string{FormatAiken, FormatGIFT, FormatMoodleXML, FormatQTI}, Formats(), "formats mismatch")
+}
+
+func TestContentType(t *testing.T) {
+	contentType, extension, err := ContentType("MOODLE-XML")
+	require.NoError(t, err, "case-insensitive format should be found")
+	require.Equal(t, "application/xml; charset=utf-8", contentType, "content type mismatch")
+	require.Equal(t, "xml", extension, "extension mismatch")
+
+	_, _, err = ContentType("docx")
+	require.ErrorIs(t, err, ErrUnsupportedFormat, "unsupported format should fail")
+}
+
+func TestImport(t *testing.T) {
+	testCases := []struct {
+		name           string
+		format         string
+		data           string
+		expectErr      require.ErrorAssertionFunc
+		expectedFields []string
+		expectedQuiz   *model_cassandra.QuizCore
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "unsupported format",
+			format:    "docx",
+			data:      "Question {T}",
+			expectErr: require.Error,
+		}, {
+			name:      "unreadable file",
+			format:    FormatMoodleXML,
+			data:      "<quiz><question>",
+			expectErr: require.Error,
+		}, {
+			name:           "parse and validation errors",
+			format:         FormatGIFT,
+			data:           "Essay {}\n\nValid {T}\n\n{=one ~two}",
+			expectErr:      require.Error,
+			expectedFields: []string{"Questions[0]", "Questions[2].Description"},
+		}, {
+			name:      "success",
+			format:    FormatGIFT,
+			data:      "$CATEGORY: $course$/Imported\n\nValid {T}",
+			expectErr: require.NoError,
+			expectedQuiz: &model_cassandra.QuizCore{Title: "Imported", Questions: []*model_cassandra.Question{
+				{Description: "Valid", Type: model_cassandra.QuestionTrueFalse, Answers: []int32{0}},
+			}},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			quiz, err := Import(testCase.format, []byte(testCase.data))
+			testCase.expectErr(t, err, "error expectation failed")
+
+			if testCase.expectedFields != nil {
+				var fields []string
+				for _, issue := range err.(*validator.ErrorValidation).Errors {
+					fields = append(fields, issue.Field)
+				}
+				require.Equal(t, testCase.expectedFields, fields, "validation error fields mismatch")
+			}
+			if err == nil {
+				require.Equal(t, testCase.expectedQuiz, quiz, "imported quiz mismatch")
+			}
+		})
+	}
+}
+
+func TestExport(t *testing.T) {
+	testCases := []struct {
+		name           string
+		format         string
+		quiz           *model_cassandra.QuizCore
+		expectErr      require.ErrorAssertionFunc
+		expectedFields []string
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "unsupported format",
+			format:    "docx",
+			quiz:      &model_cassandra.QuizCore{Title: "Quiz"},
+			expectErr: require.Error,
+		}, {
+			name:      "drawn quiz",
+			format:    FormatGIFT,
+			quiz:      &model_cassandra.QuizCore{Title: "Quiz", Draw: &model_cassandra.QuestionDraw{Count: 1}},
+			expectErr: require.Error,
+		}, {
+			name:   "unsupported questions",
+			format: FormatAiken,
+			quiz: &model_cassandra.QuizCore{Title: "Quiz", Questions: []*model_cassandra.Question{
+				{Description: "valid", Options: []string{"a", "b"}, Answers: []int32{0}},
+				{Description: "several answers", Options: []string{"a", "b"}, Answers: []int32{0, 1}},
+				{Description: "text", Type: model_cassandra.QuestionShortText, TextAnswers: []string{"a"}},
+			}},
+			expectErr:      require.Error,
+			expectedFields: []string{"Questions[1]", "Questions[2]"},
+		}, {
+			name:   "success",
+			format: FormatAiken,
+			quiz: &model_cassandra.QuizCore{Title: "Quiz", Questions: []*model_cassandra.Question{
+				{Description: "valid", Options: []string{"a", "b"}, Answers: []int32{0}},
+			}},
+			expectErr: require.NoError,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			data, err := Export(testCase.format, testCase.quiz)
+			testCase.expectErr(t, err, "error expectation failed")
+
+			if testCase.expectedFields != nil {
+				var fields []string
+				for _, issue := range err.(*validator.ErrorValidation).Errors {
+					fields = append(fields, issue.Field)
+				}
+				require.Equal(t, testCase.expectedFields, fields, "validation error fields mismatch")
+			}
+			if err == nil {
+				require.NotEmpty(t, data, "exported data should be returned")
+			}
+		})
+	}
+}
+
+func TestSplitBlocks(t *testing.T) {
+	blocks := splitBlocks([]byte("first\r\nsecond  \n\n \t\nthird\n"))
+	require.Equal(t, [][]string{{"first", "second"}, {"third"}}, blocks, "blocks mismatch")
+}
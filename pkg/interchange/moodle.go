@@ -0,0 +1,336 @@
+package interchange
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/validator"
+)
+
+// Moodle question types that map onto the question types of a quiz.
+const (
+	moodleCategory       = "category"
+	moodleMultipleChoice = "multichoice"
+	moodleTrueFalse      = "truefalse"
+	moodleShortAnswer    = "shortanswer"
+	moodleNumerical      = "numerical"
+	moodleMatching       = "matching"
+	moodleOrdering       = "ordering"
+	moodleRegex          = "regexp"
+)
+
+// moodleCourse is the prefix of the categories of questions in the course question bank.
+const moodleCourse = "$course$/"
+
+// moodleQuiz is the root element of a Moodle XML file.
+type moodleQuiz struct {
+	XMLName   xml.Name          `xml:"quiz"`
+	Questions []*moodleQuestion `xml:"question"`
+}
+
+// moodleText is an element that contains a text element.
+type moodleText struct {
+	Format string `xml:"format,attr,omitempty"`
+	Text   string `xml:"text"`
+}
+
+// moodleQuestion is a question, or the category of the questions that follow it.
+type moodleQuestion struct {
+	Type         string               `xml:"type,attr"`
+	Category     *moodleText          `xml:"category,omitempty"`
+	Name         *moodleText          `xml:"name,omitempty"`
+	QuestionText *moodleText          `xml:"questiontext,omitempty"`
+	DefaultGrade string               `xml:"defaultgrade,omitempty"`
+	Single       string               `xml:"single,omitempty"`
+	Answers      []*moodleAnswer      `xml:"answer"`
+	Subquestions []*moodleSubquestion `xml:"subquestion"`
+}
+
+// moodleAnswer is an answer to a question with the percentage of the grade it is awarded.
+type moodleAnswer struct {
+	Fraction  string `xml:"fraction,attr"`
+	Format    string `xml:"format,attr,omitempty"`
+	Text      string `xml:"text"`
+	Tolerance string `xml:"tolerance,omitempty"`
+}
+
+// moodleSubquestion is an option of a matching question and its match. Subquestions without any text are distractor matches.
+type moodleSubquestion struct {
+	Format string      `xml:"format,attr,omitempty"`
+	Text   string      `xml:"text"`
+	Answer *moodleText `xml:"answer"`
+}
+
+// decodeMoodleXML will read questions in the Moodle XML format. The last category is used as the title. Multiple choice,
+// true or false, short answer, numerical, matching, ordering, and regular expression questions are supported. Question
+// text is read as is and the default grade of a question is read as its weight.
+func decodeMoodleXML(data []byte) (string, []*parsedQuestion, error) {
+	var title string
+	var quiz moodleQuiz
+	var parsed []*parsedQuestion
+
+	if err := xml.Unmarshal(data, &quiz); err != nil {
+		return "", nil, fmt.Errorf("invalid Moodle XML: %w", err)
+	}
+
+	for _, entry := range quiz.Questions {
+		if entry.Type == moodleCategory {
+			if entry.Category != nil {
+				category := strings.TrimSpace(entry.Category.Text)
+				title = category[strings.LastIndex(category, "/")+1:]
+			}
+			continue
+		}
+
+		question, err := decodeMoodleQuestion(entry)
+		parsed = append(parsed, &parsedQuestion{question: question, err: err})
+	}
+
+	return title, parsed, nil
+}
+
+// decodeMoodleQuestion will read a single Moodle question.
+func decodeMoodleQuestion(entry *moodleQuestion) (*model_cassandra.Question, error) {
+	question := &model_cassandra.Question{}
+	if entry.QuestionText != nil {
+		question.Description = strings.TrimSpace(entry.QuestionText.Text)
+	}
+	if len(entry.DefaultGrade) != 0 {
+		grade, err := strconv.ParseFloat(strings.TrimSpace(entry.DefaultGrade), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid default grade %s", entry.DefaultGrade)
+		}
+		if grade != 1 {
+			question.Points = grade
+		}
+	}
+
+	switch entry.Type {
+	case moodleMultipleChoice:
+		for idx, answer := range entry.Answers {
+			fraction, err := moodleFraction(answer)
+			if err != nil {
+				return nil, err
+			}
+			question.Options = append(question.Options, strings.TrimSpace(answer.Text))
+			if fraction > 0 {
+				question.Answers = append(question.Answers, int32(idx))
+			}
+		}
+
+	case moodleTrueFalse:
+		question.Type = model_cassandra.QuestionTrueFalse
+		for _, answer := range entry.Answers {
+			fraction, err := moodleFraction(answer)
+			if err != nil {
+				return nil, err
+			}
+			if fraction > 0 && strings.EqualFold(strings.TrimSpace(answer.Text), "true") {
+				question.Answers = []int32{0}
+			} else if fraction > 0 {
+				question.Answers = []int32{1}
+			}
+		}
+
+	case moodleShortAnswer, moodleRegex:
+		question.Type = model_cassandra.QuestionShortText
+		if entry.Type == moodleRegex {
+			question.Type = model_cassandra.QuestionRegex
+		}
+		for _, answer := range entry.Answers {
+			fraction, err := moodleFraction(answer)
+			if err != nil {
+				return nil, err
+			}
+			if fraction >= 100 {
+				question.TextAnswers = append(question.TextAnswers, strings.TrimSpace(answer.Text))
+			}
+		}
+
+	case moodleNumerical:
+		question.Type = model_cassandra.QuestionNumeric
+		for _, answer := range entry.Answers {
+			fraction, err := moodleFraction(answer)
+			if err != nil {
+				return nil, err
+			}
+			if fraction < 100 {
+				continue
+			}
+
+			value, err := strconv.ParseFloat(strings.TrimSpace(answer.Text), 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid numerical answer %s", answer.Text)
+			}
+			question.NumericAnswer = &value
+			if len(answer.Tolerance) != 0 {
+				if question.Tolerance, err = strconv.ParseFloat(strings.TrimSpace(answer.Tolerance), 64); err != nil {
+					return nil, fmt.Errorf("invalid tolerance %s", answer.Tolerance)
+				}
+			}
+			break
+		}
+
+	case moodleMatching:
+		question.Type = model_cassandra.QuestionMatching
+		for _, subquestion := range entry.Subquestions {
+			if subquestion.Answer == nil {
+				return nil, fmt.Errorf("subquestion %s does not have an answer", subquestion.Text)
+			}
+
+			match := strings.TrimSpace(subquestion.Answer.Text)
+			matchIdx := -1
+			for idx, existing := range question.Matches {
+				if existing == match {
+					matchIdx = idx
+				}
+			}
+			if matchIdx < 0 {
+				matchIdx = len(question.Matches)
+				question.Matches = append(question.Matches, match)
+			}
+
+			if option := strings.TrimSpace(subquestion.Text); len(option) != 0 {
+				question.Options = append(question.Options, option)
+				question.Answers = append(question.Answers, int32(matchIdx))
+			}
+		}
+
+	case moodleOrdering:
+		// The answers are listed in the correct order.
+		question.Type = model_cassandra.QuestionOrdering
+		for idx, answer := range entry.Answers {
+			question.Options = append(question.Options, strings.TrimSpace(answer.Text))
+			question.Answers = append(question.Answers, int32(idx))
+		}
+
+	default:
+		return nil, fmt.Errorf("%s questions are not supported", entry.Type)
+	}
+
+	return question, nil
+}
+
+// moodleFraction will read the percentage of the grade awarded for an answer.
+func moodleFraction(answer *moodleAnswer) (float64, error) {
+	if len(answer.Fraction) == 0 {
+		return 0, nil
+	}
+
+	fraction, err := strconv.ParseFloat(strings.TrimSpace(answer.Fraction), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid answer fraction %s", answer.Fraction)
+	}
+
+	return fraction, nil
+}
+
+// encodeMoodleXML will write a quiz in the Moodle XML format. The title is written as a category in the course question bank.
+func encodeMoodleXML(quiz *model_cassandra.QuizCore) ([]byte, []*validator.ErrorField) {
+	var issues []*validator.ErrorField
+	document := moodleQuiz{}
+
+	if len(quiz.Title) != 0 {
+		document.Questions = append(document.Questions, &moodleQuestion{Type: moodleCategory,
+			Category: &moodleText{Text: moodleCourse + quiz.Title}})
+	}
+
+	for idx, question := range quiz.Questions {
+		points := question.Points
+		if points == 0 {
+			points = 1
+		}
+		entry := &moodleQuestion{
+			Name:         &moodleText{Text: fmt.Sprintf("Q%d", idx+1)},
+			QuestionText: &moodleText{Format: "html", Text: question.Description},
+			DefaultGrade: strconv.FormatFloat(points, 'f', -1, 64),
+		}
+
+		// True or false questions with their own options are written as multiple choice questions.
+		qType := questionType(question)
+		if qType == model_cassandra.QuestionTrueFalse && len(question.Options) != 0 {
+			qType = model_cassandra.QuestionMultipleChoice
+		}
+
+		switch qType {
+		case model_cassandra.QuestionMultipleChoice:
+			entry.Type = moodleMultipleChoice
+			entry.Single = strconv.FormatBool(len(question.Answers) == 1)
+			weight := strconv.FormatFloat(100/float64(len(question.Answers)), 'f', -1, 64)
+			for option, text := range question.Options {
+				fraction := "0"
+				if isAnswer(question, option) {
+					fraction = weight
+				}
+				entry.Answers = append(entry.Answers, &moodleAnswer{Fraction: fraction, Format: "html", Text: text})
+			}
+
+		case model_cassandra.QuestionTrueFalse:
+			entry.Type = moodleTrueFalse
+			entry.Answers = []*moodleAnswer{{Fraction: "0", Text: "true"}, {Fraction: "0", Text: "false"}}
+			if isAnswer(question, 0) {
+				entry.Answers[0].Fraction = "100"
+			} else {
+				entry.Answers[1].Fraction = "100"
+			}
+
+		case model_cassandra.QuestionShortText, model_cassandra.QuestionRegex:
+			entry.Type = moodleShortAnswer
+			if qType == model_cassandra.QuestionRegex {
+				entry.Type = moodleRegex
+			}
+			for _, answer := range question.TextAnswers {
+				entry.Answers = append(entry.Answers, &moodleAnswer{Fraction: "100", Text: answer})
+			}
+
+		case model_cassandra.QuestionNumeric:
+			if question.NumericAnswer == nil {
+				issues = append(issues, &validator.ErrorField{Field: questionField(idx), Tag: FormatMoodleXML, Value: "numeric answer is not set"})
+				continue
+			}
+			entry.Type = moodleNumerical
+			entry.Answers = []*moodleAnswer{{
+				Fraction:  "100",
+				Text:      strconv.FormatFloat(*question.NumericAnswer, 'f', -1, 64),
+				Tolerance: strconv.FormatFloat(question.Tolerance, 'f', -1, 64),
+			}}
+
+		case model_cassandra.QuestionMatching:
+			entry.Type = moodleMatching
+			matched := make(map[int32]struct{}, len(question.Answers))
+			for option, match := range question.Answers {
+				matched[match] = struct{}{}
+				entry.Subquestions = append(entry.Subquestions, &moodleSubquestion{Format: "html", Text: question.Options[option],
+					Answer: &moodleText{Text: question.Matches[match]}})
+			}
+			for match, text := range question.Matches {
+				if _, ok := matched[int32(match)]; !ok {
+					entry.Subquestions = append(entry.Subquestions, &moodleSubquestion{Format: "html", Answer: &moodleText{Text: text}})
+				}
+			}
+
+		case model_cassandra.QuestionOrdering:
+			// The answers are listed in the correct order.
+			entry.Type = moodleOrdering
+			for _, option := range question.Answers {
+				entry.Answers = append(entry.Answers, &moodleAnswer{Fraction: "1", Format: "html", Text: question.Options[option]})
+			}
+
+		default:
+			issues = append(issues, unsupported(idx, question, FormatMoodleXML))
+			continue
+		}
+
+		document.Questions = append(document.Questions, entry)
+	}
+
+	data, err := xml.MarshalIndent(&document, "", "  ")
+	if err != nil {
+		issues = append(issues, &validator.ErrorField{Field: "Questions", Tag: FormatMoodleXML, Value: err.Error()})
+	}
+
+	return append([]byte(xml.Header), append(data, '\n')...), issues
+}
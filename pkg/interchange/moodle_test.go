@@ -0,0 +1,147 @@
+package interchange
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+func TestDecodeMoodleXML(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+<quiz>
+  <question type="category">
+    <category><text>$course$/Top/Imported</text></category>
+  </question>
+  <question type="multichoice">
+    <name><text>Q1</text></name>
+    <questiontext format="html"><text><![CDATA[<p>Pick two</p>]]></text></questiontext>
+    <defaultgrade>2.0000000</defaultgrade>
+    <answer fraction="50"><text>one</text></answer>
+    <answer fraction="-100"><text>two</text></answer>
+    <answer fraction="50"><text>three</text></answer>
+  </question>
+  <question type="truefalse">
+    <questiontext><text>Grass is green</text></questiontext>
+    <answer fraction="0"><text>true</text></answer>
+    <answer fraction="100"><text>false</text></answer>
+  </question>
+  <question type="shortanswer">
+    <questiontext><text>Capital of France?</text></questiontext>
+    <answer fraction="100"><text>Paris</text></answer>
+    <answer fraction="50"><text>France</text></answer>
+  </question>
+  <question type="numerical">
+    <questiontext><text>Pi?</text></questiontext>
+    <answer fraction="50"><text>3</text><tolerance>1</tolerance></answer>
+    <answer fraction="100"><text>3.14</text><tolerance>0.01</tolerance></answer>
+  </question>
+  <question type="matching">
+    <questiontext><text>Match</text></questiontext>
+    <subquestion><text>cat</text><answer><text>meow</text></answer></subquestion>
+    <subquestion><text>kitten</text><answer><text>meow</text></answer></subquestion>
+    <subquestion><text></text><answer><text>woof</text></answer></subquestion>
+  </question>
+  <question type="ordering">
+    <questiontext><text>Order</text></questiontext>
+    <answer fraction="1"><text>first</text></answer>
+    <answer fraction="2"><text>second</text></answer>
+  </question>
+  <question type="regexp">
+    <questiontext><text>Regex</text></questiontext>
+    <answer fraction="100"><text>colou?r</text></answer>
+  </question>
+  <question type="essay">
+    <questiontext><text>Essay</text></questiontext>
+  </question>
+  <question type="multichoice">
+    <questiontext><text>Invalid grade</text></questiontext>
+    <defaultgrade>two</defaultgrade>
+  </question>
+  <question type="matching">
+    <questiontext><text>Missing match</text></questiontext>
+    <subquestion><text>cat</text></subquestion>
+  </question>
+</quiz>`
+
+	pi := 3.14
+	expected := []*parsedQuestion{
+		{question: &model_cassandra.Question{Description: "<p>Pick two</p>", Options: []string{"one", "two", "three"},
+			Answers: []int32{0, 2}, Points: 2}},
+		{question: &model_cassandra.Question{Description: "Grass is green", Type: model_cassandra.QuestionTrueFalse,
+			Answers: []int32{1}}},
+		{question: &model_cassandra.Question{Description: "Capital of France?", Type: model_cassandra.QuestionShortText,
+			TextAnswers: []string{"Paris"}}},
+		{question: &model_cassandra.Question{Description: "Pi?", Type: model_cassandra.QuestionNumeric, NumericAnswer: &pi,
+			Tolerance: 0.01}},
+		{question: &model_cassandra.Question{Description: "Match", Type: model_cassandra.QuestionMatching,
+			Options: []string{"cat", "kitten"}, Matches: []string{"meow", "woof"}, Answers: []int32{0, 0}}},
+		{question: &model_cassandra.Question{Description: "Order", Type: model_cassandra.QuestionOrdering,
+			Options: []string{"first", "second"}, Answers: []int32{0, 1}}},
+		{question: &model_cassandra.Question{Description: "Regex", Type: model_cassandra.QuestionRegex,
+			TextAnswers: []string{"colou?r"}}},
+		{err: errors.New("essay questions are not supported")},
+		{err: errors.New("invalid default grade two")},
+		{err: errors.New("subquestion cat does not have an answer")},
+	}
+
+	title, parsed, err := decodeMoodleXML([]byte(data))
+	require.NoError(t, err, "decoding should not fail")
+	require.Equal(t, "Imported", title, "title mismatch")
+	require.Len(t, parsed, len(expected), "question count mismatch")
+
+	for idx, entry := range parsed {
+		if expected[idx].err != nil {
+			require.EqualError(t, entry.err, expected[idx].err.Error(), "error %d mismatch", idx)
+			continue
+		}
+		require.NoError(t, entry.err, "question %d should be parsed", idx)
+		require.Equal(t, expected[idx].question, entry.question, "question %d mismatch", idx)
+	}
+
+	_, _, err = decodeMoodleXML([]byte("<quiz><question>"))
+	require.Error(t, err, "malformed XML should fail")
+}
+
+func TestEncodeMoodleXML(t *testing.T) {
+	pi := 3.14
+	quiz := &model_cassandra.QuizCore{Title: "Round trip", Questions: []*model_cassandra.Question{
+		{Description: "<p>Which is <b>red</b>?</p>", Options: []string{"Red", "Blue"}, Answers: []int32{0}, Points: 3},
+		{Description: "Pick two", Options: []string{"one", "two", "three"}, Answers: []int32{0, 2}},
+		{Description: "Grass is green", Type: model_cassandra.QuestionTrueFalse, Answers: []int32{0}},
+		{Description: "Custom options", Type: model_cassandra.QuestionTrueFalse, Options: []string{"Yes", "No"}, Answers: []int32{1}},
+		{Description: "Pi?", Type: model_cassandra.QuestionNumeric, NumericAnswer: &pi, Tolerance: 0.01},
+		{Description: "Capital of France?", Type: model_cassandra.QuestionShortText, TextAnswers: []string{"Paris", "paris"}},
+		{Description: "Regex", Type: model_cassandra.QuestionRegex, TextAnswers: []string{"colou?r"}},
+		{Description: "Match", Type: model_cassandra.QuestionMatching, Options: []string{"cat", "dog"},
+			Matches: []string{"woof", "meow", "quack"}, Answers: []int32{1, 0}},
+		{Description: "Order", Type: model_cassandra.QuestionOrdering, Options: []string{"b", "a", "c"}, Answers: []int32{1, 0, 2}},
+	}}
+
+	data, issues := encodeMoodleXML(quiz)
+	require.Empty(t, issues, "all questions should be supported")
+
+	title, parsed, err := decodeMoodleXML(data)
+	require.NoError(t, err, "decoding should not fail")
+	require.Equal(t, quiz.Title, title, "title mismatch")
+	require.Len(t, parsed, len(quiz.Questions), "question count mismatch")
+
+	// True or false questions with their own options are written as multiple choice questions.
+	custom := *quiz.Questions[3]
+	custom.Type = ""
+	quiz.Questions[3] = &custom
+
+	// Matches are read in the order they are paired with the options.
+	quiz.Questions[7].Matches = []string{"meow", "woof", "quack"}
+	quiz.Questions[7].Answers = []int32{0, 1}
+
+	// Ordering options are read in the correct order.
+	quiz.Questions[8].Options = []string{"a", "b", "c"}
+	quiz.Questions[8].Answers = []int32{0, 1, 2}
+
+	for idx, entry := range parsed {
+		require.NoError(t, entry.err, "question %d should be parsed", idx)
+		require.Equal(t, quiz.Questions[idx], entry.question, "question %d mismatch", idx)
+	}
+}
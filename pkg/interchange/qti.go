@@ -0,0 +1,611 @@
+package interchange
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/validator"
+)
+
+// Namespaces, resource types, and identifiers of QTI 2.1 assessments in IMS content packages.
+const (
+	qtiNamespace       = "http://www.imsglobal.org/xsd/imsqti_v2p1"
+	imsNamespace       = "http://www.imsglobal.org/xsd/imscp_v1p1"
+	qtiManifest        = "imsmanifest.xml"
+	qtiTestResource    = "imsqti_test_xmlv2p1"
+	qtiItemResource    = "imsqti_item_xmlv2p1"
+	qtiResponse        = "RESPONSE"
+	qtiScore           = "SCORE"
+	qtiMaxScore        = "MAXSCORE"
+	qtiMatchCorrect    = "http://www.imsglobal.org/question/qti_v2p1/rptemplates/match_correct"
+	qtiDefaultMimeType = "application/octet-stream"
+)
+
+// qtiInline are the XHTML elements that do not separate the words of the text around them.
+var qtiInline = map[string]struct{}{
+	"a": {}, "abbr": {}, "b": {}, "cite": {}, "code": {}, "em": {}, "i": {}, "kbd": {}, "q": {}, "samp": {}, "small": {},
+	"span": {}, "strong": {}, "sub": {}, "sup": {}, "tt": {}, "var": {},
+}
+
+// qtiSkipped are the elements of an item body whose text is not part of a question.
+var qtiSkipped = map[string]struct{}{
+	"feedbackBlock": {}, "feedbackInline": {}, "modalFeedback": {}, "rubricBlock": {},
+}
+
+// imsManifest is the manifest that lists the resources of an IMS content package.
+type imsManifest struct {
+	XMLName       xml.Name       `xml:"manifest"`
+	Xmlns         string         `xml:"xmlns,attr,omitempty"`
+	Identifier    string         `xml:"identifier,attr"`
+	Organizations string         `xml:"organizations"`
+	Resources     []*imsResource `xml:"resources>resource"`
+}
+
+// imsResource is a resource in a content package and the files and other resources that it depends on.
+type imsResource struct {
+	Identifier   string           `xml:"identifier,attr"`
+	Type         string           `xml:"type,attr"`
+	Href         string           `xml:"href,attr,omitempty"`
+	Files        []*imsFile       `xml:"file"`
+	Dependencies []*imsDependency `xml:"dependency"`
+}
+
+// imsFile is a file in a content package.
+type imsFile struct {
+	Href string `xml:"href,attr"`
+}
+
+// imsDependency is a reference to a resource that another resource depends on.
+type imsDependency struct {
+	IdentifierRef string `xml:"identifierref,attr"`
+}
+
+// qtiAssessmentTest is a test that presents its items in a single linear section. It is only used to write tests.
+type qtiAssessmentTest struct {
+	XMLName    xml.Name `xml:"assessmentTest"`
+	Xmlns      string   `xml:"xmlns,attr"`
+	Identifier string   `xml:"identifier,attr"`
+	Title      string   `xml:"title,attr"`
+	TestPart   struct {
+		Identifier     string `xml:"identifier,attr"`
+		NavigationMode string `xml:"navigationMode,attr"`
+		SubmissionMode string `xml:"submissionMode,attr"`
+		Section        struct {
+			Identifier string        `xml:"identifier,attr"`
+			Title      string        `xml:"title,attr"`
+			Visible    bool          `xml:"visible,attr"`
+			ItemRefs   []*qtiItemRef `xml:"assessmentItemRef"`
+		} `xml:"assessmentSection"`
+	} `xml:"testPart"`
+}
+
+// qtiItemRef is a reference to the file that contains an assessment item.
+type qtiItemRef struct {
+	Identifier string `xml:"identifier,attr"`
+	Href       string `xml:"href,attr"`
+}
+
+// qtiAssessmentItem is an assessment item with a single interaction.
+type qtiAssessmentItem struct {
+	XMLName            xml.Name                  `xml:"assessmentItem"`
+	Xmlns              string                    `xml:"xmlns,attr,omitempty"`
+	Identifier         string                    `xml:"identifier,attr"`
+	Title              string                    `xml:"title,attr"`
+	Adaptive           bool                      `xml:"adaptive,attr"`
+	TimeDependent      bool                      `xml:"timeDependent,attr"`
+	Responses          []*qtiResponseDeclaration `xml:"responseDeclaration"`
+	Outcomes           []*qtiOutcomeDeclaration  `xml:"outcomeDeclaration"`
+	ItemBody           *qtiItemBody              `xml:"itemBody"`
+	ResponseProcessing *qtiResponseProcessing    `xml:"responseProcessing,omitempty"`
+}
+
+// qtiResponseDeclaration declares the response to an interaction and the correct response that is its answer key.
+type qtiResponseDeclaration struct {
+	Identifier      string     `xml:"identifier,attr"`
+	Cardinality     string     `xml:"cardinality,attr"`
+	BaseType        string     `xml:"baseType,attr"`
+	CorrectResponse *qtiValues `xml:"correctResponse,omitempty"`
+}
+
+// qtiOutcomeDeclaration declares an outcome of response processing, such as the score of an item.
+type qtiOutcomeDeclaration struct {
+	Identifier   string     `xml:"identifier,attr"`
+	Cardinality  string     `xml:"cardinality,attr"`
+	BaseType     string     `xml:"baseType,attr"`
+	DefaultValue *qtiValues `xml:"defaultValue,omitempty"`
+}
+
+// qtiValues is a list of values.
+type qtiValues struct {
+	Values []string `xml:"value"`
+}
+
+// qtiResponseProcessing is the template used to score the response to an item.
+type qtiResponseProcessing struct {
+	Template string `xml:"template,attr,omitempty"`
+}
+
+// qtiItemBody is the content of an item. Items are written with the asset in a block before the choice interaction,
+// which contains the description as its prompt. Items are read from the text and the first object or image anywhere in
+// the body, and the single choice interaction that it must contain.
+type qtiItemBody struct {
+	Asset       *qtiAssetBlock        `xml:"div,omitempty"`
+	Interaction *qtiChoiceInteraction `xml:"choiceInteraction"`
+	description string
+	asset       string
+	unsupported []string
+	extra       []*qtiChoiceInteraction
+}
+
+// qtiAssetBlock is the block that contains the object that displays the asset of a question.
+type qtiAssetBlock struct {
+	Object *qtiObject `xml:"object"`
+}
+
+// qtiObject is an object that displays the asset of a question.
+type qtiObject struct {
+	Data string `xml:"data,attr"`
+	Type string `xml:"type,attr"`
+}
+
+// qtiChoiceInteraction is an interaction where one or more of the choices are selected.
+type qtiChoiceInteraction struct {
+	ResponseIdentifier string             `xml:"responseIdentifier,attr"`
+	Shuffle            bool               `xml:"shuffle,attr"`
+	MaxChoices         int                `xml:"maxChoices,attr"`
+	Prompt             *qtiText           `xml:"prompt,omitempty"`
+	Choices            []*qtiSimpleChoice `xml:"simpleChoice"`
+}
+
+// qtiText is flow content that is written as text and read as the text it contains.
+type qtiText struct {
+	Text string `xml:",chardata"`
+}
+
+// qtiSimpleChoice is a choice in a choice interaction.
+type qtiSimpleChoice struct {
+	Identifier string `xml:"identifier,attr"`
+	Text       string `xml:",chardata"`
+}
+
+// UnmarshalXML will read the text contained in flow content.
+func (text *qtiText) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) (err error) {
+	text.Text, err = readQTIText(decoder)
+	return
+}
+
+// UnmarshalXML will read the identifier and the text contained in a choice.
+func (choice *qtiSimpleChoice) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) (err error) {
+	choice.Identifier = qtiAttr(start, "identifier")
+	choice.Text, err = readQTIText(decoder)
+	return
+}
+
+// UnmarshalXML will read the text, asset, and interactions anywhere in an item body.
+func (body *qtiItemBody) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	var builder strings.Builder
+	for depth := 0; ; {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			name := element.Name.Local
+			switch {
+			case name == "choiceInteraction":
+				interaction := &qtiChoiceInteraction{}
+				if err = decoder.DecodeElement(interaction, &element); err != nil {
+					return err
+				}
+				if body.Interaction == nil {
+					body.Interaction = interaction
+				} else {
+					body.extra = append(body.extra, interaction)
+				}
+				continue
+			case strings.HasSuffix(name, "Interaction"):
+				body.unsupported = append(body.unsupported, name)
+			case name == "object" && len(body.asset) == 0:
+				body.asset = qtiAttr(element, "data")
+			case name == "img" && len(body.asset) == 0:
+				body.asset = qtiAttr(element, "src")
+			default:
+				if _, ok := qtiSkipped[name]; !ok {
+					depth++
+					qtiSeparate(&builder, name)
+					continue
+				}
+			}
+			if err = decoder.Skip(); err != nil {
+				return err
+			}
+
+		case xml.EndElement:
+			if depth == 0 {
+				body.description = strings.Join(strings.Fields(builder.String()), " ")
+				return nil
+			}
+			depth--
+			qtiSeparate(&builder, element.Name.Local)
+
+		case xml.CharData:
+			builder.Write(element)
+		}
+	}
+}
+
+// readQTIText will read the text contained in the element being decoded, with its whitespace collapsed. Feedback is
+// discarded.
+func readQTIText(decoder *xml.Decoder) (string, error) {
+	var builder strings.Builder
+	for depth := 0; ; {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", err
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			if _, ok := qtiSkipped[element.Name.Local]; ok {
+				if err = decoder.Skip(); err != nil {
+					return "", err
+				}
+				continue
+			}
+			depth++
+			qtiSeparate(&builder, element.Name.Local)
+		case xml.EndElement:
+			if depth == 0 {
+				return strings.Join(strings.Fields(builder.String()), " "), nil
+			}
+			depth--
+			qtiSeparate(&builder, element.Name.Local)
+		case xml.CharData:
+			builder.Write(element)
+		}
+	}
+}
+
+// qtiSeparate will separate the text on either side of the boundary of an element that is not inline.
+func qtiSeparate(builder *strings.Builder, name string) {
+	if _, ok := qtiInline[name]; !ok {
+		builder.WriteString(" ")
+	}
+}
+
+// qtiAttr will retrieve the value of an attribute of an element.
+func qtiAttr(element xml.StartElement, name string) string {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// decodeQTI will read the items of a QTI 2.1 test or, if there is no test, the items listed in the manifest of an IMS
+// content package. The title of the test is used as the title of the quiz. Only items with a single choice interaction
+// are supported, and the first object or image in an item is read as the asset of the question.
+func decodeQTI(data []byte) (string, []*parsedQuestion, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid content package: %w", err)
+	}
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, file := range archive.File {
+		files[path.Clean(file.Name)] = file
+	}
+
+	var manifest imsManifest
+	if data, err = readQTIFile(files, qtiManifest); err != nil {
+		return "", nil, err
+	}
+	if err = xml.Unmarshal(data, &manifest); err != nil {
+		return "", nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	// Items are read in the order the test presents them, or in the order of the manifest if there is no test.
+	var title string
+	var hrefs []string
+	for _, resource := range manifest.Resources {
+		if resource.Type != qtiTestResource {
+			continue
+		}
+		if data, err = readQTIFile(files, resourceHref(resource)); err != nil {
+			return "", nil, err
+		}
+		var refs []string
+		if title, refs, err = decodeQTITest(data); err != nil {
+			return "", nil, fmt.Errorf("invalid assessment test: %w", err)
+		}
+		for _, ref := range refs {
+			hrefs = append(hrefs, path.Join(path.Dir(resourceHref(resource)), ref))
+		}
+		break
+	}
+	if hrefs == nil {
+		for _, resource := range manifest.Resources {
+			if resource.Type == qtiItemResource {
+				hrefs = append(hrefs, resourceHref(resource))
+			}
+		}
+	}
+
+	parsed := make([]*parsedQuestion, 0, len(hrefs))
+	for _, href := range hrefs {
+		if data, err = readQTIFile(files, href); err != nil {
+			parsed = append(parsed, &parsedQuestion{err: err})
+			continue
+		}
+		question, err := decodeQTIItem(data)
+		parsed = append(parsed, &parsedQuestion{question: question, err: err})
+	}
+
+	return title, parsed, nil
+}
+
+// resourceHref is the file that contains a resource, which is its first file if it does not reference one.
+func resourceHref(resource *imsResource) string {
+	if len(resource.Href) == 0 && len(resource.Files) != 0 {
+		return resource.Files[0].Href
+	}
+	return resource.Href
+}
+
+// readQTIFile will read a file from a content package.
+func readQTIFile(files map[string]*zip.File, name string) ([]byte, error) {
+	file, ok := files[path.Clean(name)]
+	if !ok {
+		return nil, fmt.Errorf("content package does not contain %s", name)
+	}
+	if file.UncompressedSize64 > MaxFileSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", name, MaxFileSize)
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("unable to open %s: %w", name, err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(io.LimitReader(reader, MaxFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", name, err)
+	}
+	if len(data) > MaxFileSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", name, MaxFileSize)
+	}
+
+	return data, nil
+}
+
+// decodeQTITest will read the title of a test and the references to its items, in the order that they appear in its
+// test parts and sections.
+func decodeQTITest(data []byte) (title string, refs []string, err error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		var token xml.Token
+		if token, err = decoder.Token(); err == io.EOF {
+			return title, refs, nil
+		} else if err != nil {
+			return "", nil, err
+		}
+
+		if element, ok := token.(xml.StartElement); ok {
+			switch element.Name.Local {
+			case "assessmentTest":
+				title = strings.TrimSpace(qtiAttr(element, "title"))
+			case "assessmentItemRef":
+				refs = append(refs, qtiAttr(element, "href"))
+			}
+		}
+	}
+}
+
+// decodeQTIItem will read an assessment item with a single choice interaction as a multiple choice question.
+func decodeQTIItem(data []byte) (*model_cassandra.Question, error) {
+	var item qtiAssessmentItem
+	if err := xml.Unmarshal(data, &item); err != nil {
+		return nil, fmt.Errorf("invalid assessment item: %w", err)
+	}
+
+	body := item.ItemBody
+	if body == nil {
+		return nil, errors.New("item does not have a body")
+	}
+	if len(body.unsupported) != 0 {
+		return nil, fmt.Errorf("%s is not supported", body.unsupported[0])
+	}
+	if body.Interaction == nil {
+		return nil, errors.New("item does not have a choice interaction")
+	}
+	if len(body.extra) != 0 {
+		return nil, errors.New("items with more than one interaction are not supported")
+	}
+
+	// The description is the text of the body followed by the prompt of the interaction.
+	interaction := body.Interaction
+	question := &model_cassandra.Question{Description: body.description, Asset: body.asset}
+	if interaction.Prompt != nil && len(interaction.Prompt.Text) != 0 {
+		question.Description = strings.TrimSpace(question.Description + " " + interaction.Prompt.Text)
+	}
+
+	choices := make(map[string]int32, len(interaction.Choices))
+	for idx, choice := range interaction.Choices {
+		choices[choice.Identifier] = int32(idx)
+		question.Options = append(question.Options, choice.Text)
+	}
+
+	for _, response := range item.Responses {
+		if response.Identifier != interaction.ResponseIdentifier {
+			continue
+		}
+		if response.CorrectResponse == nil {
+			return nil, errors.New("item does not have a correct response")
+		}
+		for _, value := range response.CorrectResponse.Values {
+			answer, ok := choices[strings.TrimSpace(value)]
+			if !ok {
+				return nil, fmt.Errorf("correct response %s is not one of the choices", value)
+			}
+			question.Answers = append(question.Answers, answer)
+		}
+	}
+	if question.Answers == nil {
+		return nil, fmt.Errorf("item does not declare the response %s", interaction.ResponseIdentifier)
+	}
+
+	for _, outcome := range item.Outcomes {
+		if outcome.Identifier != qtiMaxScore || outcome.DefaultValue == nil || len(outcome.DefaultValue.Values) == 0 {
+			continue
+		}
+		points, err := strconv.ParseFloat(strings.TrimSpace(outcome.DefaultValue.Values[0]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maximum score %s", outcome.DefaultValue.Values[0])
+		}
+		if points != 1 {
+			question.Points = points
+		}
+	}
+
+	return question, nil
+}
+
+// encodeQTI will write a quiz as a QTI 2.1 test in an IMS content package. Every question is written as an item with a
+// choice interaction, and only multiple choice and true or false questions are supported. Assets are referenced by
+// their URIs and are not included in the package.
+func encodeQTI(quiz *model_cassandra.QuizCore) ([]byte, []*validator.ErrorField) {
+	var issues []*validator.ErrorField
+	var items []*qtiAssessmentItem
+
+	for idx, question := range quiz.Questions {
+		var options []string
+		switch questionType(question) {
+		case model_cassandra.QuestionMultipleChoice:
+			options = question.Options
+		case model_cassandra.QuestionTrueFalse:
+			options = trueFalseOptions(question)
+		default:
+			issues = append(issues, unsupported(idx, question, FormatQTI))
+			continue
+		}
+		items = append(items, encodeQTIItem(idx, question, options))
+	}
+	if len(issues) != 0 {
+		return nil, issues
+	}
+
+	test := &qtiAssessmentTest{Xmlns: qtiNamespace, Identifier: "test", Title: quiz.Title}
+	test.TestPart.Identifier = "part-1"
+	test.TestPart.NavigationMode = "linear"
+	test.TestPart.SubmissionMode = "simultaneous"
+	test.TestPart.Section.Identifier = "section-1"
+	test.TestPart.Section.Title = quiz.Title
+	test.TestPart.Section.Visible = true
+
+	testResource := &imsResource{Identifier: test.Identifier, Type: qtiTestResource, Href: "assessment.xml"}
+	testResource.Files = []*imsFile{{Href: testResource.Href}}
+	manifest := &imsManifest{Xmlns: imsNamespace, Identifier: "manifest", Resources: []*imsResource{testResource}}
+	documents := map[string]any{testResource.Href: test}
+	for _, item := range items {
+		href := fmt.Sprintf("items/%s.xml", item.Identifier)
+		test.TestPart.Section.ItemRefs = append(test.TestPart.Section.ItemRefs, &qtiItemRef{Identifier: item.Identifier, Href: href})
+		testResource.Dependencies = append(testResource.Dependencies, &imsDependency{IdentifierRef: item.Identifier})
+		manifest.Resources = append(manifest.Resources, &imsResource{Identifier: item.Identifier, Type: qtiItemResource,
+			Href: href, Files: []*imsFile{{Href: href}}})
+		documents[href] = item
+	}
+
+	// The manifest is written first followed by the test and the items in the order they are listed in the manifest.
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+	write := func(name string, document any) error {
+		data, err := xml.MarshalIndent(document, "", "  ")
+		if err != nil {
+			return err
+		}
+		writer, err := archive.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate})
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(append([]byte(xml.Header), append(data, '\n')...))
+		return err
+	}
+
+	err := write(qtiManifest, manifest)
+	for _, resource := range manifest.Resources {
+		if err != nil {
+			break
+		}
+		err = write(resource.Href, documents[resource.Href])
+	}
+	if err == nil {
+		err = archive.Close()
+	}
+	if err != nil {
+		return nil, []*validator.ErrorField{{Field: "Questions", Tag: FormatQTI, Value: err.Error()}}
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// encodeQTIItem will write a question as an item with a choice interaction that is scored against its answer key.
+func encodeQTIItem(idx int, question *model_cassandra.Question, options []string) *qtiAssessmentItem {
+	points := question.Points
+	if points == 0 {
+		points = 1
+	}
+	cardinality := "single"
+	maxChoices := 1
+	if len(question.Answers) != 1 {
+		cardinality = "multiple"
+		maxChoices = 0
+	}
+
+	item := &qtiAssessmentItem{
+		Xmlns:      qtiNamespace,
+		Identifier: fmt.Sprintf("item-%d", idx+1),
+		Title:      fmt.Sprintf("Q%d", idx+1),
+		Responses: []*qtiResponseDeclaration{{Identifier: qtiResponse, Cardinality: cardinality, BaseType: "identifier",
+			CorrectResponse: &qtiValues{}}},
+		Outcomes: []*qtiOutcomeDeclaration{
+			{Identifier: qtiScore, Cardinality: "single", BaseType: "float"},
+			{Identifier: qtiMaxScore, Cardinality: "single", BaseType: "float",
+				DefaultValue: &qtiValues{Values: []string{strconv.FormatFloat(points, 'f', -1, 64)}}},
+		},
+		ItemBody: &qtiItemBody{Interaction: &qtiChoiceInteraction{ResponseIdentifier: qtiResponse, MaxChoices: maxChoices,
+			Prompt: &qtiText{Text: question.Description}}},
+		ResponseProcessing: &qtiResponseProcessing{Template: qtiMatchCorrect},
+	}
+
+	if len(question.Asset) != 0 {
+		mimeType := mime.TypeByExtension(path.Ext(strings.SplitN(question.Asset, "?", 2)[0]))
+		if len(mimeType) == 0 {
+			mimeType = qtiDefaultMimeType
+		}
+		item.ItemBody.Asset = &qtiAssetBlock{Object: &qtiObject{Data: question.Asset, Type: mimeType}}
+	}
+
+	for option, text := range options {
+		identifier := fmt.Sprintf("choice-%d", option+1)
+		item.ItemBody.Interaction.Choices = append(item.ItemBody.Interaction.Choices,
+			&qtiSimpleChoice{Identifier: identifier, Text: text})
+	}
+	for _, answer := range question.Answers {
+		item.Responses[0].CorrectResponse.Values = append(item.Responses[0].CorrectResponse.Values,
+			fmt.Sprintf("choice-%d", answer+1))
+	}
+
+	return item
+}
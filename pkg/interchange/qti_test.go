@@ -0,0 +1,178 @@
+package interchange
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+)
+
+// zipFixture will package the files in a fixture directory as a content package.
+func zipFixture(t *testing.T, name string) []byte {
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+	root := filepath.Join("testdata", "qti", name)
+
+	require.NoError(t, filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		relative, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		writer, err := archive.Create(filepath.ToSlash(relative))
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(data)
+		return err
+	}), "failed to package fixture %s", name)
+	require.NoError(t, archive.Close(), "failed to close fixture %s", name)
+
+	return buffer.Bytes()
+}
+
+func TestDecodeQTI(t *testing.T) {
+	testCases := []struct {
+		name          string
+		fixture       string
+		expectedTitle string
+		expected      []*parsedQuestion
+	}{
+		// ----- test cases start ----- //
+		{
+			name:          "vendor package with test",
+			fixture:       "vendor",
+			expectedTitle: "Geography and Numbers",
+			expected: []*parsedQuestion{
+				{question: &model_cassandra.Question{Description: "What is the capital of France?", Asset: "images/paris.png",
+					Options: []string{"London", "Paris", "Berlin"}, Answers: []int32{1}}},
+				{question: &model_cassandra.Question{Description: "Which of these numbers are prime?",
+					Asset: "https://example.com/primes.svg", Options: []string{"1", "2", "3", "4"}, Answers: []int32{1, 2},
+					Points: 2.5}},
+				{question: &model_cassandra.Question{Description: "The Moon is a star.", Options: []string{"True", "False"},
+					Answers: []int32{1}}},
+			},
+		}, {
+			name:    "items without a test",
+			fixture: "items-only",
+			expected: []*parsedQuestion{
+				{question: &model_cassandra.Question{Description: "Which planet is closest to the Sun?",
+					Options: []string{"Venus", "Mercury"}, Answers: []int32{1}}},
+				{err: errors.New("textEntryInteraction is not supported")},
+				{err: errors.New("content package does not contain items/missing.xml")},
+				{err: errors.New("correct response C is not one of the choices")},
+				{err: errors.New("item does not have a correct response")},
+			},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			title, parsed, err := decodeQTI(zipFixture(t, testCase.fixture))
+			require.NoError(t, err, "decoding should not fail")
+			require.Equal(t, testCase.expectedTitle, title, "title mismatch")
+			require.Len(t, parsed, len(testCase.expected), "question count mismatch")
+
+			for idx, entry := range parsed {
+				if testCase.expected[idx].err != nil {
+					require.EqualError(t, entry.err, testCase.expected[idx].err.Error(), "error %d mismatch", idx)
+					continue
+				}
+				require.NoError(t, entry.err, "question %d should be parsed", idx)
+				require.Equal(t, testCase.expected[idx].question, entry.question, "question %d mismatch", idx)
+			}
+		})
+	}
+}
+
+func TestDecodeQTIPackage(t *testing.T) {
+	_, _, err := decodeQTI([]byte("not a zip file"))
+	require.ErrorContains(t, err, "invalid content package", "non-zip data should fail")
+
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+	writer, err := archive.Create("items/item.xml")
+	require.NoError(t, err, "failed to create file")
+	_, err = writer.Write([]byte("<assessmentItem/>"))
+	require.NoError(t, err, "failed to write file")
+	require.NoError(t, archive.Close(), "failed to close package")
+
+	_, _, err = decodeQTI(buffer.Bytes())
+	require.EqualError(t, err, "content package does not contain imsmanifest.xml", "packages without a manifest should fail")
+}
+
+func TestEncodeQTI(t *testing.T) {
+	quiz := &model_cassandra.QuizCore{Title: "Round trip", Questions: []*model_cassandra.Question{
+		{Description: "Which is <red> & not blue?", Asset: "https://example.com/colours.png?size=large",
+			Options: []string{"Red", "Blue"}, Answers: []int32{0}, Points: 3},
+		{Description: "Pick two", Options: []string{"one", "two", "three"}, Answers: []int32{0, 2}},
+		{Description: "Grass is green", Type: model_cassandra.QuestionTrueFalse, Answers: []int32{0}},
+		{Description: "Custom options", Type: model_cassandra.QuestionTrueFalse, Options: []string{"Yes", "No"}, Answers: []int32{1}},
+	}}
+
+	data, issues := encodeQTI(quiz)
+	require.Empty(t, issues, "all questions should be supported")
+
+	title, parsed, err := decodeQTI(data)
+	require.NoError(t, err, "decoding should not fail")
+	require.Equal(t, quiz.Title, title, "title mismatch")
+	require.Len(t, parsed, len(quiz.Questions), "question count mismatch")
+
+	// True or false questions are written as choice interactions with their options.
+	quiz.Questions[2] = &model_cassandra.Question{Description: "Grass is green", Options: []string{"True", "False"}, Answers: []int32{0}}
+	quiz.Questions[3] = &model_cassandra.Question{Description: "Custom options", Options: []string{"Yes", "No"}, Answers: []int32{1}}
+
+	for idx, entry := range parsed {
+		require.NoError(t, entry.err, "question %d should be parsed", idx)
+		require.Equal(t, quiz.Questions[idx], entry.question, "question %d mismatch", idx)
+	}
+
+	_, issues = encodeQTI(&model_cassandra.QuizCore{Questions: []*model_cassandra.Question{
+		{Description: "Valid", Options: []string{"a", "b"}, Answers: []int32{0}},
+		{Description: "Order", Type: model_cassandra.QuestionOrdering, Options: []string{"a", "b"}, Answers: []int32{1, 0}},
+		{Description: "Text", Type: model_cassandra.QuestionShortText, TextAnswers: []string{"a"}},
+	}})
+	require.Len(t, issues, 2, "only choice questions should be supported")
+	require.Equal(t, "Questions[1]", issues[0].Field, "ordering question should be reported")
+	require.Equal(t, "Questions[2]", issues[1].Field, "short text question should be reported")
+}
+
+func TestQTIFixtureRoundTrip(t *testing.T) {
+	for _, fixture := range []string{"vendor", "items-only"} {
+		t.Run(fixture, func(t *testing.T) {
+			title, parsed, err := decodeQTI(zipFixture(t, fixture))
+			require.NoError(t, err, "decoding fixture should not fail")
+
+			quiz := &model_cassandra.QuizCore{Title: title}
+			for _, entry := range parsed {
+				if entry.err == nil {
+					quiz.Questions = append(quiz.Questions, entry.question)
+				}
+			}
+
+			data, issues := encodeQTI(quiz)
+			require.Empty(t, issues, "imported questions should be exported")
+
+			title, parsed, err = decodeQTI(data)
+			require.NoError(t, err, "decoding export should not fail")
+			require.Equal(t, quiz.Title, title, "title mismatch")
+			require.Len(t, parsed, len(quiz.Questions), "question count mismatch")
+			for idx, entry := range parsed {
+				require.NoError(t, entry.err, "question %d should be parsed", idx)
+				require.Equal(t, quiz.Questions[idx], entry.question, "question %d mismatch", idx)
+			}
+		})
+	}
+}
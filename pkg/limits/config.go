@@ -0,0 +1,26 @@
+package limits
+
+import (
+	"github.com/spf13/afero"
+	"github.com/surahman/mcq-platform/pkg/config_loader"
+	"github.com/surahman/mcq-platform/pkg/constants"
+)
+
+// config is the configuration container for the bounds on the size of quizzes and responses.
+type config struct {
+	Quiz struct {
+		MaxQuestions int `json:"max_questions,omitempty" yaml:"max_questions,omitempty" mapstructure:"max_questions" validate:"required,min=1,max=200"`
+		MinOptions   int `json:"min_options,omitempty" yaml:"min_options,omitempty" mapstructure:"min_options" validate:"required,min=2,ltefield=MaxOptions"`
+		MaxOptions   int `json:"max_options,omitempty" yaml:"max_options,omitempty" mapstructure:"max_options" validate:"required,max=26,gtefield=MinOptions"`
+	} `json:"quiz,omitempty" yaml:"quiz,omitempty" mapstructure:"quiz" validate:"required"`
+}
+
+// newConfig creates a blank configuration struct for the limits.
+func newConfig() *config {
+	return &config{}
+}
+
+// Load will attempt to load configurations from a file on a file system and then overwrite values using environment variables.
+func (cfg *config) Load(fs afero.Fs) (err error) {
+	return config_loader.ConfigLoader(fs, cfg, constants.GetLimitsFileName(), constants.GetLimitsPrefix(), "yaml")
+}
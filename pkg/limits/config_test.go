@@ -0,0 +1,100 @@
+package limits
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/constants"
+	"github.com/surahman/mcq-platform/pkg/validator"
+	"gopkg.in/yaml.v3"
+)
+
+func TestLimitsConfigs_Load(t *testing.T) {
+	keyspaceQuiz := constants.GetLimitsPrefix() + "_QUIZ."
+
+	testCases := []struct {
+		name      string
+		input     string
+		expectErr require.ErrorAssertionFunc
+		expectLen int
+	}{
+		// ----- test cases start ----- //
+		{
+			"empty - etc dir",
+			limitsConfigTestData["empty"],
+			require.Error,
+			3,
+		}, {
+			"valid - etc dir",
+			limitsConfigTestData["valid"],
+			require.NoError,
+			0,
+		}, {
+			"no max questions - etc dir",
+			limitsConfigTestData["no_max_questions"],
+			require.Error,
+			1,
+		}, {
+			"max questions above 200 - etc dir",
+			limitsConfigTestData["max_questions_above_200"],
+			require.Error,
+			1,
+		}, {
+			"min options below 2 - etc dir",
+			limitsConfigTestData["min_options_below_2"],
+			require.Error,
+			1,
+		}, {
+			"max options above 26 - etc dir",
+			limitsConfigTestData["max_options_above_26"],
+			require.Error,
+			1,
+		}, {
+			"min options greater than max options - etc dir",
+			limitsConfigTestData["min_options_gt_max_options"],
+			require.Error,
+			2,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Configure mock filesystem.
+			fs := afero.NewMemMapFs()
+			require.NoError(t, fs.MkdirAll(constants.GetEtcDir(), 0644), "Failed to create in memory directory")
+			require.NoError(t, afero.WriteFile(fs, constants.GetEtcDir()+constants.GetLimitsFileName(), []byte(testCase.input), 0644), "Failed to write in memory file")
+
+			// Load from mock filesystem.
+			actual := &config{}
+			err := actual.Load(fs)
+			testCase.expectErr(t, err)
+
+			if err != nil {
+				validatorErrors := err.(*validator.ErrorValidation).Errors
+				require.Equalf(t, testCase.expectLen, len(validatorErrors), "validation error count not as expected: %v", validatorErrors)
+				return
+			}
+
+			// Load expected struct.
+			expected := &config{}
+			require.NoError(t, yaml.Unmarshal([]byte(testCase.input), expected), "failed to unmarshal expected constants")
+			require.True(t, reflect.DeepEqual(expected, actual))
+
+			// Test configuring of environment variable.
+			testMaxQuestions := 100
+			testMinOptions := 3
+			testMaxOptions := 8
+			t.Setenv(keyspaceQuiz+"MAX_QUESTIONS", strconv.Itoa(testMaxQuestions))
+			t.Setenv(keyspaceQuiz+"MIN_OPTIONS", strconv.Itoa(testMinOptions))
+			t.Setenv(keyspaceQuiz+"MAX_OPTIONS", strconv.Itoa(testMaxOptions))
+			err = actual.Load(fs)
+			require.NoErrorf(t, err, "Failed to load constants file: %v", err)
+			require.Equal(t, testMaxQuestions, actual.Quiz.MaxQuestions, "Failed to load max questions environment variable into configs")
+			require.Equal(t, testMinOptions, actual.Quiz.MinOptions, "Failed to load min options environment variable into configs")
+			require.Equal(t, testMaxOptions, actual.Quiz.MaxOptions, "Failed to load max options environment variable into configs")
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package limits
+
+import (
+	"errors"
+
+	"github.com/spf13/afero"
+	"github.com/surahman/mcq-platform/pkg/logger"
+	"github.com/surahman/mcq-platform/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// Limits contains the configured bounds on the size of quizzes and responses. The bounds are enforced by the validator and
+// the grader for both the REST and GraphQL APIs.
+type Limits struct {
+	conf   *config
+	logger *logger.Logger
+}
+
+// NewLimits will load the limits configuration and apply the bounds to the validator.
+func NewLimits(fs *afero.Fs, logger *logger.Logger) (*Limits, error) {
+	if fs == nil || logger == nil {
+		return nil, errors.New("nil file system or logger supplied")
+	}
+	return newLimitsImpl(fs, logger)
+}
+
+// newLimitsImpl will create a new Limits configuration, load it from disk, and apply it to the validator.
+func newLimitsImpl(fs *afero.Fs, logger *logger.Logger) (l *Limits, err error) {
+	l = &Limits{conf: newConfig(), logger: logger}
+	if err = l.conf.Load(*fs); err != nil {
+		l.logger.Error("failed to load limits configurations from disk", zap.Error(err))
+		return nil, err
+	}
+
+	if err = validator.SetLimits(l.Bounds()); err != nil {
+		l.logger.Error("failed to apply limits to the validator", zap.Error(err))
+		return nil, err
+	}
+
+	return
+}
+
+// Bounds will retrieve the configured bounds on the size of quizzes and responses.
+func (l *Limits) Bounds() validator.Limits {
+	return validator.Limits{
+		MaxQuestions: l.conf.Quiz.MaxQuestions,
+		MinOptions:   l.conf.Quiz.MinOptions,
+		MaxOptions:   l.conf.Quiz.MaxOptions,
+	}
+}
@@ -0,0 +1,80 @@
+package limits
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/constants"
+	"github.com/surahman/mcq-platform/pkg/logger"
+	"github.com/surahman/mcq-platform/pkg/validator"
+)
+
+func TestNewLimits(t *testing.T) {
+	defaults := validator.GetLimits()
+	t.Cleanup(func() { require.NoError(t, validator.SetLimits(defaults), "failed to restore default limits") })
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, fs.MkdirAll(constants.GetEtcDir(), 0644), "Failed to create in memory directory")
+	require.NoError(t, afero.WriteFile(fs, constants.GetEtcDir()+constants.GetLimitsFileName(),
+		[]byte(limitsConfigTestData["valid"]), 0644), "Failed to write in memory file")
+
+	emptyFs := afero.NewMemMapFs()
+
+	testCases := []struct {
+		name      string
+		fs        *afero.Fs
+		log       *logger.Logger
+		expectErr require.ErrorAssertionFunc
+		expectNil require.ValueAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "Invalid file system and logger",
+			fs:        nil,
+			log:       nil,
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:      "Invalid file system",
+			fs:        nil,
+			log:       zapLogger,
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:      "Invalid logger",
+			fs:        &fs,
+			log:       nil,
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:      "File not found",
+			fs:        &emptyFs,
+			log:       zapLogger,
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:      "Valid",
+			fs:        &fs,
+			log:       zapLogger,
+			expectErr: require.NoError,
+			expectNil: require.NotNil,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			limits, err := NewLimits(testCase.fs, testCase.log)
+			testCase.expectErr(t, err)
+			testCase.expectNil(t, limits)
+
+			if err != nil {
+				return
+			}
+
+			expected := validator.Limits{MaxQuestions: 40, MinOptions: 2, MaxOptions: 6}
+			require.Equal(t, expected, limits.Bounds(), "configured bounds do not match")
+			require.Equal(t, expected, validator.GetLimits(), "bounds were not applied to the validator")
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package limits
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/surahman/mcq-platform/pkg/logger"
+)
+
+// limitsConfigTestData is a map of limits configuration test data.
+var limitsConfigTestData = configTestData()
+
+// zapLogger is the Zap logger used strictly for the test suite in this package.
+var zapLogger *logger.Logger
+
+func TestMain(m *testing.M) {
+	var err error
+	// Configure logger.
+	if zapLogger, err = logger.NewTestLogger(); err != nil {
+		log.Printf("Test suite logger setup failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Run test suite.
+	os.Exit(m.Run())
+}
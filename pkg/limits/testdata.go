@@ -0,0 +1,44 @@
+package limits
+
+// configTestData will return a map of test data containing valid and invalid limits configs.
+func configTestData() map[string]string {
+	return map[string]string{
+
+		"empty": ``,
+
+		"valid": `
+quiz:
+  max_questions: 40
+  min_options: 2
+  max_options: 6`,
+
+		"no_max_questions": `
+quiz:
+  min_options: 2
+  max_options: 6`,
+
+		"max_questions_above_200": `
+quiz:
+  max_questions: 201
+  min_options: 2
+  max_options: 6`,
+
+		"min_options_below_2": `
+quiz:
+  max_questions: 40
+  min_options: 1
+  max_options: 6`,
+
+		"max_options_above_26": `
+quiz:
+  max_questions: 40
+  min_options: 2
+  max_options: 27`,
+
+		"min_options_gt_max_options": `
+quiz:
+  max_questions: 40
+  min_options: 5
+  max_options: 4`,
+	}
+}
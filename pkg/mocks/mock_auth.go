@@ -8,7 +8,8 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	model_rest "github.com/surahman/mcq-platform/pkg/model/http"
+	model_cassandra "github.com/surahman/mcq-platform/pkg/model/cassandra"
+	model_http "github.com/surahman/mcq-platform/pkg/model/http"
 )
 
 // MockAuth is a mock of Auth interface.
@@ -34,6 +35,20 @@ func (m *MockAuth) EXPECT() *MockAuthMockRecorder {
 	return m.recorder
 }
 
+// AdminAccount mocks base method.
+func (m *MockAuth) AdminAccount() *model_cassandra.UserAccount {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminAccount")
+	ret0, _ := ret[0].(*model_cassandra.UserAccount)
+	return ret0
+}
+
+// AdminAccount indicates an expected call of AdminAccount.
+func (mr *MockAuthMockRecorder) AdminAccount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminAccount", reflect.TypeOf((*MockAuth)(nil).AdminAccount))
+}
+
 // CheckPassword mocks base method.
 func (m *MockAuth) CheckPassword(arg0, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -79,18 +94,18 @@ func (mr *MockAuthMockRecorder) EncryptToString(arg0 interface{}) *gomock.Call {
 }
 
 // GenerateJWT mocks base method.
-func (m *MockAuth) GenerateJWT(arg0 string) (*model_rest.JWTAuthResponse, error) {
+func (m *MockAuth) GenerateJWT(arg0, arg1 string) (*model_http.JWTAuthResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateJWT", arg0)
-	ret0, _ := ret[0].(*model_rest.JWTAuthResponse)
+	ret := m.ctrl.Call(m, "GenerateJWT", arg0, arg1)
+	ret0, _ := ret[0].(*model_http.JWTAuthResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateJWT indicates an expected call of GenerateJWT.
-func (mr *MockAuthMockRecorder) GenerateJWT(arg0 interface{}) *gomock.Call {
+func (mr *MockAuthMockRecorder) GenerateJWT(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateJWT", reflect.TypeOf((*MockAuth)(nil).GenerateJWT), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateJWT", reflect.TypeOf((*MockAuth)(nil).GenerateJWT), arg0, arg1)
 }
 
 // HashPassword mocks base method.
@@ -109,10 +124,10 @@ func (mr *MockAuthMockRecorder) HashPassword(arg0 interface{}) *gomock.Call {
 }
 
 // RefreshJWT mocks base method.
-func (m *MockAuth) RefreshJWT(arg0 string) (*model_rest.JWTAuthResponse, error) {
+func (m *MockAuth) RefreshJWT(arg0 string) (*model_http.JWTAuthResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RefreshJWT", arg0)
-	ret0, _ := ret[0].(*model_rest.JWTAuthResponse)
+	ret0, _ := ret[0].(*model_http.JWTAuthResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -138,13 +153,14 @@ func (mr *MockAuthMockRecorder) RefreshThreshold() *gomock.Call {
 }
 
 // ValidateJWT mocks base method.
-func (m *MockAuth) ValidateJWT(arg0 string) (string, int64, error) {
+func (m *MockAuth) ValidateJWT(arg0 string) (string, int64, string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidateJWT", arg0)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(int64)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret2, _ := ret[2].(string)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
 }
 
 // ValidateJWT indicates an expected call of ValidateJWT.
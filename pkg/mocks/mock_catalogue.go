@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/surahman/mcq-platform/pkg/catalogue (interfaces: Catalogue)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gocql "github.com/gocql/gocql"
+	gomock "github.com/golang/mock/gomock"
+	model_cassandra "github.com/surahman/mcq-platform/pkg/model/cassandra"
+	model_http "github.com/surahman/mcq-platform/pkg/model/http"
+)
+
+// MockCatalogue is a mock of Catalogue interface.
+type MockCatalogue struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogueMockRecorder
+}
+
+// MockCatalogueMockRecorder is the mock recorder for MockCatalogue.
+type MockCatalogueMockRecorder struct {
+	mock *MockCatalogue
+}
+
+// NewMockCatalogue creates a new mock instance.
+func NewMockCatalogue(ctrl *gomock.Controller) *MockCatalogue {
+	mock := &MockCatalogue{ctrl: ctrl}
+	mock.recorder = &MockCatalogueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogue) EXPECT() *MockCatalogueMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockCatalogue) Add(arg0 *model_cassandra.Quiz) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Add", arg0)
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockCatalogueMockRecorder) Add(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockCatalogue)(nil).Add), arg0)
+}
+
+// Load mocks base method.
+func (m *MockCatalogue) Load() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockCatalogueMockRecorder) Load() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockCatalogue)(nil).Load))
+}
+
+// Remove mocks base method.
+func (m *MockCatalogue) Remove(arg0 gocql.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Remove", arg0)
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockCatalogueMockRecorder) Remove(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockCatalogue)(nil).Remove), arg0)
+}
+
+// Search mocks base method.
+func (m *MockCatalogue) Search(arg0 *model_http.CatalogueRequest) *model_http.CataloguePage {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0)
+	ret0, _ := ret[0].(*model_http.CataloguePage)
+	return ret0
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockCatalogueMockRecorder) Search(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockCatalogue)(nil).Search), arg0)
+}
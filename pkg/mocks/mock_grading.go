@@ -35,12 +35,13 @@ func (m *MockGrading) EXPECT() *MockGradingMockRecorder {
 }
 
 // Grade mocks base method.
-func (m *MockGrading) Grade(arg0 *model_cassandra.QuizResponse, arg1 *model_cassandra.QuizCore) (float64, error) {
+func (m *MockGrading) Grade(arg0 *model_cassandra.QuizResponse, arg1 *model_cassandra.QuizCore) (float64, float64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Grade", arg0, arg1)
 	ret0, _ := ret[0].(float64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(float64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // Grade indicates an expected call of Grade.
@@ -48,3 +49,19 @@ func (mr *MockGradingMockRecorder) Grade(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Grade", reflect.TypeOf((*MockGrading)(nil).Grade), arg0, arg1)
 }
+
+// GradeQuestions mocks base method.
+func (m *MockGrading) GradeQuestions(arg0 *model_cassandra.QuizResponse, arg1 *model_cassandra.QuizCore) ([]float64, []bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GradeQuestions", arg0, arg1)
+	ret0, _ := ret[0].([]float64)
+	ret1, _ := ret[1].([]bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GradeQuestions indicates an expected call of GradeQuestions.
+func (mr *MockGradingMockRecorder) GradeQuestions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GradeQuestions", reflect.TypeOf((*MockGrading)(nil).GradeQuestions), arg0, arg1)
+}
@@ -0,0 +1,36 @@
+package model_cassandra
+
+import (
+	"github.com/gocql/gocql"
+)
+
+// Quiz statuses are used to filter the quizzes listed for their author.
+const (
+	QuizStatusDraft     = "draft"     // The quiz is neither published nor deleted.
+	QuizStatusPublished = "published" // The quiz is published and not deleted.
+	QuizStatusDeleted   = "deleted"   // The quiz is deleted.
+)
+
+// AuthorQuiz is a summary of a quiz listed for its author and is a row in the author quizzes table.
+type AuthorQuiz struct {
+	Author  string     `json:"author,omitempty" cql:"author"`   // The username of the quiz creator.
+	QuizID  gocql.UUID `json:"quiz_id,omitempty" cql:"quiz_id"` // The unique identifier for the quiz.
+	Title   string     `json:"title,omitempty" cql:"title"`     // The title description of the quiz.
+	Status  string     `json:"status,omitempty" cql:"status"`   // The status of the quiz: draft, published, or deleted.
+	Version int        `json:"version" cql:"version"`           // The current published version of the quiz. Unpublished quizzes are not versioned.
+}
+
+// AuthorQuizzesRequest is a request for a page of the quizzes created by an author.
+type AuthorQuizzesRequest struct {
+	Author     string // The username of the quiz creator.
+	Status     string // The status of the quizzes to list. All quizzes are listed if it is not set.
+	PageCursor []byte // A cursor to where the next page of data will begin.
+	PageSize   int    // Number of records to read from the page.
+}
+
+// AuthorQuizzesResponse from the database containing the rows and a cursor position into the query.
+type AuthorQuizzesResponse struct {
+	PageCursor []byte        // A cursor to where the next page of data will begin.
+	Records    []*AuthorQuiz // Author quiz rows from the database.
+	PageSize   int           // Maximum number of records on the requested page.
+}
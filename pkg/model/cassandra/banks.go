@@ -0,0 +1,31 @@
+package model_cassandra
+
+import (
+	"github.com/gocql/gocql"
+)
+
+// QuestionBank represents a collection of questions owned by an author and is a row in the question banks table.
+// [1] Question bank core is required and is valid.
+type QuestionBank struct {
+	*QuestionBankCore `json:"bank_core,omitempty" validate:"required"` // The title and questions.
+	BankID            gocql.UUID                                       `json:"bank_id,omitempty" cql:"bank_id"`       // The unique identifier for the question bank.
+	Author            string                                           `json:"author,omitempty" cql:"author"`         // The username of the question bank creator.
+	IsDeleted         bool                                             `json:"is_deleted,omitempty" cql:"is_deleted"` // Status indicating whether the question bank has been deleted.
+}
+
+// QuestionBankCore is the actual data used to create as well as what is presented when viewing a question bank.
+// [1] Question bank title is required.
+// [2] Questions array is required and is valid (1-500 questions).
+// [3] Validate all Questions.
+type QuestionBankCore struct {
+	Title     string      `json:"title,omitempty" cql:"title" validate:"required"`                            // The title description of the question bank.
+	Questions []*Question `json:"questions,omitempty" cql:"questions" validate:"required,min=1,max=500,dive"` // A list of questions in the question bank.
+}
+
+// QuestionBankMutateRequest is the request data sent to the database handler to change the Delete and Update status of a
+// question bank record.
+type QuestionBankMutateRequest struct {
+	Username string
+	BankID   gocql.UUID
+	Bank     *QuestionBank
+}
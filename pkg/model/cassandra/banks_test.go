@@ -0,0 +1,67 @@
+package model_cassandra
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/validator"
+)
+
+func TestValidateQuestionBankCore(t *testing.T) {
+	manyQuestions := make([]*Question, 501)
+	for idx := range manyQuestions {
+		manyQuestions[idx] = &question1
+	}
+
+	testCases := []struct {
+		name        string
+		input       *QuestionBankCore
+		expectErr   require.ErrorAssertionFunc
+		expectedLen int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:        "Valid question bank",
+			input:       &QuestionBankCore{Title: "Valid bank", Questions: []*Question{&question1, &questionTagged, &questionNumeric}},
+			expectErr:   require.NoError,
+			expectedLen: 0,
+		}, {
+			name:        "More questions than a quiz",
+			input:       &QuestionBankCore{Title: "Large bank", Questions: manyQuestions[:500]},
+			expectErr:   require.NoError,
+			expectedLen: 0,
+		}, {
+			name:        "No title",
+			input:       &QuestionBankCore{Questions: []*Question{&question1}},
+			expectErr:   require.Error,
+			expectedLen: 1,
+		}, {
+			name:        "No questions",
+			input:       &QuestionBankCore{Title: "Empty bank", Questions: []*Question{}},
+			expectErr:   require.Error,
+			expectedLen: 1,
+		}, {
+			name:        "Too many questions",
+			input:       &QuestionBankCore{Title: "Oversized bank", Questions: manyQuestions},
+			expectErr:   require.Error,
+			expectedLen: 1,
+		}, {
+			name:        "Invalid questions",
+			input:       &QuestionBankCore{Title: "Invalid bank", Questions: []*Question{&question1, &questionUnknownDifficulty}},
+			expectErr:   require.Error,
+			expectedLen: 1,
+		},
+		// ----- test cases end ----- //
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validator.ValidateStruct(testCase.input)
+			testCase.expectErr(t, err)
+
+			if err != nil {
+				require.Equal(t, testCase.expectedLen, len(err.(*validator.ErrorValidation).Errors))
+			}
+		})
+	}
+}
@@ -59,3 +59,382 @@ CREATE TABLE IF NOT EXISTS mcq_platform.responses (
 --comment: Index on the responses table used to collate statistics.
 CREATE INDEX responses_statistics_index ON mcq_platform.responses (quiz_id);
 --rollback DROP INDEX mcq_platform.responses_statistics_index;
+
+
+--changeset surahman:6
+--preconditions onFail:HALT onError:HALT
+--comment: Question weights used to scale the marks awarded for a question. Fields cannot be dropped from a UDT.
+ALTER TYPE mcq_platform.question ADD points double;
+--rollback empty
+
+--changeset surahman:7
+--preconditions onFail:HALT onError:HALT
+--comment: Maximum achievable score for a response.
+ALTER TABLE mcq_platform.responses ADD max_score double;
+--rollback ALTER TABLE mcq_platform.responses DROP max_score;
+
+--changeset surahman:8
+--preconditions onFail:HALT onError:HALT
+--comment: Question types with numeric and text answer keys. Fields cannot be dropped from a UDT.
+ALTER TYPE mcq_platform.question ADD type text;
+ALTER TYPE mcq_platform.question ADD numeric_answer double;
+ALTER TYPE mcq_platform.question ADD tolerance double;
+ALTER TYPE mcq_platform.question ADD text_answers list<text>;
+--rollback empty
+
+--changeset surahman:9
+--preconditions onFail:HALT onError:HALT
+--comment: Numeric and text responses for a response.
+ALTER TABLE mcq_platform.responses ADD text_responses frozen<list<text>>;
+--rollback ALTER TABLE mcq_platform.responses DROP text_responses;
+
+--changeset surahman:10
+--preconditions onFail:HALT onError:HALT
+--comment: Matches for matching questions. Fields cannot be dropped from a UDT.
+ALTER TYPE mcq_platform.question ADD matches list<text>;
+--rollback empty
+
+--changeset surahman:11
+--preconditions onFail:HALT onError:HALT
+--comment: Current published version of a quiz.
+ALTER TABLE mcq_platform.quizzes ADD version int;
+--rollback ALTER TABLE mcq_platform.quizzes DROP version;
+
+--changeset surahman:12
+--preconditions onFail:HALT onError:HALT
+--comment: Immutable snapshots of every published version of a quiz.
+CREATE TABLE IF NOT EXISTS mcq_platform.quiz_versions (
+    quiz_id         uuid,                           // Unique identifier for the quiz.
+    version         int,                            // Version number of the published revision.
+    author          text,                           // Username of the quiz creator.
+    title           text,                           // Description of the quiz.
+    marking_type    text,                           // Marking type of the revision.
+    questions       frozen<list<frozen<question>>>, // A list of questions in the revision.
+    PRIMARY KEY ( (quiz_id), version )
+) WITH CLUSTERING ORDER BY (version ASC);
+--rollback DROP TABLE mcq_platform.quiz_versions;
+
+--changeset surahman:13
+--preconditions onFail:HALT onError:HALT
+--comment: Version of the quiz a response was graded against.
+ALTER TABLE mcq_platform.responses ADD version int;
+--rollback ALTER TABLE mcq_platform.responses DROP version;
+
+--changeset surahman:14
+--preconditions onFail:HALT onError:HALT
+--comment: Attempt limits, cooldown, and policy of a quiz.
+ALTER TABLE mcq_platform.quizzes ADD (max_attempts int, attempt_cooldown int, attempt_policy text);
+--rollback ALTER TABLE mcq_platform.quizzes DROP (max_attempts, attempt_cooldown, attempt_policy);
+
+--changeset surahman:15
+--preconditions onFail:HALT onError:HALT
+--comment: Attempt limits, cooldown, and policy of a published quiz version.
+ALTER TABLE mcq_platform.quiz_versions ADD (max_attempts int, attempt_cooldown int, attempt_policy text);
+--rollback ALTER TABLE mcq_platform.quiz_versions DROP (max_attempts, attempt_cooldown, attempt_policy);
+
+--changeset surahman:16
+--preconditions onFail:HALT onError:HALT
+--comment: Attempt UDT that describes a single graded attempt at a quiz.
+CREATE TYPE IF NOT EXISTS mcq_platform.attempt (
+    number          int,                            // Attempt number, starting at one.
+    score           double,                         // Score for the attempt.
+    max_score       double,                         // Maximum achievable score for the attempt.
+    version         int,                            // Version of the quiz the attempt was graded against.
+    responses       frozen<list<frozen<list<int>>>>, // Recorded responses for the attempt.
+    text_responses  frozen<list<text>>,             // Recorded numeric and text responses for the attempt.
+    submitted_at    timestamp                       // Time at which the attempt was submitted.
+);
+--rollback DROP TYPE mcq_platform.attempt;
+
+--changeset surahman:17
+--preconditions onFail:HALT onError:HALT
+--comment: Every attempt at a quiz for a response.
+ALTER TABLE mcq_platform.responses ADD attempts frozen<list<frozen<attempt>>>;
+--rollback ALTER TABLE mcq_platform.responses DROP attempts;
+
+--changeset surahman:18
+--preconditions onFail:HALT onError:HALT
+--comment: Time limit and grace period of a quiz.
+ALTER TABLE mcq_platform.quizzes ADD (time_limit int, grace_period int);
+--rollback ALTER TABLE mcq_platform.quizzes DROP (time_limit, grace_period);
+
+--changeset surahman:19
+--preconditions onFail:HALT onError:HALT
+--comment: Time limit and grace period of a published quiz version.
+ALTER TABLE mcq_platform.quiz_versions ADD (time_limit int, grace_period int);
+--rollback ALTER TABLE mcq_platform.quiz_versions DROP (time_limit, grace_period);
+
+--changeset surahman:20
+--preconditions onFail:HALT onError:HALT
+--comment: Late submission indicator of an attempt. Fields cannot be dropped from a UDT.
+ALTER TYPE mcq_platform.attempt ADD late boolean;
+--rollback empty
+
+--changeset surahman:21
+--preconditions onFail:HALT onError:HALT
+--comment: Attempt sessions table creation.
+CREATE TABLE IF NOT EXISTS mcq_platform.attempt_sessions (
+    username    text,                               // Username of the test taker.
+    quiz_id     uuid,                               // Started quiz's id.
+    attempt     int,                                // Number of the attempt that was started.
+    started_at  timestamp,                          // Time at which the attempt was started.
+    deadline    timestamp,                          // Time by which the attempt must be submitted, excluding the grace period.
+    PRIMARY KEY ( (username, quiz_id), attempt )
+) WITH CLUSTERING ORDER BY (attempt ASC);
+--rollback DROP TABLE mcq_platform.attempt_sessions;
+
+--changeset surahman:22
+--preconditions onFail:HALT onError:HALT
+--comment: Availability window and closed status of a quiz.
+ALTER TABLE mcq_platform.quizzes ADD (opens_at timestamp, closes_at timestamp, is_closed boolean);
+--rollback ALTER TABLE mcq_platform.quizzes DROP (opens_at, closes_at, is_closed);
+
+--changeset surahman:23
+--preconditions onFail:HALT onError:HALT
+--comment: Quiz schedule table creation.
+CREATE TABLE IF NOT EXISTS mcq_platform.quiz_schedule (
+    action      text,                               // Action to perform on the quiz: publish or close.
+    due_at      timestamp,                          // Time at which the action is due.
+    quiz_id     uuid,                               // Scheduled quiz's id.
+    PRIMARY KEY ( (action), due_at, quiz_id )
+) WITH CLUSTERING ORDER BY (due_at ASC, quiz_id ASC);
+--rollback DROP TABLE mcq_platform.quiz_schedule;
+
+--changeset surahman:24
+--preconditions onFail:HALT onError:HALT
+--comment: Per-attempt question and option shuffling of a quiz.
+ALTER TABLE mcq_platform.quizzes ADD shuffle boolean;
+--rollback ALTER TABLE mcq_platform.quizzes DROP shuffle;
+
+--changeset surahman:25
+--preconditions onFail:HALT onError:HALT
+--comment: Per-attempt question and option shuffling of a quiz version.
+ALTER TABLE mcq_platform.quiz_versions ADD shuffle boolean;
+--rollback ALTER TABLE mcq_platform.quiz_versions DROP shuffle;
+
+--changeset surahman:26
+--preconditions onFail:HALT onError:HALT
+--comment: Attempt shuffles table creation.
+CREATE TABLE IF NOT EXISTS mcq_platform.attempt_shuffles (
+    username    text,                               // Username of the test taker.
+    quiz_id     uuid,                               // Shuffled quiz's id.
+    attempt     int,                                // Number of the attempt the order applies to.
+    seed        bigint,                             // Seed from which the question and option order is generated.
+    PRIMARY KEY ( (username, quiz_id), attempt )
+) WITH CLUSTERING ORDER BY (attempt ASC);
+--rollback DROP TABLE mcq_platform.attempt_shuffles;
+
+--changeset surahman:27
+--preconditions onFail:HALT onError:HALT
+--comment: Tags and difficulty of a question used to stratify question bank draws.
+ALTER TYPE mcq_platform.question ADD tags list<text>;
+ALTER TYPE mcq_platform.question ADD difficulty text;
+--rollback empty
+
+--changeset surahman:28
+--preconditions onFail:HALT onError:HALT
+--comment: Question draw UDT creation.
+CREATE TYPE IF NOT EXISTS mcq_platform.question_draw (
+    banks       list<uuid>,                         // Ids of the question banks to draw from.
+    count       int,                                // Number of questions drawn for each attempt.
+    stratify_by text                                // Question attribute the draw is balanced across: tag or difficulty.
+);
+--rollback DROP TYPE mcq_platform.question_draw;
+
+--changeset surahman:29
+--preconditions onFail:HALT onError:HALT
+--comment: Questions of a quiz drawn from question banks.
+ALTER TABLE mcq_platform.quizzes ADD draw frozen<question_draw>;
+--rollback ALTER TABLE mcq_platform.quizzes DROP draw;
+
+--changeset surahman:30
+--preconditions onFail:HALT onError:HALT
+--comment: Questions of a quiz version drawn from question banks.
+ALTER TABLE mcq_platform.quiz_versions ADD draw frozen<question_draw>;
+--rollback ALTER TABLE mcq_platform.quiz_versions DROP draw;
+
+--changeset surahman:31
+--preconditions onFail:HALT onError:HALT
+--comment: Question banks table creation.
+CREATE TABLE IF NOT EXISTS mcq_platform.question_banks (
+    bank_id         uuid,                           // Unique identifier for the question bank.
+    author          text,                           // Username of the question bank creator.
+    title           text,                           // Description of the question bank.
+    questions       frozen<list<frozen<question>>>, // A list of questions in the question bank.
+    is_deleted      boolean,                        // Status indicating whether the question bank has been deleted.
+    PRIMARY KEY ( (bank_id) )
+);
+--rollback DROP TABLE mcq_platform.question_banks;
+
+--changeset surahman:32
+--preconditions onFail:HALT onError:HALT
+--comment: Attempt draws table creation.
+CREATE TABLE IF NOT EXISTS mcq_platform.attempt_draws (
+    username    text,                               // Username of the test taker.
+    quiz_id     uuid,                               // Drawn quiz's id.
+    attempt     int,                                // Number of the attempt the questions were drawn for.
+    questions   frozen<list<frozen<question>>>,     // Questions drawn for the attempt.
+    PRIMARY KEY ( (username, quiz_id), attempt )
+) WITH CLUSTERING ORDER BY (attempt ASC);
+--rollback DROP TABLE mcq_platform.attempt_draws;
+
+--changeset surahman:33
+--preconditions onFail:HALT onError:HALT
+--comment: Author quizzes table creation.
+CREATE TABLE IF NOT EXISTS mcq_platform.author_quizzes (
+    author      text,                               // Username of the quiz creator.
+    quiz_id     uuid,                               // Listed quiz's id.
+    title       text,                               // Description of the quiz.
+    status      text,                               // Status of the quiz: draft, published, or deleted.
+    version     int,                                // Current published version of the quiz.
+    PRIMARY KEY ( (author), quiz_id )
+) WITH CLUSTERING ORDER BY (quiz_id DESC);
+--rollback DROP TABLE mcq_platform.author_quizzes;
+
+--changeset surahman:34
+--preconditions onFail:HALT onError:HALT
+--comment: Catalogue description and tags of a quiz.
+ALTER TABLE mcq_platform.quizzes ADD (description text, tags list<text>);
+--rollback ALTER TABLE mcq_platform.quizzes DROP (description, tags);
+
+--changeset surahman:35
+--preconditions onFail:HALT onError:HALT
+--comment: Catalogue description and tags of a quiz version.
+ALTER TABLE mcq_platform.quiz_versions ADD (description text, tags list<text>);
+--rollback ALTER TABLE mcq_platform.quiz_versions DROP (description, tags);
+
+--changeset surahman:36
+--preconditions onFail:HALT onError:HALT
+--comment: User scores table creation.
+CREATE TABLE IF NOT EXISTS mcq_platform.user_scores (
+    username        text,                               // Username of the test taker.
+    quiz_id         uuid,                               // Taken quiz's id.
+    title           text,                               // Description of the quiz.
+    score           double,                             // Effective score across the attempts at the quiz.
+    max_score       double,                             // Maximum achievable score for the effective attempt.
+    attempts        int,                                // Number of attempts at the quiz.
+    version         int,                                // Version of the quiz the effective score was graded against.
+    submitted_at    timestamp,                          // Time at which the latest attempt was submitted.
+    PRIMARY KEY ( (username), quiz_id )
+) WITH CLUSTERING ORDER BY (quiz_id DESC);
+--rollback DROP TABLE mcq_platform.user_scores;
+
+--changeset surahman:37
+--preconditions onFail:HALT onError:HALT
+--comment: Explanation and per-option feedback of a question revealed after submission.
+ALTER TYPE mcq_platform.question ADD explanation text;
+ALTER TYPE mcq_platform.question ADD feedback list<text>;
+--rollback empty
+
+--changeset surahman:38
+--preconditions onFail:HALT onError:HALT
+--comment: Policy for revealing the answers of a quiz.
+ALTER TABLE mcq_platform.quizzes ADD reveal_policy text;
+--rollback ALTER TABLE mcq_platform.quizzes DROP reveal_policy;
+
+--changeset surahman:39
+--preconditions onFail:HALT onError:HALT
+--comment: Policy for revealing the answers of a quiz version.
+ALTER TABLE mcq_platform.quiz_versions ADD reveal_policy text;
+--rollback ALTER TABLE mcq_platform.quiz_versions DROP reveal_policy;
+
+--changeset surahman:40
+--preconditions onFail:HALT onError:HALT
+--comment: Answer drafts table creation.
+CREATE TABLE IF NOT EXISTS mcq_platform.answer_drafts (
+    username        text,                               // Username of the test taker.
+    quiz_id         uuid,                               // Drafted quiz's id.
+    attempt         int,                                // Number of the attempt the answers were drafted for.
+    responses       frozen<list<frozen<list<int>>>>,    // Answer card as it was last saved.
+    text_responses  frozen<list<text>>,                 // Answers to numeric and text questions as they were last saved.
+    saved_at        timestamp,                          // Time at which the draft was last saved.
+    PRIMARY KEY ( (username, quiz_id), attempt )
+) WITH CLUSTERING ORDER BY (attempt ASC);
+--rollback DROP TABLE mcq_platform.answer_drafts;
+
+--changeset surahman:41
+--preconditions onFail:HALT onError:HALT
+--comment: Role of a user that determines the operations they are permitted to perform.
+ALTER TABLE mcq_platform.users ADD role text;
+--rollback ALTER TABLE mcq_platform.users DROP role;
+
+--changeset surahman:42
+--preconditions onFail:HALT onError:HALT
+--comment: Groups a quiz is restricted to.
+ALTER TABLE mcq_platform.quizzes ADD groups set<uuid>;
+--rollback ALTER TABLE mcq_platform.quizzes DROP groups;
+
+--changeset surahman:43
+--preconditions onFail:HALT onError:HALT
+--comment: Groups table creation.
+CREATE TABLE IF NOT EXISTS mcq_platform.groups (
+    group_id        uuid,                               // Unique identifier for the class or cohort.
+    name            text,                               // Name of the group.
+    description     text,                               // Description of the group.
+    creator         text,                               // Username of the group creator.
+    join_code       text,                               // Code users can join the group with.
+    is_deleted      boolean,                            // Status indicating whether the group has been deleted.
+    PRIMARY KEY ( (group_id) )
+);
+--rollback DROP TABLE mcq_platform.groups;
+
+--changeset surahman:44
+--preconditions onFail:HALT onError:HALT
+--comment: Group members table creation.
+CREATE TABLE IF NOT EXISTS mcq_platform.group_members (
+    group_id        uuid,                               // Group's id.
+    username        text,                               // Username of the member.
+    is_owner        boolean,                            // Status indicating whether the member owns the group.
+    joined_at       timestamp,                          // Time at which the member joined the group.
+    PRIMARY KEY ( (group_id), username )
+) WITH CLUSTERING ORDER BY (username ASC);
+--rollback DROP TABLE mcq_platform.group_members;
+
+--changeset surahman:45
+--preconditions onFail:HALT onError:HALT
+--comment: User groups table creation.
+CREATE TABLE IF NOT EXISTS mcq_platform.user_groups (
+    username        text,                               // Username of the member.
+    group_id        uuid,                               // Listed group's id.
+    name            text,                               // Name of the group.
+    is_owner        boolean,                            // Status indicating whether the member owns the group.
+    PRIMARY KEY ( (username), group_id )
+) WITH CLUSTERING ORDER BY (group_id DESC);
+--rollback DROP TABLE mcq_platform.user_groups;
+
+--changeset surahman:46
+--preconditions onFail:HALT onError:HALT
+--comment: Group invitations table creation.
+CREATE TABLE IF NOT EXISTS mcq_platform.group_invitations (
+    username        text,                               // Username of the invited user.
+    group_id        uuid,                               // Group's id.
+    name            text,                               // Name of the group when the invitation was sent.
+    invited_by      text,                               // Username of the owner who sent the invitation.
+    is_owner        boolean,                            // Status indicating whether the user is invited as an owner.
+    invited_at      timestamp,                          // Time at which the invitation was sent.
+    PRIMARY KEY ( (username), group_id )
+) WITH CLUSTERING ORDER BY (group_id DESC);
+--rollback DROP TABLE mcq_platform.group_invitations;
+
+--changeset surahman:47
+--preconditions onFail:HALT onError:HALT
+--comment: Group join codes table creation.
+CREATE TABLE IF NOT EXISTS mcq_platform.group_join_codes (
+    join_code       text,                               // Code users can join the group with.
+    group_id        uuid,                               // Group's id.
+    PRIMARY KEY ( (join_code) )
+);
+--rollback DROP TABLE mcq_platform.group_join_codes;
+
+--changeset surahman:48
+--preconditions onFail:HALT onError:HALT
+--comment: Group quizzes table creation.
+CREATE TABLE IF NOT EXISTS mcq_platform.group_quizzes (
+    group_id        uuid,                               // Group's id.
+    quiz_id         uuid,                               // Assigned quiz's id.
+    title           text,                               // Description of the quiz when it was assigned.
+    assigned_by     text,                               // Username of the owner who assigned the quiz.
+    assigned_at     timestamp,                          // Time at which the quiz was assigned.
+    PRIMARY KEY ( (group_id), quiz_id )
+) WITH CLUSTERING ORDER BY (quiz_id DESC);
+--rollback DROP TABLE mcq_platform.group_quizzes;
\ No newline at end of file
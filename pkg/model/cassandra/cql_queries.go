@@ -19,6 +19,7 @@ const (
     last_name   text,
     email       text,
     is_deleted  boolean,
+    role        text,
     PRIMARY KEY ( (username, account_id) )
 );`
 
@@ -27,10 +28,27 @@ const (
     description text,
     asset       text,
     options     list<text>,
-    answers     list<int>
+    answers     list<int>,
+    points      double,
+    type        text,
+    numeric_answer double,
+    tolerance   double,
+    text_answers list<text>,
+    matches     list<text>,
+    tags        list<text>,
+    difficulty  text,
+    explanation text,
+    feedback    list<text>
 );`
 
-	// CreateQuizzesTable creates the Quizzes table. CreateQuestionUDT must be called before this statement.
+	// CreateQuestionDrawUDT creates the Question Draw UDT that is used by the Quizzes and Quiz Versions tables.
+	CreateQuestionDrawUDT = `CREATE TYPE IF NOT EXISTS question_draw (
+    banks       list<uuid>,
+    count       int,
+    stratify_by text
+);`
+
+	// CreateQuizzesTable creates the Quizzes table. CreateQuestionUDT and CreateQuestionDrawUDT must be called before this statement.
 	CreateQuizzesTable = `CREATE TABLE IF NOT EXISTS quizzes (
     quiz_id         uuid,
     author          text,
@@ -39,16 +57,80 @@ const (
     questions       frozen<list<frozen<question>>>,
     is_published    boolean,
     is_deleted      boolean,
+    version         int,
+    max_attempts    int,
+    attempt_cooldown int,
+    attempt_policy  text,
+    time_limit      int,
+    grace_period    int,
+    shuffle         boolean,
+    draw            frozen<question_draw>,
+    description     text,
+    tags            list<text>,
+    reveal_policy   text,
+    opens_at        timestamp,
+    closes_at       timestamp,
+    is_closed       boolean,
+    groups          set<uuid>,
     PRIMARY KEY ( (quiz_id) )
 );`
 
-	// CreateResponsesTable creates the Responses table. CreateResponsesIndex must be called after this statement.
+	// CreateQuizVersionsTable creates the Quiz Versions table. CreateQuestionUDT and CreateQuestionDrawUDT must be called before
+	// this statement.
+	CreateQuizVersionsTable = `CREATE TABLE IF NOT EXISTS quiz_versions (
+    quiz_id         uuid,
+    version         int,
+    author          text,
+    title           text,
+    marking_type    text,
+    questions       frozen<list<frozen<question>>>,
+    max_attempts    int,
+    attempt_cooldown int,
+    attempt_policy  text,
+    time_limit      int,
+    grace_period    int,
+    shuffle         boolean,
+    draw            frozen<question_draw>,
+    description     text,
+    tags            list<text>,
+    reveal_policy   text,
+    PRIMARY KEY ( (quiz_id), version )
+) WITH CLUSTERING ORDER BY (version ASC);`
+
+	// CreateQuestionBanksTable creates the Question Banks table. CreateQuestionUDT must be called before this statement.
+	CreateQuestionBanksTable = `CREATE TABLE IF NOT EXISTS question_banks (
+    bank_id         uuid,
+    author          text,
+    title           text,
+    questions       frozen<list<frozen<question>>>,
+    is_deleted      boolean,
+    PRIMARY KEY ( (bank_id) )
+);`
+
+	// CreateAttemptUDT creates the Attempt UDT that is used by the Responses table. CreateResponsesTable must be called after this statement.
+	CreateAttemptUDT = `CREATE TYPE IF NOT EXISTS attempt (
+    number          int,
+    score           double,
+    max_score       double,
+    version         int,
+    responses       frozen<list<frozen<list<int>>>>,
+    text_responses  frozen<list<text>>,
+    submitted_at    timestamp,
+    late            boolean
+);`
+
+	// CreateResponsesTable creates the Responses table. CreateAttemptUDT must be called before this statement and
+	// CreateResponsesIndex must be called after this statement.
 	CreateResponsesTable = `CREATE TABLE IF NOT EXISTS responses (
     username text,
     quiz_id uuid,
     author text,
     score double,
+    max_score double,
     responses frozen<list<list<int>>>,
+    text_responses frozen<list<text>>,
+    version int,
+    attempts frozen<list<frozen<attempt>>>,
     PRIMARY KEY ( (username, quiz_id) )
 );`
 
@@ -56,12 +138,139 @@ const (
 	// CreateResponsesTable must be called before this statement.
 	CreateResponsesIndex = `CREATE INDEX responses_statistics_index ON responses (quiz_id);`
 
+	// CreateAttemptSessionsTable creates the Attempt Sessions table.
+	CreateAttemptSessionsTable = `CREATE TABLE IF NOT EXISTS attempt_sessions (
+    username    text,
+    quiz_id     uuid,
+    attempt     int,
+    started_at  timestamp,
+    deadline    timestamp,
+    PRIMARY KEY ( (username, quiz_id), attempt )
+) WITH CLUSTERING ORDER BY (attempt ASC);`
+
+	// CreateAttemptShufflesTable creates the Attempt Shuffles table.
+	CreateAttemptShufflesTable = `CREATE TABLE IF NOT EXISTS attempt_shuffles (
+    username    text,
+    quiz_id     uuid,
+    attempt     int,
+    seed        bigint,
+    PRIMARY KEY ( (username, quiz_id), attempt )
+) WITH CLUSTERING ORDER BY (attempt ASC);`
+
+	// CreateAttemptDrawsTable creates the Attempt Draws table. CreateQuestionUDT must be called before this statement.
+	CreateAttemptDrawsTable = `CREATE TABLE IF NOT EXISTS attempt_draws (
+    username    text,
+    quiz_id     uuid,
+    attempt     int,
+    questions   frozen<list<frozen<question>>>,
+    PRIMARY KEY ( (username, quiz_id), attempt )
+) WITH CLUSTERING ORDER BY (attempt ASC);`
+
+	// CreateAnswerDraftsTable creates the Answer Drafts table.
+	CreateAnswerDraftsTable = `CREATE TABLE IF NOT EXISTS answer_drafts (
+    username        text,
+    quiz_id         uuid,
+    attempt         int,
+    responses       frozen<list<frozen<list<int>>>>,
+    text_responses  frozen<list<text>>,
+    saved_at        timestamp,
+    PRIMARY KEY ( (username, quiz_id), attempt )
+) WITH CLUSTERING ORDER BY (attempt ASC);`
+
+	// CreateAuthorQuizzesTable creates the Author Quizzes table.
+	CreateAuthorQuizzesTable = `CREATE TABLE IF NOT EXISTS author_quizzes (
+    author      text,
+    quiz_id     uuid,
+    title       text,
+    status      text,
+    version     int,
+    PRIMARY KEY ( (author), quiz_id )
+) WITH CLUSTERING ORDER BY (quiz_id DESC);`
+
+	// CreateUserScoresTable creates the User Scores table.
+	CreateUserScoresTable = `CREATE TABLE IF NOT EXISTS user_scores (
+    username        text,
+    quiz_id         uuid,
+    title           text,
+    score           double,
+    max_score       double,
+    attempts        int,
+    version         int,
+    submitted_at    timestamp,
+    PRIMARY KEY ( (username), quiz_id )
+) WITH CLUSTERING ORDER BY (quiz_id DESC);`
+
+	// CreateQuizScheduleTable creates the Quiz Schedule table.
+	CreateQuizScheduleTable = `CREATE TABLE IF NOT EXISTS quiz_schedule (
+    action      text,
+    due_at      timestamp,
+    quiz_id     uuid,
+    PRIMARY KEY ( (action), due_at, quiz_id )
+) WITH CLUSTERING ORDER BY (due_at ASC, quiz_id ASC);`
+
+	// CreateGroupsTable creates the Groups table.
+	CreateGroupsTable = `CREATE TABLE IF NOT EXISTS groups (
+    group_id    uuid,
+    name        text,
+    description text,
+    creator     text,
+    join_code   text,
+    is_deleted  boolean,
+    PRIMARY KEY ( (group_id) )
+);`
+
+	// CreateGroupMembersTable creates the Group Members table.
+	CreateGroupMembersTable = `CREATE TABLE IF NOT EXISTS group_members (
+    group_id    uuid,
+    username    text,
+    is_owner    boolean,
+    joined_at   timestamp,
+    PRIMARY KEY ( (group_id), username )
+) WITH CLUSTERING ORDER BY (username ASC);`
+
+	// CreateUserGroupsTable creates the User Groups table.
+	CreateUserGroupsTable = `CREATE TABLE IF NOT EXISTS user_groups (
+    username    text,
+    group_id    uuid,
+    name        text,
+    is_owner    boolean,
+    PRIMARY KEY ( (username), group_id )
+) WITH CLUSTERING ORDER BY (group_id DESC);`
+
+	// CreateGroupInvitationsTable creates the Group Invitations table.
+	CreateGroupInvitationsTable = `CREATE TABLE IF NOT EXISTS group_invitations (
+    username    text,
+    group_id    uuid,
+    name        text,
+    invited_by  text,
+    is_owner    boolean,
+    invited_at  timestamp,
+    PRIMARY KEY ( (username), group_id )
+) WITH CLUSTERING ORDER BY (group_id DESC);`
+
+	// CreateGroupJoinCodesTable creates the Group Join Codes table.
+	CreateGroupJoinCodesTable = `CREATE TABLE IF NOT EXISTS group_join_codes (
+    join_code   text,
+    group_id    uuid,
+    PRIMARY KEY ( (join_code) )
+);`
+
+	// CreateGroupQuizzesTable creates the Group Quizzes table.
+	CreateGroupQuizzesTable = `CREATE TABLE IF NOT EXISTS group_quizzes (
+    group_id    uuid,
+    quiz_id     uuid,
+    title       text,
+    assigned_by text,
+    assigned_at timestamp,
+    PRIMARY KEY ( (group_id), quiz_id )
+) WITH CLUSTERING ORDER BY (quiz_id DESC);`
+
 	// -----   Users Table Queries   -----
 
 	// CreateUser inserts a new user record into the Users table if it does not already exist.
-	// Query Params: username, account_id, password, first_name, last_name, email
-	CreateUser = `INSERT INTO users (username, account_id, password, first_name, last_name, email, is_deleted)
-VALUES (?, ?, ?, ?, ?, ?, false)
+	// Query Params: username, account_id, password, first_name, last_name, email, role
+	CreateUser = `INSERT INTO users (username, account_id, password, first_name, last_name, email, role, is_deleted)
+VALUES (?, ?, ?, ?, ?, ?, ?, false)
 IF NOT EXISTS;`
 
 	// ReadUser retrieves a user account record from the Users table.
@@ -74,22 +283,39 @@ IF NOT EXISTS;`
 SET is_deleted = true
 WHERE username = ? AND account_id = ? IF EXISTS;`
 
+	// UpdateUserRole will change the role of a user that has not been deleted.
+	// Query Params: role, username, account_id
+	UpdateUserRole = `UPDATE users
+SET role = ?
+WHERE username = ? AND account_id = ?
+IF is_deleted = false;`
+
 	// -----   Quizzes Table Queries   -----
 
 	// CreateQuiz inserts a new Quiz record into the Quizzes table if it does not already exist.
-	// Query Params: quiz_id, author, title, questions, marking_type
-	CreateQuiz = `INSERT INTO quizzes (quiz_id, author, title, questions, marking_type, is_published, is_deleted)
-VALUES (?, ?, ?, ?, ?, ?, ?)
+	// Query Params: quiz_id, author, title, questions, marking_type, max_attempts, attempt_cooldown, attempt_policy, time_limit,
+	// grace_period, shuffle, draw, description, tags, reveal_policy, is_published, is_deleted
+	CreateQuiz = `INSERT INTO quizzes (quiz_id, author, title, questions, marking_type, max_attempts, attempt_cooldown, attempt_policy, time_limit, grace_period, shuffle, draw, description, tags, reveal_policy, is_published, is_deleted)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 IF NOT EXISTS ;`
 
 	// ReadQuiz retrieves a Quiz record from the Quizzes table.
 	// Query Params: quiz_id,
 	ReadQuiz = `SELECT * FROM quizzes WHERE quiz_id = ?;`
 
+	// ReadQuizTitle retrieves the title of a Quiz record from the Quizzes table.
+	// Query Params: quiz_id
+	ReadQuizTitle = `SELECT title FROM quizzes WHERE quiz_id = ?;`
+
+	// ReadPublishedQuizzes retrieves all published Quiz records that are not deleted from the Quizzes table. This is a full
+	// table scan and should only be used to build the quiz catalogue on startup.
+	ReadPublishedQuizzes = `SELECT * FROM quizzes WHERE is_published = true AND is_deleted = false ALLOW FILTERING;`
+
 	// UpdateQuiz updates a Quiz record in the Quizzes table if it is not published.
-	// Query Params: title, questions, marking_type, quiz_id
+	// Query Params: title, questions, marking_type, max_attempts, attempt_cooldown, attempt_policy, time_limit, grace_period,
+	// shuffle, draw, description, tags, reveal_policy, quiz_id, author
 	UpdateQuiz = `UPDATE quizzes
-SET title = ?, questions = ?, marking_type = ?
+SET title = ?, questions = ?, marking_type = ?, max_attempts = ?, attempt_cooldown = ?, attempt_policy = ?, time_limit = ?, grace_period = ?, shuffle = ?, draw = ?, description = ?, tags = ?, reveal_policy = ?
 WHERE quiz_id = ? IF author = ? AND is_published = false AND is_deleted = false;`
 
 	// DeleteQuiz marks a Quiz record as deleted in the Quizzes table. A deleted quiz will be set to unpublished.
@@ -98,20 +324,119 @@ WHERE quiz_id = ? IF author = ? AND is_published = false AND is_deleted = false;
 SET is_deleted = true, is_published = false
 WHERE quiz_id = ? IF author = ?;`
 
-	// PublishQuiz marks a Quiz record as published in the Quizzes table.
-	// Query Params: quiz_id
+	// PublishQuiz marks a Quiz record as published in the Quizzes table and sets it to the first version.
+	// Query Params: quiz_id, author
 	PublishQuiz = `UPDATE quizzes
-SET is_published = true
+SET is_published = true, version = 1
+WHERE quiz_id = ? IF author = ? AND is_deleted = false AND is_published = false;`
+
+	// ReviseQuiz replaces the contents of a published Quiz record in the Quizzes table with a new version.
+	// Query Params: title, questions, marking_type, max_attempts, attempt_cooldown, attempt_policy, time_limit, grace_period,
+	// shuffle, draw, description, tags, reveal_policy, version, quiz_id, author
+	ReviseQuiz = `UPDATE quizzes
+SET title = ?, questions = ?, marking_type = ?, max_attempts = ?, attempt_cooldown = ?, attempt_policy = ?, time_limit = ?, grace_period = ?, shuffle = ?, draw = ?, description = ?, tags = ?, reveal_policy = ?, version = ?
+WHERE quiz_id = ? IF author = ? AND is_published = true AND is_deleted = false;`
+
+	// ScheduleQuiz sets the availability window of a Quiz record in the Quizzes table and reopens it if it was closed.
+	// Query Params: opens_at, closes_at, quiz_id, author, is_published
+	ScheduleQuiz = `UPDATE quizzes
+SET opens_at = ?, closes_at = ?, is_closed = false
+WHERE quiz_id = ? IF author = ? AND is_deleted = false AND is_published = ?;`
+
+	// CloseQuiz marks a Quiz record as closed in the Quizzes table if its closing time has not been changed.
+	// Query Params: quiz_id, closes_at
+	CloseQuiz = `UPDATE quizzes
+SET is_closed = true
+WHERE quiz_id = ? IF is_deleted = false AND closes_at = ?;`
+
+	// AssignQuizGroup adds a group to the groups a Quiz record in the Quizzes table is restricted to.
+	// Query Params: group_ids, quiz_id, author
+	AssignQuizGroup = `UPDATE quizzes
+SET groups = groups + ?
 WHERE quiz_id = ? IF author = ? AND is_deleted = false;`
 
+	// UnassignQuizGroup removes a group from the groups a Quiz record in the Quizzes table is restricted to.
+	// Query Params: group_ids, quiz_id, author
+	UnassignQuizGroup = `UPDATE quizzes
+SET groups = groups - ?
+WHERE quiz_id = ? IF author = ?;`
+
+	// -----   Quiz Versions Table Queries   -----
+
+	// CreateQuizVersion inserts an immutable Quiz Version record into the Quiz Versions table if it does not already exist.
+	// Query Params: quiz_id, version, author, title, questions, marking_type, max_attempts, attempt_cooldown, attempt_policy,
+	// time_limit, grace_period, shuffle, draw, description, tags, reveal_policy
+	CreateQuizVersion = `INSERT INTO quiz_versions (quiz_id, version, author, title, questions, marking_type, max_attempts, attempt_cooldown, attempt_policy, time_limit, grace_period, shuffle, draw, description, tags, reveal_policy)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+IF NOT EXISTS;`
+
+	// ReadQuizVersion retrieves a Quiz Version record from the Quiz Versions table.
+	// Query Params: quiz_id, version
+	ReadQuizVersion = `SELECT * FROM quiz_versions WHERE quiz_id = ? AND version = ?;`
+
+	// ReadQuizVersions retrieves all Quiz Version records for a given Quiz from the Quiz Versions table.
+	// Query Params: quiz_id
+	ReadQuizVersions = `SELECT * FROM quiz_versions WHERE quiz_id = ?;`
+
+	// -----   Author Quizzes Table Queries   -----
+
+	// CreateAuthorQuiz inserts an Author Quiz record into the Author Quizzes table, replacing any existing record.
+	// Query Params: author, quiz_id, title, status, version
+	CreateAuthorQuiz = `INSERT INTO author_quizzes (author, quiz_id, title, status, version)
+VALUES (?, ?, ?, ?, ?);`
+
+	// ReadAuthorQuizzes retrieves all Author Quiz records for a given author from the Author Quizzes table.
+	// Query Params: author
+	ReadAuthorQuizzes = `SELECT * FROM author_quizzes WHERE author = ?;`
+
+	// ReadAuthorQuizzesByStatus retrieves the Author Quiz records with a given status for a given author from the Author
+	// Quizzes table. Filtering is restricted to the author's partition.
+	// Query Params: author, status
+	ReadAuthorQuizzesByStatus = `SELECT * FROM author_quizzes WHERE author = ? AND status = ? ALLOW FILTERING;`
+
+	// -----   Question Banks Table Queries   -----
+
+	// CreateQuestionBank inserts a new Question Bank record into the Question Banks table if it does not already exist.
+	// Query Params: bank_id, author, title, questions
+	CreateQuestionBank = `INSERT INTO question_banks (bank_id, author, title, questions, is_deleted)
+VALUES (?, ?, ?, ?, false)
+IF NOT EXISTS;`
+
+	// ReadQuestionBank retrieves a Question Bank record from the Question Banks table.
+	// Query Params: bank_id
+	ReadQuestionBank = `SELECT * FROM question_banks WHERE bank_id = ?;`
+
+	// ReadQuestionBanks retrieves multiple Question Bank records from the Question Banks table.
+	// Query Params: bank_ids
+	ReadQuestionBanks = `SELECT * FROM question_banks WHERE bank_id IN ?;`
+
+	// UpdateQuestionBank updates a Question Bank record in the Question Banks table if it has not been deleted.
+	// Query Params: title, questions, bank_id, author
+	UpdateQuestionBank = `UPDATE question_banks
+SET title = ?, questions = ?
+WHERE bank_id = ? IF author = ? AND is_deleted = false;`
+
+	// DeleteQuestionBank marks a Question Bank record as deleted in the Question Banks table.
+	// Query Params: bank_id, author
+	DeleteQuestionBank = `UPDATE question_banks
+SET is_deleted = true
+WHERE bank_id = ? IF author = ?;`
+
 	// -----   Responses Table Queries   -----
 
-	// CreateResponse inserts a new Response record into the Quizzes table if it does not already exist.
-	// Query Params: username, quiz_id, author, responses, score
-	CreateResponse = `INSERT INTO responses (username, quiz_id, author, responses, score)
-VALUES (?, ?, ?, ?, ?)
+	// CreateResponse inserts a new Response record with the first attempt into the Responses table if it does not already exist.
+	// Query Params: username, quiz_id, author, responses, score, max_score, text_responses, version, attempts
+	CreateResponse = `INSERT INTO responses (username, quiz_id, author, responses, score, max_score, text_responses, version, attempts)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 IF NOT EXISTS;`
 
+	// UpdateResponse replaces the attempts and effective score of an existing Response record in the Responses table if the
+	// attempts have not changed since they were read. Legacy records without attempts are matched on a null attempts list.
+	// Query Params: responses, text_responses, score, max_score, version, attempts, username, quiz_id, previous attempts
+	UpdateResponse = `UPDATE responses
+SET responses = ?, text_responses = ?, score = ?, max_score = ?, version = ?, attempts = ?
+WHERE username = ? AND quiz_id = ? IF attempts = ?;`
+
 	// ReadResponse retrieves a Response record from the Responses table.
 	// Query Params: username, quiz_id
 	ReadResponse = `SELECT * FROM responses WHERE username = ? AND quiz_id = ?;`
@@ -119,4 +444,206 @@ IF NOT EXISTS;`
 	// ReadResponseStatistics retrieves all Response statistics for a given Quiz from the Responses table.
 	// Query Params: quiz_id
 	ReadResponseStatistics = `SELECT * FROM responses WHERE quiz_id = ?;`
+
+	// -----   User Scores Table Queries   -----
+
+	// CreateUserScore inserts a User Score record into the User Scores table, replacing any existing record.
+	// Query Params: username, quiz_id, title, score, max_score, attempts, version, submitted_at
+	CreateUserScore = `INSERT INTO user_scores (username, quiz_id, title, score, max_score, attempts, version, submitted_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);`
+
+	// ReadUserScores retrieves all User Score records for a given user from the User Scores table.
+	// Query Params: username
+	ReadUserScores = `SELECT * FROM user_scores WHERE username = ?;`
+
+	// -----   Attempt Sessions Table Queries   -----
+
+	// CreateAttemptSession inserts a new Attempt Session record into the Attempt Sessions table if it does not already exist.
+	// Query Params: username, quiz_id, attempt, started_at, deadline
+	CreateAttemptSession = `INSERT INTO attempt_sessions (username, quiz_id, attempt, started_at, deadline)
+VALUES (?, ?, ?, ?, ?)
+IF NOT EXISTS;`
+
+	// ReadAttemptSession retrieves an Attempt Session record from the Attempt Sessions table.
+	// Query Params: username, quiz_id, attempt
+	ReadAttemptSession = `SELECT * FROM attempt_sessions WHERE username = ? AND quiz_id = ? AND attempt = ?;`
+
+	// -----   Attempt Shuffles Table Queries   -----
+
+	// CreateAttemptShuffle inserts a new Attempt Shuffle record into the Attempt Shuffles table if it does not already exist.
+	// Query Params: username, quiz_id, attempt, seed
+	CreateAttemptShuffle = `INSERT INTO attempt_shuffles (username, quiz_id, attempt, seed)
+VALUES (?, ?, ?, ?)
+IF NOT EXISTS;`
+
+	// ReadAttemptShuffle retrieves an Attempt Shuffle record from the Attempt Shuffles table.
+	// Query Params: username, quiz_id, attempt
+	ReadAttemptShuffle = `SELECT * FROM attempt_shuffles WHERE username = ? AND quiz_id = ? AND attempt = ?;`
+
+	// -----   Attempt Draws Table Queries   -----
+
+	// CreateAttemptDraw inserts a new Attempt Draw record into the Attempt Draws table if it does not already exist.
+	// Query Params: username, quiz_id, attempt, questions
+	CreateAttemptDraw = `INSERT INTO attempt_draws (username, quiz_id, attempt, questions)
+VALUES (?, ?, ?, ?)
+IF NOT EXISTS;`
+
+	// ReadAttemptDraw retrieves an Attempt Draw record from the Attempt Draws table.
+	// Query Params: username, quiz_id, attempt
+	ReadAttemptDraw = `SELECT * FROM attempt_draws WHERE username = ? AND quiz_id = ? AND attempt = ?;`
+
+	// -----   Answer Drafts Table Queries   -----
+
+	// CreateAnswerDraft inserts an Answer Draft record into the Answer Drafts table, replacing any existing record.
+	// Query Params: username, quiz_id, attempt, responses, text_responses, saved_at
+	CreateAnswerDraft = `INSERT INTO answer_drafts (username, quiz_id, attempt, responses, text_responses, saved_at)
+VALUES (?, ?, ?, ?, ?, ?);`
+
+	// ReadAnswerDraft retrieves an Answer Draft record from the Answer Drafts table.
+	// Query Params: username, quiz_id, attempt
+	ReadAnswerDraft = `SELECT * FROM answer_drafts WHERE username = ? AND quiz_id = ? AND attempt = ?;`
+
+	// -----   Quiz Schedule Table Queries   -----
+
+	// CreateQuizScheduleEvent inserts a pending action on a quiz into the Quiz Schedule table.
+	// Query Params: action, due_at, quiz_id
+	CreateQuizScheduleEvent = `INSERT INTO quiz_schedule (action, due_at, quiz_id)
+VALUES (?, ?, ?);`
+
+	// ReadQuizScheduleEvents retrieves the earliest pending actions of a type that are due from the Quiz Schedule table.
+	// Query Params: action, due_at, limit
+	ReadQuizScheduleEvents = `SELECT * FROM quiz_schedule WHERE action = ? AND due_at <= ? LIMIT ?;`
+
+	// DeleteQuizScheduleEvent removes a pending action on a quiz from the Quiz Schedule table once it has been performed.
+	// Query Params: action, due_at, quiz_id
+	DeleteQuizScheduleEvent = `DELETE FROM quiz_schedule WHERE action = ? AND due_at = ? AND quiz_id = ?;`
+
+	// -----   Groups Table Queries   -----
+
+	// CreateGroup inserts a new Group record into the Groups table if it does not already exist.
+	// Query Params: group_id, name, description, creator, join_code
+	CreateGroup = `INSERT INTO groups (group_id, name, description, creator, join_code, is_deleted)
+VALUES (?, ?, ?, ?, ?, false)
+IF NOT EXISTS;`
+
+	// ReadGroup retrieves a Group record from the Groups table.
+	// Query Params: group_id
+	ReadGroup = `SELECT * FROM groups WHERE group_id = ?;`
+
+	// ReadGroupName retrieves the name and deletion status of a Group record from the Groups table.
+	// Query Params: group_id
+	ReadGroupName = `SELECT name, is_deleted FROM groups WHERE group_id = ?;`
+
+	// UpdateGroup updates the name and description of a Group record in the Groups table if it has not been deleted.
+	// Query Params: name, description, group_id
+	UpdateGroup = `UPDATE groups
+SET name = ?, description = ?
+WHERE group_id = ? IF is_deleted = false;`
+
+	// UpdateGroupJoinCode replaces the join code of a Group record in the Groups table if it has not been deleted or replaced.
+	// Query Params: join_code, group_id, previous join_code
+	UpdateGroupJoinCode = `UPDATE groups
+SET join_code = ?
+WHERE group_id = ? IF is_deleted = false AND join_code = ?;`
+
+	// DeleteGroup marks a Group record as deleted in the Groups table.
+	// Query Params: group_id
+	DeleteGroup = `UPDATE groups
+SET is_deleted = true
+WHERE group_id = ? IF is_deleted = false;`
+
+	// -----   Group Members Table Queries   -----
+
+	// CreateGroupMember inserts a new Group Member record into the Group Members table if it does not already exist.
+	// Query Params: group_id, username, is_owner, joined_at
+	CreateGroupMember = `INSERT INTO group_members (group_id, username, is_owner, joined_at)
+VALUES (?, ?, ?, ?)
+IF NOT EXISTS;`
+
+	// ReadGroupMember retrieves a Group Member record from the Group Members table.
+	// Query Params: group_id, username
+	ReadGroupMember = `SELECT * FROM group_members WHERE group_id = ? AND username = ?;`
+
+	// ReadGroupMembers retrieves all Group Member records for a given Group from the Group Members table.
+	// Query Params: group_id
+	ReadGroupMembers = `SELECT * FROM group_members WHERE group_id = ?;`
+
+	// DeleteGroupMember removes a Group Member record from the Group Members table.
+	// Query Params: group_id, username
+	DeleteGroupMember = `DELETE FROM group_members WHERE group_id = ? AND username = ? IF EXISTS;`
+
+	// DeleteGroupMembers removes all Group Member records for a given Group from the Group Members table.
+	// Query Params: group_id
+	DeleteGroupMembers = `DELETE FROM group_members WHERE group_id = ?;`
+
+	// -----   User Groups Table Queries   -----
+
+	// CreateUserGroup inserts a User Group record into the User Groups table, replacing any existing record.
+	// Query Params: username, group_id, name, is_owner
+	CreateUserGroup = `INSERT INTO user_groups (username, group_id, name, is_owner)
+VALUES (?, ?, ?, ?);`
+
+	// UpdateUserGroupName changes the name of a group listed in a User Group record in the User Groups table.
+	// Query Params: name, username, group_id
+	UpdateUserGroupName = `UPDATE user_groups
+SET name = ?
+WHERE username = ? AND group_id = ?;`
+
+	// ReadUserGroups retrieves all User Group records for a given user from the User Groups table.
+	// Query Params: username
+	ReadUserGroups = `SELECT * FROM user_groups WHERE username = ?;`
+
+	// DeleteUserGroup removes a User Group record from the User Groups table.
+	// Query Params: username, group_id
+	DeleteUserGroup = `DELETE FROM user_groups WHERE username = ? AND group_id = ?;`
+
+	// -----   Group Invitations Table Queries   -----
+
+	// CreateGroupInvitation inserts a Group Invitation record into the Group Invitations table, replacing any existing record.
+	// Query Params: username, group_id, name, invited_by, is_owner, invited_at
+	CreateGroupInvitation = `INSERT INTO group_invitations (username, group_id, name, invited_by, is_owner, invited_at)
+VALUES (?, ?, ?, ?, ?, ?);`
+
+	// ReadGroupInvitation retrieves a Group Invitation record from the Group Invitations table.
+	// Query Params: username, group_id
+	ReadGroupInvitation = `SELECT * FROM group_invitations WHERE username = ? AND group_id = ?;`
+
+	// ReadGroupInvitations retrieves all Group Invitation records for a given user from the Group Invitations table.
+	// Query Params: username
+	ReadGroupInvitations = `SELECT * FROM group_invitations WHERE username = ?;`
+
+	// DeleteGroupInvitation removes a Group Invitation record from the Group Invitations table.
+	// Query Params: username, group_id
+	DeleteGroupInvitation = `DELETE FROM group_invitations WHERE username = ? AND group_id = ? IF EXISTS;`
+
+	// -----   Group Join Codes Table Queries   -----
+
+	// CreateGroupJoinCode inserts a new Group Join Code record into the Group Join Codes table if it does not already exist.
+	// Query Params: join_code, group_id
+	CreateGroupJoinCode = `INSERT INTO group_join_codes (join_code, group_id)
+VALUES (?, ?)
+IF NOT EXISTS;`
+
+	// ReadGroupJoinCode retrieves the group a join code belongs to from the Group Join Codes table.
+	// Query Params: join_code
+	ReadGroupJoinCode = `SELECT group_id FROM group_join_codes WHERE join_code = ?;`
+
+	// DeleteGroupJoinCode removes a Group Join Code record from the Group Join Codes table.
+	// Query Params: join_code
+	DeleteGroupJoinCode = `DELETE FROM group_join_codes WHERE join_code = ?;`
+
+	// -----   Group Quizzes Table Queries   -----
+
+	// CreateGroupQuiz inserts a Group Quiz record into the Group Quizzes table, replacing any existing record.
+	// Query Params: group_id, quiz_id, title, assigned_by, assigned_at
+	CreateGroupQuiz = `INSERT INTO group_quizzes (group_id, quiz_id, title, assigned_by, assigned_at)
+VALUES (?, ?, ?, ?, ?);`
+
+	// ReadGroupQuizzes retrieves all Group Quiz records for a given Group from the Group Quizzes table.
+	// Query Params: group_id
+	ReadGroupQuizzes = `SELECT * FROM group_quizzes WHERE group_id = ?;`
+
+	// DeleteGroupQuiz removes a Group Quiz record from the Group Quizzes table.
+	// Query Params: group_id, quiz_id
+	DeleteGroupQuiz = `DELETE FROM group_quizzes WHERE group_id = ? AND quiz_id = ?;`
 )
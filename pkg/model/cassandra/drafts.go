@@ -0,0 +1,24 @@
+package model_cassandra
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// AnswerDraft is the partially completed answer card for a user's next attempt at a quiz and is a row in the answer drafts
+// table. The answers are in the order the quiz was presented to the user.
+type AnswerDraft struct {
+	Username      string     `json:"username,omitempty" cql:"username"` // The username of the test taker.
+	QuizID        gocql.UUID `json:"quiz_id,omitempty" cql:"quiz_id"`   // The unique identifier for the quiz.
+	Attempt       int        `json:"attempt,omitempty" cql:"attempt"`   // The number of the attempt the answers were drafted for.
+	*QuizResponse            // The answers as they were last saved.
+	SavedAt       time.Time  `json:"saved_at,omitempty" cql:"saved_at"` // The time at which the draft was last saved.
+}
+
+// AnswerDraftRequest is the request data sent to the database handler to retrieve the draft for an attempt at a quiz.
+type AnswerDraftRequest struct {
+	Username string
+	QuizID   gocql.UUID
+	Attempt  int
+}
@@ -0,0 +1,21 @@
+package model_cassandra
+
+import (
+	"github.com/gocql/gocql"
+)
+
+// AttemptDraw is the set of questions drawn from question banks for a user's attempt at a quiz and is a row in the attempt
+// draws table.
+type AttemptDraw struct {
+	Username  string      `json:"username,omitempty" cql:"username"`   // The username of the test taker.
+	QuizID    gocql.UUID  `json:"quiz_id,omitempty" cql:"quiz_id"`     // The unique identifier for the quiz.
+	Attempt   int         `json:"attempt,omitempty" cql:"attempt"`     // The number of the attempt the questions were drawn for.
+	Questions []*Question `json:"questions,omitempty" cql:"questions"` // The questions drawn for the attempt, in the order they were drawn.
+}
+
+// AttemptDrawRequest is the request data sent to the database handler to retrieve the questions drawn for an attempt at a quiz.
+type AttemptDrawRequest struct {
+	Username string
+	QuizID   gocql.UUID
+	Attempt  int
+}
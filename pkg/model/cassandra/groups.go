@@ -0,0 +1,96 @@
+package model_cassandra
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// Group is a class or cohort of users that quizzes can be assigned to and is a row in the groups table.
+// [1] Group core is required and is valid.
+type Group struct {
+	*GroupCore `json:"group_core,omitempty" validate:"required"` // The name and description.
+	GroupID    gocql.UUID                                        `json:"group_id,omitempty" cql:"group_id"`     // The unique identifier for the group.
+	Creator    string                                            `json:"creator,omitempty" cql:"creator"`       // The username of the group creator, who is always an owner.
+	JoinCode   string                                            `json:"join_code,omitempty" cql:"join_code"`   // The code users can join the group with.
+	IsDeleted  bool                                              `json:"is_deleted,omitempty" cql:"is_deleted"` // Status indicating whether the group has been deleted.
+}
+
+// GroupCore is the actual data used to create as well as what is presented when viewing a group.
+// [1] Group name is required (max 128 characters).
+// [2] Description is optional (max 2000 characters).
+type GroupCore struct {
+	Name        string `json:"name,omitempty" cql:"name" validate:"required,max=128"`       // The name of the class or cohort.
+	Description string `json:"description,omitempty" cql:"description" validate:"max=2000"` // A description of the class or cohort.
+}
+
+// GroupMember is a member of a group and is a row in the group members table. Owners manage the group and its members.
+type GroupMember struct {
+	GroupID  gocql.UUID `json:"group_id,omitempty" cql:"group_id"` // The unique identifier for the group.
+	Username string     `json:"username,omitempty" cql:"username"` // The username of the member.
+	IsOwner  bool       `json:"is_owner" cql:"is_owner"`           // Status indicating whether the member owns the group.
+	JoinedAt time.Time  `json:"joined_at" cql:"joined_at"`         // The time at which the member joined the group.
+}
+
+// UserGroup is a group listed for one of its members and is a row in the user groups table.
+type UserGroup struct {
+	Username string     `json:"username,omitempty" cql:"username"` // The username of the member.
+	GroupID  gocql.UUID `json:"group_id,omitempty" cql:"group_id"` // The unique identifier for the group.
+	Name     string     `json:"name,omitempty" cql:"name"`         // The name of the group.
+	IsOwner  bool       `json:"is_owner" cql:"is_owner"`           // Status indicating whether the member owns the group.
+}
+
+// GroupInvitation is a pending invitation for a user to join a group and is a row in the group invitations table.
+type GroupInvitation struct {
+	Username  string     `json:"username,omitempty" cql:"username"`     // The username of the invited user.
+	GroupID   gocql.UUID `json:"group_id,omitempty" cql:"group_id"`     // The unique identifier for the group.
+	Name      string     `json:"name,omitempty" cql:"name"`             // The name of the group when the invitation was sent.
+	InvitedBy string     `json:"invited_by,omitempty" cql:"invited_by"` // The username of the owner who sent the invitation.
+	IsOwner   bool       `json:"is_owner" cql:"is_owner"`               // Status indicating whether the user is invited as an owner.
+	InvitedAt time.Time  `json:"invited_at" cql:"invited_at"`           // The time at which the invitation was sent.
+}
+
+// GroupInviteRequest is the request to invite a user to join a group.
+type GroupInviteRequest struct {
+	Username string `json:"username" validate:"required"` // The username of the user to invite.
+	IsOwner  bool   `json:"is_owner"`                     // Invite the user as an owner of the group.
+}
+
+// GroupQuiz is a quiz assigned to a group and is a row in the group quizzes table.
+type GroupQuiz struct {
+	GroupID    gocql.UUID `json:"group_id,omitempty" cql:"group_id"`       // The unique identifier for the group.
+	QuizID     gocql.UUID `json:"quiz_id,omitempty" cql:"quiz_id"`         // The unique identifier for the quiz.
+	Title      string     `json:"title,omitempty" cql:"title"`             // The title description of the quiz when it was assigned.
+	AssignedBy string     `json:"assigned_by,omitempty" cql:"assigned_by"` // The username of the owner who assigned the quiz.
+	AssignedAt time.Time  `json:"assigned_at" cql:"assigned_at"`           // The time at which the quiz was assigned.
+}
+
+// GroupMemberRequest is the request data sent to the database handler to retrieve or remove the membership of, or an
+// invitation to, a group for a user.
+type GroupMemberRequest struct {
+	GroupID  gocql.UUID
+	Username string
+}
+
+// GroupMutateRequest is the request data sent to the database handler to update the name and description of a group.
+type GroupMutateRequest struct {
+	GroupID gocql.UUID
+	Group   *GroupCore
+}
+
+// GroupJoinCodeRequest is the request data sent to the database handler to replace the join code of a group.
+type GroupJoinCodeRequest struct {
+	GroupID      gocql.UUID
+	JoinCode     string // The replacement join code.
+	PreviousCode string // The join code being replaced.
+}
+
+// QuizAssignRequest is the request data sent to the database handler to assign a quiz to, or unassign it from, a group.
+type QuizAssignRequest struct {
+	Author     string // The username of the quiz creator.
+	AssignedBy string
+	GroupID    gocql.UUID
+	QuizID     gocql.UUID
+	Title      string
+	AssignedAt time.Time
+}
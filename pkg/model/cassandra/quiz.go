@@ -1,12 +1,14 @@
 package model_cassandra
 
 import (
+	"time"
+
 	"github.com/gocql/gocql"
 )
 
 // Quiz represents a quiz and is a row in the quizzes table.
 // [1] Quiz title is required
-// [2] Questions array is required and is valid (1-10 questions).
+// [2] Questions array is required and is valid (1-10 questions by default, configurable through the limits package).
 // [3] Validate all Questions.
 type Quiz struct {
 	*QuizCore   `json:"quiz_core,omitempty" validate:"required"` // The title and questions.
@@ -14,26 +16,119 @@ type Quiz struct {
 	QuizID      gocql.UUID                                       `json:"quiz_id,omitempty" cql:"quiz_id"`           // The unique identifier for the quiz.
 	IsPublished bool                                             `json:"is_published,omitempty" cql:"is_published"` // Status indicating whether the quiz can be viewed or taken by other users.
 	IsDeleted   bool                                             `json:"is_deleted,omitempty" cql:"is_deleted"`     // Status indicating whether the quiz has been deleted.
+	Version     int                                              `json:"version,omitempty" cql:"version"`           // The current published version of the quiz. Unpublished quizzes are not versioned.
+	OpensAt     *time.Time                                       `json:"opens_at,omitempty" cql:"opens_at"`         // The time at which the quiz is published and can be taken by other users.
+	ClosesAt    *time.Time                                       `json:"closes_at,omitempty" cql:"closes_at"`       // The time after which the quiz can no longer be taken.
+	IsClosed    bool                                             `json:"is_closed,omitempty" cql:"is_closed"`       // Status indicating whether the quiz has been closed by the scheduler.
+	Groups      []gocql.UUID                                     `json:"groups,omitempty" cql:"groups"`             // The groups the quiz is restricted to. Quizzes that are not assigned to a group are open to all users.
+}
+
+// QuizVersion is an immutable snapshot of a published revision of a quiz and is a row in the quiz_versions table.
+type QuizVersion struct {
+	*QuizCore `json:"quiz_core,omitempty"` // The title and questions of the revision.
+	QuizID    gocql.UUID                   `json:"quiz_id,omitempty" cql:"quiz_id"` // The unique identifier for the quiz.
+	Version   int                          `json:"version" cql:"version"`           // The version number of the revision.
+	Author    string                       `json:"author,omitempty" cql:"author"`   // The username of the quiz creator.
 }
 
+// Question types. A question without a type is a multiple choice question.
+const (
+	QuestionMultipleChoice = "multiple-choice" // Select one or more options from a list of options.
+	QuestionTrueFalse      = "true-false"      // Select one of two options. Options default to true and false.
+	QuestionNumeric        = "numeric"         // Enter a number that must be within a tolerance of the answer.
+	QuestionShortText      = "short-text"      // Enter text that must exactly match one of the answers, ignoring case.
+	QuestionRegex          = "regex"           // Enter text that must fully match one of the regular expression answers.
+	QuestionOrdering       = "ordering"        // Arrange all the options in the correct order.
+	QuestionMatching       = "matching"        // Pair each of the options with one of the matches.
+)
+
 // Question
 // [1] Question description is required.
-// [2] Options are all defined and are valid (2-5).
-// [3] Answer key is required and is valid (1-5 answers in range 0-4).
-// [4] Number of answers is less than or equal to number of options.
-// [5] URI of any assets supplied are URL Encoded.
+// [2] Question type is valid, the default is multiple choice.
+// [3] Options are all defined and are valid (2-5 by default) for multiple choice, ordering, and matching, exactly two for true/false, and none otherwise.
+// [4] Answer key is required and is valid (1-5 answers in range 0-4 by default) for multiple choice, exactly one for true/false, and none otherwise.
+// [5] Number of answers is less than or equal to number of options.
+// [6] Answer key for ordering is a permutation of the option indices and for matching is a match index for every option.
+// [7] Matches are required for matching questions (number of options to 5 by default), and none otherwise.
+// [8] Numeric answer is required for numeric questions, text answers are required for short text and regex questions.
+// [9] URI of any assets supplied are URL Encoded.
+// [10] Points are optional and must be positive if supplied. Unweighted questions are worth a single point.
+// [11] Tags and difficulty are optional and are used to stratify the questions drawn from question banks.
+// [12] Explanation is optional, up to 2000 characters, and is revealed with the answer key after submission.
+// [13] Feedback is optional and is only for multiple choice and true/false questions, with an entry for every option.
+// The bounds on the number of options and answers are configurable through the limits package.
 type Question struct {
-	Description string   `json:"description,omitempty" cql:"description" validate:"required"`                                         // The description that contains the text of the question.
-	Asset       string   `json:"asset,omitempty" cql:"asset" validate:"url_encoded"`                                                  // URI of an asset to be displayed with question.
-	Options     []string `json:"options,omitempty" cql:"options" validate:"required,min=2,max=5"`                                     // The available options for the question.
-	Answers     []int32  `json:"answers,omitempty" cql:"answers" validate:"required,min=1,max=5,answers_LT_options,dive,min=0,max=4"` // The indices of the options that are correct answers in the question.
+	Description   string   `json:"description,omitempty" cql:"description" validate:"required"`                       // The description that contains the text of the question.
+	Asset         string   `json:"asset,omitempty" cql:"asset" validate:"url_encoded"`                                // URI of an asset to be displayed with question.
+	Type          string   `json:"type,omitempty" cql:"type" validate:"question_type"`                                // The type of question which determines how it is answered and graded.
+	Options       []string `json:"options,omitempty" cql:"options" validate:"question_options"`                       // The available options for the question.
+	Answers       []int32  `json:"answers,omitempty" cql:"answers" validate:"question_answers"`                       // The indices of the options that are correct answers in the question.
+	Matches       []string `json:"matches,omitempty" cql:"matches" validate:"question_matches"`                       // The entries that the options are paired with in a matching question.
+	NumericAnswer *float64 `json:"numeric_answer,omitempty" cql:"numeric_answer" validate:"numeric_answer"`           // The answer to a numeric question.
+	Tolerance     float64  `json:"tolerance,omitempty" cql:"tolerance" validate:"min=0"`                              // The maximum absolute difference from the numeric answer for a response to be correct.
+	TextAnswers   []string `json:"text_answers,omitempty" cql:"text_answers" validate:"text_answers"`                 // The accepted answers or regular expressions for a text question.
+	Points        float64  `json:"points,omitempty" cql:"points" validate:"omitempty,gt=0"`                           // The weight of the question when grading. Defaults to a single point.
+	Tags          []string `json:"tags,omitempty" cql:"tags" validate:"max=10,dive,required"`                         // The topics that the question covers.
+	Difficulty    string   `json:"difficulty,omitempty" cql:"difficulty" validate:"omitempty,oneof=easy medium hard"` // The difficulty of the question: easy, medium, or hard.
+	Explanation   string   `json:"explanation,omitempty" cql:"explanation" validate:"max=2000"`                       // The explanation of the answer that is revealed after submission.
+	Feedback      []string `json:"feedback,omitempty" cql:"feedback" validate:"question_feedback"`                    // The feedback for each option that is revealed after submission when the option is selected.
+}
+
+// Attempt policies determine which of a user's attempts at a quiz counts towards their score.
+const (
+	AttemptPolicyBest    = "best"    // The highest scoring attempt counts. This is the default.
+	AttemptPolicyLatest  = "latest"  // The most recent attempt counts.
+	AttemptPolicyAverage = "average" // The average score across all attempts counts.
+)
+
+// Reveal policies determine when the answer key, explanations, and feedback of a quiz are revealed to the users that took it.
+const (
+	RevealImmediately = "immediately" // Answers are revealed as soon as an attempt is submitted.
+	RevealAfterClose  = "after-close" // Answers are revealed once the quiz has closed.
+	RevealNever       = "never"       // Answers are never revealed. This is the default.
+)
+
+// Question draw stratification determines how the questions drawn from question banks are balanced.
+const (
+	StratifyByTag        = "tag"        // Questions are drawn in proportion to their first tag.
+	StratifyByDifficulty = "difficulty" // Questions are drawn in proportion to their difficulty.
+)
+
+// QuestionDraw describes how the questions of a quiz are drawn at random from question banks for each user and attempt.
+// [1] Question banks are required and are unique bank ids (1-10 banks).
+// [2] Count is required and is valid (1-10 questions by default, configurable through the limits package).
+// [3] Stratification is optional and is one of tag or difficulty. Unstratified draws select from all the questions equally.
+type QuestionDraw struct {
+	Banks      []string `json:"banks,omitempty" cql:"banks" validate:"required,min=1,max=10,unique,dive,uuid"`     // The ids of the question banks to draw from.
+	Count      int      `json:"count,omitempty" cql:"count" validate:"required,min=1,draw_count"`                  // The number of questions drawn for each attempt.
+	StratifyBy string   `json:"stratify_by,omitempty" cql:"stratify_by" validate:"omitempty,oneof=tag difficulty"` // The question attribute the draw is balanced across.
 }
 
 // QuizCore is the actual data used to create as well as what is presented when viewing a quiz.
+// [1] Maximum attempts are optional and must be between 1 and 100 if supplied. Quizzes default to a single attempt.
+// [2] Attempt cooldown is optional and is the number of seconds a user must wait between attempts.
+// [3] Attempt policy is optional and is one of best, latest, or average. The default is best.
+// [4] Time limit is optional and is the number of seconds a user has to submit an attempt after starting it.
+// [5] Grace period is optional and is the number of seconds after the time limit that a submission is still accepted.
+// [6] Shuffle is optional and presents the questions and options in a different order to each user and attempt.
+// [7] Draw is optional and replaces the questions with questions drawn from question banks for each user and attempt.
+// [8] Description and tags are optional and are used to find the quiz in the catalogue of published quizzes.
+// [9] Reveal policy is optional and is one of immediately, after-close, or never. The default is never.
+// Quizzes must have either questions or a draw, but not both.
 type QuizCore struct {
-	Title       string      `json:"title,omitempty" cql:"title" validate:"required"`                                                                                                // The title description of the quiz.
-	MarkingType string      `json:"marking_type,omitempty" cql:"marking_type" validate:"oneof='None' 'none' 'Negative' 'negative' 'Non-negative' 'non-negative' 'Binary' 'binary'"` // Marking scheme type can be not marked, negative marking, or all or nothing.
-	Questions   []*Question `json:"questions,omitempty" cql:"questions" validate:"required,min=1,max=10,dive"`                                                                      // A list of questions in the quiz.
+	Title           string        `json:"title,omitempty" cql:"title" validate:"required"`                                                                             // The title description of the quiz.
+	MarkingType     string        `json:"marking_type,omitempty" cql:"marking_type" validate:"marking_type"`                                                           // Marking scheme type can be not marked or any of the registered marking schemes.
+	Questions       []*Question   `json:"questions,omitempty" cql:"questions" validate:"required_without=Draw,excluded_with=Draw,omitempty,min=1,quiz_questions,dive"` // A list of questions in the quiz.
+	MaxAttempts     int           `json:"max_attempts,omitempty" cql:"max_attempts" validate:"omitempty,min=1,max=100"`                                                // The number of times a user may take the quiz.
+	AttemptCooldown int           `json:"attempt_cooldown,omitempty" cql:"attempt_cooldown" validate:"min=0"`                                                          // The number of seconds a user must wait between attempts.
+	AttemptPolicy   string        `json:"attempt_policy,omitempty" cql:"attempt_policy" validate:"omitempty,oneof=best latest average"`                                // The attempt that counts towards a user's score.
+	TimeLimit       int           `json:"time_limit,omitempty" cql:"time_limit" validate:"min=0"`                                                                      // The number of seconds a user has to submit an attempt. Quizzes without a limit are untimed.
+	GracePeriod     int           `json:"grace_period,omitempty" cql:"grace_period" validate:"min=0"`                                                                  // The number of seconds after the time limit that a submission is still accepted.
+	Shuffle         bool          `json:"shuffle,omitempty" cql:"shuffle"`                                                                                             // Present the questions and options in a random order that is fixed for each user and attempt.
+	Draw            *QuestionDraw `json:"draw,omitempty" cql:"draw"`                                                                                                   // Draw the questions at random from question banks for each user and attempt.
+	Description     string        `json:"description,omitempty" cql:"description" validate:"max=1000"`                                                                 // A summary of the quiz shown in the catalogue.
+	Tags            []string      `json:"tags,omitempty" cql:"tags" validate:"max=10,dive,required"`                                                                   // The topics the quiz can be filtered by in the catalogue.
+	RevealPolicy    string        `json:"reveal_policy,omitempty" cql:"reveal_policy" validate:"omitempty,oneof=immediately after-close never"`                        // When the answers are revealed to the users that took the quiz.
 }
 
 // QuizMutateRequest is the request data sent to the database handler to change the Delete and Update status of a quiz record.
@@ -42,3 +137,9 @@ type QuizMutateRequest struct {
 	QuizID   gocql.UUID
 	Quiz     *Quiz
 }
+
+// QuizVersionRequest is the request data sent to the database handler to retrieve a specific version of a quiz.
+type QuizVersionRequest struct {
+	QuizID  gocql.UUID
+	Version int
+}
@@ -41,6 +41,124 @@ var questionTooManyAns = Question{Description: "Question with too many answers",
 var questionBadAns = Question{Description: "Question with bad index answers",
 	Options: []string{"option 1", "option 2", "option 3", "option 4", "option 5"},
 	Answers: []int32{0, 1, 2, 3, 5}}
+var questionWeighted = Question{Description: "Question with a weight",
+	Options: []string{"option 1", "option 2", "option 3", "option 4", "option 5"},
+	Answers: []int32{1, 3},
+	Points:  2.5}
+var questionNegativeWeight = Question{Description: "Question with a negative weight",
+	Options: []string{"option 1", "option 2", "option 3", "option 4", "option 5"},
+	Answers: []int32{1, 3},
+	Points:  -1}
+var numericAnswer = 3.14
+var questionTrueFalse = Question{Description: "True or false question",
+	Type:    QuestionTrueFalse,
+	Answers: []int32{0}}
+var questionTrueFalseOpts = Question{Description: "True or false question with options",
+	Type:    QuestionTrueFalse,
+	Options: []string{"yes", "no"},
+	Answers: []int32{1}}
+var questionTrueFalseBadAns = Question{Description: "True or false question with bad index answer",
+	Type:    QuestionTrueFalse,
+	Answers: []int32{2}}
+var questionTrueFalseTooManyOpt = Question{Description: "True or false question with too many options",
+	Type:    QuestionTrueFalse,
+	Options: []string{"true", "false", "maybe"},
+	Answers: []int32{0}}
+var questionNumeric = Question{Description: "Numeric question",
+	Type:          QuestionNumeric,
+	NumericAnswer: &numericAnswer,
+	Tolerance:     0.01}
+var questionNumericNoAns = Question{Description: "Numeric question without an answer",
+	Type: QuestionNumeric}
+var questionNumericOpts = Question{Description: "Numeric question with options",
+	Type:          QuestionNumeric,
+	Options:       []string{"option 1", "option 2"},
+	NumericAnswer: &numericAnswer}
+var questionNumericNegativeTol = Question{Description: "Numeric question with a negative tolerance",
+	Type:          QuestionNumeric,
+	NumericAnswer: &numericAnswer,
+	Tolerance:     -0.01}
+var questionNumericAnsOnMC = Question{Description: "Multiple choice question with a numeric answer",
+	Options:       []string{"option 1", "option 2"},
+	Answers:       []int32{0},
+	NumericAnswer: &numericAnswer}
+var questionShortText = Question{Description: "Short text question",
+	Type:        QuestionShortText,
+	TextAnswers: []string{"answer", "alternate answer"}}
+var questionShortTextNoAns = Question{Description: "Short text question without answers",
+	Type: QuestionShortText}
+var questionShortTextBlankAns = Question{Description: "Short text question with a blank answer",
+	Type:        QuestionShortText,
+	TextAnswers: []string{"answer", "  "}}
+var questionRegex = Question{Description: "Regex question",
+	Type:        QuestionRegex,
+	TextAnswers: []string{"colou?r"}}
+var questionRegexInvalid = Question{Description: "Regex question with an invalid expression",
+	Type:        QuestionRegex,
+	TextAnswers: []string{"colou(r"}}
+var questionUnknownType = Question{Description: "Question with an unknown type",
+	Type: "essay"}
+var questionOrdering = Question{Description: "Ordering question",
+	Type:    QuestionOrdering,
+	Options: []string{"second", "fourth", "first", "third"},
+	Answers: []int32{2, 0, 3, 1}}
+var questionOrderingRepeated = Question{Description: "Ordering question with a repeated answer",
+	Type:    QuestionOrdering,
+	Options: []string{"second", "fourth", "first", "third"},
+	Answers: []int32{2, 0, 3, 3}}
+var questionOrderingIncomplete = Question{Description: "Ordering question with an incomplete answer",
+	Type:    QuestionOrdering,
+	Options: []string{"second", "fourth", "first", "third"},
+	Answers: []int32{2, 0, 3}}
+var questionMatching = Question{Description: "Matching question",
+	Type:    QuestionMatching,
+	Options: []string{"prompt 1", "prompt 2", "prompt 3"},
+	Matches: []string{"match 1", "match 2", "match 3", "match 4"},
+	Answers: []int32{3, 0, 1}}
+var questionMatchingFewMatches = Question{Description: "Matching question with fewer matches than options",
+	Type:    QuestionMatching,
+	Options: []string{"prompt 1", "prompt 2", "prompt 3"},
+	Matches: []string{"match 1", "match 2"},
+	Answers: []int32{1, 0, 1}}
+var questionMatchingBadAns = Question{Description: "Matching question with bad index answers",
+	Type:    QuestionMatching,
+	Options: []string{"prompt 1", "prompt 2", "prompt 3"},
+	Matches: []string{"match 1", "match 2", "match 3"},
+	Answers: []int32{3, 0, 1}}
+var questionMatchesOnMC = Question{Description: "Multiple choice question with matches",
+	Options: []string{"option 1", "option 2"},
+	Answers: []int32{0},
+	Matches: []string{"match 1", "match 2"}}
+var questionTagged = Question{Description: "Question with tags and a difficulty",
+	Options:    []string{"option 1", "option 2"},
+	Answers:    []int32{0},
+	Tags:       []string{"algebra", "functions"},
+	Difficulty: "medium"}
+var questionBlankTag = Question{Description: "Question with a blank tag",
+	Options: []string{"option 1", "option 2"},
+	Answers: []int32{0},
+	Tags:    []string{"algebra", ""}}
+var questionUnknownDifficulty = Question{Description: "Question with an unknown difficulty",
+	Options:    []string{"option 1", "option 2"},
+	Answers:    []int32{0},
+	Difficulty: "extreme"}
+var questionFeedback = Question{Description: "Question with an explanation and feedback",
+	Options:     []string{"option 1", "option 2"},
+	Answers:     []int32{0},
+	Explanation: "Option 1 is correct",
+	Feedback:    []string{"Correct", "Option 2 is incorrect"}}
+var questionFeedbackTrueFalse = Question{Description: "True or false question with feedback",
+	Type:     QuestionTrueFalse,
+	Answers:  []int32{0},
+	Feedback: []string{"", "It is true"}}
+var questionFeedbackMismatch = Question{Description: "Question with feedback for fewer options",
+	Options:  []string{"option 1", "option 2", "option 3"},
+	Answers:  []int32{0},
+	Feedback: []string{"Correct", "Incorrect"}}
+var questionFeedbackOnNumeric = Question{Description: "Numeric question with feedback",
+	Type:          QuestionNumeric,
+	NumericAnswer: &numericAnswer,
+	Feedback:      []string{"Correct", "Incorrect"}}
 var questionAnsGTOpt = Question{Description: "Question with more answers than options",
 	Options: []string{"option 1", "option 2", "option 3", "option 4"},
 	Answers: []int32{0, 1, 2, 3, 4}}
@@ -57,6 +175,32 @@ var quizTooManyQuestions = QuizCore{Title: "Too many questions", MarkingType: "N
 var quizInvalidQuestions = QuizCore{Title: "Invalid questions", MarkingType: "Negative", Questions: []*Question{&questionNoDesc}}
 var quizTooManyAnswers = QuizCore{Title: "Too many answers", MarkingType: "Negative", Questions: []*Question{&questionTooManyAns}}
 var quizTooManyOpts = QuizCore{Title: "More answers than options", MarkingType: "Negative", Questions: []*Question{&questionAnsGTOpt}}
+var quizCatalogued = QuizCore{Title: "Catalogued quiz", MarkingType: "Negative", Questions: []*Question{&question1},
+	Description: "A quiz listed in the catalogue", Tags: []string{"algebra", "functions"}}
+var quizBlankTag = QuizCore{Title: "Blank tag", MarkingType: "Negative", Questions: []*Question{&question1},
+	Tags: []string{"algebra", ""}}
+var quizTooManyTags = QuizCore{Title: "Too many tags", MarkingType: "Negative", Questions: []*Question{&question1},
+	Tags: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"}}
+var quizRevealed = QuizCore{Title: "Revealed quiz", MarkingType: "Negative", Questions: []*Question{&question1},
+	RevealPolicy: RevealAfterClose}
+var quizUnknownReveal = QuizCore{Title: "Unknown reveal policy", MarkingType: "Negative", Questions: []*Question{&question1},
+	RevealPolicy: "sometimes"}
+var drawValid = QuestionDraw{Banks: []string{"1f7bd5ae-5a4e-11ed-9b6a-0242ac120002", "2a41c1b2-5a4e-11ed-9b6a-0242ac120002"}, Count: 5,
+	StratifyBy: StratifyByDifficulty}
+var quizDrawn = QuizCore{Title: "Drawn quiz", MarkingType: "Negative", Draw: &drawValid}
+var quizDrawnWithQuestions = QuizCore{Title: "Drawn quiz with questions", MarkingType: "Negative", Draw: &drawValid,
+	Questions: []*Question{&question1}}
+var quizDrawNoBanks = QuizCore{Title: "Draw without banks", MarkingType: "Negative", Draw: &QuestionDraw{Count: 5}}
+var quizDrawInvalidBank = QuizCore{Title: "Draw with an invalid bank", MarkingType: "Negative",
+	Draw: &QuestionDraw{Banks: []string{"not-a-bank-id"}, Count: 5}}
+var quizDrawRepeatedBank = QuizCore{Title: "Draw with a repeated bank", MarkingType: "Negative",
+	Draw: &QuestionDraw{Banks: []string{drawValid.Banks[0], drawValid.Banks[0]}, Count: 5}}
+var quizDrawTooMany = QuizCore{Title: "Draw too many questions", MarkingType: "Negative",
+	Draw: &QuestionDraw{Banks: drawValid.Banks, Count: 11}}
+var quizDrawNoCount = QuizCore{Title: "Draw without a count", MarkingType: "Negative",
+	Draw: &QuestionDraw{Banks: drawValid.Banks}}
+var quizDrawUnknownStrata = QuizCore{Title: "Draw with unknown stratification", MarkingType: "Negative",
+	Draw: &QuestionDraw{Banks: drawValid.Banks, Count: 5, StratifyBy: "author"}}
 
 func TestValidateQuestionNum(t *testing.T) {
 	testCases := []struct {
@@ -161,6 +305,161 @@ func TestValidateQuestion(t *testing.T) {
 			questionInput: &questionBadAns,
 			expectErr:     require.Error,
 			expectedLen:   1,
+		}, {
+			name:          "Weighted question",
+			questionInput: &questionWeighted,
+			expectErr:     require.NoError,
+			expectedLen:   0,
+		}, {
+			name:          "Negative weight",
+			questionInput: &questionNegativeWeight,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "True/false question",
+			questionInput: &questionTrueFalse,
+			expectErr:     require.NoError,
+			expectedLen:   0,
+		}, {
+			name:          "True/false question with options",
+			questionInput: &questionTrueFalseOpts,
+			expectErr:     require.NoError,
+			expectedLen:   0,
+		}, {
+			name:          "True/false out of range answer in key",
+			questionInput: &questionTrueFalseBadAns,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "True/false too many options",
+			questionInput: &questionTrueFalseTooManyOpt,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Numeric question",
+			questionInput: &questionNumeric,
+			expectErr:     require.NoError,
+			expectedLen:   0,
+		}, {
+			name:          "Numeric question without answer",
+			questionInput: &questionNumericNoAns,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Numeric question with options",
+			questionInput: &questionNumericOpts,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Numeric question negative tolerance",
+			questionInput: &questionNumericNegativeTol,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Multiple choice question with numeric answer",
+			questionInput: &questionNumericAnsOnMC,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Short text question",
+			questionInput: &questionShortText,
+			expectErr:     require.NoError,
+			expectedLen:   0,
+		}, {
+			name:          "Short text question without answers",
+			questionInput: &questionShortTextNoAns,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Short text question blank answer",
+			questionInput: &questionShortTextBlankAns,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Regex question",
+			questionInput: &questionRegex,
+			expectErr:     require.NoError,
+			expectedLen:   0,
+		}, {
+			name:          "Regex question invalid expression",
+			questionInput: &questionRegexInvalid,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Unknown question type",
+			questionInput: &questionUnknownType,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Ordering question",
+			questionInput: &questionOrdering,
+			expectErr:     require.NoError,
+			expectedLen:   0,
+		}, {
+			name:          "Ordering question repeated answer",
+			questionInput: &questionOrderingRepeated,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Ordering question incomplete answer",
+			questionInput: &questionOrderingIncomplete,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Matching question",
+			questionInput: &questionMatching,
+			expectErr:     require.NoError,
+			expectedLen:   0,
+		}, {
+			name:          "Matching question fewer matches than options",
+			questionInput: &questionMatchingFewMatches,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Matching question out of range answers",
+			questionInput: &questionMatchingBadAns,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Multiple choice question with matches",
+			questionInput: &questionMatchesOnMC,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Tagged question",
+			questionInput: &questionTagged,
+			expectErr:     require.NoError,
+			expectedLen:   0,
+		}, {
+			name:          "Blank tag",
+			questionInput: &questionBlankTag,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Unknown difficulty",
+			questionInput: &questionUnknownDifficulty,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Explanation and feedback",
+			questionInput: &questionFeedback,
+			expectErr:     require.NoError,
+			expectedLen:   0,
+		}, {
+			name:          "True or false question with feedback",
+			questionInput: &questionFeedbackTrueFalse,
+			expectErr:     require.NoError,
+			expectedLen:   0,
+		}, {
+			name:          "Feedback for fewer options",
+			questionInput: &questionFeedbackMismatch,
+			expectErr:     require.Error,
+			expectedLen:   1,
+		}, {
+			name:          "Numeric question with feedback",
+			questionInput: &questionFeedbackOnNumeric,
+			expectErr:     require.Error,
+			expectedLen:   1,
 		},
 		// ----- test cases end ----- //
 	}
@@ -240,6 +539,31 @@ func TestValidateQuizCore(t *testing.T) {
 			input:       &quizTooManyOpts,
 			expectErr:   require.Error,
 			expectedLen: 1,
+		}, {
+			name:        "Description and tags",
+			input:       &quizCatalogued,
+			expectErr:   require.NoError,
+			expectedLen: 0,
+		}, {
+			name:        "Blank tag",
+			input:       &quizBlankTag,
+			expectErr:   require.Error,
+			expectedLen: 1,
+		}, {
+			name:        "Too many tags",
+			input:       &quizTooManyTags,
+			expectErr:   require.Error,
+			expectedLen: 1,
+		}, {
+			name:        "Reveal policy",
+			input:       &quizRevealed,
+			expectErr:   require.NoError,
+			expectedLen: 0,
+		}, {
+			name:        "Unknown reveal policy",
+			input:       &quizUnknownReveal,
+			expectErr:   require.Error,
+			expectedLen: 1,
 		},
 		// ----- test cases end ----- //
 	}
@@ -255,3 +579,118 @@ func TestValidateQuizCore(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateQuizCore_Draw(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       *QuizCore
+		expectErr   require.ErrorAssertionFunc
+		expectedLen int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:        "Drawn quiz",
+			input:       &quizDrawn,
+			expectErr:   require.NoError,
+			expectedLen: 0,
+		}, {
+			name:        "Drawn quiz with questions",
+			input:       &quizDrawnWithQuestions,
+			expectErr:   require.Error,
+			expectedLen: 1,
+		}, {
+			name:        "No banks",
+			input:       &quizDrawNoBanks,
+			expectErr:   require.Error,
+			expectedLen: 1,
+		}, {
+			name:        "Invalid bank id",
+			input:       &quizDrawInvalidBank,
+			expectErr:   require.Error,
+			expectedLen: 1,
+		}, {
+			name:        "Repeated bank",
+			input:       &quizDrawRepeatedBank,
+			expectErr:   require.Error,
+			expectedLen: 1,
+		}, {
+			name:        "Too many questions drawn",
+			input:       &quizDrawTooMany,
+			expectErr:   require.Error,
+			expectedLen: 1,
+		}, {
+			name:        "No count",
+			input:       &quizDrawNoCount,
+			expectErr:   require.Error,
+			expectedLen: 1,
+		}, {
+			name:        "Unknown stratification",
+			input:       &quizDrawUnknownStrata,
+			expectErr:   require.Error,
+			expectedLen: 1,
+		},
+		// ----- test cases end ----- //
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validator.ValidateStruct(testCase.input)
+			testCase.expectErr(t, err)
+
+			if err != nil {
+				require.Equal(t, testCase.expectedLen, len(err.(*validator.ErrorValidation).Errors))
+			}
+		})
+	}
+}
+
+func TestValidateQuizCore_ConfiguredLimits(t *testing.T) {
+	defaults := validator.GetLimits()
+	t.Cleanup(func() { require.NoError(t, validator.SetLimits(defaults), "failed to restore default limits") })
+	require.NoError(t, validator.SetLimits(validator.Limits{MaxQuestions: 40, MinOptions: 2, MaxOptions: 6}), "failed to set limits")
+
+	questions := make([]*Question, 40)
+	for idx := range questions {
+		questions[idx] = &question1
+	}
+	sixOptions := Question{Description: "Question with six options",
+		Options: []string{"option 1", "option 2", "option 3", "option 4", "option 5", "option 6"},
+		Answers: []int32{5}}
+
+	testCases := []struct {
+		name        string
+		input       *QuizCore
+		expectErr   require.ErrorAssertionFunc
+		expectedLen int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:        "Forty questions",
+			input:       &QuizCore{Title: "Forty questions", MarkingType: "Negative", Questions: questions},
+			expectErr:   require.NoError,
+			expectedLen: 0,
+		}, {
+			name:        "Forty one questions",
+			input:       &QuizCore{Title: "Forty one questions", MarkingType: "Negative", Questions: append(questions, &question1)},
+			expectErr:   require.Error,
+			expectedLen: 1,
+		}, {
+			name:        "Six options",
+			input:       &QuizCore{Title: "Six options", MarkingType: "Negative", Questions: []*Question{&sixOptions}},
+			expectErr:   require.NoError,
+			expectedLen: 0,
+		},
+		// ----- test cases end ----- //
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validator.ValidateStruct(testCase.input)
+			testCase.expectErr(t, err)
+
+			if err != nil {
+				require.Equal(t, testCase.expectedLen, len(err.(*validator.ErrorValidation).Errors))
+			}
+		})
+	}
+}
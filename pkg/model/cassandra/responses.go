@@ -1,6 +1,8 @@
 package model_cassandra
 
 import (
+	"time"
+
 	"github.com/gocql/gocql"
 )
 
@@ -9,17 +11,43 @@ type Response struct {
 	Username      string  `json:"username,omitempty" cql:"username" validator:"required"`
 	Author        string  `json:"author,omitempty" cql:"author" validator:"required"`
 	Score         float64 `json:"score,omitempty" cql:"score" validator:"required"`
+	MaxScore      float64 `json:"max_score,omitempty" cql:"max_score"`
 	*QuizResponse `validator:"required"`
 	QuizID        gocql.UUID `json:"quiz_id,omitempty" cql:"quiz_id" validator:"required"`
+	Version       int        `json:"version,omitempty" cql:"version"`   // The version of the quiz the response was graded against.
+	Attempts      []*Attempt `json:"attempts,omitempty" cql:"attempts"` // Every attempt at the quiz, oldest first. The score is the effective score across the attempts.
+}
+
+// Attempt is a single graded attempt at a quiz and is stored in the attempts list of a row in the responses table.
+type Attempt struct {
+	Number        int       `json:"number" cql:"number"`                           // The attempt number, starting at one.
+	Score         float64   `json:"score" cql:"score"`                             // The score awarded for the attempt.
+	MaxScore      float64   `json:"max_score" cql:"max_score"`                     // The maximum score that could be awarded for the attempt.
+	Version       int       `json:"version" cql:"version"`                         // The version of the quiz the attempt was graded against.
+	Responses     [][]int32 `json:"responses,omitempty" cql:"responses"`           // The answer card for the attempt.
+	TextResponses []string  `json:"text_responses,omitempty" cql:"text_responses"` // The answers to numeric and text questions for the attempt.
+	SubmittedAt   time.Time `json:"submitted_at" cql:"submitted_at"`               // The time at which the attempt was submitted.
+	Late          bool      `json:"late,omitempty" cql:"late"`                     // The attempt was submitted after the deadline and grace period and scored zero.
+}
+
+// ResponseUpdateRequest is the request data sent to the database handler to replace the attempts and effective score of a
+// response record. The update is only applied if the attempts have not changed since they were read.
+type ResponseUpdateRequest struct {
+	Response         *Response  // The response record with the updated attempts and effective score.
+	PreviousAttempts []*Attempt // The attempts as they were read from the database.
 }
 
 // QuizResponse
 // [1] Can have [0-10] questions answered.
 // [2] [0-5] options selected for an answer.
 // [3] Answer indices must be valid [0-4].
+// [4] Can have [0-10] numeric or text questions answered.
+// The default bounds above are configurable through the limits package.
 type QuizResponse struct {
 	// The answer card to a quiz. The rows indices are the question numbers and the columns indices are the selected option numbers.
-	Responses [][]int32 `json:"responses,omitempty" cql:"responses" validate:"required,min=0,max=10,dive,min=0,max=5,dive,min=0,max=4"`
+	Responses [][]int32 `json:"responses,omitempty" cql:"responses" validate:"required,response_rows,dive,response_options,dive,option_index"`
+	// The answers to numeric and text questions. The indices are the question numbers and the entries for all other question types are ignored.
+	TextResponses []string `json:"text_responses,omitempty" cql:"text_responses" validate:"omitempty,response_rows"`
 }
 
 // StatsRequest is a request for statistics for a specific quiz.
@@ -17,39 +17,49 @@ func TestValidateQuizResponse(t *testing.T) {
 		// ----- test cases start ----- //
 		{
 			name:        "Valid response with one question",
-			input:       &QuizResponse{[][]int32{{0}}},
+			input:       &QuizResponse{Responses: [][]int32{{0}}},
 			expectErr:   require.NoError,
 			expectedLen: 0,
 		}, {
 			name:        "Valid response with ten question",
-			input:       &QuizResponse{[][]int32{{0}, {1}, {2}, {2}, {2}, {2}, {2}, {2}, {2}, {2}}},
+			input:       &QuizResponse{Responses: [][]int32{{0}, {1}, {2}, {2}, {2}, {2}, {2}, {2}, {2}, {2}}},
 			expectErr:   require.NoError,
 			expectedLen: 0,
 		}, {
 			name:        "Invalid response with too many question",
-			input:       &QuizResponse{[][]int32{{0}, {1}, {2}, {2}, {2}, {2}, {2}, {2}, {2}, {2}, {2}}},
+			input:       &QuizResponse{Responses: [][]int32{{0}, {1}, {2}, {2}, {2}, {2}, {2}, {2}, {2}, {2}, {2}}},
 			expectErr:   require.Error,
 			expectedLen: 1,
 		}, {
 			name:        "Invalid response with too many answers",
-			input:       &QuizResponse{[][]int32{{0}, {1}, {0, 1, 2, 3, 4, 4}, {2}, {2}, {2}, {2}, {2}, {2}, {2}}},
+			input:       &QuizResponse{Responses: [][]int32{{0}, {1}, {0, 1, 2, 3, 4, 4}, {2}, {2}, {2}, {2}, {2}, {2}, {2}}},
 			expectErr:   require.Error,
 			expectedLen: 1,
 		}, {
 			name:        "Invalid response with +ve out of range answers",
-			input:       &QuizResponse{[][]int32{{0}, {1}, {0, 1, 2, 3, 5}, {2}, {2}, {2}, {2}, {2}, {2}, {2}}},
+			input:       &QuizResponse{Responses: [][]int32{{0}, {1}, {0, 1, 2, 3, 5}, {2}, {2}, {2}, {2}, {2}, {2}, {2}}},
 			expectErr:   require.Error,
 			expectedLen: 1,
 		}, {
 			name:        "Invalid response with -ve out of range answers",
-			input:       &QuizResponse{[][]int32{{0}, {1}, {0, -1, 2, 3}, {2}, {2}, {2}, {2}, {2}, {2}, {2}}},
+			input:       &QuizResponse{Responses: [][]int32{{0}, {1}, {0, -1, 2, 3}, {2}, {2}, {2}, {2}, {2}, {2}, {2}}},
 			expectErr:   require.Error,
 			expectedLen: 1,
 		}, {
 			name:        "Valid response with no answers",
-			input:       &QuizResponse{[][]int32{{}}},
+			input:       &QuizResponse{Responses: [][]int32{{}}},
 			expectErr:   require.NoError,
 			expectedLen: 0,
+		}, {
+			name:        "Valid response with text answers",
+			input:       &QuizResponse{Responses: [][]int32{{0}}, TextResponses: []string{"", "3.14", "answer"}},
+			expectErr:   require.NoError,
+			expectedLen: 0,
+		}, {
+			name:        "Invalid response with too many text answers",
+			input:       &QuizResponse{Responses: [][]int32{}, TextResponses: []string{"", "", "", "", "", "", "", "", "", "", ""}},
+			expectErr:   require.Error,
+			expectedLen: 1,
 		},
 		// ----- test cases end ----- //
 	}
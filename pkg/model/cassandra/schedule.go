@@ -0,0 +1,48 @@
+package model_cassandra
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// Scheduled actions are performed on a quiz by the scheduler once they are due.
+const (
+	ScheduleActionPublish = "publish" // Publish the quiz at its opening time.
+	ScheduleActionClose   = "close"   // Close the quiz at its closing time.
+)
+
+// QuizSchedule is the availability window of a quiz. A quiz without an opening or closing time is not bounded on that side.
+// [1] Opening time is optional. An unpublished quiz will be published by the scheduler at its opening time.
+// [2] Closing time is optional and must be after the opening time if both are supplied.
+type QuizSchedule struct {
+	OpensAt  *time.Time `json:"opens_at,omitempty"`                                 // The time at which the quiz is published.
+	ClosesAt *time.Time `json:"closes_at,omitempty" validate:"omitempty,closes_at"` // The time after which the quiz can no longer be taken.
+}
+
+// QuizScheduleRequest is the request data sent to the database handler to set the availability window of a quiz.
+type QuizScheduleRequest struct {
+	Username string
+	QuizID   gocql.UUID
+	*QuizSchedule
+}
+
+// QuizScheduleEvent is a pending action on a quiz and is a row in the quiz schedule table.
+type QuizScheduleEvent struct {
+	Action string     `json:"action,omitempty" cql:"action"`   // The action to perform on the quiz.
+	DueAt  time.Time  `json:"due_at,omitempty" cql:"due_at"`   // The time at which the action is due.
+	QuizID gocql.UUID `json:"quiz_id,omitempty" cql:"quiz_id"` // The unique identifier for the quiz.
+}
+
+// QuizScheduleEventsRequest is the request data sent to the database handler to retrieve the actions that are due.
+type QuizScheduleEventsRequest struct {
+	Action string
+	DueBy  time.Time
+	Limit  int
+}
+
+// CloseQuizRequest is the request data sent to the database handler to close a quiz at its scheduled closing time.
+type CloseQuizRequest struct {
+	QuizID   gocql.UUID
+	ClosesAt time.Time
+}
@@ -0,0 +1,33 @@
+package model_cassandra
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// UserScore is a summary of a user's score on a quiz listed in their score history and is a row in the user scores table.
+type UserScore struct {
+	Username    string     `json:"username,omitempty" cql:"username"` // The username of the test taker.
+	QuizID      gocql.UUID `json:"quiz_id,omitempty" cql:"quiz_id"`   // The unique identifier for the quiz.
+	Title       string     `json:"title,omitempty" cql:"title"`       // The title description of the quiz.
+	Score       float64    `json:"score" cql:"score"`                 // The effective score across the attempts at the quiz.
+	MaxScore    float64    `json:"max_score" cql:"max_score"`         // The maximum score that could be awarded for the effective attempt.
+	Attempts    int        `json:"attempts" cql:"attempts"`           // The number of attempts at the quiz.
+	Version     int        `json:"version" cql:"version"`             // The version of the quiz the effective score was graded against.
+	SubmittedAt time.Time  `json:"submitted_at" cql:"submitted_at"`   // The time at which the latest attempt was submitted.
+}
+
+// UserScoresRequest is a request for a page of the scores in a user's score history.
+type UserScoresRequest struct {
+	Username   string // The username of the test taker.
+	PageCursor []byte // A cursor to where the next page of data will begin.
+	PageSize   int    // Number of records to read from the page.
+}
+
+// UserScoresResponse from the database containing the rows and a cursor position into the query.
+type UserScoresResponse struct {
+	PageCursor []byte       // A cursor to where the next page of data will begin.
+	Records    []*UserScore // User score rows from the database.
+	PageSize   int          // Maximum number of records on the requested page.
+}
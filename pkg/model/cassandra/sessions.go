@@ -0,0 +1,23 @@
+package model_cassandra
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// AttemptSession is a timed attempt at a quiz that a user has started and is a row in the attempt sessions table.
+type AttemptSession struct {
+	Username  string     `json:"username,omitempty" cql:"username"`     // The username of the test taker.
+	QuizID    gocql.UUID `json:"quiz_id,omitempty" cql:"quiz_id"`       // The unique identifier for the quiz.
+	Attempt   int        `json:"attempt,omitempty" cql:"attempt"`       // The number of the attempt that was started.
+	StartedAt time.Time  `json:"started_at,omitempty" cql:"started_at"` // The time at which the attempt was started.
+	Deadline  time.Time  `json:"deadline,omitempty" cql:"deadline"`     // The time by which the attempt must be submitted, excluding the grace period.
+}
+
+// AttemptSessionRequest is the request data sent to the database handler to retrieve the session for an attempt at a quiz.
+type AttemptSessionRequest struct {
+	Username string
+	QuizID   gocql.UUID
+	Attempt  int
+}
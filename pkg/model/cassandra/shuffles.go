@@ -0,0 +1,21 @@
+package model_cassandra
+
+import (
+	"github.com/gocql/gocql"
+)
+
+// AttemptShuffle is the seed for the order in which the questions and options of a shuffled quiz are presented to a user
+// for an attempt and is a row in the attempt shuffles table.
+type AttemptShuffle struct {
+	Username string     `json:"username,omitempty" cql:"username"` // The username of the test taker.
+	QuizID   gocql.UUID `json:"quiz_id,omitempty" cql:"quiz_id"`   // The unique identifier for the quiz.
+	Attempt  int        `json:"attempt,omitempty" cql:"attempt"`   // The number of the attempt the order applies to.
+	Seed     int64      `json:"seed,omitempty" cql:"seed"`         // The seed from which the order is generated.
+}
+
+// AttemptShuffleRequest is the request data sent to the database handler to retrieve the seed for an attempt at a quiz.
+type AttemptShuffleRequest struct {
+	Username string
+	QuizID   gocql.UUID
+	Attempt  int
+}
@@ -1,10 +1,24 @@
 package model_cassandra
 
+// Roles determine the operations a user is permitted to perform. Accounts without a role are students.
+const (
+	RoleAdmin      = "admin"      // Manages all quizzes and users.
+	RoleInstructor = "instructor" // Creates and manages their own quizzes and question banks.
+	RoleStudent    = "student"    // Takes quizzes. This is the default.
+)
+
 // User represents a users account and is a row in user table.
 type User struct {
 	*UserAccount
 	AccountID string `json:"account_id,omitempty" cql:"account_id"`
 	IsDeleted bool   `json:"is_deleted" cql:"is_deleted"`
+	Role      string `json:"role,omitempty" cql:"role"`
+}
+
+// UserRoleRequest is the request data sent to the database handler to change the role of a user.
+type UserRoleRequest struct {
+	Username string `json:"username" cql:"username" validate:"required"`
+	Role     string `json:"role" cql:"role" validate:"required,oneof=admin instructor student"`
 }
 
 // UserAccount is the core user account information.
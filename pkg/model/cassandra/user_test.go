@@ -87,3 +87,42 @@ func TestValidateUser(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateUserRoleRequest(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       *UserRoleRequest
+		expectErr   require.ErrorAssertionFunc
+		expectedLen int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:        "Empty",
+			input:       &UserRoleRequest{},
+			expectErr:   require.Error,
+			expectedLen: 2,
+		}, {
+			name:        "Valid",
+			input:       &UserRoleRequest{Username: "username1", Role: RoleInstructor},
+			expectErr:   require.NoError,
+			expectedLen: 0,
+		}, {
+			name:        "Invalid role",
+			input:       &UserRoleRequest{Username: "username1", Role: "superuser"},
+			expectErr:   require.Error,
+			expectedLen: 1,
+		},
+		// ----- test cases end ----- //
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validator.ValidateStruct(testCase.input)
+			testCase.expectErr(t, err)
+
+			if err != nil {
+				require.Equal(t, testCase.expectedLen, len(err.(*validator.ErrorValidation).Errors))
+			}
+		})
+	}
+}
@@ -59,3 +59,244 @@ type StatsResponseGraphQL struct {
 	Metadata `json:"metadata,omitempty"`
 	NextPage `json:"next_page,omitempty"`
 }
+
+// AuthorQuizzesMetadata contains information on the request for the quizzes created by the requester.
+type AuthorQuizzesMetadata struct {
+	Status     string `json:"status,omitempty"`
+	NumRecords int    `json:"num_records"`
+}
+
+// AuthorQuizzesResponse is a paginated response to a request for the quizzes created by the requester.
+type AuthorQuizzesResponse struct {
+	Records  []*model_cassandra.AuthorQuiz `json:"records"`
+	Metadata AuthorQuizzesMetadata         `json:"metadata,omitempty"`
+	Links    struct {
+		NextPage string `json:"next_page"`
+	} `json:"links,omitempty"`
+}
+
+// AuthorQuizzesResponseGraphQL is a paginated GraphQL response to a request for the quizzes created by the requester.
+type AuthorQuizzesResponseGraphQL struct {
+	Records  []*model_cassandra.AuthorQuiz `json:"records"`
+	Metadata AuthorQuizzesMetadata         `json:"metadata,omitempty"`
+	NextPage `json:"next_page,omitempty"`
+}
+
+// UserScoresMetadata contains information on the request for the scores in the requester's score history.
+type UserScoresMetadata struct {
+	NumRecords int `json:"num_records"`
+}
+
+// UserScoresResponse is a paginated response to a request for the scores in the requester's score history.
+type UserScoresResponse struct {
+	Records  []*model_cassandra.UserScore `json:"records"`
+	Metadata UserScoresMetadata           `json:"metadata,omitempty"`
+	Links    struct {
+		NextPage string `json:"next_page"`
+	} `json:"links,omitempty"`
+}
+
+// UserScoresResponseGraphQL is a paginated GraphQL response to a request for the scores in the requester's score history.
+type UserScoresResponseGraphQL struct {
+	Records  []*model_cassandra.UserScore `json:"records"`
+	Metadata UserScoresMetadata           `json:"metadata,omitempty"`
+	NextPage `json:"next_page,omitempty"`
+}
+
+// CatalogueQuiz is a published quiz listed in the quiz catalogue.
+type CatalogueQuiz struct {
+	QuizID      string   `json:"quiz_id"`
+	Author      string   `json:"author"`
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Version     int      `json:"version"`
+}
+
+// CatalogueRequest is a request for a page of the quizzes listed in the catalogue.
+type CatalogueRequest struct {
+	Search     string // Terms that must all appear in the title or description of the quizzes. All quizzes match if it is not set.
+	Tag        string // Tag the quizzes must have. All quizzes match if it is not set.
+	PageCursor []byte // A cursor to where the next page of data will begin.
+	PageSize   int    // Number of records to read from the page.
+}
+
+// CataloguePage is a page of quizzes from the catalogue along with a cursor to the next page.
+type CataloguePage struct {
+	PageCursor []byte           // A cursor to where the next page of data will begin. It is not set on the last page.
+	Records    []*CatalogueQuiz // Quizzes on the page.
+	PageSize   int              // Maximum number of records on the requested page.
+}
+
+// CatalogueMetadata contains information on the request for the quizzes listed in the catalogue.
+type CatalogueMetadata struct {
+	Search     string `json:"search,omitempty"`
+	Tag        string `json:"tag,omitempty"`
+	NumRecords int    `json:"num_records"`
+}
+
+// CatalogueResponse is a paginated response to a request for the quizzes listed in the catalogue.
+type CatalogueResponse struct {
+	Records  []*CatalogueQuiz  `json:"records"`
+	Metadata CatalogueMetadata `json:"metadata,omitempty"`
+	Links    struct {
+		NextPage string `json:"next_page"`
+	} `json:"links,omitempty"`
+}
+
+// CatalogueResponseGraphQL is a paginated GraphQL response to a request for the quizzes listed in the catalogue.
+type CatalogueResponseGraphQL struct {
+	Records  []*CatalogueQuiz  `json:"records"`
+	Metadata CatalogueMetadata `json:"metadata,omitempty"`
+	NextPage `json:"next_page,omitempty"`
+}
+
+// AttemptSession is a started attempt at a timed quiz along with the time remaining to submit it.
+type AttemptSession struct {
+	*model_cassandra.AttemptSession
+	RemainingTime int64 `json:"remaining_time"` // Number of seconds remaining before the deadline, excluding the grace period.
+}
+
+// RegradeSummary is the progress and outcome of regrading all the responses to a quiz against its current version.
+type RegradeSummary struct {
+	Version         int     `json:"version"`          // Version of the quiz the responses were regraded against.
+	Pages           int     `json:"pages"`            // Number of pages of responses processed.
+	Processed       int     `json:"processed"`        // Number of responses processed.
+	Changed         int     `json:"changed"`          // Number of responses whose score changed.
+	Unchanged       int     `json:"unchanged"`        // Number of responses whose score did not change.
+	Failed          int     `json:"failed"`           // Number of responses that could not be graded against the quiz and were left unchanged.
+	NetChange       float64 `json:"net_change"`       // Sum of the changes in score across all responses.
+	LargestIncrease float64 `json:"largest_increase"` // Largest increase in a single score.
+	LargestDecrease float64 `json:"largest_decrease"` // Largest decrease in a single score, reported as a positive value.
+}
+
+// StatsSummary contains the aggregate statistics of the effective scores of all the responses to a quiz.
+type StatsSummary struct {
+	QuizID            string          `json:"quiz_id"`            // Quiz the responses were submitted to.
+	NumResponses      int             `json:"num_responses"`      // Number of responses summarized.
+	Mean              float64         `json:"mean"`               // Mean of the scores.
+	Median            float64         `json:"median"`             // Median of the scores.
+	StandardDeviation float64         `json:"standard_deviation"` // Population standard deviation of the scores.
+	Min               float64         `json:"min"`                // Lowest score.
+	Max               float64         `json:"max"`                // Highest score.
+	Histogram         []*HistogramBin `json:"histogram"`          // Counts of the scores as a percentage of the maximum score.
+}
+
+// HistogramBin is the number of scores that fall within a range of percentages of the maximum score. The upper bound is
+// excluded from every bin except the last.
+type HistogramBin struct {
+	Lower float64 `json:"lower"` // Lower bound of the bin as a percentage of the maximum score.
+	Upper float64 `json:"upper"` // Upper bound of the bin as a percentage of the maximum score.
+	Count int     `json:"count"` // Number of scores in the bin.
+}
+
+// ItemAnalysis is a report on how well each question in a quiz distinguishes between the test takers with the highest and
+// lowest scores, along with the reliability of the quiz as a whole.
+type ItemAnalysis struct {
+	QuizID        string            `json:"quiz_id"`        // Quiz the responses were submitted to.
+	Version       int               `json:"version"`        // Version of the quiz the responses were analyzed against.
+	NumResponses  int               `json:"num_responses"`  // Number of responses analyzed.
+	NumExcluded   int               `json:"num_excluded"`   // Number of responses graded against other versions or that could not be graded.
+	GroupSize     int               `json:"group_size"`     // Number of responses in each of the top and bottom scoring groups.
+	KR20          float64           `json:"kr20"`           // Kuder-Richardson Formula 20 reliability of the questions answered correctly.
+	CronbachAlpha float64           `json:"cronbach_alpha"` // Cronbach's alpha reliability of the points awarded for the questions.
+	Items         []*ItemStatistics `json:"items"`          // Statistics for every question in the quiz.
+}
+
+// ItemStatistics contains the difficulty and discrimination of a single question along with the selection frequencies of
+// its options.
+type ItemStatistics struct {
+	Question       int                `json:"question"`          // Index of the question in the quiz.
+	Description    string             `json:"description"`       // Text of the question.
+	Type           string             `json:"type"`              // Type of the question.
+	Difficulty     float64            `json:"difficulty"`        // Proportion of responses that answered the question correctly, the p-value.
+	Discrimination float64            `json:"discrimination"`    // Point-biserial correlation between answering the question correctly and the total score.
+	Omitted        int                `json:"omitted"`           // Number of responses that did not answer the question.
+	Options        []*OptionFrequency `json:"options,omitempty"` // Selection frequencies of the options of multiple choice and true/false questions.
+}
+
+// OptionFrequency is the number of responses that selected an option, overall and in the top and bottom scoring groups.
+type OptionFrequency struct {
+	Option  int  `json:"option"`  // Index of the option in the question.
+	Correct bool `json:"correct"` // Whether the option is a correct answer.
+	Count   int  `json:"count"`   // Number of responses that selected the option.
+	Top     int  `json:"top"`     // Number of responses in the top scoring group that selected the option.
+	Bottom  int  `json:"bottom"`  // Number of responses in the bottom scoring group that selected the option.
+}
+
+// ResultRecord is the effective answers and score of a single response to a quiz in an export of the quiz's results.
+type ResultRecord struct {
+	Username      string    `json:"username"`                 // User who submitted the response.
+	Score         float64   `json:"score"`                    // Effective score of the response.
+	MaxScore      float64   `json:"max_score"`                // Maximum score that could be awarded for the response.
+	Version       int       `json:"version"`                  // Version of the quiz the response was graded against.
+	Attempts      int       `json:"attempts"`                 // Number of attempts at the quiz.
+	Responses     [][]int32 `json:"responses"`                // Options selected for every question.
+	TextResponses []string  `json:"text_responses,omitempty"` // Answers to numeric and text questions.
+	Correct       []bool    `json:"correct,omitempty"`        // Whether every question was answered correctly, if requested and available.
+}
+
+// ScoreCard is a user's response to a quiz along with a breakdown of the effective attempt for every question, if the
+// answers to the quiz have been revealed.
+type ScoreCard struct {
+	*model_cassandra.Response
+	Breakdown []*QuestionBreakdown `json:"breakdown,omitempty"` // Answers, points, and feedback for every question in the effective attempt.
+}
+
+// QuestionBreakdown is a user's answer to a single question in an attempt along with the answer key, the points awarded,
+// and the feedback for the options that were selected.
+type QuestionBreakdown struct {
+	Question      int      `json:"question"`                 // Index of the question in the quiz.
+	Description   string   `json:"description"`              // Text of the question.
+	Type          string   `json:"type,omitempty"`           // Type of the question.
+	Options       []string `json:"options,omitempty"`        // Options of the question.
+	Matches       []string `json:"matches,omitempty"`        // Entries that the options are paired with in a matching question.
+	Selected      []int32  `json:"selected,omitempty"`       // Options that were selected, or the order or matches chosen.
+	TextResponse  string   `json:"text_response,omitempty"`  // Answer to a numeric or text question.
+	Answers       []int32  `json:"answers,omitempty"`        // Indices of the correct options, or the correct order or matches.
+	NumericAnswer *float64 `json:"numeric_answer,omitempty"` // Answer to a numeric question.
+	TextAnswers   []string `json:"text_answers,omitempty"`   // Accepted answers or regular expressions for a text question.
+	Correct       bool     `json:"correct"`                  // Whether the question was answered completely correctly.
+	Points        float64  `json:"points"`                   // Points awarded for the question.
+	MaxPoints     float64  `json:"max_points"`               // Points the question is worth.
+	Explanation   string   `json:"explanation,omitempty"`    // Explanation of the answer.
+	Feedback      []string `json:"feedback,omitempty"`       // Feedback for each of the selected options, in the order they were selected.
+}
+
+// PracticeCheck is the answer to a single question of a quiz that is checked in practice mode. The question and the
+// selected options are indexed in the order the quiz is presented to the user.
+type PracticeCheck struct {
+	Question     int     `json:"question" validate:"min=0"`                                        // Index of the question being checked.
+	Response     []int32 `json:"response,omitempty" validate:"response_options,dive,option_index"` // Options selected, or the order or matches chosen.
+	TextResponse string  `json:"text_response,omitempty"`                                          // Answer to a numeric or text question.
+}
+
+// GroupRollup contains the effective scores of the members of a group on the quizzes assigned to the group. Owners of the
+// group are not included.
+type GroupRollup struct {
+	GroupID    string               `json:"group_id"`    // Group the rollup was computed for.
+	NumMembers int                  `json:"num_members"` // Number of members of the group, excluding its owners.
+	Quizzes    []*GroupQuizRollup   `json:"quizzes"`     // Aggregate scores on every quiz assigned to the group.
+	Members    []*GroupMemberRollup `json:"members"`     // Total scores of every member across the quizzes assigned to the group.
+}
+
+// GroupQuizRollup contains the aggregate effective scores of the members of a group on a quiz assigned to the group.
+type GroupQuizRollup struct {
+	QuizID         string  `json:"quiz_id"`         // Quiz assigned to the group.
+	Title          string  `json:"title"`           // Title of the quiz when it was assigned.
+	NumResponses   int     `json:"num_responses"`   // Number of members who have responded to the quiz.
+	NumOutstanding int     `json:"num_outstanding"` // Number of members who have not responded to the quiz.
+	Mean           float64 `json:"mean"`            // Mean of the scores.
+	MeanPercentage float64 `json:"mean_percentage"` // Mean of the scores as a percentage of the maximum score.
+	Min            float64 `json:"min"`             // Lowest score.
+	Max            float64 `json:"max"`             // Highest score.
+}
+
+// GroupMemberRollup contains the total effective scores of a member of a group across the quizzes assigned to the group.
+type GroupMemberRollup struct {
+	Username     string  `json:"username"`      // Member of the group.
+	NumResponses int     `json:"num_responses"` // Number of quizzes assigned to the group that the member has responded to.
+	Score        float64 `json:"score"`         // Sum of the scores on the quizzes the member has responded to.
+	MaxScore     float64 `json:"max_score"`     // Sum of the maximum scores on the quizzes the member has responded to.
+	Percentage   float64 `json:"percentage"`    // Total score as a percentage of the total maximum score.
+}
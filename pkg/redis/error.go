@@ -6,6 +6,7 @@ const (
 	ErrorCacheMiss
 	ErrorCacheSet
 	ErrorCacheDel
+	ErrorCacheIncr
 )
 
 // Error is the base error type. The builder pattern is used to add specialization codes to the errors.
@@ -44,3 +45,9 @@ func (e *Error) errorCacheDel() *Error {
 	e.Code = ErrorCacheDel
 	return e
 }
+
+// errorCacheIncr will specialize the error as a counter increment failure.
+func (e *Error) errorCacheIncr() *Error {
+	e.Code = ErrorCacheIncr
+	return e
+}
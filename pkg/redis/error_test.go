@@ -30,6 +30,10 @@ func TestError(t *testing.T) {
 			name:         "cache del",
 			err:          NewError("cache del").errorCacheDel(),
 			expectedCode: ErrorCacheDel,
+		}, {
+			name:         "cache incr",
+			err:          NewError("cache incr").errorCacheIncr(),
+			expectedCode: ErrorCacheIncr,
 		},
 		// ----- test cases end ----- //
 	}
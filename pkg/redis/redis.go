@@ -33,11 +33,17 @@ type Redis interface {
 	// Set will place a key with a given value in the cluster with a TTL, if specified in the configurations.
 	Set(string, any) error
 
+	// SetTTL will place a key with a given value in the cluster that expires after the provided TTL.
+	SetTTL(string, any, time.Duration) error
+
 	// Get will retrieve a value associated with a provided key.
 	Get(string, any) error
 
 	// Del will remove all keys provided as a set of keys.
 	Del(...string) error
+
+	// Incr will increment a counter and return its new value. New counters expire after the provided window.
+	Incr(string, time.Duration) (int64, error)
 }
 
 // Check to ensure the Redis interface has been implemented.
@@ -119,6 +125,11 @@ func (r *redisImpl) Healthcheck() (err error) {
 
 // Set will place a key with a given value in the cluster with a TTL, if specified in the configurations.
 func (r *redisImpl) Set(key string, value any) error {
+	return r.SetTTL(key, value, time.Duration(r.conf.Data.TTL)*time.Second)
+}
+
+// SetTTL will place a key with a given value in the cluster that expires after the TTL. A TTL of zero does not expire.
+func (r *redisImpl) SetTTL(key string, value any, ttl time.Duration) error {
 	// Write value to byte array.
 	buffer := bytes.Buffer{}
 	encoder := gob.NewEncoder(&buffer)
@@ -126,7 +137,7 @@ func (r *redisImpl) Set(key string, value any) error {
 		return NewError(err.Error())
 	}
 
-	if err := r.redisDb.Set(context.Background(), key, buffer.Bytes(), time.Duration(r.conf.Data.TTL)*time.Second).Err(); err != nil {
+	if err := r.redisDb.Set(context.Background(), key, buffer.Bytes(), ttl).Err(); err != nil {
 		r.logger.Error("failed to place item in Redis cache", zap.String("key", key), zap.Error(err))
 		return NewError(err.Error()).errorCacheSet()
 	}
@@ -167,6 +178,25 @@ func (r *redisImpl) Del(keys ...string) error {
 	return nil
 }
 
+// Incr will increment the counter at a key and return its new value. The window is set as the expiry of new counters so
+// that they are reset once the window has passed.
+func (r *redisImpl) Incr(key string, window time.Duration) (int64, error) {
+	count, err := r.redisDb.Incr(context.Background(), key).Result()
+	if err != nil {
+		r.logger.Error("failed to increment counter in Redis cache", zap.String("key", key), zap.Error(err))
+		return 0, NewError(err.Error()).errorCacheIncr()
+	}
+
+	if count == 1 {
+		if err = r.redisDb.Expire(context.Background(), key, window).Err(); err != nil {
+			r.logger.Error("failed to set counter expiry in Redis cache", zap.String("key", key), zap.Error(err))
+			return 0, NewError(err.Error()).errorCacheIncr()
+		}
+	}
+
+	return count, nil
+}
+
 // createSessionRetry will attempt to open the connection using binary exponential back-off and stop on the first success or fail after the last one.
 func (r *redisImpl) createSessionRetry() (err error) {
 	for attempt := 1; attempt <= r.conf.Connection.MaxConnAttempts; attempt++ {
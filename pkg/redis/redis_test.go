@@ -236,3 +236,55 @@ func TestRedisImpl_Set_Get_Del(t *testing.T) {
 		})
 	}
 }
+
+func TestRedisImpl_SetTTL(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+	// Lock connection to Redis cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+
+	quiz := quizzesTestData["myPubQuiz"]
+	key := quiz.QuizID.String() + "-ttl" + constants.GetIntegrationTestKeyspaceSuffix()
+	ttl := 2 * time.Second
+
+	// Write to Redis and read it back before it expires.
+	require.NoError(t, connection.db.SetTTL(key, quiz, ttl), "failed to write to Redis")
+	retrievedQuiz := model_cassandra.Quiz{}
+	require.NoError(t, connection.db.Get(key, &retrievedQuiz), "failed to retrieve data from Redis")
+	require.True(t, reflect.DeepEqual(*quiz, retrievedQuiz), "retrieved quiz does not match expected")
+
+	// The record is evicted once the TTL has passed.
+	time.Sleep(ttl + time.Second)
+	require.Error(t, connection.db.Get(key, &model_cassandra.Quiz{}), "expired record should not be found on redis cluster")
+}
+
+func TestRedisImpl_Incr(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+	// Lock connection to Redis cluster.
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+
+	key := "counter" + constants.GetIntegrationTestKeyspaceSuffix()
+	window := 2 * time.Second
+
+	// Increment a new counter and then an existing counter.
+	for expected := int64(1); expected <= 3; expected++ {
+		count, err := connection.db.Incr(key, window)
+		require.NoError(t, err, "failed to increment counter")
+		require.Equal(t, expected, count, "counter value mismatch")
+	}
+
+	// The counter is reset once the window has passed.
+	time.Sleep(window + time.Second)
+	count, err := connection.db.Incr(key, window)
+	require.NoError(t, err, "failed to increment expired counter")
+	require.Equal(t, int64(1), count, "expired counter should be reset")
+
+	require.NoError(t, connection.db.Del(key), "failed to remove counter from Redis cluster")
+}
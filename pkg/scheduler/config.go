@@ -0,0 +1,25 @@
+package scheduler
+
+import (
+	"github.com/spf13/afero"
+	"github.com/surahman/mcq-platform/pkg/config_loader"
+	"github.com/surahman/mcq-platform/pkg/constants"
+)
+
+// config is the configuration container for the quiz scheduler.
+type config struct {
+	Polling struct {
+		Interval  int `json:"interval,omitempty" yaml:"interval,omitempty" mapstructure:"interval" validate:"required,min=1,max=3600"`
+		BatchSize int `json:"batch_size,omitempty" yaml:"batch_size,omitempty" mapstructure:"batch_size" validate:"required,min=1,max=1000"`
+	} `json:"polling,omitempty" yaml:"polling,omitempty" mapstructure:"polling" validate:"required"`
+}
+
+// newConfig creates a blank configuration struct for the scheduler.
+func newConfig() *config {
+	return &config{}
+}
+
+// Load will attempt to load configurations from a file on a file system and then overwrite values using environment variables.
+func (cfg *config) Load(fs afero.Fs) (err error) {
+	return config_loader.ConfigLoader(fs, cfg, constants.GetSchedulerFileName(), constants.GetSchedulerPrefix(), "yaml")
+}
@@ -0,0 +1,92 @@
+package scheduler
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/constants"
+	"github.com/surahman/mcq-platform/pkg/validator"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSchedulerConfigs_Load(t *testing.T) {
+	keyspacePolling := constants.GetSchedulerPrefix() + "_POLLING."
+
+	testCases := []struct {
+		name      string
+		input     string
+		expectErr require.ErrorAssertionFunc
+		expectLen int
+	}{
+		// ----- test cases start ----- //
+		{
+			"empty - etc dir",
+			schedulerConfigTestData["empty"],
+			require.Error,
+			2,
+		}, {
+			"valid - etc dir",
+			schedulerConfigTestData["valid"],
+			require.NoError,
+			0,
+		}, {
+			"no interval - etc dir",
+			schedulerConfigTestData["no_interval"],
+			require.Error,
+			1,
+		}, {
+			"interval above 3600 - etc dir",
+			schedulerConfigTestData["interval_above_3600"],
+			require.Error,
+			1,
+		}, {
+			"no batch size - etc dir",
+			schedulerConfigTestData["no_batch_size"],
+			require.Error,
+			1,
+		}, {
+			"batch size above 1000 - etc dir",
+			schedulerConfigTestData["batch_size_above_1000"],
+			require.Error,
+			1,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Configure mock filesystem.
+			fs := afero.NewMemMapFs()
+			require.NoError(t, fs.MkdirAll(constants.GetEtcDir(), 0644), "Failed to create in memory directory")
+			require.NoError(t, afero.WriteFile(fs, constants.GetEtcDir()+constants.GetSchedulerFileName(), []byte(testCase.input), 0644), "Failed to write in memory file")
+
+			// Load from mock filesystem.
+			actual := &config{}
+			err := actual.Load(fs)
+			testCase.expectErr(t, err)
+
+			if err != nil {
+				validatorErrors := err.(*validator.ErrorValidation).Errors
+				require.Equalf(t, testCase.expectLen, len(validatorErrors), "validation error count not as expected: %v", validatorErrors)
+				return
+			}
+
+			// Load expected struct.
+			expected := &config{}
+			require.NoError(t, yaml.Unmarshal([]byte(testCase.input), expected), "failed to unmarshal expected constants")
+			require.True(t, reflect.DeepEqual(expected, actual))
+
+			// Test configuring of environment variable.
+			testInterval := 60
+			testBatchSize := 500
+			t.Setenv(keyspacePolling+"INTERVAL", strconv.Itoa(testInterval))
+			t.Setenv(keyspacePolling+"BATCH_SIZE", strconv.Itoa(testBatchSize))
+			err = actual.Load(fs)
+			require.NoErrorf(t, err, "Failed to load constants file: %v", err)
+			require.Equal(t, testInterval, actual.Polling.Interval, "Failed to load interval environment variable into configs")
+			require.Equal(t, testBatchSize, actual.Polling.BatchSize, "Failed to load batch size environment variable into configs")
+		})
+	}
+}
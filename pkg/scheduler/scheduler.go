@@ -0,0 +1,163 @@
+package scheduler
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/catalogue"
+	"github.com/surahman/mcq-platform/pkg/logger"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/redis"
+	"go.uber.org/zap"
+)
+
+// Scheduler publishes quizzes at their opening times and closes them at their closing times. The actions that are due are
+// polled from the quiz schedule table and checked against the quiz record before they are performed, so actions that were
+// superseded by a later schedule are discarded.
+type Scheduler struct {
+	cache     redis.Redis
+	catalogue catalogue.Catalogue
+	db        cassandra.Cassandra
+	conf      *config
+	logger    *logger.Logger
+	wg        *sync.WaitGroup
+}
+
+// NewScheduler will create a new quiz scheduler instance in a non-running state.
+func NewScheduler(fs *afero.Fs, cassandra cassandra.Cassandra, redis redis.Redis, catalogue catalogue.Catalogue,
+	logger *logger.Logger, wg *sync.WaitGroup) (*Scheduler, error) {
+	if fs == nil || logger == nil {
+		return nil, errors.New("nil file system or logger supplied")
+	}
+
+	// Load configurations.
+	conf := newConfig()
+	if err := conf.Load(*fs); err != nil {
+		logger.Error("failed to load scheduler configurations from disk", zap.Error(err))
+		return nil, err
+	}
+
+	return &Scheduler{
+		cache:     redis,
+		catalogue: catalogue,
+		db:        cassandra,
+		conf:      conf,
+		logger:    logger,
+		wg:        wg,
+	}, nil
+}
+
+// Run polls for and performs the scheduled actions until the process is interrupted.
+func (s *Scheduler) Run() {
+	// Indicate to bootstrapping thread to wait for completion.
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(time.Duration(s.conf.Polling.Interval) * time.Second)
+	defer ticker.Stop()
+
+	// Stop polling on an interrupt signal.
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	s.logger.Info("Quiz scheduler started", zap.Int("interval", s.conf.Polling.Interval))
+	for {
+		select {
+		case <-quit:
+			s.logger.Info("Quiz scheduler exited")
+			return
+		case now := <-ticker.C:
+			s.process(now)
+		}
+	}
+}
+
+// process will perform all the publish and close actions that are due. Actions are removed from the schedule once they
+// have been performed or discarded. Actions that fail are left in the schedule to be retried on the next poll.
+func (s *Scheduler) process(now time.Time) {
+	for _, action := range []string{model_cassandra.ScheduleActionPublish, model_cassandra.ScheduleActionClose} {
+		request := &model_cassandra.QuizScheduleEventsRequest{Action: action, DueBy: now, Limit: s.conf.Polling.BatchSize}
+		response, err := s.db.Execute(cassandra.ReadQuizScheduleEventsQuery, request)
+		if err != nil {
+			s.logger.Error("failed to read scheduled quiz actions", zap.String("action", action), zap.Error(err))
+			continue
+		}
+
+		for _, event := range response.([]*model_cassandra.QuizScheduleEvent) {
+			if err = s.perform(event, now); err != nil {
+				s.logger.Error("failed to perform scheduled quiz action", zap.String("action", event.Action),
+					zap.String("quiz_id", event.QuizID.String()), zap.Error(err))
+				continue
+			}
+
+			if _, err = s.db.Execute(cassandra.DeleteQuizScheduleEventQuery, event); err != nil {
+				s.logger.Error("failed to remove performed quiz action from schedule", zap.String("action", event.Action),
+					zap.String("quiz_id", event.QuizID.String()), zap.Error(err))
+			}
+		}
+	}
+}
+
+// perform will carry out a scheduled action on a quiz if it is still due. Quizzes that no longer exist, are deleted, have
+// already had the action performed, or were rescheduled to a later time are left unchanged.
+func (s *Scheduler) perform(event *model_cassandra.QuizScheduleEvent, now time.Time) (err error) {
+	var response any
+	if response, err = s.db.Execute(cassandra.ReadQuizQuery, event.QuizID); err != nil {
+		if cassandraErr, ok := err.(*cassandra.Error); ok && cassandraErr.Status == http.StatusNotFound {
+			return nil
+		}
+		return
+	}
+	quiz := response.(*model_cassandra.Quiz)
+
+	if quiz.IsDeleted {
+		return nil
+	}
+
+	switch event.Action {
+	case model_cassandra.ScheduleActionPublish:
+		if quiz.IsPublished || !isDue(quiz.OpensAt, now) {
+			return nil
+		}
+		request := &model_cassandra.QuizMutateRequest{Username: quiz.Author, QuizID: quiz.QuizID}
+		if _, err = s.db.Execute(cassandra.PublishQuizQuery, request); err != nil {
+			return
+		}
+		s.logger.Info("published scheduled quiz", zap.String("quiz_id", quiz.QuizID.String()))
+
+		// List the quiz in the catalogue.
+		quiz.IsPublished = true
+		s.catalogue.Add(quiz)
+
+	case model_cassandra.ScheduleActionClose:
+		if quiz.IsClosed || !isDue(quiz.ClosesAt, now) {
+			return nil
+		}
+		request := &model_cassandra.CloseQuizRequest{QuizID: quiz.QuizID, ClosesAt: *quiz.ClosesAt}
+		if _, err = s.db.Execute(cassandra.CloseQuizQuery, request); err != nil {
+			return
+		}
+		s.logger.Info("closed scheduled quiz", zap.String("quiz_id", quiz.QuizID.String()))
+
+	default:
+		return nil
+	}
+
+	// Evict the quiz from the cache. It will be reloaded on the next cache miss.
+	if err = s.cache.Del(quiz.QuizID.String()); err != nil && err.(*redis.Error).Code != redis.ErrorCacheMiss {
+		s.logger.Error("failed to evict scheduled quiz from cache", zap.String("quiz_id", quiz.QuizID.String()), zap.Error(err))
+	}
+
+	return nil
+}
+
+// isDue reports whether a scheduled time is set and has been reached.
+func isDue(at *time.Time, now time.Time) bool {
+	return at != nil && !now.Before(*at)
+}
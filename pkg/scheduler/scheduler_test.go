@@ -0,0 +1,299 @@
+package scheduler
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/mcq-platform/pkg/cassandra"
+	"github.com/surahman/mcq-platform/pkg/constants"
+	"github.com/surahman/mcq-platform/pkg/logger"
+	"github.com/surahman/mcq-platform/pkg/mocks"
+	"github.com/surahman/mcq-platform/pkg/model/cassandra"
+	"github.com/surahman/mcq-platform/pkg/redis"
+)
+
+func TestNewScheduler(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, fs.MkdirAll(constants.GetEtcDir(), 0644), "Failed to create in memory directory")
+	require.NoError(t, afero.WriteFile(fs, constants.GetEtcDir()+constants.GetSchedulerFileName(),
+		[]byte(schedulerConfigTestData["valid"]), 0644), "Failed to write in memory file")
+
+	emptyFs := afero.NewMemMapFs()
+
+	testCases := []struct {
+		name      string
+		fs        *afero.Fs
+		log       *logger.Logger
+		expectErr require.ErrorAssertionFunc
+		expectNil require.ValueAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "Invalid file system and logger",
+			fs:        nil,
+			log:       nil,
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:      "Invalid file system",
+			fs:        nil,
+			log:       zapLogger,
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:      "Invalid logger",
+			fs:        &fs,
+			log:       nil,
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:      "File not found",
+			fs:        &emptyFs,
+			log:       zapLogger,
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:      "Valid",
+			fs:        &fs,
+			log:       zapLogger,
+			expectErr: require.NoError,
+			expectNil: require.NotNil,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			scheduler, err := NewScheduler(testCase.fs, nil, nil, nil, testCase.log, &sync.WaitGroup{})
+			testCase.expectErr(t, err)
+			testCase.expectNil(t, scheduler)
+
+			if err != nil {
+				return
+			}
+
+			require.Equal(t, 15, scheduler.conf.Polling.Interval, "configured interval does not match")
+			require.Equal(t, 100, scheduler.conf.Polling.BatchSize, "configured batch size does not match")
+		})
+	}
+}
+
+func TestScheduler_Perform(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+	quizId := gocql.TimeUUID()
+	publish := &model_cassandra.QuizScheduleEvent{Action: model_cassandra.ScheduleActionPublish, DueAt: past, QuizID: quizId}
+	closing := &model_cassandra.QuizScheduleEvent{Action: model_cassandra.ScheduleActionClose, DueAt: past, QuizID: quizId}
+
+	testCases := []struct {
+		name       string
+		event      *model_cassandra.QuizScheduleEvent
+		quiz       *model_cassandra.Quiz
+		readErr    error
+		actionErr  error
+		actionCall int
+		addCall    int
+		delErr     error
+		delCall    int
+		expectErr  require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			name:      "quiz not found",
+			event:     publish,
+			readErr:   &cassandra.Error{Message: "quiz not found", Status: http.StatusNotFound},
+			expectErr: require.NoError,
+		}, {
+			name:      "quiz read failure",
+			event:     publish,
+			readErr:   &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+			expectErr: require.Error,
+		}, {
+			name:      "publish deleted quiz",
+			event:     publish,
+			quiz:      &model_cassandra.Quiz{QuizID: quizId, OpensAt: &past, IsDeleted: true},
+			expectErr: require.NoError,
+		}, {
+			name:      "publish published quiz",
+			event:     publish,
+			quiz:      &model_cassandra.Quiz{QuizID: quizId, OpensAt: &past, IsPublished: true},
+			expectErr: require.NoError,
+		}, {
+			name:      "publish rescheduled quiz",
+			event:     publish,
+			quiz:      &model_cassandra.Quiz{QuizID: quizId, OpensAt: &future},
+			expectErr: require.NoError,
+		}, {
+			name:      "publish unscheduled quiz",
+			event:     publish,
+			quiz:      &model_cassandra.Quiz{QuizID: quizId},
+			expectErr: require.NoError,
+		}, {
+			name:       "publish failure",
+			event:      publish,
+			quiz:       &model_cassandra.Quiz{QuizID: quizId, OpensAt: &past},
+			actionErr:  &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+			actionCall: 1,
+			expectErr:  require.Error,
+		}, {
+			name:       "publish",
+			event:      publish,
+			quiz:       &model_cassandra.Quiz{QuizID: quizId, OpensAt: &past},
+			actionCall: 1,
+			addCall:    1,
+			delErr:     &redis.Error{Message: "cache miss", Code: redis.ErrorCacheMiss},
+			delCall:    1,
+			expectErr:  require.NoError,
+		}, {
+			name:      "close closed quiz",
+			event:     closing,
+			quiz:      &model_cassandra.Quiz{QuizID: quizId, ClosesAt: &past, IsPublished: true, IsClosed: true},
+			expectErr: require.NoError,
+		}, {
+			name:      "close rescheduled quiz",
+			event:     closing,
+			quiz:      &model_cassandra.Quiz{QuizID: quizId, ClosesAt: &future, IsPublished: true},
+			expectErr: require.NoError,
+		}, {
+			name:       "close failure",
+			event:      closing,
+			quiz:       &model_cassandra.Quiz{QuizID: quizId, ClosesAt: &past, IsPublished: true},
+			actionErr:  &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+			actionCall: 1,
+			expectErr:  require.Error,
+		}, {
+			name:       "close - cache evict failure",
+			event:      closing,
+			quiz:       &model_cassandra.Quiz{QuizID: quizId, ClosesAt: &past, IsPublished: true},
+			actionCall: 1,
+			delErr:     &redis.Error{Message: "cache failure", Code: redis.ErrorCacheDel},
+			delCall:    1,
+			expectErr:  require.NoError,
+		}, {
+			name:       "close",
+			event:      closing,
+			quiz:       &model_cassandra.Quiz{QuizID: quizId, ClosesAt: &now, IsPublished: true},
+			actionCall: 1,
+			delCall:    1,
+			expectErr:  require.NoError,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl)
+
+			gomock.InOrder(
+				// Read the quiz record.
+				mockCassandra.EXPECT().Execute(gomock.Any(), quizId).Return(testCase.quiz, testCase.readErr).Times(1),
+
+				// Perform the action.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, testCase.actionErr).Times(testCase.actionCall),
+
+				// List a published quiz in the catalogue.
+				mockCatalogue.EXPECT().Add(testCase.quiz).Times(testCase.addCall),
+
+				// Evict from Redis.
+				mockRedis.EXPECT().Del(quizId.String()).Return(testCase.delErr).Times(testCase.delCall),
+			)
+
+			scheduler := &Scheduler{cache: mockRedis, catalogue: mockCatalogue, db: mockCassandra, conf: newConfig(), logger: zapLogger}
+			testCase.expectErr(t, scheduler.perform(testCase.event, now))
+		})
+	}
+}
+
+func TestScheduler_Process(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	quizId := gocql.TimeUUID()
+	events := []*model_cassandra.QuizScheduleEvent{
+		{Action: model_cassandra.ScheduleActionPublish, DueAt: past, QuizID: quizId},
+	}
+	quiz := &model_cassandra.Quiz{QuizID: quizId, OpensAt: &past}
+
+	testCases := []struct {
+		name        string
+		readErr     error
+		readQuizErr error
+		quizCall    int
+		publishCall int
+		deleteCall  int
+	}{
+		// ----- test cases start ----- //
+		{
+			name:    "schedule read failure",
+			readErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+		}, {
+			name:        "action failure is retried",
+			readQuizErr: &cassandra.Error{Message: "db failure", Status: http.StatusInternalServerError},
+			quizCall:    1,
+		}, {
+			name:        "action performed and removed",
+			quizCall:    1,
+			publishCall: 1,
+			deleteCall:  1,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCassandra := mocks.NewMockCassandra(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockCatalogue := mocks.NewMockCatalogue(mockCtrl)
+
+			var publishEvents any = events
+			if testCase.readErr != nil {
+				publishEvents = nil
+			}
+
+			gomock.InOrder(
+				// Read the due publish actions.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(publishEvents, testCase.readErr).Times(1),
+
+				// Read the quiz record.
+				mockCassandra.EXPECT().Execute(gomock.Any(), quizId).Return(quiz, testCase.readQuizErr).Times(testCase.quizCall),
+
+				// Publish the quiz, list it in the catalogue, and evict it from Redis.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, nil).Times(testCase.publishCall),
+				mockCatalogue.EXPECT().Add(quiz).Times(testCase.publishCall),
+				mockRedis.EXPECT().Del(quizId.String()).Return(nil).Times(testCase.publishCall),
+
+				// Remove the action from the schedule.
+				mockCassandra.EXPECT().Execute(gomock.Any(), events[0]).Return(nil, nil).Times(testCase.deleteCall),
+
+				// Read the due close actions.
+				mockCassandra.EXPECT().Execute(gomock.Any(), gomock.Any()).Return([]*model_cassandra.QuizScheduleEvent{}, nil).Times(1),
+			)
+
+			scheduler := &Scheduler{cache: mockRedis, catalogue: mockCatalogue, db: mockCassandra, conf: newConfig(), logger: zapLogger}
+			scheduler.conf.Polling.BatchSize = 100
+			scheduler.process(now)
+		})
+	}
+}
+
+func TestIsDue(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	require.False(t, isDue(nil, now), "unset time is never due")
+	require.False(t, isDue(&future, now), "future time is not due")
+	require.True(t, isDue(&now, now), "current time is due")
+	require.True(t, isDue(&past, now), "past time is due")
+}
@@ -0,0 +1,32 @@
+package scheduler
+
+// configTestData will return a map of test data containing valid and invalid scheduler configs.
+func configTestData() map[string]string {
+	return map[string]string{
+
+		"empty": ``,
+
+		"valid": `
+polling:
+  interval: 15
+  batch_size: 100`,
+
+		"no_interval": `
+polling:
+  batch_size: 100`,
+
+		"interval_above_3600": `
+polling:
+  interval: 3601
+  batch_size: 100`,
+
+		"no_batch_size": `
+polling:
+  interval: 15`,
+
+		"batch_size_above_1000": `
+polling:
+  interval: 15
+  batch_size: 1001`,
+	}
+}
@@ -4,6 +4,11 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -11,23 +16,108 @@ import (
 // structValidator is the validator instance that is used for structure validation.
 var structValidator *validator.Validate
 
+// Question types as defined in the Cassandra quiz model. A question without a type is a multiple choice question.
+const (
+	questionMultipleChoice = "multiple-choice"
+	questionTrueFalse      = "true-false"
+	questionNumeric        = "numeric"
+	questionShortText      = "short-text"
+	questionRegex          = "regex"
+	questionOrdering       = "ordering"
+	questionMatching       = "matching"
+)
+
+// Limits are the configurable bounds on the size of quizzes and responses.
+type Limits struct {
+	MaxQuestions int // Maximum number of questions in a quiz and in a response.
+	MinOptions   int // Minimum number of options in a multiple choice, ordering, or matching question.
+	MaxOptions   int // Maximum number of options, answers, and matches in a question. Option indices must be less than this.
+}
+
+// limits are the bounds in use by the validator. They default to 10 questions with 2-5 options each.
+var limits = struct {
+	sync.RWMutex
+	Limits
+}{Limits: Limits{MaxQuestions: 10, MinOptions: 2, MaxOptions: 5}}
+
+// markingTypes is the set of marking type names that will pass validation. Names are stored in lowercase and the set is
+// seeded with the marking schemes built into the grading package.
+var markingTypes = struct {
+	sync.RWMutex
+	names map[string]struct{}
+}{names: map[string]struct{}{"none": {}, "negative": {}, "non-negative": {}, "binary": {}}}
+
 // init the validator and add custom validation rules.
 func init() {
 	structValidator = validator.New()
-	if err := structValidator.RegisterValidation("answers_LT_options", validateNumAnswers); err != nil {
+	rules := map[string]validator.Func{
+		"marking_type":      validateMarkingType,
+		"question_type":     validateQuestionType,
+		"question_options":  validateQuestionOptions,
+		"question_answers":  validateQuestionAnswers,
+		"text_answers":      validateTextAnswers,
+		"question_matches":  validateQuestionMatches,
+		"question_feedback": validateQuestionFeedback,
+		"quiz_questions":    validateNumQuestions,
+		"draw_count":        validateDrawCount,
+		"response_rows":     validateNumQuestions,
+		"response_options":  validateResponseOptions,
+		"option_index":      validateOptionIndex,
+		"closes_at":         validateClosesAt,
+	}
+	for tag, rule := range rules {
+		if err := structValidator.RegisterValidation(tag, rule); err != nil {
+			log.Fatalf("failed to initialize struct validator with custom validation rule: %v", err)
+		}
+	}
+	// The numeric answer is optional and must also be validated when it is not set.
+	if err := structValidator.RegisterValidation("numeric_answer", validateNumericAnswer, true); err != nil {
 		log.Fatalf("failed to initialize struct validator with custom validation rule: %v", err)
 	}
 }
 
+// RegisterMarkingType will add a marking type name to the set of names that will pass validation. Names are case-insensitive.
+func RegisterMarkingType(name string) {
+	markingTypes.Lock()
+	defer markingTypes.Unlock()
+	markingTypes.names[strings.ToLower(name)] = struct{}{}
+}
+
+// SetLimits will replace the bounds on the size of quizzes and responses used by the validator. Limits should be set at
+// startup before any requests are validated.
+func SetLimits(bounds Limits) error {
+	if bounds.MaxQuestions < 1 || bounds.MinOptions < 2 || bounds.MaxOptions < bounds.MinOptions {
+		return fmt.Errorf("invalid limits: at least 1 question and 2 options are required and maximums must not be below minimums")
+	}
+
+	limits.Lock()
+	defer limits.Unlock()
+	limits.Limits = bounds
+
+	return nil
+}
+
+// GetLimits will retrieve the bounds on the size of quizzes and responses used by the validator.
+func GetLimits() Limits {
+	limits.RLock()
+	defer limits.RUnlock()
+
+	return limits.Limits
+}
+
 // ErrorField contains information on JSON validation errors.
 type ErrorField struct {
-	Field string `json:"field" yaml:"field"` // Field name where the validation error occurred.
-	Tag   string `json:"tag" yaml:"tag"`     // The reason for the validation failure.
-	Value any    `json:"value" yaml:"value"` // The value(s) associated with the failure.
+	Field  string `json:"field" yaml:"field"`                       // Field name where the validation error occurred.
+	Tag    string `json:"tag" yaml:"tag"`                           // The reason for the validation failure.
+	Value  any    `json:"value" yaml:"value"`                       // The value(s) associated with the failure.
+	Bounds string `json:"bounds,omitempty" yaml:"bounds,omitempty"` // The bounds that the value(s) must be within, if any.
 }
 
 // Error will output the validation error for a single structs data member.
 func (err *ErrorField) Error() string {
+	if len(err.Bounds) != 0 {
+		return fmt.Sprintf("Field: %s, Tag: %s, Value: %s, Bounds: %s\n", err.Field, err.Tag, err.Value, err.Bounds)
+	}
 	return fmt.Sprintf("Field: %s, Tag: %s, Value: %s\n", err.Field, err.Tag, err.Value)
 }
 
@@ -54,6 +144,7 @@ func ValidateStruct(body any) error {
 			ev.Field = issue.Field()
 			ev.Tag = issue.Tag()
 			ev.Value = issue.Value()
+			ev.Bounds = bounds(issue.Tag(), issue.Param())
 			validationErr.Errors = append(validationErr.Errors, &ev)
 		}
 	}
@@ -63,11 +154,218 @@ func ValidateStruct(body any) error {
 	return &validationErr
 }
 
-// validateNumAnswers is used by the validator to check if the number of answers is not greater than the number of questions.
-func validateNumAnswers(fieldValue validator.FieldLevel) bool {
-	options := fieldValue.Parent().FieldByName("Options").Interface().([]string)
-	numOptions := len(options)
-	numAnswers := fieldValue.Field().Len()
+// bounds will describe the bounds associated with a failed validation rule. Rules for configurable limits report the
+// limits in use and built-in rules report their parameter.
+func bounds(tag, param string) string {
+	current := GetLimits()
+
+	switch tag {
+	case "quiz_questions":
+		return fmt.Sprintf("1 to %d questions", current.MaxQuestions)
+	case "draw_count":
+		return fmt.Sprintf("1 to %d questions", current.MaxQuestions)
+	case "response_rows":
+		return fmt.Sprintf("0 to %d questions", current.MaxQuestions)
+	case "question_options":
+		return fmt.Sprintf("%d to %d options for multiple choice, ordering, and matching questions", current.MinOptions, current.MaxOptions)
+	case "question_answers":
+		return fmt.Sprintf("1 to %d answers with option indices 0 to %d", current.MaxOptions, current.MaxOptions-1)
+	case "question_matches":
+		return fmt.Sprintf("number of options to %d matches", current.MaxOptions)
+	case "question_feedback":
+		return "none or one entry for every option of multiple choice and true/false questions"
+	case "response_options":
+		return fmt.Sprintf("0 to %d options", current.MaxOptions)
+	case "option_index":
+		return fmt.Sprintf("0 to %d", current.MaxOptions-1)
+	}
+
+	if len(param) != 0 {
+		return fmt.Sprintf("%s=%s", tag, param)
+	}
+	return ""
+}
+
+// validateNumQuestions is used by the validator to check that a quiz or response does not exceed the maximum number of questions.
+func validateNumQuestions(fieldValue validator.FieldLevel) bool {
+	return fieldValue.Field().Len() <= GetLimits().MaxQuestions
+}
+
+// validateDrawCount is used by the validator to check that the number of questions drawn for a quiz does not exceed the
+// maximum number of questions.
+func validateDrawCount(fieldValue validator.FieldLevel) bool {
+	return fieldValue.Field().Int() <= int64(GetLimits().MaxQuestions)
+}
+
+// validateResponseOptions is used by the validator to check that a response to a question does not exceed the maximum
+// number of options.
+func validateResponseOptions(fieldValue validator.FieldLevel) bool {
+	return fieldValue.Field().Len() <= GetLimits().MaxOptions
+}
+
+// validateOptionIndex is used by the validator to check that an option index is within the maximum number of options.
+func validateOptionIndex(fieldValue validator.FieldLevel) bool {
+	index := fieldValue.Field().Int()
+	return index >= 0 && index < int64(GetLimits().MaxOptions)
+}
+
+// validateClosesAt is used by the validator to check that the closing time of a quiz is after its opening time, if set.
+func validateClosesAt(fieldValue validator.FieldLevel) bool {
+	opensAt := fieldValue.Parent().FieldByName("OpensAt")
+	if opensAt.Kind() == reflect.Ptr {
+		if opensAt.IsNil() {
+			return true
+		}
+		opensAt = opensAt.Elem()
+	}
+
+	closesAt, ok := fieldValue.Field().Interface().(time.Time)
+	return ok && closesAt.After(opensAt.Interface().(time.Time))
+}
+
+// validateMarkingType is used by the validator to check if the marking type is one of the registered marking types.
+func validateMarkingType(fieldValue validator.FieldLevel) bool {
+	markingTypes.RLock()
+	defer markingTypes.RUnlock()
+	_, ok := markingTypes.names[strings.ToLower(fieldValue.Field().String())]
+
+	return ok
+}
+
+// questionType will retrieve the question type from the parent of a question's field. An empty type is multiple choice.
+func questionType(fieldValue validator.FieldLevel) string {
+	if qType := fieldValue.Parent().FieldByName("Type").String(); len(qType) != 0 {
+		return qType
+	}
+	return questionMultipleChoice
+}
+
+// validateQuestionType is used by the validator to check if the question type is supported.
+func validateQuestionType(fieldValue validator.FieldLevel) bool {
+	switch questionType(fieldValue) {
+	case questionMultipleChoice, questionTrueFalse, questionNumeric, questionShortText, questionRegex, questionOrdering,
+		questionMatching:
+		return true
+	default:
+		return false
+	}
+}
+
+// validateQuestionOptions is used by the validator to check the number of options is valid for the question type.
+func validateQuestionOptions(fieldValue validator.FieldLevel) bool {
+	numOptions := fieldValue.Field().Len()
+	current := GetLimits()
+
+	switch questionType(fieldValue) {
+	case questionMultipleChoice, questionOrdering, questionMatching:
+		return numOptions >= current.MinOptions && numOptions <= current.MaxOptions
+	case questionTrueFalse:
+		return numOptions == 0 || numOptions == 2
+	default:
+		return numOptions == 0
+	}
+}
+
+// validateQuestionAnswers is used by the validator to check the answer key is valid for the question type.
+func validateQuestionAnswers(fieldValue validator.FieldLevel) bool {
+	answers := fieldValue.Field().Interface().([]int32)
+
+	switch questionType(fieldValue) {
+	case questionMultipleChoice:
+		numOptions := fieldValue.Parent().FieldByName("Options").Len()
+		if len(answers) < 1 || len(answers) > numOptions {
+			return false
+		}
+		return answersInRange(answers, int32(numOptions))
+	case questionTrueFalse:
+		return len(answers) == 1 && answersInRange(answers, 2)
+	case questionOrdering:
+		// The answer key is the correct order of the options and must be a permutation of the option indices.
+		numOptions := fieldValue.Parent().FieldByName("Options").Len()
+		seen := make(map[int32]struct{}, len(answers))
+		for _, answer := range answers {
+			seen[answer] = struct{}{}
+		}
+		return len(answers) == numOptions && len(seen) == numOptions && answersInRange(answers, int32(numOptions))
+	case questionMatching:
+		// The answer key has the index of the matching entry for every option.
+		numOptions := fieldValue.Parent().FieldByName("Options").Len()
+		numMatches := fieldValue.Parent().FieldByName("Matches").Len()
+		return len(answers) == numOptions && answersInRange(answers, int32(numMatches))
+	default:
+		return len(answers) == 0
+	}
+}
+
+// validateQuestionMatches is used by the validator to check that only matching questions have matches and that there are
+// enough matches for all the options.
+func validateQuestionMatches(fieldValue validator.FieldLevel) bool {
+	numMatches := fieldValue.Field().Len()
+
+	if questionType(fieldValue) != questionMatching {
+		return numMatches == 0
+	}
+	return numMatches >= fieldValue.Parent().FieldByName("Options").Len() && numMatches <= GetLimits().MaxOptions
+}
+
+// validateQuestionFeedback is used by the validator to check that only multiple choice and true/false questions have option
+// feedback and that there is an entry for every option. True/false questions without options have two options.
+func validateQuestionFeedback(fieldValue validator.FieldLevel) bool {
+	numFeedback := fieldValue.Field().Len()
+	if numFeedback == 0 {
+		return true
+	}
+
+	numOptions := fieldValue.Parent().FieldByName("Options").Len()
+	switch questionType(fieldValue) {
+	case questionMultipleChoice:
+		return numFeedback == numOptions
+	case questionTrueFalse:
+		return numFeedback == 2
+	default:
+		return false
+	}
+}
+
+// answersInRange checks that all the answer indices are in the range [0, min(upper, maximum options)).
+func answersInRange(answers []int32, upper int32) bool {
+	if maxOptions := int32(GetLimits().MaxOptions); upper > maxOptions {
+		upper = maxOptions
+	}
+	for _, answer := range answers {
+		if answer < 0 || answer >= upper {
+			return false
+		}
+	}
+	return true
+}
+
+// validateNumericAnswer is used by the validator to check that only numeric questions have a numeric answer.
+func validateNumericAnswer(fieldValue validator.FieldLevel) bool {
+	field := fieldValue.Field()
+	isSet := !(field.Kind() == reflect.Ptr && field.IsNil())
+
+	return isSet == (questionType(fieldValue) == questionNumeric)
+}
+
+// validateTextAnswers is used by the validator to check that only text questions have text answers and that they are valid.
+func validateTextAnswers(fieldValue validator.FieldLevel) bool {
+	answers := fieldValue.Field().Interface().([]string)
+	qType := questionType(fieldValue)
 
-	return numAnswers <= numOptions
+	if qType != questionShortText && qType != questionRegex {
+		return len(answers) == 0
+	}
+	if len(answers) == 0 {
+		return false
+	}
+	for _, answer := range answers {
+		if len(strings.TrimSpace(answer)) == 0 {
+			return false
+		}
+		if _, err := regexp.Compile(answer); qType == questionRegex && err != nil {
+			return false
+		}
+	}
+	return true
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -36,6 +37,11 @@ func TestErrorField_Error(t *testing.T) {
 			&ErrorField{Field: "field", Tag: "tag", Value: "value"},
 			fmt.Sprintf(errorStr, "field", "tag", "value"),
 		},
+		{
+			"Field, Tag, Value, and Bounds error",
+			&ErrorField{Field: "field", Tag: "tag", Value: "value", Bounds: "1 to 10"},
+			"Field: field, Tag: tag, Value: value, Bounds: 1 to 10\n",
+		},
 		// ----- test cases end ----- //
 	}
 	for _, testCase := range testCases {
@@ -160,3 +166,219 @@ func TestValidateStruct(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMarkingType(t *testing.T) {
+
+	type MarkingTestStruct struct {
+		MarkingType string `validate:"marking_type"`
+	}
+
+	RegisterMarkingType("Certainty-Based")
+
+	testCases := []struct {
+		name      string
+		input     *MarkingTestStruct
+		expectErr require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			"Built-in marking type",
+			&MarkingTestStruct{MarkingType: "Non-negative"},
+			require.NoError,
+		},
+		{
+			"No marking",
+			&MarkingTestStruct{MarkingType: "none"},
+			require.NoError,
+		},
+		{
+			"Registered marking type",
+			&MarkingTestStruct{MarkingType: "certainty-based"},
+			require.NoError,
+		},
+		{
+			"Unregistered marking type",
+			&MarkingTestStruct{MarkingType: "invalid"},
+			require.Error,
+		},
+		{
+			"Empty marking type",
+			&MarkingTestStruct{},
+			require.Error,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.expectErr(t, ValidateStruct(testCase.input))
+		})
+	}
+}
+
+func TestValidateClosesAt(t *testing.T) {
+
+	type ScheduleTestStruct struct {
+		OpensAt  *time.Time
+		ClosesAt *time.Time `validate:"omitempty,closes_at"`
+	}
+
+	opensAt := time.Date(2022, 10, 1, 9, 0, 0, 0, time.UTC)
+	closesAt := opensAt.Add(time.Hour)
+
+	testCases := []struct {
+		name      string
+		input     *ScheduleTestStruct
+		expectErr require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			"No window",
+			&ScheduleTestStruct{},
+			require.NoError,
+		},
+		{
+			"Opening time only",
+			&ScheduleTestStruct{OpensAt: &opensAt},
+			require.NoError,
+		},
+		{
+			"Closing time only",
+			&ScheduleTestStruct{ClosesAt: &closesAt},
+			require.NoError,
+		},
+		{
+			"Closing after opening",
+			&ScheduleTestStruct{OpensAt: &opensAt, ClosesAt: &closesAt},
+			require.NoError,
+		},
+		{
+			"Closing before opening",
+			&ScheduleTestStruct{OpensAt: &closesAt, ClosesAt: &opensAt},
+			require.Error,
+		},
+		{
+			"Closing at opening",
+			&ScheduleTestStruct{OpensAt: &opensAt, ClosesAt: &opensAt},
+			require.Error,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.expectErr(t, ValidateStruct(testCase.input))
+		})
+	}
+}
+
+func TestSetLimits(t *testing.T) {
+	defaults := GetLimits()
+	t.Cleanup(func() { require.NoError(t, SetLimits(defaults), "failed to restore default limits") })
+
+	testCases := []struct {
+		name      string
+		input     Limits
+		expectErr require.ErrorAssertionFunc
+	}{
+		// ----- test cases start ----- //
+		{
+			"Valid limits",
+			Limits{MaxQuestions: 40, MinOptions: 2, MaxOptions: 8},
+			require.NoError,
+		}, {
+			"No questions",
+			Limits{MaxQuestions: 0, MinOptions: 2, MaxOptions: 8},
+			require.Error,
+		}, {
+			"Too few options",
+			Limits{MaxQuestions: 40, MinOptions: 1, MaxOptions: 8},
+			require.Error,
+		}, {
+			"Maximum options below minimum options",
+			Limits{MaxQuestions: 40, MinOptions: 4, MaxOptions: 3},
+			require.Error,
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			before := GetLimits()
+			err := SetLimits(testCase.input)
+			testCase.expectErr(t, err)
+
+			if err != nil {
+				require.Equal(t, before, GetLimits(), "limits should not change on failure")
+				return
+			}
+			require.Equal(t, testCase.input, GetLimits(), "limits were not set")
+		})
+	}
+}
+
+func TestValidateLimits(t *testing.T) {
+	defaults := GetLimits()
+	t.Cleanup(func() { require.NoError(t, SetLimits(defaults), "failed to restore default limits") })
+	require.NoError(t, SetLimits(Limits{MaxQuestions: 3, MinOptions: 2, MaxOptions: 4}), "failed to set limits")
+
+	type LimitsTestStruct struct {
+		Questions []string  `validate:"required,min=1,quiz_questions"`
+		Responses [][]int32 `validate:"required,response_rows,dive,response_options,dive,option_index"`
+		Draw      int       `validate:"omitempty,min=1,draw_count"`
+	}
+
+	testCases := []struct {
+		name         string
+		input        *LimitsTestStruct
+		expectErr    require.ErrorAssertionFunc
+		expectBounds []string
+	}{
+		// ----- test cases start ----- //
+		{
+			name:         "Within limits",
+			input:        &LimitsTestStruct{Questions: []string{"1", "2", "3"}, Responses: [][]int32{{0, 1, 2, 3}, {}, {3}}, Draw: 3},
+			expectErr:    require.NoError,
+			expectBounds: nil,
+		}, {
+			name:         "Too many questions",
+			input:        &LimitsTestStruct{Questions: []string{"1", "2", "3", "4"}, Responses: [][]int32{{0}, {1}, {2}, {3}}},
+			expectErr:    require.Error,
+			expectBounds: []string{"1 to 3 questions", "0 to 3 questions"},
+		}, {
+			name:         "Too many options",
+			input:        &LimitsTestStruct{Questions: []string{"1"}, Responses: [][]int32{{0, 1, 2, 3, 0}}},
+			expectErr:    require.Error,
+			expectBounds: []string{"0 to 4 options"},
+		}, {
+			name:         "Option index out of range",
+			input:        &LimitsTestStruct{Questions: []string{"1"}, Responses: [][]int32{{4}}},
+			expectErr:    require.Error,
+			expectBounds: []string{"0 to 3"},
+		}, {
+			name:         "Too many questions drawn",
+			input:        &LimitsTestStruct{Questions: []string{"1"}, Responses: [][]int32{{0}}, Draw: 4},
+			expectErr:    require.Error,
+			expectBounds: []string{"1 to 3 questions"},
+		}, {
+			name:         "Built-in rule parameter",
+			input:        &LimitsTestStruct{Questions: []string{}, Responses: [][]int32{}},
+			expectErr:    require.Error,
+			expectBounds: []string{"min=1"},
+		},
+		// ----- test cases end ----- //
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateStruct(testCase.input)
+			testCase.expectErr(t, err)
+
+			if err == nil {
+				return
+			}
+
+			errs := err.(*ErrorValidation).Errors
+			require.Equal(t, len(testCase.expectBounds), len(errs), "error count mismatch: %v", errs)
+			for idx, field := range errs {
+				require.Equal(t, testCase.expectBounds[idx], field.Bounds, "bounds mismatch")
+			}
+		})
+	}
+}